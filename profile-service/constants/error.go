@@ -3,5 +3,109 @@ package constants
 import "errors"
 
 var (
-	ErrProfileNotFound = errors.New("profile not found")
+	ErrProfileNotFound          = errors.New("profile not found")
+	ErrIdempotencyKeyMismatch   = errors.New("idempotency key was already used with a different request")
+	ErrIdempotencyKeyInProgress = errors.New("a request with the idempotency key is still in progress")
+	ErrIdempotencyKeyNoUser     = errors.New("an idempotency key needs the X-User-Id header, keys are kept per user")
+	ErrProfileAlreadyExists     = errors.New("profile already exists")
+	ErrExternalIdConflict       = errors.New("external id is already used by another profile")
+	ErrBatchTooLarge            = errors.New("too many operations in batch")
+	ErrInvalidBatchOperation    = errors.New("invalid batch operation")
+	ErrBatchRolledBack          = errors.New("rolled back because another operation in the batch failed")
+	ErrBatchNotExecuted         = errors.New("not executed because another operation in the batch failed")
+	ErrUnknownGender            = errors.New("unknown gender, see GET /genders for the allowed values")
+	ErrDuplicateNameLocale      = errors.New("a profile can have one name per language")
+
+	ErrContactNotFound        = errors.New("contact not found")
+	ErrInvalidContactType     = errors.New("contact type must be email, phone or guardian")
+	ErrInvalidEmail           = errors.New("invalid email address")
+	ErrInvalidPhone           = errors.New("invalid phone number, expected E.164 format such as +66812345678")
+	ErrInvalidGuardianContact = errors.New("guardian contact must be an email address or a phone number in E.164 format")
+	ErrContactEmailConflict   = errors.New("email is already used by another contact")
+
+	ErrEducationNotFound  = errors.New("education entry not found")
+	ErrExperienceNotFound = errors.New("experience entry not found")
+	ErrInvalidDateRange   = errors.New("end date cannot be before start date")
+	ErrEducationOverlap   = errors.New("dates overlap another education entry of the profile")
+	ErrExperienceOverlap  = errors.New("dates overlap another experience entry of the profile")
+
+	ErrJobNotFound          = errors.New("job not found")
+	ErrJobFinished          = errors.New("job already finished")
+	ErrJobResultNotReady    = errors.New("job has no result")
+	ErrInvalidJobPayload    = errors.New("invalid job payload")
+	ErrJobLeaseLost         = errors.New("job is no longer held by this worker")
+	ErrJobCancelled         = errors.New("job was cancelled")
+	ErrJobAttemptsExhausted = errors.New("job stopped without finishing too many times")
+
+	ErrClassNotFound             = errors.New("class not found")
+	ErrUnknownClass              = errors.New("unknown class")
+	ErrInvalidClassCode          = errors.New("class code cannot be blank")
+	ErrClassCodeConflict         = errors.New("class code is already used by another class")
+	ErrClassFull                 = errors.New("class is full")
+	ErrClassCapacityTooLow       = errors.New("capacity is below the number of profiles in the class")
+	ErrClassHasProfiles          = errors.New("class still has profiles")
+	ErrClassHasEnrollments       = errors.New("class has enrollment history, which must be kept")
+	ErrInvalidPromotion          = errors.New("a class can only be promoted into another class")
+	ErrPromotionBeforeEnrollment = errors.New("the effective date is before the start date of an enrollment in the class")
+	ErrClassForbidden            = errors.New("only staff members can change classes")
+
+	ErrAttachmentNotFound        = errors.New("attachment not found")
+	ErrThumbnailNotFound         = errors.New("attachment has no thumbnail")
+	ErrEmptyAttachment           = errors.New("attachment file is empty")
+	ErrAttachmentTooLarge        = errors.New("attachment file is too large")
+	ErrUnsupportedAttachmentType = errors.New("file type is not allowed for this kind of attachment, photos must be JPEG, PNG or GIF images and documents may also be PDF")
+	ErrInvalidImage              = errors.New("image cannot be decoded or is too large to process")
+	ErrBlobNotFound              = errors.New("blob not found")
+
+	ErrCertificationNotFound     = errors.New("certification not found")
+	ErrInvalidCertificationDates = errors.New("expiry date cannot be before issue date")
+	ErrUnknownSkillCatalog       = errors.New("unknown skill catalog entry")
+	ErrUnknownAttachment         = errors.New("unknown attachment")
+	ErrNotCertificateAttachment  = errors.New("attachment is not a certificate")
+	ErrInvalidExpiryWindow       = errors.New("invalid period, expected a number of days or weeks up to ten years such as 30d or 2w")
+
+	ErrCustomAttributeNotFound      = errors.New("custom attribute not found")
+	ErrCustomAttributeAlreadyExists = errors.New("custom attribute already exists")
+	ErrInvalidCustomAttributeSchema = errors.New("invalid custom attribute schema")
+	ErrCustomAttributeInUse         = errors.New("profiles have values the custom attribute does not allow")
+	ErrInvalidCustomAttributes      = errors.New("invalid custom attributes")
+	ErrInvalidCustomAttributeFilter = errors.New("invalid custom attribute filter, expected an attribute key optionally followed by = and a value")
+
+	ErrInvalidTag         = errors.New("invalid tag, expected up to 50 letters, digits, colons, dots, underscores or hyphens")
+	ErrProfileTagNotFound = errors.New("profile does not have the tag")
+	ErrConflictingTags    = errors.New("a tag cannot be both added and removed")
+
+	ErrRelationshipNotFound      = errors.New("relationship not found")
+	ErrRelationshipAlreadyExists = errors.New("the profiles already have this relationship")
+	ErrInvalidRelationshipType   = errors.New("invalid relationship type, expected mentor, guardian or sibling")
+	ErrSelfRelationship          = errors.New("a profile cannot be related to itself")
+
+	ErrNoteNotFound  = errors.New("note not found")
+	ErrEmptyNote     = errors.New("note body cannot be empty")
+	ErrNoteForbidden = errors.New("the user is not allowed to change the note")
+	ErrNotStaff      = errors.New("only staff members can write notes")
+
+	ErrInvalidStatusTransition = errors.New("invalid status transition")
+	ErrProfileStatusChanged    = errors.New("the status of the profile changed meanwhile")
+	ErrInvalidInitialStatus    = errors.New("a new profile starts as a draft or active")
+	ErrEmptyTransitionReason   = errors.New("transition reason cannot be blank")
+
+	ErrChangeRequestNotFound  = errors.New("change request not found")
+	ErrChangeRequestResolved  = errors.New("change request was already reviewed")
+	ErrChangeRequestForbidden = errors.New("only staff members can review change requests")
+
+	ErrMergeSameProfile = errors.New("a profile cannot be merged into itself")
+	ErrMergeForbidden   = errors.New("only staff members can merge profiles")
+
+	ErrInvalidMatchRequest = errors.New("at least one required or optional skill with a name is needed")
+
+	ErrSkillCatalogNotFound       = errors.New("skill catalog entry not found")
+	ErrSkillNameConflict          = errors.New("skill name or alias is already used by another catalog entry")
+	ErrSkillCategoryNotFound      = errors.New("skill category not found")
+	ErrUnknownSkillCategory       = errors.New("unknown skill category")
+	ErrSkillCategoryCycle         = errors.New("skill category cannot be nested under itself")
+	ErrSkillReviewNotFound        = errors.New("skill review not found")
+	ErrSkillReviewResolved        = errors.New("skill review was already resolved")
+	ErrInvalidSkillReviewDecision = errors.New("invalid skill review resolution")
+	ErrInvalidSkillLevelFilter    = errors.New("invalid skill level filter, expected a skill name optionally followed by >= and a level from 1 to 5")
 )
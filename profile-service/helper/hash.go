@@ -0,0 +1,18 @@
+package helper
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+)
+
+// HashJSON returns the hex encoded SHA-256 of the JSON encoding of v.
+func HashJSON(v interface{}) (string, error) {
+	bu, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(bu)
+	return hex.EncodeToString(sum[:]), nil
+}
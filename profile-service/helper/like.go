@@ -0,0 +1,11 @@
+package helper
+
+import "strings"
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// ContainsPattern returns the LIKE pattern matching the values containing text.
+// The wildcards in text match themselves, the query needs ESCAPE '\'.
+func ContainsPattern(text string) string {
+	return "%" + likeEscaper.Replace(text) + "%"
+}
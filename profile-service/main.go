@@ -1,16 +1,64 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"github.com/jariwat/p_project/profile-service/helper"
 	"log"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	myMiddL "github.com/jariwat/p_project/profile-service/middleware"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/attachment"
+	"github.com/jariwat/p_project/profile-service/service/attachment/blobstore"
+	attachment_handler "github.com/jariwat/p_project/profile-service/service/attachment/handler"
+	attachment_repository "github.com/jariwat/p_project/profile-service/service/attachment/repository"
+	attachment_usecase "github.com/jariwat/p_project/profile-service/service/attachment/usecase"
+	"github.com/jariwat/p_project/profile-service/service/attribute"
+	attribute_handler "github.com/jariwat/p_project/profile-service/service/attribute/handler"
+	attribute_repository "github.com/jariwat/p_project/profile-service/service/attribute/repository"
+	attribute_usecase "github.com/jariwat/p_project/profile-service/service/attribute/usecase"
+	"github.com/jariwat/p_project/profile-service/service/certification"
+	certification_handler "github.com/jariwat/p_project/profile-service/service/certification/handler"
+	certification_repository "github.com/jariwat/p_project/profile-service/service/certification/repository"
+	certification_usecase "github.com/jariwat/p_project/profile-service/service/certification/usecase"
+	"github.com/jariwat/p_project/profile-service/service/class"
+	class_handler "github.com/jariwat/p_project/profile-service/service/class/handler"
+	class_repository "github.com/jariwat/p_project/profile-service/service/class/repository"
+	class_usecase "github.com/jariwat/p_project/profile-service/service/class/usecase"
+	"github.com/jariwat/p_project/profile-service/service/job"
+	job_handler "github.com/jariwat/p_project/profile-service/service/job/handler"
+	job_repository "github.com/jariwat/p_project/profile-service/service/job/repository"
+	job_usecase "github.com/jariwat/p_project/profile-service/service/job/usecase"
+	job_worker "github.com/jariwat/p_project/profile-service/service/job/worker"
+	"github.com/jariwat/p_project/profile-service/service/lifecycle"
+	lifecycle_handler "github.com/jariwat/p_project/profile-service/service/lifecycle/handler"
+	lifecycle_repository "github.com/jariwat/p_project/profile-service/service/lifecycle/repository"
+	lifecycle_usecase "github.com/jariwat/p_project/profile-service/service/lifecycle/usecase"
+	"github.com/jariwat/p_project/profile-service/service/note"
+	note_handler "github.com/jariwat/p_project/profile-service/service/note/handler"
+	note_repository "github.com/jariwat/p_project/profile-service/service/note/repository"
+	note_usecase "github.com/jariwat/p_project/profile-service/service/note/usecase"
+	"github.com/jariwat/p_project/profile-service/service/outbox"
 	"github.com/jariwat/p_project/profile-service/service/profile"
 	profile_repository "github.com/jariwat/p_project/profile-service/service/profile/repository"
 	profile_usecase "github.com/jariwat/p_project/profile-service/service/profile/usecase"
 	profile_handler "github.com/jariwat/p_project/profile-service/service/profile/handler"
+	"github.com/jariwat/p_project/profile-service/service/relationship"
+	relationship_handler "github.com/jariwat/p_project/profile-service/service/relationship/handler"
+	relationship_repository "github.com/jariwat/p_project/profile-service/service/relationship/repository"
+	relationship_usecase "github.com/jariwat/p_project/profile-service/service/relationship/usecase"
+	"github.com/jariwat/p_project/profile-service/service/skill"
+	skill_handler "github.com/jariwat/p_project/profile-service/service/skill/handler"
+	skill_repository "github.com/jariwat/p_project/profile-service/service/skill/repository"
+	skill_usecase "github.com/jariwat/p_project/profile-service/service/skill/usecase"
+	"github.com/jariwat/p_project/profile-service/service/tag"
+	tag_handler "github.com/jariwat/p_project/profile-service/service/tag/handler"
+	tag_repository "github.com/jariwat/p_project/profile-service/service/tag/repository"
+	tag_usecase "github.com/jariwat/p_project/profile-service/service/tag/usecase"
 
 	"github.com/gin-gonic/gin"
 	"gorm.io/driver/postgres"
@@ -27,8 +75,46 @@ var (
 	DB_USER     = helper.GetENV("DB_USER", "postgres")
 	DB_PORT     = helper.GetENV("DB_PORT", "5432")
 	DB_PASSWORD = helper.GetENV("DB_PASSWORD", "postgres")
+
+	IDEMPOTENCY_KEY_TTL = helper.GetENV("IDEMPOTENCY_KEY_TTL", "24h")
+
+	BATCH_MAX_OPERATIONS = helper.GetENV("BATCH_MAX_OPERATIONS", "1000")
+	BATCH_MAX_BODY_BYTES = helper.GetENV("BATCH_MAX_BODY_BYTES", "5242880")
+
+	JOB_WORKERS       = helper.GetENV("JOB_WORKERS", "4")
+	JOB_POLL_INTERVAL = helper.GetENV("JOB_POLL_INTERVAL", "2s")
+	JOB_LEASE_TIMEOUT = helper.GetENV("JOB_LEASE_TIMEOUT", "1m")
+
+	SKILL_SUGGEST_CACHE_TTL = helper.GetENV("SKILL_SUGGEST_CACHE_TTL", "1m")
+
+	ATTACHMENT_MAX_BYTES      = helper.GetENV("ATTACHMENT_MAX_BYTES", "10485760")
+	ATTACHMENT_PURGE_INTERVAL = helper.GetENV("ATTACHMENT_PURGE_INTERVAL", "1m")
+
+	// ATTACHMENT_STORE is local to keep files under ATTACHMENT_LOCAL_DIR, or s3 for an S3-compatible store such as MinIO
+	ATTACHMENT_STORE         = helper.GetENV("ATTACHMENT_STORE", "local")
+	ATTACHMENT_LOCAL_DIR     = helper.GetENV("ATTACHMENT_LOCAL_DIR", "./data/attachments")
+	ATTACHMENT_S3_ENDPOINT   = helper.GetENV("ATTACHMENT_S3_ENDPOINT", "http://localhost:9000")
+	ATTACHMENT_S3_REGION     = helper.GetENV("ATTACHMENT_S3_REGION", "us-east-1")
+	ATTACHMENT_S3_BUCKET     = helper.GetENV("ATTACHMENT_S3_BUCKET", "attachments")
+	ATTACHMENT_S3_ACCESS_KEY = helper.GetENV("ATTACHMENT_S3_ACCESS_KEY", "")
+	ATTACHMENT_S3_SECRET_KEY = helper.GetENV("ATTACHMENT_S3_SECRET_KEY", "")
+
+	// CERTIFICATION_REMINDER_DAYS lists how many days before expiry reminders are sent, comma separated
+	CERTIFICATION_REMINDER_DAYS     = helper.GetENV("CERTIFICATION_REMINDER_DAYS", "30,7,1")
+	CERTIFICATION_REMINDER_INTERVAL = helper.GetENV("CERTIFICATION_REMINDER_INTERVAL", "1h")
+	// CERTIFICATION_WEBHOOK_URL receives the reminders, they are only logged when it is empty
+	CERTIFICATION_WEBHOOK_URL    = helper.GetENV("CERTIFICATION_WEBHOOK_URL", "")
+	CERTIFICATION_WEBHOOK_SECRET = helper.GetENV("CERTIFICATION_WEBHOOK_SECRET", "")
+
+	PROFILE_EVENT_INTERVAL = helper.GetENV("PROFILE_EVENT_INTERVAL", "1m")
+	// PROFILE_EVENT_WEBHOOK_URL receives the lifecycle events and reviewed change requests, they are only logged when it is empty
+	PROFILE_EVENT_WEBHOOK_URL    = helper.GetENV("PROFILE_EVENT_WEBHOOK_URL", "")
+	PROFILE_EVENT_WEBHOOK_SECRET = helper.GetENV("PROFILE_EVENT_WEBHOOK_SECRET", "")
 )
 
+// multipartOverhead is allowed on top of ATTACHMENT_MAX_BYTES for the form fields and part headers of an upload.
+const multipartOverhead = 1 << 20
+
 
 func runMigrations() {
 	dbURL := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable", DB_USER, DB_PASSWORD, DB_HOST, DB_PORT, DB_NAME)
@@ -61,6 +147,128 @@ func gormDB() *gorm.DB {
 	return db
 }
 
+func purgeExpiredIdempotencyKeys(profileUsecase profile.ProfileUsecase) {
+	ticker := time.NewTicker(time.Hour)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := profileUsecase.PurgeExpiredIdempotencyKeys(); err != nil {
+			log.Println("Failed to purge expired idempotency keys:", err)
+		}
+	}
+}
+
+func purgeDeletedBlobs(attachmentUsecase attachment.AttachmentUsecase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := attachmentUsecase.PurgeDeletedBlobs(); err != nil {
+			log.Println("Failed to purge deleted blobs:", err)
+		}
+	}
+}
+
+func remindExpiringCertifications(certificationUsecase certification.CertificationUsecase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := certificationUsecase.RemindExpiringCertifications(); err != nil {
+			log.Println("Failed to create certification reminders:", err)
+		}
+		if err := certificationUsecase.DeliverCertificationEvents(); err != nil {
+			log.Println("Failed to deliver certification events:", err)
+		}
+	}
+}
+
+func certificationReminderDays() []int {
+	var days []int
+	for _, value := range strings.Split(CERTIFICATION_REMINDER_DAYS, ",") {
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+		day, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || day < 0 {
+			log.Fatal("Invalid CERTIFICATION_REMINDER_DAYS, expected days such as 30,7,1:", CERTIFICATION_REMINDER_DAYS)
+		}
+		days = append(days, day)
+	}
+
+	return days
+}
+
+func certificationNotifier() certification.EventNotifier {
+	if CERTIFICATION_WEBHOOK_URL == "" {
+		return outbox.NewLogNotifier[*models.CertificationEvent]()
+	}
+
+	webhook, err := outbox.NewWebhookNotifier[*models.CertificationEvent](outbox.WebhookConfig{
+		URL:     CERTIFICATION_WEBHOOK_URL,
+		Secret:  CERTIFICATION_WEBHOOK_SECRET,
+		Timeout: 10 * time.Second,
+	})
+	if err != nil {
+		log.Fatal("Invalid CERTIFICATION_WEBHOOK_URL:", err)
+	}
+
+	return webhook
+}
+
+func deliverProfileEvents(lifecycleUsecase lifecycle.LifecycleUsecase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := lifecycleUsecase.DeliverProfileEvents(); err != nil {
+			log.Println("Failed to deliver profile events:", err)
+		}
+	}
+}
+
+func profileEventNotifier() lifecycle.EventNotifier {
+	if PROFILE_EVENT_WEBHOOK_URL == "" {
+		return outbox.NewLogNotifier[*models.ProfileEvent]()
+	}
+
+	webhook, err := outbox.NewWebhookNotifier[*models.ProfileEvent](outbox.WebhookConfig{
+		URL:     PROFILE_EVENT_WEBHOOK_URL,
+		Secret:  PROFILE_EVENT_WEBHOOK_SECRET,
+		Timeout: 10 * time.Second,
+	})
+	if err != nil {
+		log.Fatal("Invalid PROFILE_EVENT_WEBHOOK_URL:", err)
+	}
+
+	return webhook
+}
+
+func blobStore() attachment.BlobStore {
+	var store attachment.BlobStore
+	var err error
+	switch ATTACHMENT_STORE {
+	case "local":
+		store, err = blobstore.NewLocalBlobStore(ATTACHMENT_LOCAL_DIR)
+	case "s3":
+		store, err = blobstore.NewS3BlobStore(blobstore.S3Config{
+			Endpoint:  ATTACHMENT_S3_ENDPOINT,
+			Region:    ATTACHMENT_S3_REGION,
+			Bucket:    ATTACHMENT_S3_BUCKET,
+			AccessKey: ATTACHMENT_S3_ACCESS_KEY,
+			SecretKey: ATTACHMENT_S3_SECRET_KEY,
+			Timeout:   time.Minute,
+		})
+	default:
+		log.Fatal("Invalid ATTACHMENT_STORE, expected local or s3:", ATTACHMENT_STORE)
+	}
+	if err != nil {
+		log.Fatal("Failed to set up the attachment store:", err)
+	}
+
+	return store
+}
+
 func main() {
 	psqlClient := gormDB()
 
@@ -79,8 +287,20 @@ func main() {
 		return
 	})
 
+	batchMaxBodyBytes, err := strconv.ParseInt(BATCH_MAX_BODY_BYTES, 10, 64)
+	if err != nil {
+		log.Fatal("Invalid BATCH_MAX_BODY_BYTES:", err)
+	}
+	g.Use(myMiddL.LimitRequestBody(batchMaxBodyBytes, "/profiles/batch"))
+
+	attachmentMaxBytes, err := strconv.ParseInt(ATTACHMENT_MAX_BYTES, 10, 64)
+	if err != nil {
+		log.Fatal("Invalid ATTACHMENT_MAX_BYTES:", err)
+	}
+	g.Use(myMiddL.LimitRequestBody(attachmentMaxBytes+multipartOverhead, "/profile/:id/attachments"))
+
 	// init openapi middleware here
-	mw, err := myMiddL.CreateOpenapiMiddleware(profile.GetSwagger)
+	mw, err := myMiddL.CreateOpenapiMiddleware(profile.GetSwagger, job.GetSwagger, skill.GetSwagger, class.GetSwagger, attachment.GetSwagger, certification.GetSwagger, attribute.GetSwagger, tag.GetSwagger, relationship.GetSwagger, note.GetSwagger, lifecycle.GetSwagger)
 	if err != nil {
 		panic(err)
 	}
@@ -88,15 +308,104 @@ func main() {
 
 	/* repository */
 	profileRepo := profile_repository.NewPsqlProfileRepository(psqlClient)
+	jobRepo := job_repository.NewPsqlJobRepository(psqlClient)
+	skillRepo := skill_repository.NewPsqlSkillRepository(psqlClient)
+	classRepo := class_repository.NewPsqlClassRepository(psqlClient)
+	attachmentRepo := attachment_repository.NewPsqlAttachmentRepository(psqlClient)
+	certificationRepo := certification_repository.NewPsqlCertificationRepository(psqlClient)
+	attributeRepo := attribute_repository.NewPsqlAttributeRepository(psqlClient)
+	tagRepo := tag_repository.NewPsqlTagRepository(psqlClient)
+	relationshipRepo := relationship_repository.NewPsqlRelationshipRepository(psqlClient)
+	noteRepo := note_repository.NewPsqlNoteRepository(psqlClient)
+	lifecycleRepo := lifecycle_repository.NewPsqlLifecycleRepository(psqlClient)
 
 	/* usecase */
-	profileUsecase := profile_usecase.NewProfileUsecase(profileRepo)
+	skillSuggestCacheTTL, err := time.ParseDuration(SKILL_SUGGEST_CACHE_TTL)
+	if err != nil {
+		log.Fatal("Invalid SKILL_SUGGEST_CACHE_TTL:", err)
+	}
+	skillUsecase := skill_usecase.NewSkillUsecase(skillRepo, skillSuggestCacheTTL)
+	classUsecase := class_usecase.NewClassUsecase(classRepo)
+	attributeUsecase := attribute_usecase.NewAttributeUsecase(attributeRepo)
+
+	idempotencyKeyTTL, err := time.ParseDuration(IDEMPOTENCY_KEY_TTL)
+	if err != nil {
+		log.Fatal("Invalid IDEMPOTENCY_KEY_TTL:", err)
+	}
+	batchMaxOperations, err := strconv.Atoi(BATCH_MAX_OPERATIONS)
+	if err != nil {
+		log.Fatal("Invalid BATCH_MAX_OPERATIONS:", err)
+	}
+	profileUsecase := profile_usecase.NewProfileUsecase(profileRepo, skillUsecase, classUsecase, attributeUsecase, idempotencyKeyTTL, batchMaxOperations)
+
+	jobLeaseTimeout, err := time.ParseDuration(JOB_LEASE_TIMEOUT)
+	if err != nil {
+		log.Fatal("Invalid JOB_LEASE_TIMEOUT:", err)
+	}
+	jobUsecase := job_usecase.NewJobUsecase(jobRepo, profileUsecase, jobLeaseTimeout)
+	attachmentUsecase := attachment_usecase.NewAttachmentUsecase(attachmentRepo, profileUsecase, blobStore(), attachmentMaxBytes)
+	certificationUsecase := certification_usecase.NewCertificationUsecase(certificationRepo, profileUsecase, skillUsecase, attachmentUsecase, certificationNotifier(), certificationReminderDays())
+	tagUsecase := tag_usecase.NewTagUsecase(tagRepo, profileUsecase)
+	relationshipUsecase := relationship_usecase.NewRelationshipUsecase(relationshipRepo, profileUsecase)
+	noteUsecase := note_usecase.NewNoteUsecase(noteRepo, profileUsecase)
+	lifecycleUsecase := lifecycle_usecase.NewLifecycleUsecase(lifecycleRepo, profileUsecase, profileEventNotifier())
+
+	/* background */
+	go purgeExpiredIdempotencyKeys(profileUsecase)
+
+	attachmentPurgeInterval, err := time.ParseDuration(ATTACHMENT_PURGE_INTERVAL)
+	if err != nil {
+		log.Fatal("Invalid ATTACHMENT_PURGE_INTERVAL:", err)
+	}
+	go purgeDeletedBlobs(attachmentUsecase, attachmentPurgeInterval)
+
+	certificationReminderInterval, err := time.ParseDuration(CERTIFICATION_REMINDER_INTERVAL)
+	if err != nil {
+		log.Fatal("Invalid CERTIFICATION_REMINDER_INTERVAL:", err)
+	}
+	go remindExpiringCertifications(certificationUsecase, certificationReminderInterval)
+
+	profileEventInterval, err := time.ParseDuration(PROFILE_EVENT_INTERVAL)
+	if err != nil {
+		log.Fatal("Invalid PROFILE_EVENT_INTERVAL:", err)
+	}
+	go deliverProfileEvents(lifecycleUsecase, profileEventInterval)
+
+	jobWorkers, err := strconv.Atoi(JOB_WORKERS)
+	if err != nil {
+		log.Fatal("Invalid JOB_WORKERS:", err)
+	}
+	jobPollInterval, err := time.ParseDuration(JOB_POLL_INTERVAL)
+	if err != nil {
+		log.Fatal("Invalid JOB_POLL_INTERVAL:", err)
+	}
+	job_worker.NewWorkerPool(jobUsecase, jobWorkers, jobPollInterval).Start(context.Background())
 
 	/* handler */
 	profileHandler := profile_handler.NewProfileHandler(profileUsecase)
+	jobHandler := job_handler.NewJobHandler(jobUsecase)
+	skillHandler := skill_handler.NewSkillHandler(skillUsecase)
+	classHandler := class_handler.NewClassHandler(classUsecase)
+	attachmentHandler := attachment_handler.NewAttachmentHandler(attachmentUsecase)
+	certificationHandler := certification_handler.NewCertificationHandler(certificationUsecase)
+	attributeHandler := attribute_handler.NewAttributeHandler(attributeUsecase)
+	tagHandler := tag_handler.NewTagHandler(tagUsecase)
+	relationshipHandler := relationship_handler.NewRelationshipHandler(relationshipUsecase)
+	noteHandler := note_handler.NewNoteHandler(noteUsecase)
+	lifecycleHandler := lifecycle_handler.NewLifecycleHandler(lifecycleUsecase)
 
 	/* inject route */
 	profile.RegisterHandlers(g, profileHandler)
+	job.RegisterHandlers(g, jobHandler)
+	skill.RegisterHandlers(g, skillHandler)
+	class.RegisterHandlers(g, classHandler)
+	attachment.RegisterHandlers(g, attachmentHandler)
+	certification.RegisterHandlers(g, certificationHandler)
+	attribute.RegisterHandlers(g, attributeHandler)
+	tag.RegisterHandlers(g, tagHandler)
+	relationship.RegisterHandlers(g, relationshipHandler)
+	note.RegisterHandlers(g, noteHandler)
+	lifecycle.RegisterHandlers(g, lifecycleHandler)
 
 	/* serve */
 	port := fmt.Sprintf(":%s", APP_PORT)
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// LimitRequestBody rejects requests to the given routes whose body is larger than maxBytes.
+// It has to run before the openapi middleware, which reads the whole body.
+func LimitRequestBody(maxBytes int64, routes ...string) gin.HandlerFunc {
+	limited := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		limited[route] = true
+	}
+
+	return func(c *gin.Context) {
+		if !limited[c.FullPath()] {
+			c.Next()
+			return
+		}
+
+		if c.Request.ContentLength > maxBytes {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "request body too large"})
+			c.Abort()
+			return
+		}
+
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxBytes)
+		c.Next()
+	}
+}
@@ -36,6 +36,7 @@ func CreateOpenapiMiddleware(
 
 	return func(c *gin.Context) {
 		var matched bool
+		var invalid error
 
 		for _, r := range routersList {
 			route, pathParams, err := r.FindRoute(c.Request)
@@ -50,11 +51,23 @@ func CreateOpenapiMiddleware(
 				Route:      route,
 			}
 
-			if err := openapi3filter.ValidateRequest(c.Request.Context(), reqValidation); err == nil {
+			err = openapi3filter.ValidateRequest(c.Request.Context(), reqValidation)
+			if err == nil {
 				// ผ่าน
 				matched = true
 				break
 			}
+			if invalid == nil {
+				invalid = err
+			}
+		}
+
+		// the operation exists but the request does not satisfy it, such as a
+		// missing required header, so the caller gets the reason instead of a 404
+		if !matched && invalid != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": invalid.Error()})
+			c.Abort()
+			return
 		}
 
 		if !matched {
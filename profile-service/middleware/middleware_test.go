@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jariwat/p_project/profile-service/service/profile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newOpenapiRouter(t *testing.T) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	mw, err := CreateOpenapiMiddleware(profile.GetSwagger)
+	require.NoError(t, err)
+
+	r := gin.New()
+	r.Use(mw)
+	r.PUT("/profile/:id", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	return r
+}
+
+func putProfile(r *gin.Engine, headers map[string]string) *httptest.ResponseRecorder {
+	body := `{"first_name":"SeiA","last_name":"Phanes","gender":"MALE","skills":[]}`
+	req, _ := http.NewRequest(http.MethodPut, "/profile/4b1c5c3e-2f0a-4c55-9a57-3f1f4bb0c3a1", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestCreateOpenapiMiddleware_MissingHeader(t *testing.T) {
+	r := newOpenapiRouter(t)
+
+	w := putProfile(r, map[string]string{"X-User-Role": "staff"})
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	var resp map[string]string
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Contains(t, resp["error"], "X-User-Id")
+}
+
+func TestCreateOpenapiMiddleware_Valid(t *testing.T) {
+	r := newOpenapiRouter(t)
+
+	w := putProfile(r, map[string]string{"X-User-Id": "teacher-42", "X-User-Role": "staff"})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestCreateOpenapiMiddleware_UnknownOperation(t *testing.T) {
+	r := newOpenapiRouter(t)
+
+	req, _ := http.NewRequest(http.MethodGet, "/unknown", nil)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
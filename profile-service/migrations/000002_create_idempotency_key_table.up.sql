@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS idempotency_key (
+  "user_id" VARCHAR(255) NOT NULL,
+  "key" VARCHAR(255) NOT NULL,
+  "request_hash" VARCHAR(64) NOT NULL,
+  "response_status" INTEGER NOT NULL,
+  "response_body" BYTEA,
+  "created_at" TIMESTAMP,
+  "expires_at" TIMESTAMP,
+  PRIMARY KEY ("user_id", "key")
+);
+
+CREATE INDEX idx_idempotency_key_expires_at ON idempotency_key(expires_at);
@@ -0,0 +1,3 @@
+ALTER TABLE profile ADD COLUMN IF NOT EXISTS "external_id" VARCHAR(255);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_profile_external_id ON profile(external_id);
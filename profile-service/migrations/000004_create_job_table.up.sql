@@ -0,0 +1,35 @@
+CREATE TYPE JOB_TYPE AS ENUM (
+  'import',
+  'export',
+  'purge'
+);
+
+CREATE TYPE JOB_STATUS AS ENUM (
+  'pending',
+  'running',
+  'succeeded',
+  'failed',
+  'cancelled'
+);
+
+CREATE TABLE IF NOT EXISTS job (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "type" JOB_TYPE NOT NULL,
+  "status" JOB_STATUS NOT NULL DEFAULT 'pending',
+  "payload" JSONB,
+  "total" INTEGER NOT NULL DEFAULT 0,
+  "processed" INTEGER NOT NULL DEFAULT 0,
+  "failed" INTEGER NOT NULL DEFAULT 0,
+  "errors" JSONB,
+  "result" JSONB,
+  "cancel_requested" BOOLEAN NOT NULL DEFAULT FALSE,
+  "attempts" INTEGER NOT NULL DEFAULT 0,
+  "locked_by" VARCHAR(255),
+  "heartbeat_at" TIMESTAMP,
+  "created_at" TIMESTAMP,
+  "started_at" TIMESTAMP,
+  "finished_at" TIMESTAMP,
+  "updated_at" TIMESTAMP
+);
+
+CREATE INDEX idx_job_status_created_at ON job(status, created_at);
@@ -0,0 +1,17 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_profile_normalized_name ON profile
+USING GIN (LOWER(REPLACE(COALESCE(first_name, '') || COALESCE(last_name, ''), ' ', '')) gin_trgm_ops);
+
+CREATE TABLE IF NOT EXISTS profile_merge (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "survivor_id" UUID NOT NULL,
+  "merged_id" UUID NOT NULL,
+  "fields" JSONB,
+  "merged_snapshot" JSONB,
+  "moved_skills" INTEGER NOT NULL DEFAULT 0,
+  "created_at" TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_profile_merge_merged_id ON profile_merge(merged_id);
+CREATE INDEX IF NOT EXISTS idx_profile_merge_survivor_id ON profile_merge(survivor_id);
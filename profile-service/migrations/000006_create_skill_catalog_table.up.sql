@@ -0,0 +1,56 @@
+CREATE TYPE SKILL_REVIEW_STATUS AS ENUM (
+  'pending',
+  'approved',
+  'rejected'
+);
+
+CREATE TABLE IF NOT EXISTS skill_category (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "name" VARCHAR(255) NOT NULL,
+  "parent_id" UUID REFERENCES skill_category ("id") ON DELETE SET NULL,
+  "created_at" TIMESTAMP,
+  "updated_at" TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_skill_category_parent_id ON skill_category(parent_id);
+
+CREATE TABLE IF NOT EXISTS skill_catalog (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "name" VARCHAR(255) NOT NULL,
+  "normalized_name" VARCHAR(255) NOT NULL,
+  "category_id" UUID REFERENCES skill_category ("id") ON DELETE SET NULL,
+  "created_at" TIMESTAMP,
+  "updated_at" TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_skill_catalog_normalized_name ON skill_catalog(normalized_name);
+CREATE INDEX IF NOT EXISTS idx_skill_catalog_category_id ON skill_catalog(category_id);
+
+CREATE TABLE IF NOT EXISTS skill_alias (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "catalog_id" UUID NOT NULL REFERENCES skill_catalog ("id") ON DELETE CASCADE,
+  "alias" VARCHAR(255) NOT NULL,
+  "normalized_alias" VARCHAR(255) NOT NULL,
+  "created_at" TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_skill_alias_normalized_alias ON skill_alias(normalized_alias);
+CREATE INDEX IF NOT EXISTS idx_skill_alias_catalog_id ON skill_alias(catalog_id);
+
+CREATE TABLE IF NOT EXISTS skill_review (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "name" VARCHAR(255) NOT NULL,
+  "normalized_name" VARCHAR(255) NOT NULL,
+  "occurrences" INTEGER NOT NULL DEFAULT 1,
+  "status" SKILL_REVIEW_STATUS NOT NULL DEFAULT 'pending',
+  "catalog_id" UUID REFERENCES skill_catalog ("id") ON DELETE SET NULL,
+  "created_at" TIMESTAMP,
+  "updated_at" TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_skill_review_normalized_name ON skill_review(normalized_name);
+CREATE INDEX IF NOT EXISTS idx_skill_review_status ON skill_review(status);
+
+ALTER TABLE skill ADD COLUMN IF NOT EXISTS "catalog_id" UUID REFERENCES skill_catalog ("id") ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_skill_catalog_id ON skill(catalog_id);
@@ -0,0 +1,5 @@
+ALTER TABLE skill ADD COLUMN IF NOT EXISTS "proficiency" SMALLINT CHECK ("proficiency" BETWEEN 1 AND 5);
+ALTER TABLE skill ADD COLUMN IF NOT EXISTS "years_experience" NUMERIC(4, 1) CHECK ("years_experience" >= 0);
+ALTER TABLE skill ADD COLUMN IF NOT EXISTS "last_used" DATE;
+
+CREATE INDEX IF NOT EXISTS idx_skill_catalog_id_proficiency ON skill(catalog_id, proficiency);
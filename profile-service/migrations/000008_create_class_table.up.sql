@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS class (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "code" VARCHAR(255) NOT NULL,
+  "normalized_code" VARCHAR(255) NOT NULL,
+  "name" VARCHAR(255) NOT NULL,
+  "homeroom_teacher" VARCHAR(255),
+  "academic_year" VARCHAR(20),
+  "capacity" INTEGER CHECK ("capacity" >= 0),
+  "created_at" TIMESTAMP,
+  "updated_at" TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_class_normalized_code ON class(normalized_code);
+CREATE INDEX IF NOT EXISTS idx_class_academic_year ON class(academic_year);
+
+-- one class per class string, ignoring case and spacing, spelled like most of its profiles
+INSERT INTO class ("code", "normalized_code", "name", "created_at", "updated_at")
+SELECT DISTINCT ON (normalized_code) code, normalized_code, code, NOW(), NOW()
+FROM (
+  SELECT REGEXP_REPLACE(TRIM(class), '\s+', ' ', 'g') AS code,
+    LOWER(REGEXP_REPLACE(TRIM(class), '\s+', ' ', 'g')) AS normalized_code,
+    COUNT(*) AS profiles
+  FROM profile
+  WHERE TRIM(COALESCE(class, '')) <> ''
+  GROUP BY 1, 2
+) spelling
+ORDER BY normalized_code, profiles DESC, code
+ON CONFLICT (normalized_code) DO NOTHING;
+
+ALTER TABLE profile ADD COLUMN IF NOT EXISTS "class_id" UUID REFERENCES class ("id") ON DELETE RESTRICT;
+
+CREATE INDEX IF NOT EXISTS idx_profile_class_id ON profile(class_id);
+
+-- profile.class is kept as the code of the class so lists and stats need no join
+UPDATE profile SET class_id = class.id, class = class.code
+FROM class
+WHERE LOWER(REGEXP_REPLACE(TRIM(profile.class), '\s+', ' ', 'g')) = class.normalized_code;
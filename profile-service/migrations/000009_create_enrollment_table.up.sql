@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS enrollment (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "profile_id" UUID NOT NULL REFERENCES profile ("id") ON DELETE CASCADE,
+  "class_id" UUID NOT NULL REFERENCES class ("id") ON DELETE RESTRICT,
+  "academic_year" VARCHAR(20),
+  "start_date" DATE NOT NULL,
+  "end_date" DATE,
+  "created_at" TIMESTAMP,
+  "updated_at" TIMESTAMP,
+  CONSTRAINT enrollment_date_range CHECK ("end_date" IS NULL OR "end_date" >= "start_date")
+);
+
+-- an enrollment without end date is the current class of the profile
+CREATE UNIQUE INDEX IF NOT EXISTS idx_enrollment_current_profile_id ON enrollment(profile_id) WHERE end_date IS NULL;
+CREATE INDEX IF NOT EXISTS idx_enrollment_current_class_id ON enrollment(class_id) WHERE end_date IS NULL;
+CREATE INDEX IF NOT EXISTS idx_enrollment_profile_id_start_date ON enrollment(profile_id, start_date);
+
+-- profile.class_id stays as a copy of the current enrollment, written in the same transaction
+INSERT INTO enrollment ("profile_id", "class_id", "academic_year", "start_date", "created_at", "updated_at")
+SELECT profile.id, profile.class_id, class.academic_year, COALESCE(profile.created_at::DATE, CURRENT_DATE), NOW(), NOW()
+FROM profile
+JOIN class ON class.id = profile.class_id
+WHERE NOT EXISTS (SELECT 1 FROM enrollment WHERE enrollment.profile_id = profile.id AND enrollment.end_date IS NULL);
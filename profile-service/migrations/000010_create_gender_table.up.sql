@@ -0,0 +1,28 @@
+-- the allowed genders are rows rather than an enum, a new value is one INSERT
+CREATE TABLE IF NOT EXISTS gender (
+  "code" VARCHAR(32) PRIMARY KEY CHECK ("code" ~ '^[A-Z][A-Z0-9_]*$'),
+  "label" VARCHAR(100) NOT NULL,
+  "sort_order" INTEGER NOT NULL DEFAULT 0,
+  "created_at" TIMESTAMP,
+  "updated_at" TIMESTAMP
+);
+
+INSERT INTO gender ("code", "label", "sort_order", "created_at", "updated_at") VALUES
+  ('MALE', 'Male', 1, NOW(), NOW()),
+  ('FEMALE', 'Female', 2, NOW(), NOW()),
+  ('NON_BINARY', 'Non-binary', 3, NOW(), NOW()),
+  ('UNSPECIFIED', 'Prefer not to say', 4, NOW(), NOW())
+ON CONFLICT ("code") DO NOTHING;
+
+ALTER TABLE profile ALTER COLUMN "gender" TYPE VARCHAR(32) USING "gender"::TEXT;
+UPDATE profile SET "gender" = 'UNSPECIFIED' WHERE "gender" IS NULL;
+ALTER TABLE profile
+ADD CONSTRAINT fk_profile_gender
+FOREIGN KEY ("gender")
+REFERENCES gender ("code")
+ON UPDATE CASCADE;
+DROP TYPE IF EXISTS GENDER;
+
+CREATE INDEX IF NOT EXISTS idx_profile_gender ON profile(gender);
+
+ALTER TABLE profile ADD COLUMN IF NOT EXISTS "pronouns" VARCHAR(50);
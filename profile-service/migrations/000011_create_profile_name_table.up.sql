@@ -0,0 +1,18 @@
+-- names sort by the rules of their language rather than the database locale
+CREATE COLLATION IF NOT EXISTS name_th (provider = icu, locale = 'th-TH');
+CREATE COLLATION IF NOT EXISTS name_en (provider = icu, locale = 'en-US');
+
+CREATE TABLE IF NOT EXISTS profile_name (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "profile_id" UUID NOT NULL REFERENCES profile ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+  "locale" VARCHAR(10) NOT NULL,
+  "first_name" VARCHAR(255) NOT NULL,
+  "middle_name" VARCHAR(255),
+  "last_name" VARCHAR(255) NOT NULL,
+  "created_at" TIMESTAMP,
+  "updated_at" TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_profile_name_profile_id_locale ON profile_name(profile_id, locale);
+CREATE INDEX IF NOT EXISTS idx_profile_name_normalized_name ON profile_name
+  USING GIN (LOWER(REPLACE(first_name || COALESCE(middle_name, '') || last_name, ' ', '')) gin_trgm_ops);
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS contact (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "profile_id" UUID NOT NULL REFERENCES profile ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+  "type" VARCHAR(20) NOT NULL CHECK ("type" IN ('email', 'phone', 'guardian')),
+  "value" VARCHAR(320) NOT NULL,
+  "normalized_value" VARCHAR(320) NOT NULL,
+  "name" VARCHAR(255),
+  "is_primary" BOOLEAN NOT NULL DEFAULT FALSE,
+  "verified_at" TIMESTAMP,
+  "created_at" TIMESTAMP,
+  "updated_at" TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_contact_profile_id ON contact(profile_id);
+-- an email belongs to one profile of the database, see Single tenant in README.md
+CREATE UNIQUE INDEX IF NOT EXISTS idx_contact_email ON contact(normalized_value) WHERE "type" = 'email';
+CREATE UNIQUE INDEX IF NOT EXISTS idx_contact_primary ON contact(profile_id, "type") WHERE is_primary;
@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS attachment (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "profile_id" UUID NOT NULL REFERENCES profile ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+  "kind" VARCHAR(20) NOT NULL CHECK ("kind" IN ('photo', 'certificate', 'transcript', 'other')),
+  "file_name" VARCHAR(255) NOT NULL,
+  "content_type" VARCHAR(100) NOT NULL,
+  "size" BIGINT NOT NULL,
+  "checksum" CHAR(64) NOT NULL,
+  "storage_key" VARCHAR(255) NOT NULL UNIQUE,
+  "thumbnail_key" VARCHAR(255),
+  "created_at" TIMESTAMP,
+  "updated_at" TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_attachment_profile_id_created_at ON attachment(profile_id, created_at);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_attachment_photo ON attachment(profile_id) WHERE "kind" = 'photo';
+
+-- blobs of deleted attachments wait here until the service removes them from
+-- the blob store, so rows deleted with their profile leave no orphan files
+CREATE TABLE IF NOT EXISTS blob_deletion (
+  "key" VARCHAR(255) PRIMARY KEY,
+  "created_at" TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE OR REPLACE FUNCTION queue_attachment_blob_deletion() RETURNS TRIGGER AS $$
+BEGIN
+  INSERT INTO blob_deletion ("key") VALUES (OLD.storage_key) ON CONFLICT DO NOTHING;
+  IF OLD.thumbnail_key IS NOT NULL THEN
+    INSERT INTO blob_deletion ("key") VALUES (OLD.thumbnail_key) ON CONFLICT DO NOTHING;
+  END IF;
+  RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS trg_attachment_blob_deletion ON attachment;
+CREATE TRIGGER trg_attachment_blob_deletion AFTER DELETE ON attachment
+  FOR EACH ROW EXECUTE FUNCTION queue_attachment_blob_deletion();
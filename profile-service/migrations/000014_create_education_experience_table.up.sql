@@ -0,0 +1,31 @@
+-- btree_gist lets the exclusion constraints compare profile_id with = next to the date ranges
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+CREATE TABLE IF NOT EXISTS education (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "profile_id" UUID NOT NULL REFERENCES profile ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+  "institution" VARCHAR(255) NOT NULL,
+  "degree" VARCHAR(255),
+  "start_date" DATE NOT NULL,
+  "end_date" DATE,
+  "created_at" TIMESTAMP,
+  "updated_at" TIMESTAMP,
+  CONSTRAINT education_date_range CHECK (end_date IS NULL OR end_date >= start_date),
+  -- end_date is the last day of study, an entry without it is still ongoing
+  CONSTRAINT education_no_overlap EXCLUDE USING gist (profile_id WITH =, daterange(start_date, end_date, '[]') WITH &&)
+);
+
+CREATE TABLE IF NOT EXISTS experience (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "profile_id" UUID NOT NULL REFERENCES profile ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+  "organization" VARCHAR(255) NOT NULL,
+  "role" VARCHAR(255) NOT NULL,
+  "description" TEXT,
+  "start_date" DATE NOT NULL,
+  "end_date" DATE,
+  "created_at" TIMESTAMP,
+  "updated_at" TIMESTAMP,
+  CONSTRAINT experience_date_range CHECK (end_date IS NULL OR end_date >= start_date),
+  -- end_date is the last day in the role, an entry without it is still ongoing
+  CONSTRAINT experience_no_overlap EXCLUDE USING gist (profile_id WITH =, daterange(start_date, end_date, '[]') WITH &&)
+);
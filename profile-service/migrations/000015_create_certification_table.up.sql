@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS certification (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "profile_id" UUID NOT NULL REFERENCES profile ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+  "catalog_id" UUID REFERENCES skill_catalog ("id") ON DELETE SET NULL,
+  "name" VARCHAR(255) NOT NULL,
+  "issuer" VARCHAR(255) NOT NULL,
+  "issue_date" DATE NOT NULL,
+  "expiry_date" DATE,
+  "attachment_id" UUID REFERENCES attachment ("id") ON DELETE SET NULL,
+  "created_at" TIMESTAMP,
+  "updated_at" TIMESTAMP,
+  CONSTRAINT certification_date_range CHECK (expiry_date IS NULL OR expiry_date >= issue_date)
+);
+
+CREATE INDEX IF NOT EXISTS idx_certification_profile_id ON certification(profile_id);
+CREATE INDEX IF NOT EXISTS idx_certification_expiry_date ON certification(expiry_date) WHERE expiry_date IS NOT NULL;
+
+-- certification_event is the outbox of expiry reminders, delivered to the webhook by the reminder loop
+CREATE TABLE IF NOT EXISTS certification_event (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "certification_id" UUID NOT NULL REFERENCES certification ("id") ON DELETE CASCADE,
+  "type" VARCHAR(50) NOT NULL CHECK ("type" IN ('certification.expiring', 'certification.expired')),
+  "days_before" INTEGER NOT NULL,
+  "expiry_date" DATE NOT NULL,
+  "payload" JSONB NOT NULL,
+  "attempts" INTEGER NOT NULL DEFAULT 0,
+  "next_attempt_at" TIMESTAMP,
+  "delivered_at" TIMESTAMP,
+  "last_error" TEXT,
+  "created_at" TIMESTAMP
+);
+
+-- a reminder is sent once per expiry date, so moving the date sends them again
+CREATE UNIQUE INDEX IF NOT EXISTS idx_certification_event_reminder ON certification_event(certification_id, expiry_date, "type", days_before);
+CREATE INDEX IF NOT EXISTS idx_certification_event_pending ON certification_event(next_attempt_at) WHERE delivered_at IS NULL;
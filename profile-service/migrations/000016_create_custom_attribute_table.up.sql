@@ -0,0 +1,13 @@
+-- the attributes defined apply to every profile of the database
+CREATE TABLE IF NOT EXISTS custom_attribute (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "key" VARCHAR(64) NOT NULL,
+  "schema" JSONB NOT NULL,
+  "required" BOOLEAN NOT NULL DEFAULT FALSE,
+  "created_at" TIMESTAMP,
+  "updated_at" TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_custom_attribute_key ON custom_attribute("key");
+
+ALTER TABLE profile ADD COLUMN IF NOT EXISTS custom_attributes JSONB NOT NULL DEFAULT '{}';
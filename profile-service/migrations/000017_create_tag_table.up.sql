@@ -0,0 +1,17 @@
+-- tags are stored normalized, so "Scholarship" and "scholarship" are the same tag
+CREATE TABLE IF NOT EXISTS tag (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "name" VARCHAR(50) NOT NULL,
+  "created_at" TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_tag_name ON tag("name");
+
+CREATE TABLE IF NOT EXISTS profile_tag (
+  "profile_id" UUID NOT NULL REFERENCES profile ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+  "tag_id" UUID NOT NULL REFERENCES tag ("id") ON DELETE CASCADE,
+  "created_at" TIMESTAMP,
+  PRIMARY KEY ("profile_id", "tag_id")
+);
+
+CREATE INDEX IF NOT EXISTS idx_profile_tag_tag_id ON profile_tag(tag_id);
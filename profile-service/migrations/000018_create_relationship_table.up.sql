@@ -0,0 +1,14 @@
+-- an asymmetric relationship reads from_profile is the <type> of to_profile, such as the mentor of a mentee,
+-- a symmetric one is stored once with the smaller id first so either order is the same edge
+CREATE TABLE IF NOT EXISTS relationship (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "type" VARCHAR(50) NOT NULL CHECK ("type" IN ('mentor', 'guardian', 'sibling')),
+  "from_profile_id" UUID NOT NULL REFERENCES profile ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+  "to_profile_id" UUID NOT NULL REFERENCES profile ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+  "created_at" TIMESTAMP,
+  CONSTRAINT relationship_no_self_link CHECK (from_profile_id <> to_profile_id),
+  CONSTRAINT relationship_symmetric_order CHECK ("type" <> 'sibling' OR from_profile_id < to_profile_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_relationship_edge ON relationship("type", from_profile_id, to_profile_id);
+CREATE INDEX IF NOT EXISTS idx_relationship_to_profile_id ON relationship(to_profile_id);
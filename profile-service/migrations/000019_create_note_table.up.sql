@@ -0,0 +1,26 @@
+-- author_id and edited_by are the users the gateway authenticated, who are not profiles
+CREATE TABLE IF NOT EXISTS note (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "profile_id" UUID NOT NULL REFERENCES profile ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+  "author_id" VARCHAR(255) NOT NULL,
+  "body" TEXT NOT NULL,
+  "visibility" VARCHAR(20) NOT NULL DEFAULT 'staff' CHECK ("visibility" IN ('private', 'staff', 'all')),
+  "created_at" TIMESTAMP,
+  "updated_at" TIMESTAMP,
+  "deleted_at" TIMESTAMP,
+  "deleted_by" VARCHAR(255)
+);
+
+CREATE INDEX IF NOT EXISTS idx_note_profile_id ON note(profile_id, created_at DESC) WHERE deleted_at IS NULL;
+
+-- every version of a note, the one it was written with included
+CREATE TABLE IF NOT EXISTS note_revision (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "note_id" UUID NOT NULL REFERENCES note ("id") ON DELETE CASCADE,
+  "body" TEXT NOT NULL,
+  "visibility" VARCHAR(20) NOT NULL,
+  "edited_by" VARCHAR(255) NOT NULL,
+  "created_at" TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_note_revision_note_id ON note_revision(note_id, created_at DESC);
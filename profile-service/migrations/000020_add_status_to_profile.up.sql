@@ -0,0 +1,32 @@
+-- profiles created before statuses existed are the ones in use, so they start active
+ALTER TABLE profile ADD COLUMN IF NOT EXISTS "status" VARCHAR(20) NOT NULL DEFAULT 'active'
+  CHECK ("status" IN ('draft', 'active', 'graduated', 'withdrawn', 'archived'));
+
+CREATE INDEX IF NOT EXISTS idx_profile_status ON profile(status);
+
+CREATE TABLE IF NOT EXISTS status_transition (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "profile_id" UUID NOT NULL REFERENCES profile ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+  "from_status" VARCHAR(20) NOT NULL,
+  "to_status" VARCHAR(20) NOT NULL,
+  "reason" TEXT NOT NULL,
+  "created_at" TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_status_transition_profile_id ON status_transition(profile_id, created_at DESC);
+
+-- profile_event is the outbox of lifecycle events, delivered to the webhook by the event loop. It keeps
+-- no reference to the profile so the events of a profile deleted meanwhile are still delivered.
+CREATE TABLE IF NOT EXISTS profile_event (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "profile_id" UUID NOT NULL,
+  "type" VARCHAR(50) NOT NULL,
+  "payload" JSONB NOT NULL,
+  "attempts" INTEGER NOT NULL DEFAULT 0,
+  "next_attempt_at" TIMESTAMP,
+  "delivered_at" TIMESTAMP,
+  "last_error" TEXT,
+  "created_at" TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_profile_event_pending ON profile_event(next_attempt_at) WHERE delivered_at IS NULL;
@@ -0,0 +1,19 @@
+-- change_request holds the changes to the first name, last name or class of a profile asked for by
+-- users other than staff members, applied to the profile once a staff member approves them.
+CREATE TABLE IF NOT EXISTS change_request (
+  "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+  "profile_id" UUID NOT NULL REFERENCES profile ("id") ON DELETE CASCADE ON UPDATE CASCADE,
+  "status" VARCHAR(20) NOT NULL DEFAULT 'pending'
+    CHECK ("status" IN ('pending', 'approved', 'rejected', 'superseded')),
+  "changes" JSONB NOT NULL,
+  "requested_by" VARCHAR(255) NOT NULL,
+  "reviewed_by" VARCHAR(255),
+  "review_reason" TEXT,
+  "created_at" TIMESTAMP,
+  "reviewed_at" TIMESTAMP
+);
+
+-- a later change of a profile supersedes the one still pending, so a profile has one pending change at most
+CREATE UNIQUE INDEX IF NOT EXISTS idx_change_request_pending ON change_request(profile_id) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_change_request_status ON change_request(status, created_at);
+CREATE INDEX IF NOT EXISTS idx_change_request_requested_by ON change_request(requested_by);
@@ -0,0 +1,97 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+type AttachmentKind string
+
+const (
+	AttachmentKindPhoto       AttachmentKind = "photo"
+	AttachmentKindCertificate AttachmentKind = "certificate"
+	AttachmentKindTranscript  AttachmentKind = "transcript"
+	AttachmentKindOther       AttachmentKind = "other"
+)
+
+// imageContentTypes are the sniffed types a thumbnail can be made from.
+var imageContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+// Attachment is the metadata of a file uploaded for a profile, the content
+// lives in the blob store under StorageKey.
+type Attachment struct {
+	ID           *uuid.UUID     `json:"id"`
+	ProfileID    *uuid.UUID     `json:"-"`
+	Kind         AttachmentKind `json:"kind"`
+	FileName     string         `json:"file_name"`
+	ContentType  string         `json:"content_type"`
+	Size         int64          `json:"size"`
+	Checksum     string         `json:"checksum"`
+	StorageKey   string         `json:"-"`
+	ThumbnailKey *string        `json:"-"`
+	CreatedAt    *time.Time     `json:"created_at"`
+	UpdatedAt    *time.Time     `json:"updated_at"`
+}
+
+func (Attachment) TableName() string {
+	return "attachment"
+}
+
+func (a *Attachment) GenUUID() {
+	id, _ := uuid.NewV4()
+	a.ID = &id
+}
+
+func (a *Attachment) SetCreatedAt() {
+	now := time.Now()
+	a.CreatedAt = &now
+}
+
+func (a *Attachment) SetUpdatedAt() {
+	now := time.Now()
+	a.UpdatedAt = &now
+}
+
+// AllowsContentType reports whether a file of contentType can be attached as
+// kind: images for a photo, images and PDF documents for the other kinds.
+func (k AttachmentKind) AllowsContentType(contentType string) bool {
+	if k == AttachmentKindPhoto {
+		return imageContentTypes[contentType]
+	}
+
+	return imageContentTypes[contentType] || contentType == "application/pdf"
+}
+
+// IsImage reports whether a thumbnail can be made from the attachment.
+func (a *Attachment) IsImage() bool {
+	return imageContentTypes[a.ContentType]
+}
+
+// MarshalJSON adds the derived has_thumbnail field to the API representation.
+func (a Attachment) MarshalJSON() ([]byte, error) {
+	type attachment Attachment
+	return json.Marshal(struct {
+		attachment
+		HasThumbnail bool `json:"has_thumbnail"`
+	}{
+		attachment:   attachment(a),
+		HasThumbnail: a.ThumbnailKey != nil,
+	})
+}
+
+// BlobDeletion is a blob left behind by a deleted attachment, queued by a
+// trigger on the attachment table until it is removed from the blob store.
+type BlobDeletion struct {
+	Key       string     `gorm:"primaryKey"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+func (BlobDeletion) TableName() string {
+	return "blob_deletion"
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"github.com/gofrs/uuid"
+)
+
+type BatchOperationResult struct {
+	Index           int        `json:"index"`
+	Op              string     `json:"op"`
+	ID              *uuid.UUID `json:"id"`
+	Status          int        `json:"status"`
+	ChangeRequestID *uuid.UUID `json:"change_request_id,omitempty"`
+	Error           string     `json:"error,omitempty"`
+}
+
+func (r *BatchOperationResult) Failed() bool {
+	return r.Error != ""
+}
+
+type BatchResult struct {
+	Atomic    bool                    `json:"atomic"`
+	Committed bool                    `json:"committed"`
+	Succeeded int                     `json:"succeeded"`
+	Failed    int                     `json:"failed"`
+	Results   []*BatchOperationResult `json:"results"`
+}
+
+func (b *BatchResult) Add(result *BatchOperationResult) {
+	if result.Failed() {
+		b.Failed++
+	} else {
+		b.Succeeded++
+	}
+	b.Results = append(b.Results, result)
+}
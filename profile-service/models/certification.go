@@ -0,0 +1,131 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// Certification is a certificate a profile holds, optionally for a skill of the
+// catalog. ExpiryDate is the last day it is valid, a certification that does not
+// expire has none.
+type Certification struct {
+	ID           *uuid.UUID `json:"id"`
+	ProfileID    *uuid.UUID `json:"profile_id"`
+	CatalogID    *uuid.UUID `json:"catalog_id"`
+	Name         string     `json:"name"`
+	Issuer       string     `json:"issuer"`
+	IssueDate    time.Time  `json:"issue_date"`
+	ExpiryDate   *time.Time `json:"expiry_date"`
+	AttachmentID *uuid.UUID `json:"attachment_id"`
+	CreatedAt    *time.Time `json:"created_at"`
+	UpdatedAt    *time.Time `json:"updated_at"`
+}
+
+func (Certification) TableName() string {
+	return "certification"
+}
+
+func (c *Certification) GenUUID() {
+	id, _ := uuid.NewV4()
+	c.ID = &id
+}
+
+func (c *Certification) SetCreatedAt() {
+	now := time.Now()
+	c.CreatedAt = &now
+}
+
+func (c *Certification) SetUpdatedAt() {
+	now := time.Now()
+	c.UpdatedAt = &now
+}
+
+// DaysUntilExpiry returns the number of days from today to the expiry date,
+// zero on the last valid day and negative once expired.
+func (c *Certification) DaysUntilExpiry(today time.Time) int {
+	return int(c.ExpiryDate.Sub(DateOf(today)).Hours() / 24)
+}
+
+// MarshalJSON writes the issue and expiry as calendar dates.
+func (c Certification) MarshalJSON() ([]byte, error) {
+	type certification Certification
+	return json.Marshal(struct {
+		certification
+		IssueDate  string  `json:"issue_date"`
+		ExpiryDate *string `json:"expiry_date"`
+	}{
+		certification: certification(c),
+		IssueDate:     c.IssueDate.Format(DateFormat),
+		ExpiryDate:    formatDate(c.ExpiryDate),
+	})
+}
+
+type CertificationEventType string
+
+const (
+	CertificationEventExpiring CertificationEventType = "certification.expiring"
+	CertificationEventExpired  CertificationEventType = "certification.expired"
+)
+
+// CertificationEvent is an expiry reminder waiting in the outbox until the
+// webhook accepts it. DaysBefore is the reminder it was sent for, zero for an
+// expired certification.
+type CertificationEvent struct {
+	ID              *uuid.UUID             `json:"id"`
+	CertificationID *uuid.UUID             `json:"-"`
+	Type            CertificationEventType `json:"type"`
+	DaysBefore      int                    `json:"days_before"`
+	ExpiryDate      time.Time              `json:"expiry_date"`
+	// Payload is the certification as it was when the event was created
+	Payload json.RawMessage `json:"certification" gorm:"type:jsonb"`
+	EventDelivery
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+func (CertificationEvent) TableName() string {
+	return "certification_event"
+}
+
+func (e *CertificationEvent) EventID() *uuid.UUID {
+	return e.ID
+}
+
+func (e *CertificationEvent) EventType() string {
+	return string(e.Type)
+}
+
+// NewCertificationEvent creates the event of certification reaching the
+// reminder daysBefore its expiry, or of it having expired.
+func NewCertificationEvent(certification *Certification, eventType CertificationEventType, daysBefore int) (*CertificationEvent, error) {
+	payload, err := json.Marshal(certification)
+	if err != nil {
+		return nil, err
+	}
+
+	id, _ := uuid.NewV4()
+	now := time.Now()
+	return &CertificationEvent{
+		ID:              &id,
+		CertificationID: certification.ID,
+		Type:            eventType,
+		DaysBefore:      daysBefore,
+		ExpiryDate:      *certification.ExpiryDate,
+		Payload:         payload,
+		EventDelivery:   EventDelivery{NextAttemptAt: &now},
+		CreatedAt:       &now,
+	}, nil
+}
+
+// MarshalJSON writes the event as the body of the webhook request.
+func (e CertificationEvent) MarshalJSON() ([]byte, error) {
+	type certificationEvent CertificationEvent
+	return json.Marshal(struct {
+		certificationEvent
+		ExpiryDate string `json:"expiry_date"`
+	}{
+		certificationEvent: certificationEvent(e),
+		ExpiryDate:         e.ExpiryDate.Format(DateFormat),
+	})
+}
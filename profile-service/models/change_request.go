@@ -0,0 +1,118 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+type ChangeRequestStatus string
+
+const (
+	ChangeRequestStatusPending    ChangeRequestStatus = "pending"
+	ChangeRequestStatusApproved   ChangeRequestStatus = "approved"
+	ChangeRequestStatusRejected   ChangeRequestStatus = "rejected"
+	ChangeRequestStatusSuperseded ChangeRequestStatus = "superseded"
+)
+
+// changeRequestEvents are the events sent to the user who asked for a change
+// once it is reviewed
+var changeRequestEvents = map[ChangeRequestStatus]ProfileEventType{
+	ChangeRequestStatusApproved: ProfileEventChangeApproved,
+	ChangeRequestStatusRejected: ProfileEventChangeRejected,
+}
+
+// ProfileChanges are the new values of the fields of a profile only staff
+// members change freely, the fields left out stay as they are. Names replaces
+// the names in other languages. Class is blank and ClassID nil when the
+// profile leaves its class.
+type ProfileChanges struct {
+	FirstName *string         `json:"first_name,omitempty"`
+	LastName  *string         `json:"last_name,omitempty"`
+	Names     *[]*ProfileName `json:"names,omitempty"`
+	ClassID   *uuid.UUID      `json:"class_id,omitempty"`
+	Class     *string         `json:"class,omitempty"`
+}
+
+func (c *ProfileChanges) IsEmpty() bool {
+	return c.FirstName == nil && c.LastName == nil && c.Names == nil && c.Class == nil
+}
+
+// ChangeRequest is a change to a profile waiting for a staff member to approve
+// or reject it.
+type ChangeRequest struct {
+	ID           *uuid.UUID          `json:"id"`
+	ProfileID    *uuid.UUID          `json:"profile_id"`
+	Status       ChangeRequestStatus `json:"status"`
+	Changes      json.RawMessage     `json:"changes" gorm:"type:jsonb"`
+	RequestedBy  string              `json:"requested_by"`
+	ReviewedBy   *string             `json:"reviewed_by"`
+	ReviewReason *string             `json:"review_reason"`
+	CreatedAt    *time.Time          `json:"created_at"`
+	ReviewedAt   *time.Time          `json:"reviewed_at"`
+}
+
+func (ChangeRequest) TableName() string {
+	return "change_request"
+}
+
+func (r *ChangeRequest) GenUUID() {
+	id, _ := uuid.NewV4()
+	r.ID = &id
+}
+
+func (r *ChangeRequest) SetCreatedAt() {
+	now := time.Now()
+	r.CreatedAt = &now
+}
+
+// NewChangeRequest creates the pending request of requestedBy to make changes
+// to the profile profileId.
+func NewChangeRequest(profileId *uuid.UUID, changes *ProfileChanges, requestedBy string) (*ChangeRequest, error) {
+	encoded, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &ChangeRequest{
+		ProfileID:   profileId,
+		Status:      ChangeRequestStatusPending,
+		Changes:     encoded,
+		RequestedBy: requestedBy,
+	}
+	request.GenUUID()
+	request.SetCreatedAt()
+
+	return request, nil
+}
+
+// ProfileChanges decodes the changes asked for.
+func (r *ChangeRequest) ProfileChanges() (*ProfileChanges, error) {
+	var changes ProfileChanges
+	if err := json.Unmarshal(r.Changes, &changes); err != nil {
+		return nil, err
+	}
+
+	return &changes, nil
+}
+
+func (r *ChangeRequest) IsPending() bool {
+	return r.Status == ChangeRequestStatusPending
+}
+
+// Review records that reviewedBy approved or rejected the request, with the
+// reason given for a rejection.
+func (r *ChangeRequest) Review(status ChangeRequestStatus, reviewedBy string, reason *string) {
+	now := time.Now()
+	r.Status = status
+	r.ReviewedBy = &reviewedBy
+	r.ReviewReason = reason
+	r.ReviewedAt = &now
+}
+
+// NewChangeRequestEvent creates the event telling the user who asked for the
+// change that it was reviewed.
+func NewChangeRequestEvent(request *ChangeRequest) (*ProfileEvent, error) {
+	return NewProfileEvent(request.ProfileID, changeRequestEvents[request.Status], request)
+}
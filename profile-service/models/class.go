@@ -0,0 +1,60 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// NormalizeClassCode is the key classes are matched on: lower case with
+// surrounding and repeated whitespace removed.
+func NormalizeClassCode(code string) string {
+	return strings.Join(strings.Fields(strings.ToLower(code)), " ")
+}
+
+type Class struct {
+	ID              *uuid.UUID `json:"id"`
+	Code            string     `json:"code"`
+	NormalizedCode  string     `json:"-"`
+	Name            string     `json:"name"`
+	HomeroomTeacher *string    `json:"homeroom_teacher"`
+	AcademicYear    *string    `json:"academic_year"`
+	Capacity        *int       `json:"capacity"`
+	CreatedAt       *time.Time `json:"created_at"`
+	UpdatedAt       *time.Time `json:"updated_at"`
+}
+
+func (Class) TableName() string {
+	return "class"
+}
+
+func (c *Class) GenUUID() {
+	id, _ := uuid.NewV4()
+	c.ID = &id
+}
+
+func (c *Class) SetCreatedAt() {
+	now := time.Now()
+	c.CreatedAt = &now
+}
+
+func (c *Class) SetUpdatedAt() {
+	now := time.Now()
+	c.UpdatedAt = &now
+}
+
+// SetCode sets the code with its inner whitespace collapsed together with its normalized key.
+func (c *Class) SetCode(code string) {
+	c.Code = strings.Join(strings.Fields(code), " ")
+	c.NormalizedCode = NormalizeClassCode(code)
+}
+
+// ClassPromotion is the result of moving every profile of a class to another class.
+type ClassPromotion struct {
+	FromClassID   *uuid.UUID `json:"from_class_id"`
+	ToClassID     *uuid.UUID `json:"to_class_id"`
+	AcademicYear  string     `json:"academic_year"`
+	EffectiveDate string     `json:"effective_date"`
+	Promoted      int64      `json:"promoted"`
+}
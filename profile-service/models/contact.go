@@ -0,0 +1,81 @@
+package models
+
+import (
+	"net/mail"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+type ContactType string
+
+const (
+	ContactTypeEmail    ContactType = "email"
+	ContactTypePhone    ContactType = "phone"
+	ContactTypeGuardian ContactType = "guardian"
+)
+
+var (
+	e164Pattern = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+	// phoneSeparators are the characters people write phone numbers with that E.164 leaves out
+	phoneSeparators = strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "")
+)
+
+// Contact is a way to reach a profile. A guardian contact is a phone number or
+// an email address of someone responsible for the profile.
+type Contact struct {
+	ID              *uuid.UUID  `json:"id"`
+	ProfileID       *uuid.UUID  `json:"-"`
+	Type            ContactType `json:"type"`
+	Value           string      `json:"value"`
+	NormalizedValue string      `json:"-"`
+	Name            *string     `json:"name"`
+	IsPrimary       bool        `json:"is_primary"`
+	VerifiedAt      *time.Time  `json:"verified_at"`
+	CreatedAt       *time.Time  `json:"created_at"`
+	UpdatedAt       *time.Time  `json:"updated_at"`
+}
+
+func (Contact) TableName() string {
+	return "contact"
+}
+
+func (c *Contact) GenUUID() {
+	id, _ := uuid.NewV4()
+	c.ID = &id
+}
+
+func (c *Contact) SetCreatedAt() {
+	now := time.Now()
+	c.CreatedAt = &now
+}
+
+func (c *Contact) SetUpdatedAt() {
+	now := time.Now()
+	c.UpdatedAt = &now
+}
+
+// NormalizeEmail returns the lower-cased address when value is a bare RFC 5322
+// address, without display name or angle brackets.
+func NormalizeEmail(value string) (string, bool) {
+	value = strings.TrimSpace(value)
+	address, err := mail.ParseAddress(value)
+	if err != nil || address.Name != "" || address.Address != value {
+		return "", false
+	}
+
+	return strings.ToLower(address.Address), true
+}
+
+// NormalizePhone returns value in E.164 format once spaces, dashes, dots and
+// parentheses are removed, such as +66812345678 for "+66 81-234-5678".
+func NormalizePhone(value string) (string, bool) {
+	phone := phoneSeparators.Replace(strings.TrimSpace(value))
+	if !e164Pattern.MatchString(phone) {
+		return "", false
+	}
+
+	return phone, true
+}
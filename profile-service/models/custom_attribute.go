@@ -0,0 +1,93 @@
+package models
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// customAttributeKeyPattern is the pattern of the key path parameter of /attributes/{key}.
+var customAttributeKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,63}$`)
+
+// CustomAttribute is an extra field of the profiles defined at runtime, the
+// values profiles hold for it in custom_attributes must match Schema.
+type CustomAttribute struct {
+	ID        *uuid.UUID      `json:"id"`
+	Key       string          `json:"key"`
+	Schema    json.RawMessage `json:"schema" gorm:"type:jsonb"`
+	Required  bool            `json:"required"`
+	CreatedAt *time.Time      `json:"created_at"`
+	UpdatedAt *time.Time      `json:"updated_at"`
+}
+
+func (CustomAttribute) TableName() string {
+	return "custom_attribute"
+}
+
+func (a *CustomAttribute) GenUUID() {
+	id, _ := uuid.NewV4()
+	a.ID = &id
+}
+
+func (a *CustomAttribute) SetCreatedAt() {
+	now := time.Now()
+	a.CreatedAt = &now
+}
+
+func (a *CustomAttribute) SetUpdatedAt() {
+	now := time.Now()
+	a.UpdatedAt = &now
+}
+
+// CustomAttributeFilter matches profiles having the custom attribute Key, with
+// a value equal to Value as text when Value is not nil.
+type CustomAttributeFilter struct {
+	Key   string
+	Value *string
+}
+
+// ParseCustomAttributeFilter parses filters such as "bus_route=12" or "locker_number".
+func ParseCustomAttributeFilter(filter string) (*CustomAttributeFilter, bool) {
+	key, value, hasValue := strings.Cut(filter, "=")
+	key = strings.TrimSpace(key)
+	if !customAttributeKeyPattern.MatchString(key) {
+		return nil, false
+	}
+
+	attributeFilter := &CustomAttributeFilter{Key: key}
+	if hasValue {
+		attributeFilter.Value = &value
+	}
+
+	return attributeFilter, true
+}
+
+// MergeCustomAttributes returns the custom attributes of survivor, with the
+// values of merged for the attributes survivor has no value for.
+func MergeCustomAttributes(survivor, merged json.RawMessage) (json.RawMessage, error) {
+	var values, mergedValues map[string]interface{}
+	if len(survivor) > 0 {
+		if err := json.Unmarshal(survivor, &values); err != nil {
+			return nil, err
+		}
+	}
+	if len(merged) > 0 {
+		if err := json.Unmarshal(merged, &mergedValues); err != nil {
+			return nil, err
+		}
+	}
+	if values == nil {
+		values = make(map[string]interface{}, len(mergedValues))
+	}
+
+	for key, value := range mergedValues {
+		if _, ok := values[key]; !ok {
+			values[key] = value
+		}
+	}
+
+	return json.Marshal(values)
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// Enrollment is a period a profile spent in a class. EndDate is the first day
+// the profile was no longer in the class, the current enrollment has none.
+type Enrollment struct {
+	ID           *uuid.UUID `json:"id"`
+	ProfileID    *uuid.UUID `json:"profile_id"`
+	ClassID      *uuid.UUID `json:"class_id"`
+	ClassCode    string     `json:"class_code" gorm:"->;-:migration"`
+	AcademicYear *string    `json:"academic_year"`
+	StartDate    time.Time  `json:"start_date"`
+	EndDate      *time.Time `json:"end_date"`
+	CreatedAt    *time.Time `json:"created_at"`
+	UpdatedAt    *time.Time `json:"updated_at"`
+}
+
+func (Enrollment) TableName() string {
+	return "enrollment"
+}
+
+// NewEnrollment opens an enrollment of a profile in class from startDate on.
+func NewEnrollment(profileId *uuid.UUID, class *Class, academicYear *string, startDate time.Time) *Enrollment {
+	id, _ := uuid.NewV4()
+	now := time.Now()
+	return &Enrollment{
+		ID:           &id,
+		ProfileID:    profileId,
+		ClassID:      class.ID,
+		ClassCode:    class.Code,
+		AcademicYear: academicYear,
+		StartDate:    DateOf(startDate),
+		CreatedAt:    &now,
+		UpdatedAt:    &now,
+	}
+}
+
+// MarshalJSON writes the start and end as calendar dates.
+func (e Enrollment) MarshalJSON() ([]byte, error) {
+	type enrollment Enrollment
+	var endDate *string
+	if e.EndDate != nil {
+		formatted := e.EndDate.Format(DateFormat)
+		endDate = &formatted
+	}
+
+	return json.Marshal(struct {
+		enrollment
+		StartDate string  `json:"start_date"`
+		EndDate   *string `json:"end_date"`
+	}{
+		enrollment: enrollment(e),
+		StartDate:  e.StartDate.Format(DateFormat),
+		EndDate:    endDate,
+	})
+}
+
+// DateFormat is the layout of dates in the API.
+const DateFormat = "2006-01-02"
+
+// DateOf truncates t to midnight UTC of its calendar day, matching a DATE column.
+func DateOf(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// EventDelivery tracks the delivery of an event kept in an outbox table until
+// it reaches the receiver outside the service.
+type EventDelivery struct {
+	Attempts      int        `json:"-"`
+	NextAttemptAt *time.Time `json:"-"`
+	DeliveredAt   *time.Time `json:"-"`
+	LastError     *string    `json:"-"`
+}
+
+func (d *EventDelivery) DeliveryAttempts() int {
+	return d.Attempts
+}
+
+func (d *EventDelivery) MarkDelivered(at time.Time) {
+	d.DeliveredAt = &at
+	d.LastError = nil
+}
+
+// MarkFailed records why the last attempt failed, the event is given up when
+// nextAttemptAt is nil.
+func (d *EventDelivery) MarkFailed(err error, nextAttemptAt *time.Time) {
+	message := err.Error()
+	d.LastError = &message
+	d.NextAttemptAt = nextAttemptAt
+}
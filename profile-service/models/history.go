@@ -0,0 +1,111 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// Education is a school or university a profile studied at. EndDate is the
+// last day of study, an entry still ongoing has none.
+type Education struct {
+	ID          *uuid.UUID `json:"id"`
+	ProfileID   *uuid.UUID `json:"-"`
+	Institution string     `json:"institution"`
+	Degree      *string    `json:"degree"`
+	StartDate   time.Time  `json:"start_date"`
+	EndDate     *time.Time `json:"end_date"`
+	CreatedAt   *time.Time `json:"created_at"`
+	UpdatedAt   *time.Time `json:"updated_at"`
+}
+
+func (Education) TableName() string {
+	return "education"
+}
+
+func (e *Education) GenUUID() {
+	id, _ := uuid.NewV4()
+	e.ID = &id
+}
+
+func (e *Education) SetCreatedAt() {
+	now := time.Now()
+	e.CreatedAt = &now
+}
+
+func (e *Education) SetUpdatedAt() {
+	now := time.Now()
+	e.UpdatedAt = &now
+}
+
+// MarshalJSON writes the start and end as calendar dates.
+func (e Education) MarshalJSON() ([]byte, error) {
+	type education Education
+	return json.Marshal(struct {
+		education
+		StartDate string  `json:"start_date"`
+		EndDate   *string `json:"end_date"`
+	}{
+		education: education(e),
+		StartDate: e.StartDate.Format(DateFormat),
+		EndDate:   formatDate(e.EndDate),
+	})
+}
+
+// Experience is a position a profile held in an organization. EndDate is the
+// last day in the role, a position still held has none.
+type Experience struct {
+	ID           *uuid.UUID `json:"id"`
+	ProfileID    *uuid.UUID `json:"-"`
+	Organization string     `json:"organization"`
+	Role         string     `json:"role"`
+	Description  *string    `json:"description"`
+	StartDate    time.Time  `json:"start_date"`
+	EndDate      *time.Time `json:"end_date"`
+	CreatedAt    *time.Time `json:"created_at"`
+	UpdatedAt    *time.Time `json:"updated_at"`
+}
+
+func (Experience) TableName() string {
+	return "experience"
+}
+
+func (e *Experience) GenUUID() {
+	id, _ := uuid.NewV4()
+	e.ID = &id
+}
+
+func (e *Experience) SetCreatedAt() {
+	now := time.Now()
+	e.CreatedAt = &now
+}
+
+func (e *Experience) SetUpdatedAt() {
+	now := time.Now()
+	e.UpdatedAt = &now
+}
+
+// MarshalJSON writes the start and end as calendar dates.
+func (e Experience) MarshalJSON() ([]byte, error) {
+	type experience Experience
+	return json.Marshal(struct {
+		experience
+		StartDate string  `json:"start_date"`
+		EndDate   *string `json:"end_date"`
+	}{
+		experience: experience(e),
+		StartDate:  e.StartDate.Format(DateFormat),
+		EndDate:    formatDate(e.EndDate),
+	})
+}
+
+// formatDate formats date in DateFormat, nil when there is no date.
+func formatDate(date *time.Time) *string {
+	if date == nil {
+		return nil
+	}
+
+	formatted := date.Format(DateFormat)
+	return &formatted
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"time"
+)
+
+// IdempotencyKey is reserved by the first request a user sends with the key,
+// and holds its response once it completes. ResponseStatus is 0 while the
+// request is still in progress.
+type IdempotencyKey struct {
+	UserID         string     `json:"user_id" gorm:"primaryKey"`
+	Key            string     `json:"key" gorm:"primaryKey"`
+	RequestHash    string     `json:"request_hash"`
+	ResponseStatus int        `json:"response_status"`
+	ResponseBody   []byte     `json:"response_body"`
+	CreatedAt      *time.Time `json:"created_at"`
+	ExpiresAt      *time.Time `json:"expires_at"`
+}
+
+func (IdempotencyKey) TableName() string {
+	return "idempotency_key"
+}
+
+func (k *IdempotencyKey) SetCreatedAt() {
+	now := time.Now()
+	k.CreatedAt = &now
+}
+
+func (k *IdempotencyKey) SetExpiresAt(ttl time.Duration) {
+	expiresAt := time.Now().Add(ttl)
+	k.ExpiresAt = &expiresAt
+}
+
+func (k *IdempotencyKey) IsExpired() bool {
+	return k.ExpiresAt != nil && k.ExpiresAt.Before(time.Now())
+}
+
+// IsPending reports whether the request that reserved the key has not completed yet.
+func (k *IdempotencyKey) IsPending() bool {
+	return k.ResponseStatus == 0
+}
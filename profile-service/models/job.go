@@ -0,0 +1,130 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+type JobType string
+
+const (
+	JobTypeImport JobType = "import"
+	JobTypeExport JobType = "export"
+	JobTypePurge  JobType = "purge"
+)
+
+type JobStatus string
+
+const (
+	JobStatusPending   JobStatus = "pending"
+	JobStatusRunning   JobStatus = "running"
+	JobStatusSucceeded JobStatus = "succeeded"
+	JobStatusFailed    JobStatus = "failed"
+	JobStatusCancelled JobStatus = "cancelled"
+)
+
+// maxJobErrors caps the item errors kept on a job so a bad import cannot grow the row without bound.
+const maxJobErrors = 100
+
+type JobError struct {
+	Index   int    `json:"index"`
+	Message string `json:"message"`
+}
+
+type JobProgress struct {
+	Total     int `json:"total"`
+	Processed int `json:"processed"`
+	Failed    int `json:"failed"`
+}
+
+type Job struct {
+	ID              *uuid.UUID      `json:"id"`
+	Type            JobType         `json:"type"`
+	Status          JobStatus       `json:"status"`
+	Payload         json.RawMessage `json:"-" gorm:"type:jsonb"`
+	Total           int             `json:"-"`
+	Processed       int             `json:"-"`
+	Failed          int             `json:"-"`
+	Errors          json.RawMessage `json:"errors" gorm:"type:jsonb"`
+	Result          json.RawMessage `json:"-" gorm:"type:jsonb"`
+	CancelRequested bool            `json:"cancel_requested"`
+	Attempts        int             `json:"attempts"`
+	LockedBy        *string         `json:"-"`
+	HeartbeatAt     *time.Time      `json:"-"`
+	CreatedAt       *time.Time      `json:"created_at"`
+	StartedAt       *time.Time      `json:"started_at"`
+	FinishedAt      *time.Time      `json:"finished_at"`
+	UpdatedAt       *time.Time      `json:"updated_at"`
+}
+
+func (Job) TableName() string {
+	return "job"
+}
+
+func (j *Job) GenUUID() {
+	id, _ := uuid.NewV4()
+	j.ID = &id
+}
+
+func (j *Job) SetCreatedAt() {
+	now := time.Now()
+	j.CreatedAt = &now
+}
+
+func (j *Job) SetUpdatedAt() {
+	now := time.Now()
+	j.UpdatedAt = &now
+}
+
+func (j *Job) SetFinishedAt() {
+	now := time.Now()
+	j.FinishedAt = &now
+}
+
+func (j *Job) IsFinished() bool {
+	return j.Status == JobStatusSucceeded || j.Status == JobStatusFailed || j.Status == JobStatusCancelled
+}
+
+func (j *Job) HasResult() bool {
+	return j.Status == JobStatusSucceeded && len(j.Result) > 0
+}
+
+func (j *Job) Progress() JobProgress {
+	return JobProgress{
+		Total:     j.Total,
+		Processed: j.Processed,
+		Failed:    j.Failed,
+	}
+}
+
+// AddError records a failed item, keeping at most maxJobErrors messages.
+func (j *Job) AddError(index int, message string) {
+	j.Failed++
+
+	var jobErrors []JobError
+	if len(j.Errors) > 0 {
+		_ = json.Unmarshal(j.Errors, &jobErrors)
+	}
+	if len(jobErrors) >= maxJobErrors {
+		return
+	}
+
+	jobErrors = append(jobErrors, JobError{Index: index, Message: message})
+	j.Errors, _ = json.Marshal(jobErrors)
+}
+
+// MarshalJSON adds the derived progress and has_result fields to the API representation.
+func (j Job) MarshalJSON() ([]byte, error) {
+	type job Job
+	return json.Marshal(struct {
+		job
+		Progress  JobProgress `json:"progress"`
+		HasResult bool        `json:"has_result"`
+	}{
+		job:       job(j),
+		Progress:  j.Progress(),
+		HasResult: j.HasResult(),
+	})
+}
@@ -0,0 +1,153 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// ProfileStatus is where a profile is in its lifecycle.
+type ProfileStatus string
+
+const (
+	ProfileStatusDraft     ProfileStatus = "draft"
+	ProfileStatusActive    ProfileStatus = "active"
+	ProfileStatusGraduated ProfileStatus = "graduated"
+	ProfileStatusWithdrawn ProfileStatus = "withdrawn"
+	ProfileStatusArchived  ProfileStatus = "archived"
+)
+
+// profileStatusTransitions are the statuses a profile can move to from each status
+var profileStatusTransitions = map[ProfileStatus][]ProfileStatus{
+	ProfileStatusDraft:     {ProfileStatusActive},
+	ProfileStatusActive:    {ProfileStatusGraduated, ProfileStatusWithdrawn},
+	ProfileStatusGraduated: {ProfileStatusArchived},
+	ProfileStatusWithdrawn: {ProfileStatusArchived},
+	ProfileStatusArchived:  {},
+}
+
+func (s ProfileStatus) IsValid() bool {
+	_, ok := profileStatusTransitions[s]
+	return ok
+}
+
+// IsInitial reports whether a new profile can start in the status.
+func (s ProfileStatus) IsInitial() bool {
+	return s == ProfileStatusDraft || s == ProfileStatusActive
+}
+
+// CurrentProfileStatuses are the statuses of the profiles still in use, the
+// others are left out of suggestions such as similar profiles and duplicates.
+func CurrentProfileStatuses() []ProfileStatus {
+	return []ProfileStatus{ProfileStatusDraft, ProfileStatusActive}
+}
+
+// NextStatuses lists the statuses a profile can move to from s, none once archived.
+func (s ProfileStatus) NextStatuses() []ProfileStatus {
+	return profileStatusTransitions[s]
+}
+
+// CanTransitionTo reports whether a profile can move from s to the status to.
+func (s ProfileStatus) CanTransitionTo(to ProfileStatus) bool {
+	for _, next := range profileStatusTransitions[s] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// StatusTransition is a change of the status of a profile with its reason.
+type StatusTransition struct {
+	ID         *uuid.UUID    `json:"id"`
+	ProfileID  *uuid.UUID    `json:"profile_id"`
+	FromStatus ProfileStatus `json:"from_status"`
+	ToStatus   ProfileStatus `json:"to_status"`
+	Reason     string        `json:"reason"`
+	CreatedAt  *time.Time    `json:"created_at"`
+}
+
+func (StatusTransition) TableName() string {
+	return "status_transition"
+}
+
+func (t *StatusTransition) GenUUID() {
+	id, _ := uuid.NewV4()
+	t.ID = &id
+}
+
+func (t *StatusTransition) SetCreatedAt() {
+	now := time.Now()
+	t.CreatedAt = &now
+}
+
+type ProfileEventType string
+
+const (
+	ProfileEventActivated ProfileEventType = "profile.activated"
+	ProfileEventGraduated ProfileEventType = "profile.graduated"
+	ProfileEventWithdrawn ProfileEventType = "profile.withdrawn"
+	ProfileEventArchived  ProfileEventType = "profile.archived"
+
+	ProfileEventChangeApproved ProfileEventType = "profile.change_approved"
+	ProfileEventChangeRejected ProfileEventType = "profile.change_rejected"
+)
+
+// profileStatusEvents are the events sent when a profile moves to each status
+var profileStatusEvents = map[ProfileStatus]ProfileEventType{
+	ProfileStatusActive:    ProfileEventActivated,
+	ProfileStatusGraduated: ProfileEventGraduated,
+	ProfileStatusWithdrawn: ProfileEventWithdrawn,
+	ProfileStatusArchived:  ProfileEventArchived,
+}
+
+// ProfileEvent is a lifecycle event or the review of a change request waiting
+// in the outbox until the webhook accepts it.
+type ProfileEvent struct {
+	ID        *uuid.UUID       `json:"id"`
+	ProfileID *uuid.UUID       `json:"profile_id"`
+	Type      ProfileEventType `json:"type"`
+	// Payload is what happened to the profile, such as its status transition or its reviewed change request
+	Payload json.RawMessage `json:"data" gorm:"type:jsonb"`
+	EventDelivery
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+func (ProfileEvent) TableName() string {
+	return "profile_event"
+}
+
+func (e *ProfileEvent) EventID() *uuid.UUID {
+	return e.ID
+}
+
+func (e *ProfileEvent) EventType() string {
+	return string(e.Type)
+}
+
+// NewProfileEvent creates the event of type about the profile profileId with
+// data as its payload.
+func NewProfileEvent(profileId *uuid.UUID, eventType ProfileEventType, data interface{}) (*ProfileEvent, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	id, _ := uuid.NewV4()
+	now := time.Now()
+	return &ProfileEvent{
+		ID:            &id,
+		ProfileID:     profileId,
+		Type:          eventType,
+		Payload:       payload,
+		EventDelivery: EventDelivery{NextAttemptAt: &now},
+		CreatedAt:     &now,
+	}, nil
+}
+
+// NewStatusTransitionEvent creates the lifecycle event of the transition.
+func NewStatusTransitionEvent(transition *StatusTransition) (*ProfileEvent, error) {
+	return NewProfileEvent(transition.ProfileID, profileStatusEvents[transition.ToStatus], transition)
+}
@@ -0,0 +1,136 @@
+package models
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+type NoteVisibility string
+
+const (
+	NoteVisibilityPrivate NoteVisibility = "private"
+	NoteVisibilityStaff   NoteVisibility = "staff"
+	NoteVisibilityAll     NoteVisibility = "all"
+)
+
+type UserRole string
+
+const (
+	UserRoleAdmin    UserRole = "admin"
+	UserRoleStaff    UserRole = "staff"
+	UserRoleStudent  UserRole = "student"
+	UserRoleGuardian UserRole = "guardian"
+)
+
+// Viewer is the user making a request, as authenticated by the gateway.
+type Viewer struct {
+	UserID string
+	Role   UserRole
+}
+
+// NewViewer is the user the gateway authenticated the request for, role
+// being the user role of any of the generated servers.
+func NewViewer[R ~string](userId string, role R) *Viewer {
+	return &Viewer{UserID: userId, Role: UserRole(role)}
+}
+
+// IsStaff reports whether the user works at the school.
+func (v *Viewer) IsStaff() bool {
+	return v.Role == UserRoleAdmin || v.Role == UserRoleStaff
+}
+
+// SharedNoteVisibilities lists the visibilities of the notes the user reads
+// whoever wrote them, private notes being read by their author only.
+func (v *Viewer) SharedNoteVisibilities() []NoteVisibility {
+	if v.IsStaff() {
+		return []NoteVisibility{NoteVisibilityAll, NoteVisibilityStaff}
+	}
+
+	return []NoteVisibility{NoteVisibilityAll}
+}
+
+// Note is a Markdown note a staff member wrote about a profile. A deleted note
+// keeps its row and history but is no longer shown.
+type Note struct {
+	ID         *uuid.UUID     `json:"id"`
+	ProfileID  *uuid.UUID     `json:"profile_id"`
+	AuthorID   string         `json:"author_id"`
+	Body       string         `json:"body"`
+	Visibility NoteVisibility `json:"visibility"`
+	CreatedAt  *time.Time     `json:"created_at"`
+	UpdatedAt  *time.Time     `json:"updated_at"`
+	DeletedAt  *time.Time     `json:"-"`
+	DeletedBy  *string        `json:"-"`
+}
+
+func (Note) TableName() string {
+	return "note"
+}
+
+func (n *Note) GenUUID() {
+	id, _ := uuid.NewV4()
+	n.ID = &id
+}
+
+func (n *Note) SetCreatedAt() {
+	now := time.Now()
+	n.CreatedAt = &now
+}
+
+func (n *Note) SetUpdatedAt() {
+	now := time.Now()
+	n.UpdatedAt = &now
+}
+
+// VisibleTo reports whether viewer can read the note.
+func (n *Note) VisibleTo(viewer *Viewer) bool {
+	if n.Visibility == NoteVisibilityPrivate {
+		return n.AuthorID == viewer.UserID
+	}
+
+	for _, visibility := range viewer.SharedNoteVisibilities() {
+		if n.Visibility == visibility {
+			return true
+		}
+	}
+
+	return false
+}
+
+// EditableBy reports whether viewer can edit the note, only its author while
+// still a staff member can.
+func (n *Note) EditableBy(viewer *Viewer) bool {
+	return viewer.IsStaff() && n.AuthorID == viewer.UserID
+}
+
+// DeletableBy reports whether viewer can delete the note, its author or an admin.
+func (n *Note) DeletableBy(viewer *Viewer) bool {
+	return n.EditableBy(viewer) || viewer.Role == UserRoleAdmin
+}
+
+// Revision returns the current version of the note, written by editedBy.
+func (n *Note) Revision(editedBy string) *NoteRevision {
+	revision := &NoteRevision{NoteID: n.ID, Body: n.Body, Visibility: n.Visibility, EditedBy: editedBy, CreatedAt: n.UpdatedAt}
+	revision.GenUUID()
+	return revision
+}
+
+// NoteRevision is a version of a note, from when it was written or edited.
+type NoteRevision struct {
+	ID         *uuid.UUID     `json:"id"`
+	NoteID     *uuid.UUID     `json:"-"`
+	Body       string         `json:"body"`
+	Visibility NoteVisibility `json:"visibility"`
+	EditedBy   string         `json:"edited_by"`
+	CreatedAt  *time.Time     `json:"created_at"`
+}
+
+func (NoteRevision) TableName() string {
+	return "note_revision"
+}
+
+func (r *NoteRevision) GenUUID() {
+	id, _ := uuid.NewV4()
+	r.ID = &id
+}
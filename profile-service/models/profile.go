@@ -1,6 +1,8 @@
 package models
 
 import (
+	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -9,21 +11,54 @@ import (
 type Gender string
 
 const (
-	GenderMale   Gender = "MALE"
-	GenderFemale Gender = "FEMALE"
+	GenderMale        Gender = "MALE"
+	GenderFemale      Gender = "FEMALE"
+	GenderNonBinary   Gender = "NON_BINARY"
+	GenderUnspecified Gender = "UNSPECIFIED"
 )
 
+// NormalizeGender trims value and upper-cases it like the codes of the gender table.
+func NormalizeGender(value string) Gender {
+	return Gender(strings.ToUpper(strings.TrimSpace(value)))
+}
+
+// GenderOption is a row of the gender table, the values a profile's gender may take.
+type GenderOption struct {
+	Code      Gender     `json:"code" gorm:"primaryKey"`
+	Label     string     `json:"label"`
+	SortOrder int        `json:"-"`
+	CreatedAt *time.Time `json:"-"`
+	UpdatedAt *time.Time `json:"-"`
+}
+
+func (GenderOption) TableName() string {
+	return "gender"
+}
+
 type Profile struct {
 	ID         *uuid.UUID `json:"id"`
+	ExternalID *string    `json:"external_id"`
 	FirstName  string     `json:"first_name"`
 	MiddleName *string    `json:"middle_name"`
 	LastName   string     `json:"last_name"`
-	Gender     Gender     `json:"gender"`
-	Class      string     `json:"class"`
-	CreatedAt  *time.Time `json:"created_at"`
-	UpdatedAt  *time.Time `json:"updated_at"`
+	// DisplayName is set by SetDisplayName for the language of the request
+	DisplayName string         `json:"display_name,omitempty" gorm:"-"`
+	Names       []*ProfileName `json:"names" gorm:"foreignKey:ProfileID"`
+	Contacts    []*Contact     `json:"contacts" gorm:"foreignKey:ProfileID"`
+	Gender      Gender         `json:"gender"`
+	Pronouns    *string        `json:"pronouns"`
+	ClassID     *uuid.UUID     `json:"class_id"`
+	Class       string         `json:"class"`
+	Status      ProfileStatus  `json:"status"`
+	CreatedAt   *time.Time     `json:"created_at"`
+	UpdatedAt   *time.Time     `json:"updated_at"`
 
 	Skills []*Skill `json:"skills"`
+	// CustomAttributes holds the values of the custom attributes by key
+	CustomAttributes json.RawMessage `json:"custom_attributes" gorm:"type:jsonb"`
+	// Education and Experience are only loaded when asked for with include
+	Education  *[]*Education  `json:"education,omitempty" gorm:"-"`
+	Experience *[]*Experience `json:"experience,omitempty" gorm:"-"`
 }
 
 func (Profile) TableName() string {
@@ -45,3 +80,16 @@ func (p *Profile) SetUpdatedAt() {
 	now := time.Now()
 	p.UpdatedAt = &now
 }
+
+// SetDisplayName sets the display name to the name in locale, or to the first,
+// middle and last name when the profile has no name in it.
+func (p *Profile) SetDisplayName(locale string) {
+	for _, name := range p.Names {
+		if name.Locale == locale {
+			p.DisplayName = fullName(name.FirstName, name.MiddleName, name.LastName)
+			return
+		}
+	}
+
+	p.DisplayName = fullName(p.FirstName, p.MiddleName, p.LastName)
+}
@@ -0,0 +1,52 @@
+package models
+
+// SkillRequirement is a skill asked for when matching profiles.
+type SkillRequirement struct {
+	Skill          string            `json:"skill"`
+	Key            string            `json:"-"`
+	MinProficiency *SkillProficiency `json:"min_proficiency"`
+	Weight         float64           `json:"weight"`
+	Required       bool              `json:"required"`
+}
+
+func (r *SkillRequirement) LevelFilter() *SkillLevelFilter {
+	return &SkillLevelFilter{
+		Key:            r.Key,
+		MinProficiency: r.MinProficiency,
+	}
+}
+
+type ProfileMatch struct {
+	Profile       *Profile            `json:"profile"`
+	Score         float64             `json:"score"`
+	MatchedSkills []*SkillRequirement `json:"matched_skills"`
+	MissingSkills []*SkillRequirement `json:"missing_skills"`
+}
+
+// NewProfileMatch splits requirements by the matched bitmask, bit i being set
+// when the profile has requirements[i], and scores the profile by the share of
+// the total weight it matched.
+func NewProfileMatch(profile *Profile, requirements []*SkillRequirement, matched int64) *ProfileMatch {
+	match := &ProfileMatch{
+		Profile:       profile,
+		MatchedSkills: make([]*SkillRequirement, 0),
+		MissingSkills: make([]*SkillRequirement, 0),
+	}
+
+	var matchedWeight, totalWeight float64
+	for i, requirement := range requirements {
+		totalWeight += requirement.Weight
+		if matched&(1<<i) != 0 {
+			matchedWeight += requirement.Weight
+			match.MatchedSkills = append(match.MatchedSkills, requirement)
+		} else {
+			match.MissingSkills = append(match.MissingSkills, requirement)
+		}
+	}
+
+	if totalWeight > 0 {
+		match.Score = matchedWeight / totalWeight
+	}
+
+	return match
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+type ProfileMergeSource string
+
+const (
+	ProfileMergeSourceSurvivor ProfileMergeSource = "survivor"
+	ProfileMergeSourceMerged   ProfileMergeSource = "merged"
+)
+
+// ProfileMerge records a duplicate profile that was folded into another one.
+// The merged profile is deleted, MergedSnapshot keeps its last state.
+type ProfileMerge struct {
+	ID             *uuid.UUID      `json:"id"`
+	SurvivorID     *uuid.UUID      `json:"survivor_id"`
+	MergedID       *uuid.UUID      `json:"merged_id"`
+	Fields         json.RawMessage `json:"fields" gorm:"type:jsonb"`
+	MergedSnapshot json.RawMessage `json:"-" gorm:"type:jsonb"`
+	MovedSkills    int             `json:"moved_skills"`
+	CreatedAt      *time.Time      `json:"created_at"`
+
+	Profile *Profile `json:"profile" gorm:"-"`
+}
+
+func (ProfileMerge) TableName() string {
+	return "profile_merge"
+}
+
+func (m *ProfileMerge) GenUUID() {
+	id, _ := uuid.NewV4()
+	m.ID = &id
+}
+
+func (m *ProfileMerge) SetCreatedAt() {
+	now := time.Now()
+	m.CreatedAt = &now
+}
+
+// DuplicateCandidate is a pair of profiles with similar names.
+type DuplicateCandidate struct {
+	ProfileID      *uuid.UUID
+	DuplicateID    *uuid.UUID
+	NameSimilarity float64
+}
+
+type ProfileDuplicate struct {
+	Profile        *Profile `json:"profile"`
+	Duplicate      *Profile `json:"duplicate"`
+	Score          float64  `json:"score"`
+	NameSimilarity float64  `json:"name_similarity"`
+	SameClass      bool     `json:"same_class"`
+	SharedSkills   []string `json:"shared_skills"`
+}
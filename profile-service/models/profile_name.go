@@ -0,0 +1,95 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"golang.org/x/text/language"
+)
+
+const (
+	NameLocaleThai    = "th"
+	NameLocaleEnglish = "en"
+)
+
+// nameLocales are the languages a profile name can be written in, in the
+// order of nameMatcher.
+var nameLocales = []string{NameLocaleThai, NameLocaleEnglish}
+
+var nameMatcher = language.NewMatcher([]language.Tag{language.Thai, language.English})
+
+// nameCollations are the ICU collations created for sorting names in each language.
+var nameCollations = map[string]string{
+	NameLocaleThai:    "name_th",
+	NameLocaleEnglish: "name_en",
+}
+
+// ProfileName is the name of a profile written in one language.
+type ProfileName struct {
+	ID         *uuid.UUID `json:"-"`
+	ProfileID  *uuid.UUID `json:"-"`
+	Locale     string     `json:"locale"`
+	FirstName  string     `json:"first_name"`
+	MiddleName *string    `json:"middle_name"`
+	LastName   string     `json:"last_name"`
+	CreatedAt  *time.Time `json:"-"`
+	UpdatedAt  *time.Time `json:"-"`
+}
+
+func (ProfileName) TableName() string {
+	return "profile_name"
+}
+
+func (n *ProfileName) GenUUID() {
+	id, _ := uuid.NewV4()
+	n.ID = &id
+}
+
+func (n *ProfileName) SetCreatedAt() {
+	now := time.Now()
+	n.CreatedAt = &now
+}
+
+func (n *ProfileName) SetUpdatedAt() {
+	now := time.Now()
+	n.UpdatedAt = &now
+}
+
+// MatchNameLocale picks the name language that best fits an Accept-Language
+// header. It returns "" when the header is empty, malformed or asks for
+// neither Thai nor English.
+func MatchNameLocale(acceptLanguage string) string {
+	tags, _, err := language.ParseAcceptLanguage(acceptLanguage)
+	if err != nil || len(tags) == 0 {
+		return ""
+	}
+
+	_, index, confidence := nameMatcher.Match(tags...)
+	if confidence == language.No {
+		return ""
+	}
+
+	return nameLocales[index]
+}
+
+// NameCollation returns the collation names in locale sort by, English for an unknown locale.
+func NameCollation(locale string) string {
+	if collation, ok := nameCollations[locale]; ok {
+		return collation
+	}
+
+	return nameCollations[NameLocaleEnglish]
+}
+
+// fullName joins the non-blank parts of a name with spaces.
+func fullName(firstName string, middleName *string, lastName string) string {
+	parts := make([]string, 0, 3)
+	for _, part := range []*string{&firstName, middleName, &lastName} {
+		if part != nil && strings.TrimSpace(*part) != "" {
+			parts = append(parts, strings.TrimSpace(*part))
+		}
+	}
+
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,25 @@
+package models
+
+import "github.com/gofrs/uuid"
+
+// SkillKey identifies a skill when comparing profiles: skills linked to the
+// same catalog entry are the same skill, the others compare by normalized name.
+func SkillKey(skill *Skill) string {
+	if skill.CatalogID != nil {
+		return skill.CatalogID.String()
+	}
+
+	return NormalizeSkillName(skill.Skill)
+}
+
+type SimilarCandidate struct {
+	ProfileID    *uuid.UUID
+	SharedSkills int
+	Score        float64
+}
+
+type SimilarProfile struct {
+	Profile      *Profile `json:"profile"`
+	Score        float64  `json:"score"`
+	SharedSkills []string `json:"shared_skills"`
+}
@@ -0,0 +1,14 @@
+package models
+
+type ProfileStatCount struct {
+	Key   string `json:"key"`
+	Count int64  `json:"count"`
+}
+
+type ProfileStats struct {
+	Total    int64               `json:"total"`
+	ByGender []*ProfileStatCount `json:"by_gender"`
+	ByClass  []*ProfileStatCount `json:"by_class"`
+	BySkill  []*ProfileStatCount `json:"by_skill"`
+	ByMonth  []*ProfileStatCount `json:"by_month"`
+}
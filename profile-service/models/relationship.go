@@ -0,0 +1,108 @@
+package models
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// RelationshipType reads from the profile a relationship starts at, the
+// mentor of a mentee or the guardian of a ward.
+type RelationshipType string
+
+const (
+	RelationshipTypeMentor   RelationshipType = "mentor"
+	RelationshipTypeGuardian RelationshipType = "guardian"
+	RelationshipTypeSibling  RelationshipType = "sibling"
+)
+
+// relationshipRoles are the roles of the profiles a relationship starts and ends at
+var relationshipRoles = map[RelationshipType][2]string{
+	RelationshipTypeMentor:   {"mentor", "mentee"},
+	RelationshipTypeGuardian: {"guardian", "ward"},
+	RelationshipTypeSibling:  {"sibling", "sibling"},
+}
+
+func (t RelationshipType) IsValid() bool {
+	_, ok := relationshipRoles[t]
+	return ok
+}
+
+// IsSymmetric reports whether the relationship reads the same both ways.
+func (t RelationshipType) IsSymmetric() bool {
+	roles := relationshipRoles[t]
+	return roles[0] == roles[1]
+}
+
+// SymmetricRelationshipTypes lists the types stored with the smaller profile id first.
+func SymmetricRelationshipTypes() []RelationshipType {
+	return []RelationshipType{RelationshipTypeSibling}
+}
+
+// Relationship is a typed edge from one profile to another.
+type Relationship struct {
+	ID            *uuid.UUID       `json:"id"`
+	Type          RelationshipType `json:"type"`
+	FromProfileID *uuid.UUID       `json:"from_profile_id"`
+	ToProfileID   *uuid.UUID       `json:"to_profile_id"`
+	CreatedAt     *time.Time       `json:"created_at"`
+
+	FromProfile *Profile `json:"-" gorm:"foreignKey:FromProfileID"`
+	ToProfile   *Profile `json:"-" gorm:"foreignKey:ToProfileID"`
+}
+
+func (Relationship) TableName() string {
+	return "relationship"
+}
+
+func (r *Relationship) GenUUID() {
+	id, _ := uuid.NewV4()
+	r.ID = &id
+}
+
+func (r *Relationship) SetCreatedAt() {
+	now := time.Now()
+	r.CreatedAt = &now
+}
+
+// Normalize orders the profiles of a symmetric relationship the way they are
+// stored, so either order is the same edge.
+func (r *Relationship) Normalize() {
+	if r.Type.IsSymmetric() && bytes.Compare(r.FromProfileID.Bytes(), r.ToProfileID.Bytes()) > 0 {
+		r.FromProfileID, r.ToProfileID = r.ToProfileID, r.FromProfileID
+	}
+}
+
+// RelatedProfile is the profile at the other end of a relationship.
+type RelatedProfile struct {
+	ID        *uuid.UUID `json:"id"`
+	FirstName string     `json:"first_name"`
+	LastName  string     `json:"last_name"`
+}
+
+// ProfileRelationship is a relationship seen from one of its profiles, Role
+// being what the related profile is to it, such as its mentor.
+type ProfileRelationship struct {
+	ID             *uuid.UUID       `json:"id"`
+	Type           RelationshipType `json:"type"`
+	Role           string           `json:"role"`
+	RelatedProfile *RelatedProfile  `json:"related_profile"`
+	CreatedAt      *time.Time       `json:"created_at"`
+}
+
+// ForProfile returns the relationship seen from the profile profileId.
+func (r *Relationship) ForProfile(profileId *uuid.UUID) *ProfileRelationship {
+	roles := relationshipRoles[r.Type]
+	role, related := roles[0], r.FromProfile
+	if r.ToProfileID != nil && profileId != nil && *r.ToProfileID != *profileId {
+		role, related = roles[1], r.ToProfile
+	}
+
+	viewed := &ProfileRelationship{ID: r.ID, Type: r.Type, Role: role, CreatedAt: r.CreatedAt}
+	if related != nil {
+		viewed.RelatedProfile = &RelatedProfile{ID: related.ID, FirstName: related.FirstName, LastName: related.LastName}
+	}
+
+	return viewed
+}
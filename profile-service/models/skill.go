@@ -1,18 +1,38 @@
 package models
 
 import (
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gofrs/uuid"
 )
 
 type Skill struct {
-	ID        *uuid.UUID `json:"id"`
-	ProfileID *uuid.UUID `json:"profile_id"`
-	Skill     string     `json:"skill"`
-	Detail    string     `json:"detail"`
-	CreatedAt *time.Time `json:"created_at"`
-	UpdatedAt *time.Time `json:"updated_at"`
+	ID              *uuid.UUID        `json:"id"`
+	ProfileID       *uuid.UUID        `json:"profile_id"`
+	CatalogID       *uuid.UUID        `json:"catalog_id"`
+	Skill           string            `json:"skill"`
+	Detail          string            `json:"detail"`
+	Proficiency     *SkillProficiency `json:"proficiency"`
+	YearsExperience *float64          `json:"years_experience"`
+	LastUsed        *time.Time        `json:"last_used"`
+	CreatedAt       *time.Time        `json:"created_at"`
+	UpdatedAt       *time.Time        `json:"updated_at"`
+}
+
+type SkillProficiency int
+
+const (
+	SkillProficiencyBeginner SkillProficiency = iota + 1
+	SkillProficiencyElementary
+	SkillProficiencyIntermediate
+	SkillProficiencyAdvanced
+	SkillProficiencyExpert
+)
+
+func (p SkillProficiency) IsValid() bool {
+	return p >= SkillProficiencyBeginner && p <= SkillProficiencyExpert
 }
 
 func (Skill) TableName() string {
@@ -32,4 +52,51 @@ func (s *Skill) SetCreatedAt() {
 func (s *Skill) SetUpdatedAt() {
 	now := time.Now()
 	s.UpdatedAt = &now
-}
\ No newline at end of file
+}
+
+// SetLastUsed keeps only the date of lastUsed.
+func (s *Skill) SetLastUsed(lastUsed *time.Time) {
+	if lastUsed == nil {
+		s.LastUsed = nil
+		return
+	}
+
+	date := lastUsed.UTC().Truncate(24 * time.Hour)
+	s.LastUsed = &date
+}
+
+// SkillLevelFilter matches profiles having a skill with at least MinProficiency,
+// any proficiency when MinProficiency is nil.
+type SkillLevelFilter struct {
+	Key            string
+	MinProficiency *SkillProficiency
+}
+
+// skillLevelOperators are the characters of comparison operators, >= being
+// the only one a filter accepts.
+const skillLevelOperators = "<>=!"
+
+// ParseSkillLevelFilter parses filters such as "Go>=3" or "Go".
+func ParseSkillLevelFilter(filter string) (*SkillLevelFilter, bool) {
+	name, level, hasLevel := strings.Cut(filter, ">=")
+	if strings.ContainsAny(name, skillLevelOperators) || strings.ContainsAny(level, skillLevelOperators) {
+		return nil, false
+	}
+
+	key := NormalizeSkillName(name)
+	if key == "" {
+		return nil, false
+	}
+
+	skillFilter := &SkillLevelFilter{Key: key}
+	if hasLevel {
+		value, err := strconv.Atoi(strings.TrimSpace(level))
+		if err != nil || !SkillProficiency(value).IsValid() {
+			return nil, false
+		}
+		proficiency := SkillProficiency(value)
+		skillFilter.MinProficiency = &proficiency
+	}
+
+	return skillFilter, true
+}
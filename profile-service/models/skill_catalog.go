@@ -0,0 +1,180 @@
+package models
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// NormalizeSkillName is the key skills are matched on: lower case with
+// surrounding and repeated whitespace removed.
+func NormalizeSkillName(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), " ")
+}
+
+type SkillCategory struct {
+	ID        *uuid.UUID `json:"id"`
+	Name      string     `json:"name"`
+	ParentID  *uuid.UUID `json:"parent_id"`
+	CreatedAt *time.Time `json:"created_at"`
+	UpdatedAt *time.Time `json:"updated_at"`
+}
+
+func (SkillCategory) TableName() string {
+	return "skill_category"
+}
+
+func (c *SkillCategory) GenUUID() {
+	id, _ := uuid.NewV4()
+	c.ID = &id
+}
+
+func (c *SkillCategory) SetCreatedAt() {
+	now := time.Now()
+	c.CreatedAt = &now
+}
+
+func (c *SkillCategory) SetUpdatedAt() {
+	now := time.Now()
+	c.UpdatedAt = &now
+}
+
+type SkillAlias struct {
+	ID              *uuid.UUID `json:"id"`
+	CatalogID       *uuid.UUID `json:"catalog_id"`
+	Alias           string     `json:"alias"`
+	NormalizedAlias string     `json:"-"`
+	CreatedAt       *time.Time `json:"created_at"`
+}
+
+func (SkillAlias) TableName() string {
+	return "skill_alias"
+}
+
+func NewSkillAlias(catalogId *uuid.UUID, alias string) *SkillAlias {
+	id, _ := uuid.NewV4()
+	now := time.Now()
+	return &SkillAlias{
+		ID:              &id,
+		CatalogID:       catalogId,
+		Alias:           strings.TrimSpace(alias),
+		NormalizedAlias: NormalizeSkillName(alias),
+		CreatedAt:       &now,
+	}
+}
+
+type SkillCatalog struct {
+	ID             *uuid.UUID    `json:"id"`
+	Name           string        `json:"name"`
+	NormalizedName string        `json:"-"`
+	CategoryID     *uuid.UUID    `json:"category_id"`
+	CreatedAt      *time.Time    `json:"created_at"`
+	UpdatedAt      *time.Time    `json:"updated_at"`
+	Aliases        []*SkillAlias `json:"-" gorm:"foreignKey:CatalogID"`
+}
+
+func (SkillCatalog) TableName() string {
+	return "skill_catalog"
+}
+
+func (c *SkillCatalog) GenUUID() {
+	id, _ := uuid.NewV4()
+	c.ID = &id
+}
+
+func (c *SkillCatalog) SetCreatedAt() {
+	now := time.Now()
+	c.CreatedAt = &now
+}
+
+func (c *SkillCatalog) SetUpdatedAt() {
+	now := time.Now()
+	c.UpdatedAt = &now
+}
+
+// SetName sets the canonical name together with its normalized key.
+func (c *SkillCatalog) SetName(name string) {
+	c.Name = strings.TrimSpace(name)
+	c.NormalizedName = NormalizeSkillName(name)
+}
+
+// SetAliases replaces the aliases, dropping blanks, duplicates and the canonical name itself.
+func (c *SkillCatalog) SetAliases(aliases []string) {
+	seen := map[string]bool{c.NormalizedName: true}
+	c.Aliases = make([]*SkillAlias, 0, len(aliases))
+	for _, alias := range aliases {
+		key := NormalizeSkillName(alias)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		c.Aliases = append(c.Aliases, NewSkillAlias(c.ID, alias))
+	}
+}
+
+// Keys returns the normalized name followed by the normalized aliases.
+func (c *SkillCatalog) Keys() []string {
+	keys := []string{c.NormalizedName}
+	for _, alias := range c.Aliases {
+		keys = append(keys, alias.NormalizedAlias)
+	}
+	return keys
+}
+
+// MarshalJSON exposes the aliases as plain strings.
+func (c SkillCatalog) MarshalJSON() ([]byte, error) {
+	type skillCatalog SkillCatalog
+	aliases := make([]string, 0, len(c.Aliases))
+	for _, alias := range c.Aliases {
+		aliases = append(aliases, alias.Alias)
+	}
+
+	return json.Marshal(struct {
+		skillCatalog
+		Aliases []string `json:"aliases"`
+	}{
+		skillCatalog: skillCatalog(c),
+		Aliases:      aliases,
+	})
+}
+
+type SkillReviewStatus string
+
+const (
+	SkillReviewStatusPending  SkillReviewStatus = "pending"
+	SkillReviewStatusApproved SkillReviewStatus = "approved"
+	SkillReviewStatusRejected SkillReviewStatus = "rejected"
+)
+
+// SkillReview is a skill entered on a profile that is not in the catalog yet.
+type SkillReview struct {
+	ID             *uuid.UUID        `json:"id"`
+	Name           string            `json:"name"`
+	NormalizedName string            `json:"-"`
+	Occurrences    int               `json:"occurrences"`
+	Status         SkillReviewStatus `json:"status"`
+	CatalogID      *uuid.UUID        `json:"catalog_id"`
+	CreatedAt      *time.Time        `json:"created_at"`
+	UpdatedAt      *time.Time        `json:"updated_at"`
+}
+
+func (SkillReview) TableName() string {
+	return "skill_review"
+}
+
+func (r *SkillReview) GenUUID() {
+	id, _ := uuid.NewV4()
+	r.ID = &id
+}
+
+func (r *SkillReview) SetCreatedAt() {
+	now := time.Now()
+	r.CreatedAt = &now
+}
+
+func (r *SkillReview) SetUpdatedAt() {
+	now := time.Now()
+	r.UpdatedAt = &now
+}
@@ -0,0 +1,51 @@
+package models
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/gofrs/uuid"
+)
+
+// SkillSuggestion is a skill name offered while typing, either a catalog entry
+// or a skill entered on profiles that is not in the catalog.
+type SkillSuggestion struct {
+	CatalogID *uuid.UUID `json:"catalog_id"`
+	Name      string     `json:"name"`
+	Usage     int64      `json:"usage"`
+	// Keys are the normalized names the suggestion is matched on, the aliases included
+	Keys []string `json:"-"`
+}
+
+// SkillTrigrams splits a normalized skill name into trigrams the way pg_trgm
+// does: every word is padded with two spaces in front and one behind.
+func SkillTrigrams(key string) map[string]bool {
+	trigrams := make(map[string]bool)
+	words := strings.FieldsFunc(key, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		padded := []rune("  " + word + " ")
+		for i := 0; i+3 <= len(padded); i++ {
+			trigrams[string(padded[i:i+3])] = true
+		}
+	}
+
+	return trigrams
+}
+
+// TrigramSimilarity is the share of trigrams a and b have in common, from 0 to 1.
+func TrigramSimilarity(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	common := 0
+	for trigram := range a {
+		if b[trigram] {
+			common++
+		}
+	}
+
+	return float64(common) / float64(len(a)+len(b)-common)
+}
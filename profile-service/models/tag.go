@@ -0,0 +1,91 @@
+package models
+
+import (
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gofrs/uuid"
+)
+
+// maxTagLength is the length of the tag name column
+const maxTagLength = 50
+
+// tagPattern allows letters with their combining marks, as in Thai, digits and
+// the separators of tags such as club:robotics or needs-follow-up.
+var tagPattern = regexp.MustCompile(`^[\p{L}\p{N}][\p{L}\p{M}\p{N}:._-]*$`)
+
+// Tag is a label profiles are tagged with, such as scholarship.
+type Tag struct {
+	ID        *uuid.UUID `json:"id"`
+	Name      string     `json:"name"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+func (Tag) TableName() string {
+	return "tag"
+}
+
+func (t *Tag) GenUUID() {
+	id, _ := uuid.NewV4()
+	t.ID = &id
+}
+
+func (t *Tag) SetCreatedAt() {
+	now := time.Now()
+	t.CreatedAt = &now
+}
+
+// ProfileTag links a profile to one of its tags.
+type ProfileTag struct {
+	ProfileID *uuid.UUID `json:"profile_id" gorm:"primaryKey"`
+	TagID     *uuid.UUID `json:"tag_id" gorm:"primaryKey"`
+	CreatedAt *time.Time `json:"created_at"`
+}
+
+func (ProfileTag) TableName() string {
+	return "profile_tag"
+}
+
+// TagCount is how many profiles have the tag Name.
+type TagCount struct {
+	Name  string `json:"name"`
+	Count int64  `json:"count"`
+}
+
+// TagBulkResult is how many tags a bulk change added to and removed from profiles.
+type TagBulkResult struct {
+	Added   int64 `json:"added"`
+	Removed int64 `json:"removed"`
+}
+
+// NormalizeTag returns the tag as it is stored, in lower case with runs of
+// spaces turned into a hyphen, and whether it is a valid tag.
+func NormalizeTag(name string) (string, bool) {
+	normalized := strings.Join(strings.Fields(strings.ToLower(name)), "-")
+	if utf8.RuneCountInString(normalized) > maxTagLength || !tagPattern.MatchString(normalized) {
+		return "", false
+	}
+
+	return normalized, true
+}
+
+// NormalizeTags normalizes every tag of names, dropping duplicates, and
+// whether they are all valid.
+func NormalizeTags(names []string) ([]string, bool) {
+	normalized := make([]string, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		tag, ok := NormalizeTag(name)
+		if !ok {
+			return nil, false
+		}
+		if !seen[tag] {
+			seen[tag] = true
+			normalized = append(normalized, tag)
+		}
+	}
+
+	return normalized, true
+}
@@ -0,0 +1,11 @@
+package attachment
+
+import "io"
+
+// BlobStore keeps the content of attachments, addressed by key. Get returns
+// constants.ErrBlobNotFound for a missing key and Delete ignores one.
+type BlobStore interface {
+	Put(key string, data []byte, contentType string) error
+	Get(key string) (io.ReadCloser, error)
+	Delete(key string) error
+}
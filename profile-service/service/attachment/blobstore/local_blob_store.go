@@ -0,0 +1,106 @@
+package blobstore
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/service/attachment"
+)
+
+// localBlobStore keeps blobs as files under a root directory, the key being
+// the slash-separated path below it.
+type localBlobStore struct {
+	root string
+}
+
+// path returns the file of key, rejecting keys that would leave the root.
+func (l *localBlobStore) path(key string) (string, error) {
+	path := filepath.Join(l.root, filepath.FromSlash(key))
+	if key == "" || !strings.HasPrefix(path, l.root+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+
+	return path, nil
+}
+
+// Put implements attachment.BlobStore.
+// The blob is written to a temporary file first so readers never see a partial file.
+func (l *localBlobStore) Put(key string, data []byte, contentType string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// Get implements attachment.BlobStore.
+func (l *localBlobStore) Get(key string) (io.ReadCloser, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, constants.ErrBlobNotFound
+		}
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// Delete implements attachment.BlobStore.
+func (l *localBlobStore) Delete(key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func NewLocalBlobStore(root string) (attachment.BlobStore, error) {
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &localBlobStore{
+		root: root,
+	}, nil
+}
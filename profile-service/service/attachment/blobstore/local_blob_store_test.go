@@ -0,0 +1,46 @@
+package blobstore
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalBlobStore(t *testing.T) {
+	root := t.TempDir()
+	store, err := NewLocalBlobStore(root)
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put("attachments/1/thumbnail", []byte("jpeg"), "image/jpeg"))
+
+	body, err := store.Get("attachments/1/thumbnail")
+	require.NoError(t, err)
+	data, _ := io.ReadAll(body)
+	body.Close()
+	assert.Equal(t, "jpeg", string(data))
+
+	// no temporary file is left next to the blob
+	entries, err := os.ReadDir(filepath.Join(root, "attachments", "1"))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	require.NoError(t, store.Delete("attachments/1/thumbnail"))
+	require.NoError(t, store.Delete("attachments/1/thumbnail"))
+
+	_, err = store.Get("attachments/1/thumbnail")
+	assert.ErrorIs(t, err, constants.ErrBlobNotFound)
+}
+
+func TestLocalBlobStore_KeyOutsideRoot(t *testing.T) {
+	store, err := NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+
+	assert.Error(t, store.Put("../escape", []byte("x"), "text/plain"))
+	_, err = store.Get("")
+	assert.Error(t, err)
+}
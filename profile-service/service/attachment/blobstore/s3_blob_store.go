@@ -0,0 +1,217 @@
+package blobstore
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/service/attachment"
+)
+
+const (
+	s3Service   = "s3"
+	s3Algorithm = "AWS4-HMAC-SHA256"
+	amzDate     = "20060102T150405Z"
+	// s3ErrorBodyLimit is how much of an error response is kept in the returned error
+	s3ErrorBodyLimit = 1024
+)
+
+// S3Config locates a bucket of an S3-compatible store such as MinIO.
+type S3Config struct {
+	// Endpoint is the base URL of the store, e.g. https://s3.ap-southeast-1.amazonaws.com or http://localhost:9000
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	Timeout   time.Duration
+}
+
+// s3BlobStore talks to the store with path-style URLs and requests signed
+// with AWS Signature Version 4, which every S3-compatible store accepts.
+type s3BlobStore struct {
+	endpoint  *url.URL
+	region    string
+	bucket    string
+	accessKey string
+	secretKey string
+	client    *http.Client
+	now       func() time.Time
+}
+
+// Put implements attachment.BlobStore.
+func (s *s3BlobStore) Put(key string, data []byte, contentType string) error {
+	resp, err := s.do(http.MethodPut, key, data, contentType)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return s3Error(http.MethodPut, key, resp)
+	}
+
+	return nil
+}
+
+// Get implements attachment.BlobStore.
+func (s *s3BlobStore) Get(key string) (io.ReadCloser, error) {
+	resp, err := s.do(http.MethodGet, key, nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, constants.ErrBlobNotFound
+	}
+
+	defer resp.Body.Close()
+	return nil, s3Error(http.MethodGet, key, resp)
+}
+
+// Delete implements attachment.BlobStore.
+// S3 answers 204 whether or not the object existed.
+func (s *s3BlobStore) Delete(key string) error {
+	resp, err := s.do(http.MethodDelete, key, nil, "")
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return s3Error(http.MethodDelete, key, resp)
+	}
+
+	return nil
+}
+
+func (s *s3BlobStore) do(method string, key string, body []byte, contentType string) (*http.Response, error) {
+	objectURL := *s.endpoint
+	objectURL.Path = strings.TrimSuffix(objectURL.Path, "/") + "/" + s.bucket + "/" + key
+	objectURL.RawPath = strings.TrimSuffix(s.endpoint.EscapedPath(), "/") + "/" + s3Escape(s.bucket) + "/" + s3Escape(key)
+
+	req, err := http.NewRequest(method, objectURL.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	signS3Request(req, body, s.accessKey, s.secretKey, s.region, s.now())
+
+	return s.client.Do(req)
+}
+
+func s3Error(method string, key string, resp *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, s3ErrorBodyLimit))
+	return fmt.Errorf("s3 %s %s: %s: %s", method, key, resp.Status, strings.TrimSpace(string(body)))
+}
+
+// signS3Request adds the headers of AWS Signature Version 4 to req, signing
+// the host and every header already set on it.
+func signS3Request(req *http.Request, body []byte, accessKey string, secretKey string, region string, now time.Time) {
+	now = now.UTC()
+	payloadHash := sha256Hex(body)
+	req.Header.Set("X-Amz-Date", now.Format(amzDate))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	date := now.Format("20060102")
+	scope := date + "/" + region + "/" + s3Service + "/aws4_request"
+	stringToSign := strings.Join([]string{s3Algorithm, now.Format(amzDate), scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+secretKey), date)
+	for _, part := range []string{region, s3Service, "aws4_request"} {
+		signingKey = hmacSHA256(signingKey, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, accessKey, scope, signedHeaders, signature))
+}
+
+// s3Escape percent-encodes a key as Signature Version 4 expects, every byte
+// but the unreserved characters and the slashes between segments.
+func s3Escape(key string) string {
+	var escaped strings.Builder
+	for _, b := range []byte(key) {
+		switch {
+		case 'A' <= b && b <= 'Z', 'a' <= b && b <= 'z', '0' <= b && b <= '9',
+			b == '-', b == '.', b == '_', b == '~', b == '/':
+			escaped.WriteByte(b)
+		default:
+			fmt.Fprintf(&escaped, "%%%02X", b)
+		}
+	}
+
+	return escaped.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func NewS3BlobStore(config S3Config) (attachment.BlobStore, error) {
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint.Scheme == "" || endpoint.Host == "" || config.Bucket == "" {
+		return nil, fmt.Errorf("s3 blob store needs an endpoint URL and a bucket")
+	}
+
+	return &s3BlobStore{
+		endpoint:  endpoint,
+		region:    config.Region,
+		bucket:    config.Bucket,
+		accessKey: config.AccessKey,
+		secretKey: config.SecretKey,
+		client:    &http.Client{Timeout: config.Timeout},
+		now:       time.Now,
+	}, nil
+}
@@ -0,0 +1,2 @@
+package attachment 
+//go:generate oapi-codegen --config=./server.cfg.yaml ../../../api-spec/attachment/openapi_bundle.yml
@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_attachment "github.com/jariwat/p_project/profile-service/service/attachment"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type attachmentHandler struct {
+	attachmentUs _attachment.AttachmentUsecase
+}
+
+// respondError maps domain errors to their HTTP status.
+func respondError(c *gin.Context, err error) {
+	var maxBytesErr *http.MaxBytesError
+	switch {
+	case errors.Is(err, constants.ErrProfileNotFound),
+		errors.Is(err, constants.ErrAttachmentNotFound),
+		errors.Is(err, constants.ErrThumbnailNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrEmptyAttachment),
+		errors.Is(err, constants.ErrInvalidImage):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrAttachmentTooLarge), errors.As(err, &maxBytesErr):
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": constants.ErrAttachmentTooLarge.Error()})
+	case errors.Is(err, constants.ErrUnsupportedAttachmentType):
+		c.JSON(http.StatusUnsupportedMediaType, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// GetProfileIdAttachments implements attachment.ServerInterface.
+func (a *attachmentHandler) GetProfileIdAttachments(c *gin.Context, id types.UUID, params _attachment.GetProfileIdAttachmentsParams) {
+	var profileId = uuid.FromStringOrNil(id.String())
+
+	attachments, err := a.attachmentUs.FetchAttachments(&profileId, params)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var data []_attachment.Attachment
+	bu, err := json.Marshal(attachments)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal attachments"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal attachments"})
+		return
+	}
+
+	c.JSON(http.StatusOK, _attachment.AttachmentsResponse{Data: &data})
+}
+
+// PostProfileIdAttachments implements attachment.ServerInterface.
+func (a *attachmentHandler) PostProfileIdAttachments(c *gin.Context, id types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			respondError(c, err)
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Missing file"})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	uploaded, err := a.attachmentUs.UploadAttachment(&profileId, models.AttachmentKind(c.PostForm("kind")), fileHeader.Filename, data)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var response _attachment.Attachment
+	bu, err := json.Marshal(uploaded)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal attachment"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &response); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal attachment"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, _attachment.AttachmentResponse{Data: &response})
+}
+
+// GetProfileIdAttachmentsAttachmentId implements attachment.ServerInterface.
+func (a *attachmentHandler) GetProfileIdAttachmentsAttachmentId(c *gin.Context, id types.UUID, attachmentId types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+	var downloadId = uuid.FromStringOrNil(attachmentId.String())
+
+	found, content, err := a.attachmentUs.OpenAttachment(&profileId, &downloadId)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	defer content.Close()
+
+	c.DataFromReader(http.StatusOK, found.Size, found.ContentType, content, map[string]string{
+		"Content-Disposition":    mime.FormatMediaType("attachment", map[string]string{"filename": found.FileName}),
+		"X-Content-Type-Options": "nosniff",
+		"ETag":                   `"` + found.Checksum + `"`,
+	})
+}
+
+// GetProfileIdAttachmentsAttachmentIdThumbnail implements attachment.ServerInterface.
+func (a *attachmentHandler) GetProfileIdAttachmentsAttachmentIdThumbnail(c *gin.Context, id types.UUID, attachmentId types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+	var thumbnailId = uuid.FromStringOrNil(attachmentId.String())
+
+	content, err := a.attachmentUs.OpenThumbnail(&profileId, &thumbnailId)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+	defer content.Close()
+
+	c.DataFromReader(http.StatusOK, -1, "image/jpeg", content, map[string]string{
+		"X-Content-Type-Options": "nosniff",
+	})
+}
+
+// DeleteProfileIdAttachmentsAttachmentId implements attachment.ServerInterface.
+func (a *attachmentHandler) DeleteProfileIdAttachmentsAttachmentId(c *gin.Context, id types.UUID, attachmentId types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+	var deleteId = uuid.FromStringOrNil(attachmentId.String())
+
+	if err := a.attachmentUs.DeleteAttachment(&profileId, &deleteId); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, _attachment.Success{Message: "Attachment deleted successfully"})
+}
+
+func NewAttachmentHandler(attachmentUs _attachment.AttachmentUsecase) _attachment.ServerInterface {
+	return &attachmentHandler{
+		attachmentUs: attachmentUs,
+	}
+}
@@ -0,0 +1,208 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_attachment "github.com/jariwat/p_project/profile-service/service/attachment"
+	"github.com/jariwat/p_project/profile-service/service/attachment/mocks"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func uploadRequest(t *testing.T, profileId *uuid.UUID, kind string, fileName string, data []byte) *http.Request {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	require.NoError(t, writer.WriteField("kind", kind))
+	if fileName != "" {
+		part, err := writer.CreateFormFile("file", fileName)
+		require.NoError(t, err)
+		part.Write(data)
+	}
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/profile/"+profileId.String()+"/attachments", &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+func TestGetProfileIdAttachments_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileID := ptrUUID()
+	thumbnailKey := "attachments/1/thumbnail.jpg"
+	params := _attachment.GetProfileIdAttachmentsParams{}
+	mockUsecase := new(mocks.AttachmentUsecase)
+	mockUsecase.On("FetchAttachments", profileID, params).Return([]*models.Attachment{
+		{ID: ptrUUID(), Kind: models.AttachmentKindPhoto, FileName: "me.png", ContentType: "image/png", Size: 10,
+			StorageKey: "attachments/1/original", ThumbnailKey: &thumbnailKey},
+		{ID: ptrUUID(), Kind: models.AttachmentKindTranscript, FileName: "t.pdf", ContentType: "application/pdf", Size: 20},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/profile/"+profileID.String()+"/attachments", nil)
+
+	handler := NewAttachmentHandler(mockUsecase)
+	handler.GetProfileIdAttachments(c, types.UUID(*profileID), params)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "attachments/1")
+
+	var resp _attachment.AttachmentsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, *resp.Data, 2)
+	assert.True(t, *(*resp.Data)[0].HasThumbnail)
+	assert.False(t, *(*resp.Data)[1].HasThumbnail)
+}
+
+func TestPostProfileIdAttachments_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileID := ptrUUID()
+	data := []byte("%PDF-1.7")
+	mockUsecase := new(mocks.AttachmentUsecase)
+	mockUsecase.On("UploadAttachment", profileID, models.AttachmentKindCertificate, "toeic.pdf", data).Return(&models.Attachment{
+		ID: ptrUUID(), Kind: models.AttachmentKindCertificate, FileName: "toeic.pdf", ContentType: "application/pdf", Size: 8,
+	}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = uploadRequest(t, profileID, "certificate", "toeic.pdf", data)
+
+	handler := NewAttachmentHandler(mockUsecase)
+	handler.PostProfileIdAttachments(c, types.UUID(*profileID))
+
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var resp _attachment.AttachmentResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "application/pdf", *resp.Data.ContentType)
+	assert.Equal(t, int64(8), *resp.Data.Size)
+}
+
+func TestPostProfileIdAttachments_MissingFile(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileID := ptrUUID()
+	mockUsecase := new(mocks.AttachmentUsecase)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = uploadRequest(t, profileID, "photo", "", nil)
+
+	handler := NewAttachmentHandler(mockUsecase)
+	handler.PostProfileIdAttachments(c, types.UUID(*profileID))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockUsecase.AssertNotCalled(t, "UploadAttachment", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPostProfileIdAttachments_Errors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{constants.ErrProfileNotFound, http.StatusNotFound},
+		{constants.ErrEmptyAttachment, http.StatusBadRequest},
+		{constants.ErrInvalidImage, http.StatusBadRequest},
+		{constants.ErrAttachmentTooLarge, http.StatusRequestEntityTooLarge},
+		{constants.ErrUnsupportedAttachmentType, http.StatusUnsupportedMediaType},
+		{errors.New("store unavailable"), http.StatusInternalServerError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.err.Error(), func(t *testing.T) {
+			profileID := ptrUUID()
+			mockUsecase := new(mocks.AttachmentUsecase)
+			mockUsecase.On("UploadAttachment", profileID, mock.Anything, mock.Anything, mock.Anything).Return(nil, tc.err)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = uploadRequest(t, profileID, "photo", "me.png", []byte("x"))
+
+			handler := NewAttachmentHandler(mockUsecase)
+			handler.PostProfileIdAttachments(c, types.UUID(*profileID))
+
+			assert.Equal(t, tc.status, w.Code)
+		})
+	}
+}
+
+func TestGetProfileIdAttachmentsAttachmentId_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileID, attachmentID := ptrUUID(), ptrUUID()
+	mockUsecase := new(mocks.AttachmentUsecase)
+	mockUsecase.On("OpenAttachment", profileID, attachmentID).Return(&models.Attachment{
+		ID: attachmentID, FileName: "ใบรับรอง.pdf", ContentType: "application/pdf", Size: 8, Checksum: "abc",
+	}, io.NopCloser(strings.NewReader("%PDF-1.7")), nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/profile/"+profileID.String()+"/attachments/"+attachmentID.String(), nil)
+
+	handler := NewAttachmentHandler(mockUsecase)
+	handler.GetProfileIdAttachmentsAttachmentId(c, types.UUID(*profileID), types.UUID(*attachmentID))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "%PDF-1.7", w.Body.String())
+	assert.Equal(t, "application/pdf", w.Header().Get("Content-Type"))
+	assert.Equal(t, "8", w.Header().Get("Content-Length"))
+	assert.Equal(t, "attachment; filename*=utf-8''%E0%B9%83%E0%B8%9A%E0%B8%A3%E0%B8%B1%E0%B8%9A%E0%B8%A3%E0%B8%AD%E0%B8%87.pdf", w.Header().Get("Content-Disposition"))
+	assert.Equal(t, "nosniff", w.Header().Get("X-Content-Type-Options"))
+}
+
+func TestGetProfileIdAttachmentsAttachmentIdThumbnail_NotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileID, attachmentID := ptrUUID(), ptrUUID()
+	mockUsecase := new(mocks.AttachmentUsecase)
+	mockUsecase.On("OpenThumbnail", profileID, attachmentID).Return(nil, constants.ErrThumbnailNotFound)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/profile/"+profileID.String()+"/attachments/"+attachmentID.String()+"/thumbnail", nil)
+
+	handler := NewAttachmentHandler(mockUsecase)
+	handler.GetProfileIdAttachmentsAttachmentIdThumbnail(c, types.UUID(*profileID), types.UUID(*attachmentID))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestDeleteProfileIdAttachmentsAttachmentId_NotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileID, attachmentID := ptrUUID(), ptrUUID()
+	mockUsecase := new(mocks.AttachmentUsecase)
+	mockUsecase.On("DeleteAttachment", profileID, attachmentID).Return(constants.ErrAttachmentNotFound)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodDelete, "/profile/"+profileID.String()+"/attachments/"+attachmentID.String(), nil)
+
+	handler := NewAttachmentHandler(mockUsecase)
+	handler.DeleteProfileIdAttachmentsAttachmentId(c, types.UUID(*profileID), types.UUID(*attachmentID))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
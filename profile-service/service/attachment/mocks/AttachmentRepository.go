@@ -0,0 +1,172 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	uuid "github.com/gofrs/uuid"
+	models "github.com/jariwat/p_project/profile-service/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AttachmentRepository is an autogenerated mock type for the AttachmentRepository type
+type AttachmentRepository struct {
+	mock.Mock
+}
+
+// CreateAttachment provides a mock function with given fields: _a0
+func (_m *AttachmentRepository) CreateAttachment(_a0 *models.Attachment) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateAttachment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Attachment) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteAttachment provides a mock function with given fields: profileId, attachmentId
+func (_m *AttachmentRepository) DeleteAttachment(profileId *uuid.UUID, attachmentId *uuid.UUID) error {
+	ret := _m.Called(profileId, attachmentId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAttachment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) error); ok {
+		r0 = rf(profileId, attachmentId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteBlobDeletions provides a mock function with given fields: keys
+func (_m *AttachmentRepository) DeleteBlobDeletions(keys []string) error {
+	ret := _m.Called(keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteBlobDeletions")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]string) error); ok {
+		r0 = rf(keys)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FetchAttachmentById provides a mock function with given fields: profileId, attachmentId
+func (_m *AttachmentRepository) FetchAttachmentById(profileId *uuid.UUID, attachmentId *uuid.UUID) (*models.Attachment, error) {
+	ret := _m.Called(profileId, attachmentId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchAttachmentById")
+	}
+
+	var r0 *models.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) (*models.Attachment, error)); ok {
+		return rf(profileId, attachmentId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) *models.Attachment); ok {
+		r0 = rf(profileId, attachmentId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *uuid.UUID) error); ok {
+		r1 = rf(profileId, attachmentId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchAttachments provides a mock function with given fields: profileId, kind
+func (_m *AttachmentRepository) FetchAttachments(profileId *uuid.UUID, kind *models.AttachmentKind) ([]*models.Attachment, error) {
+	ret := _m.Called(profileId, kind)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchAttachments")
+	}
+
+	var r0 []*models.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *models.AttachmentKind) ([]*models.Attachment, error)); ok {
+		return rf(profileId, kind)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *models.AttachmentKind) []*models.Attachment); ok {
+		r0 = rf(profileId, kind)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *models.AttachmentKind) error); ok {
+		r1 = rf(profileId, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchBlobDeletions provides a mock function with given fields: limit
+func (_m *AttachmentRepository) FetchBlobDeletions(limit int) ([]*models.BlobDeletion, error) {
+	ret := _m.Called(limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchBlobDeletions")
+	}
+
+	var r0 []*models.BlobDeletion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int) ([]*models.BlobDeletion, error)); ok {
+		return rf(limit)
+	}
+	if rf, ok := ret.Get(0).(func(int) []*models.BlobDeletion); ok {
+		r0 = rf(limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.BlobDeletion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int) error); ok {
+		r1 = rf(limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAttachmentRepository creates a new instance of AttachmentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAttachmentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AttachmentRepository {
+	mock := &AttachmentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,229 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	io "io"
+
+	attachment "github.com/jariwat/p_project/profile-service/service/attachment"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/jariwat/p_project/profile-service/models"
+
+	uuid "github.com/gofrs/uuid"
+)
+
+// AttachmentUsecase is an autogenerated mock type for the AttachmentUsecase type
+type AttachmentUsecase struct {
+	mock.Mock
+}
+
+// DeleteAttachment provides a mock function with given fields: profileId, attachmentId
+func (_m *AttachmentUsecase) DeleteAttachment(profileId *uuid.UUID, attachmentId *uuid.UUID) error {
+	ret := _m.Called(profileId, attachmentId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAttachment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) error); ok {
+		r0 = rf(profileId, attachmentId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FetchAttachmentById provides a mock function with given fields: profileId, attachmentId
+func (_m *AttachmentUsecase) FetchAttachmentById(profileId *uuid.UUID, attachmentId *uuid.UUID) (*models.Attachment, error) {
+	ret := _m.Called(profileId, attachmentId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchAttachmentById")
+	}
+
+	var r0 *models.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) (*models.Attachment, error)); ok {
+		return rf(profileId, attachmentId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) *models.Attachment); ok {
+		r0 = rf(profileId, attachmentId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *uuid.UUID) error); ok {
+		r1 = rf(profileId, attachmentId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchAttachments provides a mock function with given fields: profileId, params
+func (_m *AttachmentUsecase) FetchAttachments(profileId *uuid.UUID, params attachment.GetProfileIdAttachmentsParams) ([]*models.Attachment, error) {
+	ret := _m.Called(profileId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchAttachments")
+	}
+
+	var r0 []*models.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, attachment.GetProfileIdAttachmentsParams) ([]*models.Attachment, error)); ok {
+		return rf(profileId, params)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, attachment.GetProfileIdAttachmentsParams) []*models.Attachment); ok {
+		r0 = rf(profileId, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, attachment.GetProfileIdAttachmentsParams) error); ok {
+		r1 = rf(profileId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OpenAttachment provides a mock function with given fields: profileId, attachmentId
+func (_m *AttachmentUsecase) OpenAttachment(profileId *uuid.UUID, attachmentId *uuid.UUID) (*models.Attachment, io.ReadCloser, error) {
+	ret := _m.Called(profileId, attachmentId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenAttachment")
+	}
+
+	var r0 *models.Attachment
+	var r1 io.ReadCloser
+	var r2 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) (*models.Attachment, io.ReadCloser, error)); ok {
+		return rf(profileId, attachmentId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) *models.Attachment); ok {
+		r0 = rf(profileId, attachmentId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *uuid.UUID) io.ReadCloser); ok {
+		r1 = rf(profileId, attachmentId)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(*uuid.UUID, *uuid.UUID) error); ok {
+		r2 = rf(profileId, attachmentId)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// OpenThumbnail provides a mock function with given fields: profileId, attachmentId
+func (_m *AttachmentUsecase) OpenThumbnail(profileId *uuid.UUID, attachmentId *uuid.UUID) (io.ReadCloser, error) {
+	ret := _m.Called(profileId, attachmentId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenThumbnail")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) (io.ReadCloser, error)); ok {
+		return rf(profileId, attachmentId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) io.ReadCloser); ok {
+		r0 = rf(profileId, attachmentId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *uuid.UUID) error); ok {
+		r1 = rf(profileId, attachmentId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeDeletedBlobs provides a mock function with no fields
+func (_m *AttachmentUsecase) PurgeDeletedBlobs() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeDeletedBlobs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UploadAttachment provides a mock function with given fields: profileId, kind, fileName, data
+func (_m *AttachmentUsecase) UploadAttachment(profileId *uuid.UUID, kind models.AttachmentKind, fileName string, data []byte) (*models.Attachment, error) {
+	ret := _m.Called(profileId, kind, fileName, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadAttachment")
+	}
+
+	var r0 *models.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, models.AttachmentKind, string, []byte) (*models.Attachment, error)); ok {
+		return rf(profileId, kind, fileName, data)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, models.AttachmentKind, string, []byte) *models.Attachment); ok {
+		r0 = rf(profileId, kind, fileName, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, models.AttachmentKind, string, []byte) error); ok {
+		r1 = rf(profileId, kind, fileName, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAttachmentUsecase creates a new instance of AttachmentUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAttachmentUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AttachmentUsecase {
+	mock := &AttachmentUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
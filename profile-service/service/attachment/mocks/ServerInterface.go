@@ -0,0 +1,56 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	attachment "github.com/jariwat/p_project/profile-service/service/attachment"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ServerInterface is an autogenerated mock type for the ServerInterface type
+type ServerInterface struct {
+	mock.Mock
+}
+
+// DeleteProfileIdAttachmentsAttachmentId provides a mock function with given fields: c, id, attachmentId
+func (_m *ServerInterface) DeleteProfileIdAttachmentsAttachmentId(c *gin.Context, id uuid.UUID, attachmentId uuid.UUID) {
+	_m.Called(c, id, attachmentId)
+}
+
+// GetProfileIdAttachments provides a mock function with given fields: c, id, params
+func (_m *ServerInterface) GetProfileIdAttachments(c *gin.Context, id uuid.UUID, params attachment.GetProfileIdAttachmentsParams) {
+	_m.Called(c, id, params)
+}
+
+// GetProfileIdAttachmentsAttachmentId provides a mock function with given fields: c, id, attachmentId
+func (_m *ServerInterface) GetProfileIdAttachmentsAttachmentId(c *gin.Context, id uuid.UUID, attachmentId uuid.UUID) {
+	_m.Called(c, id, attachmentId)
+}
+
+// GetProfileIdAttachmentsAttachmentIdThumbnail provides a mock function with given fields: c, id, attachmentId
+func (_m *ServerInterface) GetProfileIdAttachmentsAttachmentIdThumbnail(c *gin.Context, id uuid.UUID, attachmentId uuid.UUID) {
+	_m.Called(c, id, attachmentId)
+}
+
+// PostProfileIdAttachments provides a mock function with given fields: c, id
+func (_m *ServerInterface) PostProfileIdAttachments(c *gin.Context, id uuid.UUID) {
+	_m.Called(c, id)
+}
+
+// NewServerInterface creates a new instance of ServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServerInterface {
+	mock := &ServerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,16 @@
+package attachment
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/models"
+)
+
+type AttachmentRepository interface {
+	FetchAttachments(profileId *uuid.UUID, kind *models.AttachmentKind) ([]*models.Attachment, error)
+	FetchAttachmentById(profileId *uuid.UUID, attachmentId *uuid.UUID) (*models.Attachment, error)
+	CreateAttachment(attachment *models.Attachment) error
+	DeleteAttachment(profileId *uuid.UUID, attachmentId *uuid.UUID) error
+
+	FetchBlobDeletions(limit int) ([]*models.BlobDeletion, error)
+	DeleteBlobDeletions(keys []string) error
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/attachment"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type attachmentRepository struct {
+	client *gorm.DB
+}
+
+// FetchAttachments implements attachment.AttachmentRepository.
+func (a *attachmentRepository) FetchAttachments(profileId *uuid.UUID, kind *models.AttachmentKind) ([]*models.Attachment, error) {
+	var attachments []*models.Attachment
+	query := a.client.Where("profile_id = ?", profileId)
+	if kind != nil {
+		query = query.Where("kind = ?", *kind)
+	}
+
+	if err := query.Order("created_at DESC, id").Find(&attachments).Error; err != nil {
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+// FetchAttachmentById implements attachment.AttachmentRepository.
+func (a *attachmentRepository) FetchAttachmentById(profileId *uuid.UUID, attachmentId *uuid.UUID) (*models.Attachment, error) {
+	var attachment models.Attachment
+	if err := a.client.First(&attachment, "id = ? AND profile_id = ?", attachmentId, profileId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &attachment, nil
+}
+
+// CreateAttachment implements attachment.AttachmentRepository.
+// The profile row is locked so concurrent uploads of a photo replace each
+// other in turn, a new photo deletes the previous one.
+func (a *attachmentRepository) CreateAttachment(attachment *models.Attachment) error {
+	return a.client.Transaction(func(tx *gorm.DB) error {
+		var locked []*models.Profile
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id").
+			Where("id = ?", attachment.ProfileID).
+			Find(&locked).Error; err != nil {
+			return err
+		}
+		if len(locked) == 0 {
+			return constants.ErrProfileNotFound
+		}
+
+		if attachment.Kind == models.AttachmentKindPhoto {
+			if err := tx.Where("profile_id = ? AND kind = ?", attachment.ProfileID, models.AttachmentKindPhoto).
+				Delete(&models.Attachment{}).Error; err != nil {
+				return err
+			}
+		}
+
+		return tx.Create(attachment).Error
+	})
+}
+
+// DeleteAttachment implements attachment.AttachmentRepository.
+func (a *attachmentRepository) DeleteAttachment(profileId *uuid.UUID, attachmentId *uuid.UUID) error {
+	result := a.client.Where("id = ? AND profile_id = ?", attachmentId, profileId).Delete(&models.Attachment{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return constants.ErrAttachmentNotFound
+	}
+
+	return nil
+}
+
+// FetchBlobDeletions implements attachment.AttachmentRepository.
+func (a *attachmentRepository) FetchBlobDeletions(limit int) ([]*models.BlobDeletion, error) {
+	var deletions []*models.BlobDeletion
+	if err := a.client.Order("created_at, key").Limit(limit).Find(&deletions).Error; err != nil {
+		return nil, err
+	}
+
+	return deletions, nil
+}
+
+// DeleteBlobDeletions implements attachment.AttachmentRepository.
+func (a *attachmentRepository) DeleteBlobDeletions(keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	return a.client.Where("key IN ?", keys).Delete(&models.BlobDeletion{}).Error
+}
+
+func NewPsqlAttachmentRepository(client *gorm.DB) attachment.AttachmentRepository {
+	return &attachmentRepository{
+		client: client,
+	}
+}
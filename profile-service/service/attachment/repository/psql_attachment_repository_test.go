@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	return gormDB, mock
+}
+
+func newAttachment(profileId *uuid.UUID, kind models.AttachmentKind) *models.Attachment {
+	attachment := &models.Attachment{ProfileID: profileId, Kind: kind, FileName: "me.png", ContentType: "image/png", Size: 3}
+	attachment.GenUUID()
+	attachment.StorageKey = "attachments/" + attachment.ID.String() + "/original"
+	attachment.SetCreatedAt()
+	attachment.SetUpdatedAt()
+	return attachment
+}
+
+func TestFetchAttachments_Kind(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlAttachmentRepository(gormDB)
+
+	profileId := ptrUUID()
+	kind := models.AttachmentKindCertificate
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "attachment" WHERE profile_id = $1 AND kind = $2 ORDER BY created_at DESC, id`)).
+		WithArgs(profileId, kind).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "profile_id", "kind", "thumbnail_key"}).
+			AddRow(ptrUUID(), profileId, "certificate", nil))
+
+	attachments, err := repo.FetchAttachments(profileId, &kind)
+
+	assert.NoError(t, err)
+	assert.Len(t, attachments, 1)
+	assert.Nil(t, attachments[0].ThumbnailKey)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateAttachment_PhotoReplacesPrevious(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlAttachmentRepository(gormDB)
+
+	attachment := newAttachment(ptrUUID(), models.AttachmentKindPhoto)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "profile" WHERE id = $1 FOR UPDATE`)).
+		WithArgs(attachment.ProfileID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(attachment.ProfileID))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "attachment" WHERE profile_id = $1 AND kind = $2`)).
+		WithArgs(attachment.ProfileID, models.AttachmentKindPhoto).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO "attachment"`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.CreateAttachment(attachment))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateAttachment_ProfileGone(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlAttachmentRepository(gormDB)
+
+	attachment := newAttachment(ptrUUID(), models.AttachmentKindOther)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "profile" WHERE id = $1 FOR UPDATE`)).
+		WithArgs(attachment.ProfileID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectRollback()
+
+	assert.ErrorIs(t, repo.CreateAttachment(attachment), constants.ErrProfileNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteAttachment_NotFound(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlAttachmentRepository(gormDB)
+
+	profileId, attachmentId := ptrUUID(), ptrUUID()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "attachment" WHERE id = $1 AND profile_id = $2`)).
+		WithArgs(attachmentId, profileId).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	assert.ErrorIs(t, repo.DeleteAttachment(profileId, attachmentId), constants.ErrAttachmentNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBlobDeletions(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlAttachmentRepository(gormDB)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "blob_deletion" ORDER BY created_at, key LIMIT $1`)).
+		WithArgs(100).
+		WillReturnRows(sqlmock.NewRows([]string{"key"}).AddRow("a").AddRow("b"))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "blob_deletion" WHERE key IN ($1,$2)`)).
+		WithArgs("a", "b").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	deletions, err := repo.FetchBlobDeletions(100)
+	assert.NoError(t, err)
+	assert.Len(t, deletions, 2)
+
+	assert.NoError(t, repo.DeleteBlobDeletions([]string{deletions[0].Key, deletions[1].Key}))
+	assert.NoError(t, repo.DeleteBlobDeletions(nil))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
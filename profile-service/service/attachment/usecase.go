@@ -0,0 +1,18 @@
+package attachment
+
+import (
+	"io"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/models"
+)
+
+type AttachmentUsecase interface {
+	FetchAttachments(profileId *uuid.UUID, params GetProfileIdAttachmentsParams) ([]*models.Attachment, error)
+	FetchAttachmentById(profileId *uuid.UUID, attachmentId *uuid.UUID) (*models.Attachment, error)
+	UploadAttachment(profileId *uuid.UUID, kind models.AttachmentKind, fileName string, data []byte) (*models.Attachment, error)
+	OpenAttachment(profileId *uuid.UUID, attachmentId *uuid.UUID) (*models.Attachment, io.ReadCloser, error)
+	OpenThumbnail(profileId *uuid.UUID, attachmentId *uuid.UUID) (io.ReadCloser, error)
+	DeleteAttachment(profileId *uuid.UUID, attachmentId *uuid.UUID) error
+	PurgeDeletedBlobs() error
+}
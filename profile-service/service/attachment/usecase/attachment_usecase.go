@@ -0,0 +1,248 @@
+package usecase
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"log"
+	"net/http"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/attachment"
+	"github.com/jariwat/p_project/profile-service/service/profile"
+)
+
+const (
+	// blobDeletionBatchSize is how many queued blobs one purge removes at most
+	blobDeletionBatchSize = 100
+	// maxFileNameLength matches the file_name column
+	maxFileNameLength = 255
+	// thumbnailContentType is the type thumbnails are encoded in
+	thumbnailContentType = "image/jpeg"
+)
+
+type attachmentUsecase struct {
+	attachmentRepo attachment.AttachmentRepository
+	profileUs      profile.ProfileUsecase
+	blobStore      attachment.BlobStore
+	maxBytes       int64
+}
+
+// FetchAttachments implements attachment.AttachmentUsecase.
+func (a *attachmentUsecase) FetchAttachments(profileId *uuid.UUID, params attachment.GetProfileIdAttachmentsParams) ([]*models.Attachment, error) {
+	if err := a.checkProfileExists(profileId); err != nil {
+		return nil, err
+	}
+
+	var kind *models.AttachmentKind
+	if params.Kind != nil {
+		k := models.AttachmentKind(*params.Kind)
+		kind = &k
+	}
+
+	return a.attachmentRepo.FetchAttachments(profileId, kind)
+}
+
+// UploadAttachment implements attachment.AttachmentUsecase.
+// The type is sniffed from data, whatever the client declared. Images get a
+// thumbnail. The blobs are stored before the metadata and removed again when
+// the metadata cannot be saved.
+func (a *attachmentUsecase) UploadAttachment(profileId *uuid.UUID, kind models.AttachmentKind, fileName string, data []byte) (*models.Attachment, error) {
+	if len(data) == 0 {
+		return nil, constants.ErrEmptyAttachment
+	}
+	if int64(len(data)) > a.maxBytes {
+		return nil, constants.ErrAttachmentTooLarge
+	}
+
+	contentType, _, _ := strings.Cut(http.DetectContentType(data), ";")
+	if !kind.AllowsContentType(contentType) {
+		return nil, constants.ErrUnsupportedAttachmentType
+	}
+
+	if err := a.checkProfileExists(profileId); err != nil {
+		return nil, err
+	}
+
+	checksum := sha256.Sum256(data)
+	newAttachment := &models.Attachment{
+		ProfileID:   profileId,
+		Kind:        kind,
+		FileName:    cleanFileName(fileName),
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		Checksum:    hex.EncodeToString(checksum[:]),
+	}
+	newAttachment.GenUUID()
+	newAttachment.SetCreatedAt()
+	newAttachment.SetUpdatedAt()
+	newAttachment.StorageKey = "attachments/" + newAttachment.ID.String() + "/original"
+
+	var thumbnail []byte
+	if newAttachment.IsImage() {
+		var err error
+		if thumbnail, err = makeThumbnail(data); err != nil {
+			return nil, err
+		}
+		thumbnailKey := "attachments/" + newAttachment.ID.String() + "/thumbnail.jpg"
+		newAttachment.ThumbnailKey = &thumbnailKey
+	}
+
+	if err := a.blobStore.Put(newAttachment.StorageKey, data, contentType); err != nil {
+		return nil, err
+	}
+	if newAttachment.ThumbnailKey != nil {
+		if err := a.blobStore.Put(*newAttachment.ThumbnailKey, thumbnail, thumbnailContentType); err != nil {
+			a.deleteBlobs(newAttachment)
+			return nil, err
+		}
+	}
+
+	if err := a.attachmentRepo.CreateAttachment(newAttachment); err != nil {
+		a.deleteBlobs(newAttachment)
+		return nil, err
+	}
+
+	return newAttachment, nil
+}
+
+// deleteBlobs removes the blobs of an attachment whose metadata was not saved.
+func (a *attachmentUsecase) deleteBlobs(unsaved *models.Attachment) {
+	keys := []string{unsaved.StorageKey}
+	if unsaved.ThumbnailKey != nil {
+		keys = append(keys, *unsaved.ThumbnailKey)
+	}
+
+	for _, key := range keys {
+		if err := a.blobStore.Delete(key); err != nil {
+			log.Printf("Failed to delete blob %s of unsaved attachment: %v", key, err)
+		}
+	}
+}
+
+// cleanFileName keeps the last element of the uploaded file name, as browsers
+// on Windows may send the full path, and caps its length.
+func cleanFileName(fileName string) string {
+	fileName = strings.TrimSpace(path.Base(strings.ReplaceAll(fileName, "\\", "/")))
+	if fileName == "" || fileName == "." || fileName == "/" {
+		return "file"
+	}
+
+	for len(fileName) > maxFileNameLength {
+		_, size := utf8.DecodeLastRuneInString(fileName)
+		fileName = fileName[:len(fileName)-size]
+	}
+
+	return fileName
+}
+
+// FetchAttachmentById implements attachment.AttachmentUsecase.
+func (a *attachmentUsecase) FetchAttachmentById(profileId *uuid.UUID, attachmentId *uuid.UUID) (*models.Attachment, error) {
+	return a.attachmentRepo.FetchAttachmentById(profileId, attachmentId)
+}
+
+// OpenAttachment implements attachment.AttachmentUsecase.
+// The caller closes the returned content.
+func (a *attachmentUsecase) OpenAttachment(profileId *uuid.UUID, attachmentId *uuid.UUID) (*models.Attachment, io.ReadCloser, error) {
+	found, err := a.fetchAttachment(profileId, attachmentId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	content, err := a.blobStore.Get(found.StorageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return found, content, nil
+}
+
+// OpenThumbnail implements attachment.AttachmentUsecase.
+// The caller closes the returned content.
+func (a *attachmentUsecase) OpenThumbnail(profileId *uuid.UUID, attachmentId *uuid.UUID) (io.ReadCloser, error) {
+	found, err := a.fetchAttachment(profileId, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if found.ThumbnailKey == nil {
+		return nil, constants.ErrThumbnailNotFound
+	}
+
+	return a.blobStore.Get(*found.ThumbnailKey)
+}
+
+// DeleteAttachment implements attachment.AttachmentUsecase.
+// The blobs are queued for deletion by the database and removed by PurgeDeletedBlobs.
+func (a *attachmentUsecase) DeleteAttachment(profileId *uuid.UUID, attachmentId *uuid.UUID) error {
+	return a.attachmentRepo.DeleteAttachment(profileId, attachmentId)
+}
+
+// PurgeDeletedBlobs implements attachment.AttachmentUsecase.
+// Blobs that fail to delete stay queued for the next purge.
+func (a *attachmentUsecase) PurgeDeletedBlobs() error {
+	for {
+		deletions, err := a.attachmentRepo.FetchBlobDeletions(blobDeletionBatchSize)
+		if err != nil {
+			return err
+		}
+
+		deleted := make([]string, 0, len(deletions))
+		for _, deletion := range deletions {
+			if err := a.blobStore.Delete(deletion.Key); err != nil {
+				log.Printf("Failed to delete blob %s: %v", deletion.Key, err)
+				continue
+			}
+			deleted = append(deleted, deletion.Key)
+		}
+
+		if err := a.attachmentRepo.DeleteBlobDeletions(deleted); err != nil {
+			return err
+		}
+
+		// stop on a short batch, or when failures would bring the same blobs back
+		if len(deletions) < blobDeletionBatchSize || len(deleted) < len(deletions) {
+			return nil
+		}
+	}
+}
+
+func (a *attachmentUsecase) checkProfileExists(profileId *uuid.UUID) error {
+	found, err := a.profileUs.FetchProfileById(profileId)
+	if err != nil {
+		return err
+	}
+
+	if found == nil {
+		return constants.ErrProfileNotFound
+	}
+
+	return nil
+}
+
+func (a *attachmentUsecase) fetchAttachment(profileId *uuid.UUID, attachmentId *uuid.UUID) (*models.Attachment, error) {
+	found, err := a.attachmentRepo.FetchAttachmentById(profileId, attachmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		return nil, constants.ErrAttachmentNotFound
+	}
+
+	return found, nil
+}
+
+func NewAttachmentUsecase(attachmentRepo attachment.AttachmentRepository, profileUs profile.ProfileUsecase, blobStore attachment.BlobStore, maxBytes int64) attachment.AttachmentUsecase {
+	return &attachmentUsecase{
+		attachmentRepo: attachmentRepo,
+		profileUs:      profileUs,
+		blobStore:      blobStore,
+		maxBytes:       maxBytes,
+	}
+}
@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"errors"
+	"image"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/attachment"
+	"github.com/jariwat/p_project/profile-service/service/attachment/mocks"
+	profileMocks "github.com/jariwat/p_project/profile-service/service/profile/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func newUsecase(maxBytes int64) (attachment.AttachmentUsecase, *mocks.AttachmentRepository, *profileMocks.ProfileUsecase, *mocks.BlobStore) {
+	mockRepo := new(mocks.AttachmentRepository)
+	mockProfileUs := new(profileMocks.ProfileUsecase)
+	mockStore := new(mocks.BlobStore)
+	return NewAttachmentUsecase(mockRepo, mockProfileUs, mockStore, maxBytes), mockRepo, mockProfileUs, mockStore
+}
+
+func TestUploadAttachment_PhotoWithThumbnail(t *testing.T) {
+	usecase, mockRepo, mockProfileUs, mockStore := newUsecase(1 << 20)
+
+	profileId := ptrUUID()
+	data := encodePNG(t, image.NewNRGBA(image.Rect(0, 0, 400, 300)))
+	mockProfileUs.On("FetchProfileById", profileId).Return(&models.Profile{ID: profileId}, nil)
+	mockStore.On("Put", mock.MatchedBy(func(key string) bool { return strings.HasSuffix(key, "/original") }), data, "image/png").Return(nil)
+	mockStore.On("Put", mock.MatchedBy(func(key string) bool { return strings.HasSuffix(key, "/thumbnail.jpg") }), mock.Anything, "image/jpeg").Return(nil)
+	mockRepo.On("CreateAttachment", mock.AnythingOfType("*models.Attachment")).Return(nil)
+
+	uploaded, err := usecase.UploadAttachment(profileId, models.AttachmentKindPhoto, `C:\Users\seia\Pictures\me.png`, data)
+
+	require.NoError(t, err)
+	require.Equal(t, profileId, uploaded.ProfileID)
+	require.Equal(t, "me.png", uploaded.FileName)
+	require.Equal(t, "image/png", uploaded.ContentType)
+	require.Equal(t, int64(len(data)), uploaded.Size)
+	require.Len(t, uploaded.Checksum, 64)
+	require.Equal(t, "attachments/"+uploaded.ID.String()+"/original", uploaded.StorageKey)
+	require.NotNil(t, uploaded.ThumbnailKey)
+	mockStore.AssertExpectations(t)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUploadAttachment_DocumentWithoutThumbnail(t *testing.T) {
+	usecase, mockRepo, mockProfileUs, mockStore := newUsecase(1 << 20)
+
+	profileId := ptrUUID()
+	data := []byte("%PDF-1.7\n%...")
+	mockProfileUs.On("FetchProfileById", profileId).Return(&models.Profile{ID: profileId}, nil)
+	mockStore.On("Put", mock.Anything, data, "application/pdf").Return(nil).Once()
+	mockRepo.On("CreateAttachment", mock.AnythingOfType("*models.Attachment")).Return(nil)
+
+	uploaded, err := usecase.UploadAttachment(profileId, models.AttachmentKindTranscript, "transcript.pdf", data)
+
+	require.NoError(t, err)
+	require.Nil(t, uploaded.ThumbnailKey)
+	mockStore.AssertExpectations(t)
+}
+
+func TestUploadAttachment_Rejected(t *testing.T) {
+	cases := []struct {
+		name string
+		kind models.AttachmentKind
+		data []byte
+		err  error
+	}{
+		{"empty", models.AttachmentKindOther, nil, constants.ErrEmptyAttachment},
+		{"too large", models.AttachmentKindOther, []byte("%PDF-1.7 and more than sixteen bytes"), constants.ErrAttachmentTooLarge},
+		{"pdf photo", models.AttachmentKindPhoto, []byte("%PDF-1.7"), constants.ErrUnsupportedAttachmentType},
+		{"script document", models.AttachmentKindCertificate, []byte("<html><script>"), constants.ErrUnsupportedAttachmentType},
+		{"png that is not", models.AttachmentKindPhoto, []byte("\x89PNG\r\n\x1a\nbroken"), constants.ErrInvalidImage},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			usecase, mockRepo, mockProfileUs, mockStore := newUsecase(16)
+
+			profileId := ptrUUID()
+			mockProfileUs.On("FetchProfileById", profileId).Return(&models.Profile{ID: profileId}, nil)
+
+			_, err := usecase.UploadAttachment(profileId, tc.kind, "file", tc.data)
+
+			require.ErrorIs(t, err, tc.err)
+			mockStore.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything)
+			mockRepo.AssertNotCalled(t, "CreateAttachment", mock.Anything)
+		})
+	}
+}
+
+func TestUploadAttachment_ProfileNotFound(t *testing.T) {
+	usecase, _, mockProfileUs, mockStore := newUsecase(1 << 20)
+
+	profileId := ptrUUID()
+	mockProfileUs.On("FetchProfileById", profileId).Return(nil, nil)
+
+	_, err := usecase.UploadAttachment(profileId, models.AttachmentKindOther, "a.pdf", []byte("%PDF-1.7"))
+
+	require.ErrorIs(t, err, constants.ErrProfileNotFound)
+	mockStore.AssertNotCalled(t, "Put", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestUploadAttachment_SaveFailsRemovesBlobs(t *testing.T) {
+	usecase, mockRepo, mockProfileUs, mockStore := newUsecase(1 << 20)
+
+	profileId := ptrUUID()
+	mockProfileUs.On("FetchProfileById", profileId).Return(&models.Profile{ID: profileId}, nil)
+	mockStore.On("Put", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	mockStore.On("Delete", mock.Anything).Return(nil)
+	mockRepo.On("CreateAttachment", mock.Anything).Return(constants.ErrProfileNotFound)
+
+	_, err := usecase.UploadAttachment(profileId, models.AttachmentKindPhoto, "me.png", encodePNG(t, image.NewNRGBA(image.Rect(0, 0, 2, 2))))
+
+	require.ErrorIs(t, err, constants.ErrProfileNotFound)
+	mockStore.AssertNumberOfCalls(t, "Delete", 2)
+}
+
+func TestOpenThumbnail_None(t *testing.T) {
+	usecase, mockRepo, _, mockStore := newUsecase(1 << 20)
+
+	profileId, attachmentId := ptrUUID(), ptrUUID()
+	mockRepo.On("FetchAttachmentById", profileId, attachmentId).Return(&models.Attachment{ID: attachmentId, StorageKey: "k"}, nil)
+
+	_, err := usecase.OpenThumbnail(profileId, attachmentId)
+
+	require.ErrorIs(t, err, constants.ErrThumbnailNotFound)
+	mockStore.AssertNotCalled(t, "Get", mock.Anything)
+}
+
+func TestOpenAttachment(t *testing.T) {
+	usecase, mockRepo, _, mockStore := newUsecase(1 << 20)
+
+	profileId, attachmentId := ptrUUID(), ptrUUID()
+	mockRepo.On("FetchAttachmentById", profileId, attachmentId).Return(&models.Attachment{ID: attachmentId, StorageKey: "k"}, nil)
+	mockStore.On("Get", "k").Return(io.NopCloser(strings.NewReader("%PDF")), nil)
+
+	found, content, err := usecase.OpenAttachment(profileId, attachmentId)
+
+	require.NoError(t, err)
+	require.Equal(t, attachmentId, found.ID)
+	data, _ := io.ReadAll(content)
+	require.Equal(t, "%PDF", string(data))
+
+	mockRepo.On("FetchAttachmentById", profileId, profileId).Return(nil, nil)
+	_, _, err = usecase.OpenAttachment(profileId, profileId)
+	require.ErrorIs(t, err, constants.ErrAttachmentNotFound)
+}
+
+func TestPurgeDeletedBlobs_KeepsFailedBlobsQueued(t *testing.T) {
+	usecase, mockRepo, _, mockStore := newUsecase(1 << 20)
+
+	mockRepo.On("FetchBlobDeletions", blobDeletionBatchSize).Return([]*models.BlobDeletion{{Key: "a"}, {Key: "b"}}, nil).Once()
+	mockStore.On("Delete", "a").Return(nil)
+	mockStore.On("Delete", "b").Return(errors.New("store unavailable"))
+	mockRepo.On("DeleteBlobDeletions", []string{"a"}).Return(nil)
+
+	require.NoError(t, usecase.PurgeDeletedBlobs())
+	mockRepo.AssertExpectations(t)
+}
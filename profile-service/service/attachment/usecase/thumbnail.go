@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+
+	"github.com/jariwat/p_project/profile-service/constants"
+)
+
+const (
+	// thumbnailSize is the largest width and height of a thumbnail
+	thumbnailSize = 256
+	// thumbnailMaxPixels keeps a small file that decodes into a huge image from exhausting memory
+	thumbnailMaxPixels = 40_000_000
+	thumbnailQuality   = 80
+)
+
+// makeThumbnail scales the image in data down to fit in thumbnailSize by
+// thumbnailSize, averaging the pixels each thumbnail pixel covers, and encodes
+// it as a JPEG over a white background.
+func makeThumbnail(data []byte) ([]byte, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > thumbnailMaxPixels {
+		return nil, constants.ErrInvalidImage
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, constants.ErrInvalidImage
+	}
+
+	bounds := src.Bounds()
+	width, height := thumbnailBounds(bounds.Dx(), bounds.Dy())
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*bounds.Dy()/height
+		y1 := max(bounds.Min.Y+(y+1)*bounds.Dy()/height, y0+1)
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*bounds.Dx()/width
+			x1 := max(bounds.Min.X+(x+1)*bounds.Dx()/width, x0+1)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := src.At(sx, sy).RGBA()
+					r, g, b, a, n = r+uint64(pr), g+uint64(pg), b+uint64(pb), a+uint64(pa), n+1
+				}
+			}
+
+			// the colors are alpha-premultiplied, adding the uncovered part of white flattens them
+			white := 0xffff - a/n
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(r/n + white),
+				G: uint16(g/n + white),
+				B: uint16(b/n + white),
+				A: 0xffff,
+			})
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: thumbnailQuality}); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// thumbnailBounds fits width by height in thumbnailSize by thumbnailSize,
+// keeping the aspect ratio. Smaller images keep their size.
+func thumbnailBounds(width int, height int) (int, int) {
+	if width <= thumbnailSize && height <= thumbnailSize {
+		return width, height
+	}
+
+	if width >= height {
+		return thumbnailSize, max(height*thumbnailSize/width, 1)
+	}
+
+	return max(width*thumbnailSize/height, 1), thumbnailSize
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/stretchr/testify/require"
+)
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+func TestMakeThumbnail_FitsAndKeepsAspectRatio(t *testing.T) {
+	src := image.NewNRGBA(image.Rect(0, 0, 1000, 500))
+	for y := 0; y < 500; y++ {
+		for x := 0; x < 1000; x++ {
+			src.Set(x, y, color.NRGBA{R: 200, G: 40, B: 40, A: 255})
+		}
+	}
+
+	thumbnail, err := makeThumbnail(encodePNG(t, src))
+	require.NoError(t, err)
+
+	decoded, err := jpeg.Decode(bytes.NewReader(thumbnail))
+	require.NoError(t, err)
+	require.Equal(t, image.Rect(0, 0, 256, 128), decoded.Bounds())
+
+	r, g, _, _ := decoded.At(128, 64).RGBA()
+	require.InDelta(t, 200, r>>8, 4)
+	require.InDelta(t, 40, g>>8, 4)
+}
+
+func TestMakeThumbnail_TransparencyBecomesWhite(t *testing.T) {
+	thumbnail, err := makeThumbnail(encodePNG(t, image.NewNRGBA(image.Rect(0, 0, 10, 20))))
+	require.NoError(t, err)
+
+	decoded, err := jpeg.Decode(bytes.NewReader(thumbnail))
+	require.NoError(t, err)
+	require.Equal(t, image.Rect(0, 0, 10, 20), decoded.Bounds())
+
+	r, g, b, _ := decoded.At(5, 10).RGBA()
+	require.Greater(t, r>>8, uint32(250))
+	require.Greater(t, g>>8, uint32(250))
+	require.Greater(t, b>>8, uint32(250))
+}
+
+func TestMakeThumbnail_InvalidImage(t *testing.T) {
+	_, err := makeThumbnail([]byte("\x89PNG\r\n\x1a\nbroken"))
+	require.ErrorIs(t, err, constants.ErrInvalidImage)
+}
+
+func TestThumbnailBounds(t *testing.T) {
+	cases := []struct{ width, height, wantWidth, wantHeight int }{
+		{100, 80, 100, 80},
+		{512, 512, 256, 256},
+		{300, 1200, 64, 256},
+		{5000, 1, 256, 1},
+	}
+
+	for _, tc := range cases {
+		width, height := thumbnailBounds(tc.width, tc.height)
+		require.Equal(t, tc.wantWidth, width)
+		require.Equal(t, tc.wantHeight, height)
+	}
+}
@@ -0,0 +1,2 @@
+package attribute 
+//go:generate oapi-codegen --config=./server.cfg.yaml ../../../api-spec/attribute/openapi_bundle.yml
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_attribute "github.com/jariwat/p_project/profile-service/service/attribute"
+)
+
+type attributeHandler struct {
+	attributeUs _attribute.AttributeUsecase
+}
+
+// respondError maps domain errors to their HTTP status.
+func respondError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, constants.ErrCustomAttributeNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrInvalidCustomAttributeSchema):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrCustomAttributeInUse):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// GetAttributes implements attribute.ServerInterface.
+func (a *attributeHandler) GetAttributes(c *gin.Context) {
+	customAttributes, err := a.attributeUs.FetchCustomAttributes()
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var data []_attribute.CustomAttribute
+	bu, err := json.Marshal(customAttributes)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal custom attributes"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal custom attributes"})
+		return
+	}
+
+	c.JSON(http.StatusOK, _attribute.CustomAttributesResponse{Data: &data})
+}
+
+// PutAttributesKey implements attribute.ServerInterface.
+func (a *attributeHandler) PutAttributesKey(c *gin.Context, key string) {
+	var request _attribute.UpsertCustomAttribute
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	customAttribute, created, err := a.attributeUs.UpsertCustomAttribute(key, request)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	respondCustomAttribute(c, status, customAttribute)
+}
+
+// DeleteAttributesKey implements attribute.ServerInterface.
+func (a *attributeHandler) DeleteAttributesKey(c *gin.Context, key string) {
+	if err := a.attributeUs.DeleteCustomAttribute(key); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, _attribute.Success{Message: "Custom attribute deleted successfully"})
+}
+
+// GetOpenapiJson implements attribute.ServerInterface.
+func (a *attributeHandler) GetOpenapiJson(c *gin.Context) {
+	spec, err := a.attributeUs.FetchProfileSpec()
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, spec)
+}
+
+func respondCustomAttribute(c *gin.Context, status int, customAttribute *models.CustomAttribute) {
+	var data _attribute.CustomAttribute
+	bu, err := json.Marshal(customAttribute)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal custom attribute"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal custom attribute"})
+		return
+	}
+
+	c.JSON(status, _attribute.CustomAttributeResponse{Data: &data})
+}
+
+func NewAttributeHandler(attributeUs _attribute.AttributeUsecase) _attribute.ServerInterface {
+	return &attributeHandler{
+		attributeUs: attributeUs,
+	}
+}
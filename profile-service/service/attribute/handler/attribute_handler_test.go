@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_attribute "github.com/jariwat/p_project/profile-service/service/attribute"
+	"github.com/jariwat/p_project/profile-service/service/attribute/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func putRequest(key string, body string) *http.Request {
+	req, _ := http.NewRequest(http.MethodPut, "/attributes/"+key, bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestPutAttributesKey_Created(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = putRequest("bus_route", `{"schema":{"type":"integer","minimum":1},"required":false}`)
+
+	mockUsecase := new(mocks.AttributeUsecase)
+	mockUsecase.On("UpsertCustomAttribute", "bus_route", mock.AnythingOfType("attribute.UpsertCustomAttribute")).
+		Return(&models.CustomAttribute{ID: ptrUUID(), Key: "bus_route", Schema: json.RawMessage(`{"type":"integer","minimum":1}`)}, true, nil)
+
+	handler := NewAttributeHandler(mockUsecase)
+	handler.PutAttributesKey(c, "bus_route")
+
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var resp _attribute.CustomAttributeResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "bus_route", *resp.Data.Key)
+	assert.Equal(t, "integer", (*resp.Data.Schema)["type"])
+}
+
+func TestPutAttributesKey_Errors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"invalid schema", fmt.Errorf("%w: unsupported type", constants.ErrInvalidCustomAttributeSchema), http.StatusBadRequest},
+		{"in use", fmt.Errorf("%w: /bus_route: number must be at least 1", constants.ErrCustomAttributeInUse), http.StatusConflict},
+		{"database", errors.New("connection refused"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = putRequest("bus_route", `{"schema":{"type":"integer"}}`)
+
+			mockUsecase := new(mocks.AttributeUsecase)
+			mockUsecase.On("UpsertCustomAttribute", "bus_route", mock.Anything).Return(nil, false, tt.err)
+
+			handler := NewAttributeHandler(mockUsecase)
+			handler.PutAttributesKey(c, "bus_route")
+
+			assert.Equal(t, tt.status, w.Code)
+			assert.Contains(t, w.Body.String(), tt.err.Error())
+		})
+	}
+}
+
+func TestDeleteAttributesKey_NotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, _ := http.NewRequest(http.MethodDelete, "/attributes/locker_number", nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.AttributeUsecase)
+	mockUsecase.On("DeleteCustomAttribute", "locker_number").Return(constants.ErrCustomAttributeNotFound)
+
+	handler := NewAttributeHandler(mockUsecase)
+	handler.DeleteAttributesKey(c, "locker_number")
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGetOpenapiJson(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, _ := http.NewRequest(http.MethodGet, "/openapi.json", nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	spec := &openapi3.T{OpenAPI: "3.0.3", Info: &openapi3.Info{Title: "Profile API", Version: "1.0.0"}}
+	mockUsecase := new(mocks.AttributeUsecase)
+	mockUsecase.On("FetchProfileSpec").Return(spec, nil)
+
+	handler := NewAttributeHandler(mockUsecase)
+	handler.GetOpenapiJson(c)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"title":"Profile API"`)
+}
@@ -0,0 +1,202 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	jsontext "encoding/json/jsontext"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/jariwat/p_project/profile-service/models"
+)
+
+// AttributeRepository is an autogenerated mock type for the AttributeRepository type
+type AttributeRepository struct {
+	mock.Mock
+}
+
+// CountProfilesWithoutCustomAttribute provides a mock function with given fields: key
+func (_m *AttributeRepository) CountProfilesWithoutCustomAttribute(key string) (int64, error) {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountProfilesWithoutCustomAttribute")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int64, error)); ok {
+		return rf(key)
+	}
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCustomAttribute provides a mock function with given fields: customAttribute
+func (_m *AttributeRepository) CreateCustomAttribute(customAttribute *models.CustomAttribute) error {
+	ret := _m.Called(customAttribute)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCustomAttribute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.CustomAttribute) error); ok {
+		r0 = rf(customAttribute)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCustomAttribute provides a mock function with given fields: key
+func (_m *AttributeRepository) DeleteCustomAttribute(key string) error {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCustomAttribute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FetchCustomAttributeByKey provides a mock function with given fields: key
+func (_m *AttributeRepository) FetchCustomAttributeByKey(key string) (*models.CustomAttribute, error) {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchCustomAttributeByKey")
+	}
+
+	var r0 *models.CustomAttribute
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.CustomAttribute, error)); ok {
+		return rf(key)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.CustomAttribute); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CustomAttribute)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchCustomAttributeValues provides a mock function with given fields: key
+func (_m *AttributeRepository) FetchCustomAttributeValues(key string) ([]jsontext.Value, error) {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchCustomAttributeValues")
+	}
+
+	var r0 []jsontext.Value
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]jsontext.Value, error)); ok {
+		return rf(key)
+	}
+	if rf, ok := ret.Get(0).(func(string) []jsontext.Value); ok {
+		r0 = rf(key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]jsontext.Value)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchCustomAttributes provides a mock function with no fields
+func (_m *AttributeRepository) FetchCustomAttributes() ([]*models.CustomAttribute, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchCustomAttributes")
+	}
+
+	var r0 []*models.CustomAttribute
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*models.CustomAttribute, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*models.CustomAttribute); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.CustomAttribute)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCustomAttribute provides a mock function with given fields: customAttribute
+func (_m *AttributeRepository) UpdateCustomAttribute(customAttribute *models.CustomAttribute) error {
+	ret := _m.Called(customAttribute)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCustomAttribute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.CustomAttribute) error); ok {
+		r0 = rf(customAttribute)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewAttributeRepository creates a new instance of AttributeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAttributeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AttributeRepository {
+	mock := &AttributeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,164 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	attribute "github.com/jariwat/p_project/profile-service/service/attribute"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/jariwat/p_project/profile-service/models"
+
+	openapi3 "github.com/getkin/kin-openapi/openapi3"
+)
+
+// AttributeUsecase is an autogenerated mock type for the AttributeUsecase type
+type AttributeUsecase struct {
+	mock.Mock
+}
+
+// DeleteCustomAttribute provides a mock function with given fields: key
+func (_m *AttributeUsecase) DeleteCustomAttribute(key string) error {
+	ret := _m.Called(key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCustomAttribute")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FetchCustomAttributes provides a mock function with no fields
+func (_m *AttributeUsecase) FetchCustomAttributes() ([]*models.CustomAttribute, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchCustomAttributes")
+	}
+
+	var r0 []*models.CustomAttribute
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*models.CustomAttribute, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*models.CustomAttribute); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.CustomAttribute)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchProfileSpec provides a mock function with no fields
+func (_m *AttributeUsecase) FetchProfileSpec() (*openapi3.T, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchProfileSpec")
+	}
+
+	var r0 *openapi3.T
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (*openapi3.T, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *openapi3.T); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*openapi3.T)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpsertCustomAttribute provides a mock function with given fields: key, upsertAttribute
+func (_m *AttributeUsecase) UpsertCustomAttribute(key string, upsertAttribute attribute.UpsertCustomAttribute) (*models.CustomAttribute, bool, error) {
+	ret := _m.Called(key, upsertAttribute)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertCustomAttribute")
+	}
+
+	var r0 *models.CustomAttribute
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string, attribute.UpsertCustomAttribute) (*models.CustomAttribute, bool, error)); ok {
+		return rf(key, upsertAttribute)
+	}
+	if rf, ok := ret.Get(0).(func(string, attribute.UpsertCustomAttribute) *models.CustomAttribute); ok {
+		r0 = rf(key, upsertAttribute)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.CustomAttribute)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, attribute.UpsertCustomAttribute) bool); ok {
+		r1 = rf(key, upsertAttribute)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func(string, attribute.UpsertCustomAttribute) error); ok {
+		r2 = rf(key, upsertAttribute)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ValidateCustomAttributes provides a mock function with given fields: values
+func (_m *AttributeUsecase) ValidateCustomAttributes(values map[string]interface{}) error {
+	ret := _m.Called(values)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateCustomAttributes")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(map[string]interface{}) error); ok {
+		r0 = rf(values)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewAttributeUsecase creates a new instance of AttributeUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAttributeUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AttributeUsecase {
+	mock := &AttributeUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
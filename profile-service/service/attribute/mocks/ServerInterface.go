@@ -0,0 +1,47 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ServerInterface is an autogenerated mock type for the ServerInterface type
+type ServerInterface struct {
+	mock.Mock
+}
+
+// DeleteAttributesKey provides a mock function with given fields: c, key
+func (_m *ServerInterface) DeleteAttributesKey(c *gin.Context, key string) {
+	_m.Called(c, key)
+}
+
+// GetAttributes provides a mock function with given fields: c
+func (_m *ServerInterface) GetAttributes(c *gin.Context) {
+	_m.Called(c)
+}
+
+// GetOpenapiJson provides a mock function with given fields: c
+func (_m *ServerInterface) GetOpenapiJson(c *gin.Context) {
+	_m.Called(c)
+}
+
+// PutAttributesKey provides a mock function with given fields: c, key
+func (_m *ServerInterface) PutAttributesKey(c *gin.Context, key string) {
+	_m.Called(c, key)
+}
+
+// NewServerInterface creates a new instance of ServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServerInterface {
+	mock := &ServerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,18 @@
+package attribute
+
+import (
+	"encoding/json"
+
+	"github.com/jariwat/p_project/profile-service/models"
+)
+
+type AttributeRepository interface {
+	FetchCustomAttributes() ([]*models.CustomAttribute, error)
+	FetchCustomAttributeByKey(key string) (*models.CustomAttribute, error)
+	CreateCustomAttribute(customAttribute *models.CustomAttribute) error
+	UpdateCustomAttribute(customAttribute *models.CustomAttribute) error
+	DeleteCustomAttribute(key string) error
+
+	FetchCustomAttributeValues(key string) ([]json.RawMessage, error)
+	CountProfilesWithoutCustomAttribute(key string) (int64, error)
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/attribute"
+	"gorm.io/gorm"
+)
+
+const (
+	uniqueViolationCode     = "23505"
+	customAttributeKeyIndex = "idx_custom_attribute_key"
+)
+
+type attributeRepository struct {
+	client *gorm.DB
+}
+
+// translateError maps an attribute defined twice with the same key to a domain error.
+func translateError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == customAttributeKeyIndex {
+		return constants.ErrCustomAttributeAlreadyExists
+	}
+
+	return err
+}
+
+// FetchCustomAttributes implements attribute.AttributeRepository.
+func (a *attributeRepository) FetchCustomAttributes() ([]*models.CustomAttribute, error) {
+	var customAttributes []*models.CustomAttribute
+	if err := a.client.Order("key").Find(&customAttributes).Error; err != nil {
+		return nil, err
+	}
+
+	return customAttributes, nil
+}
+
+// FetchCustomAttributeByKey implements attribute.AttributeRepository.
+func (a *attributeRepository) FetchCustomAttributeByKey(key string) (*models.CustomAttribute, error) {
+	var customAttribute models.CustomAttribute
+	if err := a.client.First(&customAttribute, "key = ?", key).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &customAttribute, nil
+}
+
+// CreateCustomAttribute implements attribute.AttributeRepository.
+func (a *attributeRepository) CreateCustomAttribute(customAttribute *models.CustomAttribute) error {
+	return translateError(a.client.Create(customAttribute).Error)
+}
+
+// UpdateCustomAttribute implements attribute.AttributeRepository.
+func (a *attributeRepository) UpdateCustomAttribute(customAttribute *models.CustomAttribute) error {
+	result := a.client.Model(&models.CustomAttribute{}).Where("key = ?", customAttribute.Key).Updates(map[string]interface{}{
+		"schema":     customAttribute.Schema,
+		"required":   customAttribute.Required,
+		"updated_at": customAttribute.UpdatedAt,
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return constants.ErrCustomAttributeNotFound
+	}
+
+	return nil
+}
+
+// DeleteCustomAttribute implements attribute.AttributeRepository.
+// The values profiles hold for the attribute are removed with it.
+func (a *attributeRepository) DeleteCustomAttribute(key string) error {
+	return a.client.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("key = ?", key).Delete(&models.CustomAttribute{})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return constants.ErrCustomAttributeNotFound
+		}
+
+		return tx.Model(&models.Profile{}).
+			Where("custom_attributes -> ?::text IS NOT NULL", key).
+			Update("custom_attributes", gorm.Expr("custom_attributes - ?::text", key)).Error
+	})
+}
+
+// FetchCustomAttributeValues implements attribute.AttributeRepository.
+// It lists the distinct values profiles hold for the attribute.
+func (a *attributeRepository) FetchCustomAttributeValues(key string) ([]json.RawMessage, error) {
+	var rows []struct {
+		Value json.RawMessage
+	}
+	if err := a.client.Model(&models.Profile{}).
+		Select("DISTINCT custom_attributes -> ?::text AS value", key).
+		Where("custom_attributes -> ?::text IS NOT NULL", key).
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	values := make([]json.RawMessage, 0, len(rows))
+	for _, row := range rows {
+		values = append(values, row.Value)
+	}
+
+	return values, nil
+}
+
+// CountProfilesWithoutCustomAttribute implements attribute.AttributeRepository.
+func (a *attributeRepository) CountProfilesWithoutCustomAttribute(key string) (int64, error) {
+	var count int64
+	if err := a.client.Model(&models.Profile{}).Where("custom_attributes -> ?::text IS NULL", key).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func NewPsqlAttributeRepository(client *gorm.DB) attribute.AttributeRepository {
+	return &attributeRepository{
+		client: client,
+	}
+}
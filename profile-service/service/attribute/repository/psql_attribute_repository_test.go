@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	return gormDB, mock
+}
+
+func TestCreateCustomAttribute_AlreadyExists(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlAttributeRepository(gormDB)
+
+	customAttribute := &models.CustomAttribute{ID: ptrUUID(), Key: "blood_type", Schema: json.RawMessage(`{"type":"string"}`)}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO "custom_attribute"`).
+		WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "idx_custom_attribute_key"})
+	mock.ExpectRollback()
+
+	assert.ErrorIs(t, repo.CreateCustomAttribute(customAttribute), constants.ErrCustomAttributeAlreadyExists)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteCustomAttribute(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlAttributeRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "custom_attribute" WHERE key = $1`)).
+		WithArgs("bus_route").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "profile" SET "custom_attributes"=custom_attributes - $1::text,"updated_at"=$2 WHERE custom_attributes -> $3::text IS NOT NULL`)).
+		WithArgs("bus_route", sqlmock.AnyArg(), "bus_route").
+		WillReturnResult(sqlmock.NewResult(0, 12))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.DeleteCustomAttribute("bus_route"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteCustomAttribute_NotFound(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlAttributeRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "custom_attribute" WHERE key = $1`)).
+		WithArgs("bus_route").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	assert.ErrorIs(t, repo.DeleteCustomAttribute("bus_route"), constants.ErrCustomAttributeNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchCustomAttributeValues(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlAttributeRepository(gormDB)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT DISTINCT custom_attributes -> $1::text AS value FROM "profile" WHERE custom_attributes -> $2::text IS NOT NULL`)).
+		WithArgs("bus_route", "bus_route").
+		WillReturnRows(sqlmock.NewRows([]string{"value"}).AddRow([]byte(`12`)).AddRow([]byte(`"12"`)))
+
+	values, err := repo.FetchCustomAttributeValues("bus_route")
+	assert.NoError(t, err)
+	assert.Equal(t, []json.RawMessage{json.RawMessage(`12`), json.RawMessage(`"12"`)}, values)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCountProfilesWithoutCustomAttribute(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlAttributeRepository(gormDB)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "profile" WHERE custom_attributes -> $1::text IS NULL`)).
+		WithArgs("blood_type").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+
+	count, err := repo.CountProfilesWithoutCustomAttribute("blood_type")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,14 @@
+package attribute
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/jariwat/p_project/profile-service/models"
+)
+
+type AttributeUsecase interface {
+	FetchCustomAttributes() ([]*models.CustomAttribute, error)
+	UpsertCustomAttribute(key string, upsertAttribute UpsertCustomAttribute) (*models.CustomAttribute, bool, error)
+	DeleteCustomAttribute(key string) error
+	ValidateCustomAttributes(values map[string]interface{}) error
+	FetchProfileSpec() (*openapi3.T, error)
+}
@@ -0,0 +1,248 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/attribute"
+	"github.com/jariwat/p_project/profile-service/service/profile"
+)
+
+// customAttributesSchema is the component of the Profile API spec describing custom_attributes
+const customAttributesSchema = "CustomAttributes"
+
+type attributeUsecase struct {
+	attributeRepo attribute.AttributeRepository
+}
+
+// FetchCustomAttributes implements attribute.AttributeUsecase.
+func (a *attributeUsecase) FetchCustomAttributes() ([]*models.CustomAttribute, error) {
+	return a.attributeRepo.FetchCustomAttributes()
+}
+
+// UpsertCustomAttribute implements attribute.AttributeUsecase.
+// The attribute is created when the key is new, and only saved once the values
+// profiles already hold for it match. It reports whether it was created.
+func (a *attributeUsecase) UpsertCustomAttribute(key string, upsertAttribute attribute.UpsertCustomAttribute) (*models.CustomAttribute, bool, error) {
+	raw, schema, err := parseSchema(upsertAttribute.Schema)
+	if err != nil {
+		return nil, false, err
+	}
+
+	customAttribute := &models.CustomAttribute{
+		Key:      key,
+		Schema:   raw,
+		Required: upsertAttribute.Required != nil && *upsertAttribute.Required,
+	}
+	if err := a.checkProfileValues(customAttribute, schema); err != nil {
+		return nil, false, err
+	}
+
+	existing, err := a.attributeRepo.FetchCustomAttributeByKey(key)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if existing == nil {
+		customAttribute.GenUUID()
+		customAttribute.SetCreatedAt()
+		customAttribute.SetUpdatedAt()
+		err := a.attributeRepo.CreateCustomAttribute(customAttribute)
+		if !errors.Is(err, constants.ErrCustomAttributeAlreadyExists) {
+			if err != nil {
+				return nil, false, err
+			}
+			return customAttribute, true, nil
+		}
+		// defined by a concurrent request in the meantime, change it instead
+		if existing, err = a.attributeRepo.FetchCustomAttributeByKey(key); err != nil {
+			return nil, false, err
+		}
+		if existing == nil {
+			return nil, false, constants.ErrCustomAttributeNotFound
+		}
+	}
+
+	existing.Schema = customAttribute.Schema
+	existing.Required = customAttribute.Required
+	existing.SetUpdatedAt()
+	if err := a.attributeRepo.UpdateCustomAttribute(existing); err != nil {
+		return nil, false, err
+	}
+
+	return existing, false, nil
+}
+
+// checkProfileValues makes sure the values profiles hold for the attribute
+// match schema, and that every profile has one when it is required.
+func (a *attributeUsecase) checkProfileValues(customAttribute *models.CustomAttribute, schema *openapi3.Schema) error {
+	values, err := a.attributeRepo.FetchCustomAttributeValues(customAttribute.Key)
+	if err != nil {
+		return err
+	}
+
+	for _, raw := range values {
+		var value interface{}
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return err
+		}
+		if err := schema.VisitJSON(value); err != nil {
+			return fmt.Errorf("%w: %s", constants.ErrCustomAttributeInUse, describeSchemaError("/"+customAttribute.Key, err))
+		}
+	}
+
+	if !customAttribute.Required {
+		return nil
+	}
+
+	missing, err := a.attributeRepo.CountProfilesWithoutCustomAttribute(customAttribute.Key)
+	if err != nil {
+		return err
+	}
+
+	if missing > 0 {
+		return fmt.Errorf("%w: %d profiles have no value for %s", constants.ErrCustomAttributeInUse, missing, customAttribute.Key)
+	}
+
+	return nil
+}
+
+// DeleteCustomAttribute implements attribute.AttributeUsecase.
+func (a *attributeUsecase) DeleteCustomAttribute(key string) error {
+	return a.attributeRepo.DeleteCustomAttribute(key)
+}
+
+// ValidateCustomAttributes implements attribute.AttributeUsecase.
+// values must only have the attributes defined, each matching its schema, and
+// every required attribute.
+func (a *attributeUsecase) ValidateCustomAttributes(values map[string]interface{}) error {
+	customAttributes, err := a.attributeRepo.FetchCustomAttributes()
+	if err != nil {
+		return err
+	}
+
+	schema, err := profileSchema(customAttributes)
+	if err != nil {
+		return err
+	}
+
+	if err := schema.VisitJSON(values, openapi3.MultiErrors()); err != nil {
+		return fmt.Errorf("%w: %s", constants.ErrInvalidCustomAttributes, describeSchemaError("", err))
+	}
+
+	return nil
+}
+
+// FetchProfileSpec implements attribute.AttributeUsecase.
+// It is the Profile API spec with custom_attributes described by the schema
+// ValidateCustomAttributes checks values against.
+func (a *attributeUsecase) FetchProfileSpec() (*openapi3.T, error) {
+	spec, err := profile.GetSwagger()
+	if err != nil {
+		return nil, err
+	}
+
+	customAttributes, err := a.attributeRepo.FetchCustomAttributes()
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := profileSchema(customAttributes)
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.Components.Schemas == nil {
+		spec.Components.Schemas = make(openapi3.Schemas)
+	}
+	if documented := spec.Components.Schemas[customAttributesSchema]; documented != nil && documented.Value != nil {
+		schema.Description = documented.Value.Description
+	}
+	spec.Components.Schemas[customAttributesSchema] = openapi3.NewSchemaRef("", schema)
+
+	return spec, nil
+}
+
+// parseSchema checks that schema is a valid schema without references, and
+// returns it as JSON to be stored.
+func parseSchema(schema map[string]interface{}) (json.RawMessage, *openapi3.Schema, error) {
+	raw, err := json.Marshal(schema)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var parsed openapi3.Schema
+	if err := json.Unmarshal(raw, &parsed); err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", constants.ErrInvalidCustomAttributeSchema, err.Error())
+	}
+
+	if err := parsed.Validate(context.Background()); err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", constants.ErrInvalidCustomAttributeSchema, err.Error())
+	}
+
+	return raw, &parsed, nil
+}
+
+// profileSchema is the schema of custom_attributes, an object with a property
+// per attribute that allows no other.
+func profileSchema(customAttributes []*models.CustomAttribute) (*openapi3.Schema, error) {
+	schema := openapi3.NewObjectSchema()
+	schema.AdditionalProperties = openapi3.AdditionalProperties{Has: openapi3.BoolPtr(false)}
+
+	for _, customAttribute := range customAttributes {
+		var property openapi3.Schema
+		if err := json.Unmarshal(customAttribute.Schema, &property); err != nil {
+			return nil, err
+		}
+
+		schema.WithProperty(customAttribute.Key, &property)
+		if customAttribute.Required {
+			schema.Required = append(schema.Required, customAttribute.Key)
+		}
+	}
+
+	return schema, nil
+}
+
+// describeSchemaError lists the reasons values failed validation, each after
+// the JSON pointer of the value under prefix, such as /bus_route: number must
+// be at least 1.
+func describeSchemaError(prefix string, err error) string {
+	var errs openapi3.MultiError
+	if !errors.As(err, &errs) {
+		errs = openapi3.MultiError{err}
+	}
+
+	reasons := make([]string, 0, len(errs))
+	for _, err := range errs {
+		var schemaErr *openapi3.SchemaError
+		if !errors.As(err, &schemaErr) {
+			reasons = append(reasons, err.Error())
+			continue
+		}
+
+		pointer := prefix
+		for _, token := range schemaErr.JSONPointer() {
+			pointer += "/" + token
+		}
+		if pointer == "" {
+			reasons = append(reasons, schemaErr.Reason)
+		} else {
+			reasons = append(reasons, pointer+": "+schemaErr.Reason)
+		}
+	}
+
+	return strings.Join(reasons, "; ")
+}
+
+func NewAttributeUsecase(attributeRepo attribute.AttributeRepository) attribute.AttributeUsecase {
+	return &attributeUsecase{
+		attributeRepo: attributeRepo,
+	}
+}
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_attribute "github.com/jariwat/p_project/profile-service/service/attribute"
+	"github.com/jariwat/p_project/profile-service/service/attribute/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+var bloodTypeSchema = map[string]interface{}{
+	"type": "string",
+	"enum": []interface{}{"A+", "A-", "B+", "B-", "AB+", "AB-", "O+", "O-"},
+}
+
+func definedAttributes() []*models.CustomAttribute {
+	return []*models.CustomAttribute{
+		{Key: "blood_type", Schema: json.RawMessage(`{"type":"string","enum":["A+","A-","B+","B-","AB+","AB-","O+","O-"]}`), Required: true},
+		{Key: "bus_route", Schema: json.RawMessage(`{"type":"integer","minimum":1}`)},
+	}
+}
+
+func TestUpsertCustomAttribute_Created(t *testing.T) {
+	mockRepo := new(mocks.AttributeRepository)
+	mockRepo.On("FetchCustomAttributeValues", "blood_type").Return([]json.RawMessage{json.RawMessage(`"O+"`)}, nil)
+	mockRepo.On("CountProfilesWithoutCustomAttribute", "blood_type").Return(int64(0), nil)
+	mockRepo.On("FetchCustomAttributeByKey", "blood_type").Return(nil, nil)
+	mockRepo.On("CreateCustomAttribute", mock.AnythingOfType("*models.CustomAttribute")).Return(nil)
+
+	usecase := NewAttributeUsecase(mockRepo)
+	customAttribute, created, err := usecase.UpsertCustomAttribute("blood_type", _attribute.UpsertCustomAttribute{
+		Schema:   bloodTypeSchema,
+		Required: boolPtr(true),
+	})
+
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.NotNil(t, customAttribute.ID)
+	assert.True(t, customAttribute.Required)
+	assert.JSONEq(t, `{"type":"string","enum":["A+","A-","B+","B-","AB+","AB-","O+","O-"]}`, string(customAttribute.Schema))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpsertCustomAttribute_Updated(t *testing.T) {
+	mockRepo := new(mocks.AttributeRepository)
+	mockRepo.On("FetchCustomAttributeValues", "bus_route").Return([]json.RawMessage{json.RawMessage(`12`)}, nil)
+	mockRepo.On("FetchCustomAttributeByKey", "bus_route").Return(&models.CustomAttribute{Key: "bus_route", Schema: json.RawMessage(`{"type":"integer"}`)}, nil)
+	mockRepo.On("UpdateCustomAttribute", mock.MatchedBy(func(customAttribute *models.CustomAttribute) bool {
+		return string(customAttribute.Schema) == `{"maximum":99,"type":"integer"}` && customAttribute.UpdatedAt != nil
+	})).Return(nil)
+
+	usecase := NewAttributeUsecase(mockRepo)
+	_, created, err := usecase.UpsertCustomAttribute("bus_route", _attribute.UpsertCustomAttribute{
+		Schema: map[string]interface{}{"type": "integer", "maximum": 99},
+	})
+
+	require.NoError(t, err)
+	assert.False(t, created)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpsertCustomAttribute_InvalidSchema(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema map[string]interface{}
+	}{
+		{"unknown type", map[string]interface{}{"type": "strin"}},
+		{"reference", map[string]interface{}{"$ref": "#/components/schemas/Profile"}},
+		{"invalid pattern", map[string]interface{}{"type": "string", "pattern": "(["}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.AttributeRepository)
+
+			usecase := NewAttributeUsecase(mockRepo)
+			_, _, err := usecase.UpsertCustomAttribute("locker_number", _attribute.UpsertCustomAttribute{Schema: tt.schema})
+
+			assert.ErrorIs(t, err, constants.ErrInvalidCustomAttributeSchema)
+			mockRepo.AssertNotCalled(t, "CreateCustomAttribute", mock.Anything)
+		})
+	}
+}
+
+func TestUpsertCustomAttribute_InUse(t *testing.T) {
+	t.Run("values rejected", func(t *testing.T) {
+		mockRepo := new(mocks.AttributeRepository)
+		mockRepo.On("FetchCustomAttributeValues", "blood_type").Return([]json.RawMessage{json.RawMessage(`"O+"`), json.RawMessage(`"unknown"`)}, nil)
+
+		usecase := NewAttributeUsecase(mockRepo)
+		_, _, err := usecase.UpsertCustomAttribute("blood_type", _attribute.UpsertCustomAttribute{Schema: bloodTypeSchema})
+
+		assert.ErrorIs(t, err, constants.ErrCustomAttributeInUse)
+		assert.Contains(t, err.Error(), "/blood_type: value is not one of the allowed values")
+		mockRepo.AssertNotCalled(t, "UpdateCustomAttribute", mock.Anything)
+	})
+
+	t.Run("values missing", func(t *testing.T) {
+		mockRepo := new(mocks.AttributeRepository)
+		mockRepo.On("FetchCustomAttributeValues", "blood_type").Return([]json.RawMessage{}, nil)
+		mockRepo.On("CountProfilesWithoutCustomAttribute", "blood_type").Return(int64(3), nil)
+
+		usecase := NewAttributeUsecase(mockRepo)
+		_, _, err := usecase.UpsertCustomAttribute("blood_type", _attribute.UpsertCustomAttribute{Schema: bloodTypeSchema, Required: boolPtr(true)})
+
+		assert.ErrorIs(t, err, constants.ErrCustomAttributeInUse)
+		assert.Contains(t, err.Error(), "3 profiles have no value for blood_type")
+	})
+}
+
+func TestValidateCustomAttributes(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  map[string]interface{}
+		reasons []string
+	}{
+		{"valid", map[string]interface{}{"blood_type": "O+", "bus_route": float64(12)}, nil},
+		{"optional left out", map[string]interface{}{"blood_type": "AB-"}, nil},
+		{"required missing", map[string]interface{}{"bus_route": float64(12)}, []string{`property "blood_type" is missing`}},
+		{"not allowed", map[string]interface{}{"blood_type": "C", "bus_route": float64(0)},
+			[]string{"/blood_type: value is not one of the allowed values", "/bus_route: number must be at least 1"}},
+		{"unknown", map[string]interface{}{"blood_type": "O+", "locker": "12B"}, []string{`property "locker" is unsupported`}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.AttributeRepository)
+			mockRepo.On("FetchCustomAttributes").Return(definedAttributes(), nil)
+
+			usecase := NewAttributeUsecase(mockRepo)
+			err := usecase.ValidateCustomAttributes(tt.values)
+
+			if tt.reasons == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, constants.ErrInvalidCustomAttributes)
+			for _, reason := range tt.reasons {
+				assert.Contains(t, err.Error(), reason)
+			}
+		})
+	}
+}
+
+func TestFetchProfileSpec(t *testing.T) {
+	mockRepo := new(mocks.AttributeRepository)
+	mockRepo.On("FetchCustomAttributes").Return(definedAttributes(), nil)
+
+	usecase := NewAttributeUsecase(mockRepo)
+	spec, err := usecase.FetchProfileSpec()
+	require.NoError(t, err)
+
+	schema := spec.Components.Schemas["CustomAttributes"].Value
+	require.Contains(t, schema.Properties, "blood_type")
+	require.Contains(t, schema.Properties, "bus_route")
+	assert.Equal(t, []string{"blood_type"}, schema.Required)
+	assert.Len(t, schema.Properties["blood_type"].Value.Enum, 8)
+	assert.NotEmpty(t, schema.Description)
+
+	bu, err := json.Marshal(spec.Components.Schemas["Profile"])
+	require.NoError(t, err)
+	assert.Contains(t, string(bu), `"#/components/schemas/CustomAttributes"`)
+}
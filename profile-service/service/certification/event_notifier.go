@@ -0,0 +1,9 @@
+package certification
+
+import "github.com/jariwat/p_project/profile-service/models"
+
+// EventNotifier delivers certification events outside the service, such as to a webhook.
+// An event that fails to be delivered is retried later, so Notify may see it more than once.
+type EventNotifier interface {
+	Notify(event *models.CertificationEvent) error
+}
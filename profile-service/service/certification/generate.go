@@ -0,0 +1,2 @@
+package certification 
+//go:generate oapi-codegen --config=./server.cfg.yaml ../../../api-spec/certification/openapi_bundle.yml
@@ -0,0 +1,172 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_certification "github.com/jariwat/p_project/profile-service/service/certification"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type certificationHandler struct {
+	certificationUs _certification.CertificationUsecase
+}
+
+// respondError maps domain errors to their HTTP status.
+func respondError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, constants.ErrProfileNotFound),
+		errors.Is(err, constants.ErrCertificationNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrInvalidCertificationDates),
+		errors.Is(err, constants.ErrUnknownSkillCatalog),
+		errors.Is(err, constants.ErrUnknownAttachment),
+		errors.Is(err, constants.ErrNotCertificateAttachment),
+		errors.Is(err, constants.ErrInvalidExpiryWindow):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// GetProfileIdCertifications implements certification.ServerInterface.
+func (h *certificationHandler) GetProfileIdCertifications(c *gin.Context, id types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+
+	certifications, err := h.certificationUs.FetchCertifications(&profileId)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var data []_certification.Certification
+	bu, err := json.Marshal(certifications)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal certifications"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal certifications"})
+		return
+	}
+
+	c.JSON(http.StatusOK, _certification.CertificationsResponse{Data: &data})
+}
+
+// PostProfileIdCertifications implements certification.ServerInterface.
+func (h *certificationHandler) PostProfileIdCertifications(c *gin.Context, id types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+
+	var request _certification.UpsertCertification
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	created, err := h.certificationUs.CreateCertification(&profileId, request)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	respondCertification(c, http.StatusCreated, created)
+}
+
+// PutProfileIdCertificationsCertificationId implements certification.ServerInterface.
+func (h *certificationHandler) PutProfileIdCertificationsCertificationId(c *gin.Context, id types.UUID, certificationId types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+	var updateCertificationId = uuid.FromStringOrNil(certificationId.String())
+
+	var request _certification.UpsertCertification
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	updated, err := h.certificationUs.UpdateCertification(&profileId, &updateCertificationId, request)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	respondCertification(c, http.StatusOK, updated)
+}
+
+// DeleteProfileIdCertificationsCertificationId implements certification.ServerInterface.
+func (h *certificationHandler) DeleteProfileIdCertificationsCertificationId(c *gin.Context, id types.UUID, certificationId types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+	var deleteCertificationId = uuid.FromStringOrNil(certificationId.String())
+
+	if err := h.certificationUs.DeleteCertification(&profileId, &deleteCertificationId); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, _certification.Success{Message: "Certification deleted successfully"})
+}
+
+// GetCertificationsExpiring implements certification.ServerInterface.
+func (h *certificationHandler) GetCertificationsExpiring(c *gin.Context, params _certification.GetCertificationsExpiringParams) {
+	var page, perPage int
+	if params.Page != nil && params.PerPage != nil {
+		page = *params.Page
+		perPage = *params.PerPage
+	}
+	var paginator = models.NewPaginator(page, perPage)
+
+	certifications, err := h.certificationUs.FetchExpiringCertifications(params, paginator)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var data []_certification.Certification
+	bu, err := json.Marshal(certifications)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal certifications"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal certifications"})
+		return
+	}
+
+	response := _certification.CertificationsPaginationResponse{
+		Data:       &data,
+		Page:       &paginator.Page,
+		PerPage:    &paginator.PerPage,
+		TotalPages: &paginator.TotalPages,
+		TotalRows:  &paginator.TotalRows,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func respondCertification(c *gin.Context, status int, certification *models.Certification) {
+	var data _certification.Certification
+	bu, err := json.Marshal(certification)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal certification"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal certification"})
+		return
+	}
+
+	c.JSON(status, _certification.CertificationResponse{Data: &data})
+}
+
+func NewCertificationHandler(certificationUs _certification.CertificationUsecase) _certification.ServerInterface {
+	return &certificationHandler{
+		certificationUs: certificationUs,
+	}
+}
@@ -0,0 +1,168 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_certification "github.com/jariwat/p_project/profile-service/service/certification"
+	"github.com/jariwat/p_project/profile-service/service/certification/mocks"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func TestPostProfileIdCertifications_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileID := ptrUUID()
+	issueDate := time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)
+	expiryDate := issueDate.AddDate(2, 0, 0)
+	body := []byte(`{"name":"Basic First Aid","issuer":"Thai Red Cross Society","issue_date":"2025-01-15","expiry_date":"2027-01-15"}`)
+	req, _ := http.NewRequest(http.MethodPost, "/profile/"+profileID.String()+"/certifications", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.CertificationUsecase)
+	mockUsecase.On("CreateCertification", profileID, mock.AnythingOfType("certification.UpsertCertification")).
+		Return(&models.Certification{ID: ptrUUID(), ProfileID: profileID, Name: "Basic First Aid", Issuer: "Thai Red Cross Society",
+			IssueDate: issueDate, ExpiryDate: &expiryDate}, nil)
+
+	handler := NewCertificationHandler(mockUsecase)
+	handler.PostProfileIdCertifications(c, types.UUID(*profileID))
+
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var resp _certification.CertificationResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "Basic First Aid", *resp.Data.Name)
+	assert.Equal(t, "2027-01-15", resp.Data.ExpiryDate.String())
+}
+
+func TestPostProfileIdCertifications_Errors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"profile not found", constants.ErrProfileNotFound, http.StatusNotFound},
+		{"invalid dates", constants.ErrInvalidCertificationDates, http.StatusBadRequest},
+		{"unknown skill", constants.ErrUnknownSkillCatalog, http.StatusBadRequest},
+		{"unknown attachment", constants.ErrUnknownAttachment, http.StatusBadRequest},
+		{"not a certificate", constants.ErrNotCertificateAttachment, http.StatusBadRequest},
+		{"database", errors.New("connection refused"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profileID := ptrUUID()
+			body := []byte(`{"name":"Basic First Aid","issuer":"Thai Red Cross Society","issue_date":"2025-01-15"}`)
+			req, _ := http.NewRequest(http.MethodPost, "/profile/"+profileID.String()+"/certifications", bytes.NewBuffer(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+
+			mockUsecase := new(mocks.CertificationUsecase)
+			mockUsecase.On("CreateCertification", profileID, mock.Anything).Return(nil, tt.err)
+
+			handler := NewCertificationHandler(mockUsecase)
+			handler.PostProfileIdCertifications(c, types.UUID(*profileID))
+
+			assert.Equal(t, tt.status, w.Code)
+		})
+	}
+}
+
+func TestDeleteProfileIdCertificationsCertificationId_NotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileID, certificationID := ptrUUID(), ptrUUID()
+	req, _ := http.NewRequest(http.MethodDelete, "/profile/"+profileID.String()+"/certifications/"+certificationID.String(), nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.CertificationUsecase)
+	mockUsecase.On("DeleteCertification", profileID, certificationID).Return(constants.ErrCertificationNotFound)
+
+	handler := NewCertificationHandler(mockUsecase)
+	handler.DeleteProfileIdCertificationsCertificationId(c, types.UUID(*profileID), types.UUID(*certificationID))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGetCertificationsExpiring_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, _ := http.NewRequest(http.MethodGet, "/certifications/expiring?within=2w", nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	within := "2w"
+	params := _certification.GetCertificationsExpiringParams{Within: &within}
+	expiryDate := time.Now().AddDate(0, 0, 10)
+	mockUsecase := new(mocks.CertificationUsecase)
+	mockUsecase.
+		On("FetchExpiringCertifications", params, mock.AnythingOfType("*models.Paginator")).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*models.Paginator).SetTotal(1)
+		}).
+		Return([]*models.Certification{{ID: ptrUUID(), ProfileID: ptrUUID(), Name: "Basic First Aid", Issuer: "Thai Red Cross Society",
+			IssueDate: expiryDate.AddDate(-2, 0, 0), ExpiryDate: &expiryDate}}, nil)
+
+	handler := NewCertificationHandler(mockUsecase)
+	handler.GetCertificationsExpiring(c, params)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp _certification.CertificationsPaginationResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, *resp.Data, 1)
+	assert.Equal(t, 1, *resp.TotalRows)
+}
+
+func TestGetCertificationsExpiring_InvalidWindow(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, _ := http.NewRequest(http.MethodGet, "/certifications/expiring?within=9999w", nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	within := "9999w"
+	params := _certification.GetCertificationsExpiringParams{Within: &within}
+	mockUsecase := new(mocks.CertificationUsecase)
+	mockUsecase.
+		On("FetchExpiringCertifications", params, mock.AnythingOfType("*models.Paginator")).
+		Return(nil, constants.ErrInvalidExpiryWindow)
+
+	handler := NewCertificationHandler(mockUsecase)
+	handler.GetCertificationsExpiring(c, params)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,271 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	time "time"
+
+	models "github.com/jariwat/p_project/profile-service/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/gofrs/uuid"
+)
+
+// CertificationRepository is an autogenerated mock type for the CertificationRepository type
+type CertificationRepository struct {
+	mock.Mock
+}
+
+// ClaimCertificationEvents provides a mock function with given fields: leaseUntil, limit
+func (_m *CertificationRepository) ClaimCertificationEvents(leaseUntil time.Time, limit int) ([]*models.CertificationEvent, error) {
+	ret := _m.Called(leaseUntil, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimCertificationEvents")
+	}
+
+	var r0 []*models.CertificationEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, int) ([]*models.CertificationEvent, error)); ok {
+		return rf(leaseUntil, limit)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, int) []*models.CertificationEvent); ok {
+		r0 = rf(leaseUntil, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.CertificationEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, int) error); ok {
+		r1 = rf(leaseUntil, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCertification provides a mock function with given fields: _a0
+func (_m *CertificationRepository) CreateCertification(_a0 *models.Certification) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCertification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Certification) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateCertificationEvents provides a mock function with given fields: events
+func (_m *CertificationRepository) CreateCertificationEvents(events []*models.CertificationEvent) error {
+	ret := _m.Called(events)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCertificationEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*models.CertificationEvent) error); ok {
+		r0 = rf(events)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCertification provides a mock function with given fields: profileId, certificationId
+func (_m *CertificationRepository) DeleteCertification(profileId *uuid.UUID, certificationId *uuid.UUID) error {
+	ret := _m.Called(profileId, certificationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCertification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) error); ok {
+		r0 = rf(profileId, certificationId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FetchCertificationById provides a mock function with given fields: profileId, certificationId
+func (_m *CertificationRepository) FetchCertificationById(profileId *uuid.UUID, certificationId *uuid.UUID) (*models.Certification, error) {
+	ret := _m.Called(profileId, certificationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchCertificationById")
+	}
+
+	var r0 *models.Certification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) (*models.Certification, error)); ok {
+		return rf(profileId, certificationId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) *models.Certification); ok {
+		r0 = rf(profileId, certificationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Certification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *uuid.UUID) error); ok {
+		r1 = rf(profileId, certificationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchCertifications provides a mock function with given fields: profileId
+func (_m *CertificationRepository) FetchCertifications(profileId *uuid.UUID) ([]*models.Certification, error) {
+	ret := _m.Called(profileId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchCertifications")
+	}
+
+	var r0 []*models.Certification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) ([]*models.Certification, error)); ok {
+		return rf(profileId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) []*models.Certification); ok {
+		r0 = rf(profileId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Certification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(profileId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchCertificationsToRemind provides a mock function with given fields: expiringBy
+func (_m *CertificationRepository) FetchCertificationsToRemind(expiringBy time.Time) ([]*models.Certification, error) {
+	ret := _m.Called(expiringBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchCertificationsToRemind")
+	}
+
+	var r0 []*models.Certification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) ([]*models.Certification, error)); ok {
+		return rf(expiringBy)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) []*models.Certification); ok {
+		r0 = rf(expiringBy)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Certification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(expiringBy)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchExpiringCertifications provides a mock function with given fields: from, to, paginator
+func (_m *CertificationRepository) FetchExpiringCertifications(from time.Time, to time.Time, paginator *models.Paginator) ([]*models.Certification, error) {
+	ret := _m.Called(from, to, paginator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchExpiringCertifications")
+	}
+
+	var r0 []*models.Certification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, time.Time, *models.Paginator) ([]*models.Certification, error)); ok {
+		return rf(from, to, paginator)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, time.Time, *models.Paginator) []*models.Certification); ok {
+		r0 = rf(from, to, paginator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Certification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, time.Time, *models.Paginator) error); ok {
+		r1 = rf(from, to, paginator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCertification provides a mock function with given fields: _a0
+func (_m *CertificationRepository) UpdateCertification(_a0 *models.Certification) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCertification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Certification) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCertificationEvent provides a mock function with given fields: event
+func (_m *CertificationRepository) UpdateCertificationEvent(event *models.CertificationEvent) error {
+	ret := _m.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCertificationEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.CertificationEvent) error); ok {
+		r0 = rf(event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewCertificationRepository creates a new instance of CertificationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCertificationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CertificationRepository {
+	mock := &CertificationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
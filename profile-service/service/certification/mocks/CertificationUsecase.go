@@ -0,0 +1,205 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	certification "github.com/jariwat/p_project/profile-service/service/certification"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/jariwat/p_project/profile-service/models"
+
+	uuid "github.com/gofrs/uuid"
+)
+
+// CertificationUsecase is an autogenerated mock type for the CertificationUsecase type
+type CertificationUsecase struct {
+	mock.Mock
+}
+
+// CreateCertification provides a mock function with given fields: profileId, newCertification
+func (_m *CertificationUsecase) CreateCertification(profileId *uuid.UUID, newCertification certification.UpsertCertification) (*models.Certification, error) {
+	ret := _m.Called(profileId, newCertification)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCertification")
+	}
+
+	var r0 *models.Certification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, certification.UpsertCertification) (*models.Certification, error)); ok {
+		return rf(profileId, newCertification)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, certification.UpsertCertification) *models.Certification); ok {
+		r0 = rf(profileId, newCertification)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Certification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, certification.UpsertCertification) error); ok {
+		r1 = rf(profileId, newCertification)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCertification provides a mock function with given fields: profileId, certificationId
+func (_m *CertificationUsecase) DeleteCertification(profileId *uuid.UUID, certificationId *uuid.UUID) error {
+	ret := _m.Called(profileId, certificationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCertification")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) error); ok {
+		r0 = rf(profileId, certificationId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeliverCertificationEvents provides a mock function with no fields
+func (_m *CertificationUsecase) DeliverCertificationEvents() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeliverCertificationEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FetchCertifications provides a mock function with given fields: profileId
+func (_m *CertificationUsecase) FetchCertifications(profileId *uuid.UUID) ([]*models.Certification, error) {
+	ret := _m.Called(profileId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchCertifications")
+	}
+
+	var r0 []*models.Certification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) ([]*models.Certification, error)); ok {
+		return rf(profileId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) []*models.Certification); ok {
+		r0 = rf(profileId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Certification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(profileId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchExpiringCertifications provides a mock function with given fields: params, paginator
+func (_m *CertificationUsecase) FetchExpiringCertifications(params certification.GetCertificationsExpiringParams, paginator *models.Paginator) ([]*models.Certification, error) {
+	ret := _m.Called(params, paginator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchExpiringCertifications")
+	}
+
+	var r0 []*models.Certification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(certification.GetCertificationsExpiringParams, *models.Paginator) ([]*models.Certification, error)); ok {
+		return rf(params, paginator)
+	}
+	if rf, ok := ret.Get(0).(func(certification.GetCertificationsExpiringParams, *models.Paginator) []*models.Certification); ok {
+		r0 = rf(params, paginator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Certification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(certification.GetCertificationsExpiringParams, *models.Paginator) error); ok {
+		r1 = rf(params, paginator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemindExpiringCertifications provides a mock function with no fields
+func (_m *CertificationUsecase) RemindExpiringCertifications() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemindExpiringCertifications")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCertification provides a mock function with given fields: profileId, certificationId, updateCertification
+func (_m *CertificationUsecase) UpdateCertification(profileId *uuid.UUID, certificationId *uuid.UUID, updateCertification certification.UpsertCertification) (*models.Certification, error) {
+	ret := _m.Called(profileId, certificationId, updateCertification)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCertification")
+	}
+
+	var r0 *models.Certification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID, certification.UpsertCertification) (*models.Certification, error)); ok {
+		return rf(profileId, certificationId, updateCertification)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID, certification.UpsertCertification) *models.Certification); ok {
+		r0 = rf(profileId, certificationId, updateCertification)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Certification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *uuid.UUID, certification.UpsertCertification) error); ok {
+		r1 = rf(profileId, certificationId, updateCertification)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCertificationUsecase creates a new instance of CertificationUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCertificationUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CertificationUsecase {
+	mock := &CertificationUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,56 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	certification "github.com/jariwat/p_project/profile-service/service/certification"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ServerInterface is an autogenerated mock type for the ServerInterface type
+type ServerInterface struct {
+	mock.Mock
+}
+
+// DeleteProfileIdCertificationsCertificationId provides a mock function with given fields: c, id, certificationId
+func (_m *ServerInterface) DeleteProfileIdCertificationsCertificationId(c *gin.Context, id uuid.UUID, certificationId uuid.UUID) {
+	_m.Called(c, id, certificationId)
+}
+
+// GetCertificationsExpiring provides a mock function with given fields: c, params
+func (_m *ServerInterface) GetCertificationsExpiring(c *gin.Context, params certification.GetCertificationsExpiringParams) {
+	_m.Called(c, params)
+}
+
+// GetProfileIdCertifications provides a mock function with given fields: c, id
+func (_m *ServerInterface) GetProfileIdCertifications(c *gin.Context, id uuid.UUID) {
+	_m.Called(c, id)
+}
+
+// PostProfileIdCertifications provides a mock function with given fields: c, id
+func (_m *ServerInterface) PostProfileIdCertifications(c *gin.Context, id uuid.UUID) {
+	_m.Called(c, id)
+}
+
+// PutProfileIdCertificationsCertificationId provides a mock function with given fields: c, id, certificationId
+func (_m *ServerInterface) PutProfileIdCertificationsCertificationId(c *gin.Context, id uuid.UUID, certificationId uuid.UUID) {
+	_m.Called(c, id, certificationId)
+}
+
+// NewServerInterface creates a new instance of ServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServerInterface {
+	mock := &ServerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
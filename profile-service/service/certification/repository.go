@@ -0,0 +1,22 @@
+package certification
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/models"
+)
+
+type CertificationRepository interface {
+	FetchCertifications(profileId *uuid.UUID) ([]*models.Certification, error)
+	FetchCertificationById(profileId *uuid.UUID, certificationId *uuid.UUID) (*models.Certification, error)
+	FetchExpiringCertifications(from time.Time, to time.Time, paginator *models.Paginator) ([]*models.Certification, error)
+	CreateCertification(certification *models.Certification) error
+	UpdateCertification(certification *models.Certification) error
+	DeleteCertification(profileId *uuid.UUID, certificationId *uuid.UUID) error
+
+	FetchCertificationsToRemind(expiringBy time.Time) ([]*models.Certification, error)
+	CreateCertificationEvents(events []*models.CertificationEvent) error
+	ClaimCertificationEvents(leaseUntil time.Time, limit int) ([]*models.CertificationEvent, error)
+	UpdateCertificationEvent(event *models.CertificationEvent) error
+}
@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/certification"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	foreignKeyViolationCode = "23503"
+	profileForeignKey       = "certification_profile_id_fkey"
+	catalogForeignKey       = "certification_catalog_id_fkey"
+	attachmentForeignKey    = "certification_attachment_id_fkey"
+
+	// certificationOrder lists the certifications the next to expire first, those that do not expire last
+	certificationOrder = "expiry_date NULLS LAST, issue_date DESC, id"
+)
+
+type certificationRepository struct {
+	client *gorm.DB
+}
+
+// translateError maps a profile, skill or attachment removed while the
+// certification was written to domain errors.
+func translateError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != foreignKeyViolationCode {
+		return err
+	}
+
+	switch pgErr.ConstraintName {
+	case profileForeignKey:
+		return constants.ErrProfileNotFound
+	case catalogForeignKey:
+		return constants.ErrUnknownSkillCatalog
+	case attachmentForeignKey:
+		return constants.ErrUnknownAttachment
+	}
+
+	return err
+}
+
+// FetchCertifications implements certification.CertificationRepository.
+func (c *certificationRepository) FetchCertifications(profileId *uuid.UUID) ([]*models.Certification, error) {
+	var certifications []*models.Certification
+	if err := c.client.Where("profile_id = ?", profileId).Order(certificationOrder).Find(&certifications).Error; err != nil {
+		return nil, err
+	}
+
+	return certifications, nil
+}
+
+// FetchCertificationById implements certification.CertificationRepository.
+func (c *certificationRepository) FetchCertificationById(profileId *uuid.UUID, certificationId *uuid.UUID) (*models.Certification, error) {
+	var found models.Certification
+	if err := c.client.First(&found, "id = ? AND profile_id = ?", certificationId, profileId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &found, nil
+}
+
+// FetchExpiringCertifications implements certification.CertificationRepository.
+// It lists the certifications expiring from from to to, both included.
+func (c *certificationRepository) FetchExpiringCertifications(from time.Time, to time.Time, paginator *models.Paginator) ([]*models.Certification, error) {
+	var certifications []*models.Certification
+	var totalRows int64
+	var limit = paginator.PerPage
+	var offset = (paginator.Page - 1) * paginator.PerPage
+
+	query := c.client.Model(&models.Certification{}).Where("expiry_date BETWEEN ? AND ?", from, to)
+	if err := query.Count(&totalRows).Error; err != nil {
+		return nil, err
+	}
+
+	if err := query.Order(certificationOrder).Limit(limit).Offset(offset).Find(&certifications).Error; err != nil {
+		return nil, err
+	}
+
+	paginator.SetTotal(int(totalRows))
+
+	return certifications, nil
+}
+
+// CreateCertification implements certification.CertificationRepository.
+func (c *certificationRepository) CreateCertification(certification *models.Certification) error {
+	return translateError(c.client.Create(certification).Error)
+}
+
+// UpdateCertification implements certification.CertificationRepository.
+func (c *certificationRepository) UpdateCertification(certification *models.Certification) error {
+	return translateError(c.client.Model(&models.Certification{}).Where("id = ?", certification.ID).Updates(map[string]interface{}{
+		"catalog_id":    certification.CatalogID,
+		"name":          certification.Name,
+		"issuer":        certification.Issuer,
+		"issue_date":    certification.IssueDate,
+		"expiry_date":   certification.ExpiryDate,
+		"attachment_id": certification.AttachmentID,
+		"updated_at":    certification.UpdatedAt,
+	}).Error)
+}
+
+// DeleteCertification implements certification.CertificationRepository.
+func (c *certificationRepository) DeleteCertification(profileId *uuid.UUID, certificationId *uuid.UUID) error {
+	result := c.client.Where("id = ? AND profile_id = ?", certificationId, profileId).Delete(&models.Certification{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return constants.ErrCertificationNotFound
+	}
+
+	return nil
+}
+
+// FetchCertificationsToRemind implements certification.CertificationRepository.
+// It lists the certifications expiring by expiringBy, leaving out those whose
+// expiry has already been announced for their current expiry date.
+func (c *certificationRepository) FetchCertificationsToRemind(expiringBy time.Time) ([]*models.Certification, error) {
+	var certifications []*models.Certification
+	if err := c.client.
+		Where("expiry_date <= ? AND NOT EXISTS (?)", expiringBy,
+			c.client.Model(&models.CertificationEvent{}).Select("1").
+				Where("certification_event.certification_id = certification.id AND certification_event.expiry_date = certification.expiry_date AND certification_event.type = ?", models.CertificationEventExpired)).
+		Order("expiry_date, id").
+		Find(&certifications).Error; err != nil {
+		return nil, err
+	}
+
+	return certifications, nil
+}
+
+// CreateCertificationEvents implements certification.CertificationRepository.
+// Events already created for the same reminder are skipped.
+func (c *certificationRepository) CreateCertificationEvents(events []*models.CertificationEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	return c.client.Clauses(clause.OnConflict{DoNothing: true}).Create(&events).Error
+}
+
+// ClaimCertificationEvents implements certification.CertificationRepository.
+// Up to limit undelivered events that are due are counted as attempted and held
+// until leaseUntil, so other instances skip them while they are being delivered.
+func (c *certificationRepository) ClaimCertificationEvents(leaseUntil time.Time, limit int) ([]*models.CertificationEvent, error) {
+	var events []*models.CertificationEvent
+	err := c.client.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("delivered_at IS NULL AND next_attempt_at <= ?", time.Now()).
+			Order("next_attempt_at, created_at").
+			Limit(limit).
+			Find(&events).Error; err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		ids := make([]*uuid.UUID, 0, len(events))
+		for _, event := range events {
+			event.Attempts++
+			event.NextAttemptAt = &leaseUntil
+			ids = append(ids, event.ID)
+		}
+
+		return tx.Model(&models.CertificationEvent{}).Where("id IN ?", ids).Updates(map[string]interface{}{
+			"attempts":        gorm.Expr("attempts + 1"),
+			"next_attempt_at": leaseUntil,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// UpdateCertificationEvent implements certification.CertificationRepository.
+func (c *certificationRepository) UpdateCertificationEvent(event *models.CertificationEvent) error {
+	return c.client.Model(&models.CertificationEvent{}).Where("id = ?", event.ID).Updates(map[string]interface{}{
+		"attempts":        event.Attempts,
+		"next_attempt_at": event.NextAttemptAt,
+		"delivered_at":    event.DeliveredAt,
+		"last_error":      event.LastError,
+	}).Error
+}
+
+func NewPsqlCertificationRepository(client *gorm.DB) certification.CertificationRepository {
+	return &certificationRepository{
+		client: client,
+	}
+}
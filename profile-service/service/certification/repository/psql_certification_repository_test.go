@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	return gormDB, mock
+}
+
+func TestFetchExpiringCertifications(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlCertificationRepository(gormDB)
+
+	from := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 30)
+	paginator := models.NewPaginator(2, 1)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "certification" WHERE expiry_date BETWEEN $1 AND $2`)).
+		WithArgs(from, to).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "certification" WHERE expiry_date BETWEEN $1 AND $2 ORDER BY expiry_date NULLS LAST, issue_date DESC, id LIMIT $3 OFFSET $4`)).
+		WithArgs(from, to, 1, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "expiry_date"}).AddRow(ptrUUID(), "Basic First Aid", to))
+
+	certifications, err := repo.FetchExpiringCertifications(from, to, paginator)
+	assert.NoError(t, err)
+	assert.Len(t, certifications, 1)
+	assert.Equal(t, 2, paginator.TotalRows)
+	assert.Equal(t, 2, paginator.TotalPages)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateCertification_AttachmentGone(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlCertificationRepository(gormDB)
+
+	certification := &models.Certification{ID: ptrUUID(), ProfileID: ptrUUID(), AttachmentID: ptrUUID(), Name: "Basic First Aid",
+		Issuer: "Thai Red Cross Society", IssueDate: time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO "certification"`).
+		WillReturnError(&pgconn.PgError{Code: "23503", ConstraintName: "certification_attachment_id_fkey"})
+	mock.ExpectRollback()
+
+	assert.ErrorIs(t, repo.CreateCertification(certification), constants.ErrUnknownAttachment)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteCertification_NotFound(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlCertificationRepository(gormDB)
+
+	profileId, certificationId := ptrUUID(), ptrUUID()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "certification" WHERE id = $1 AND profile_id = $2`)).
+		WithArgs(certificationId, profileId).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	assert.ErrorIs(t, repo.DeleteCertification(profileId, certificationId), constants.ErrCertificationNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchCertificationsToRemind(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlCertificationRepository(gormDB)
+
+	expiringBy := time.Date(2026, 5, 31, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "certification" WHERE expiry_date <= $1 AND NOT EXISTS (SELECT 1 FROM "certification_event" WHERE certification_event.certification_id = certification.id AND certification_event.expiry_date = certification.expiry_date AND certification_event.type = $2) ORDER BY expiry_date, id`)).
+		WithArgs(expiringBy, models.CertificationEventExpired).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(ptrUUID()))
+
+	certifications, err := repo.FetchCertificationsToRemind(expiringBy)
+	assert.NoError(t, err)
+	assert.Len(t, certifications, 1)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateCertificationEvents_SkipsSent(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlCertificationRepository(gormDB)
+
+	expiryDate := time.Date(2026, 5, 31, 0, 0, 0, 0, time.UTC)
+	event, err := models.NewCertificationEvent(&models.Certification{ID: ptrUUID(), ExpiryDate: &expiryDate}, models.CertificationEventExpiring, 30)
+	assert.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "certification_event"`) + `.*` + regexp.QuoteMeta(`ON CONFLICT DO NOTHING`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.CreateCertificationEvents([]*models.CertificationEvent{event}))
+	assert.NoError(t, repo.CreateCertificationEvents(nil))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClaimCertificationEvents(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlCertificationRepository(gormDB)
+
+	eventId := ptrUUID()
+	leaseUntil := time.Now().Add(5 * time.Minute)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "certification_event" WHERE delivered_at IS NULL AND next_attempt_at <= $1 ORDER BY next_attempt_at, created_at LIMIT $2 FOR UPDATE SKIP LOCKED`)).
+		WithArgs(sqlmock.AnyArg(), 50).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "type", "attempts"}).AddRow(eventId, models.CertificationEventExpiring, 2))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "certification_event" SET "attempts"=attempts + 1,"next_attempt_at"=$1 WHERE id IN ($2)`)).
+		WithArgs(leaseUntil, eventId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	events, err := repo.ClaimCertificationEvents(leaseUntil, 50)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, 3, events[0].Attempts)
+	assert.Equal(t, leaseUntil, *events[0].NextAttemptAt)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
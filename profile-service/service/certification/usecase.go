@@ -0,0 +1,17 @@
+package certification
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/models"
+)
+
+type CertificationUsecase interface {
+	FetchCertifications(profileId *uuid.UUID) ([]*models.Certification, error)
+	CreateCertification(profileId *uuid.UUID, newCertification UpsertCertification) (*models.Certification, error)
+	UpdateCertification(profileId *uuid.UUID, certificationId *uuid.UUID, updateCertification UpsertCertification) (*models.Certification, error)
+	DeleteCertification(profileId *uuid.UUID, certificationId *uuid.UUID) error
+	FetchExpiringCertifications(params GetCertificationsExpiringParams, paginator *models.Paginator) ([]*models.Certification, error)
+
+	RemindExpiringCertifications() error
+	DeliverCertificationEvents() error
+}
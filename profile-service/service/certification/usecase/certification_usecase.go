@@ -0,0 +1,267 @@
+package usecase
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/attachment"
+	"github.com/jariwat/p_project/profile-service/service/certification"
+	"github.com/jariwat/p_project/profile-service/service/outbox"
+	"github.com/jariwat/p_project/profile-service/service/profile"
+	"github.com/jariwat/p_project/profile-service/service/skill"
+)
+
+const (
+	// defaultExpiryWindowDays is the period GET /certifications/expiring looks ahead without within
+	defaultExpiryWindowDays = 30
+	// maxExpiryWindowDays is the longest period GET /certifications/expiring looks ahead
+	maxExpiryWindowDays = 3650
+)
+
+var expiryWindowPattern = regexp.MustCompile(`^([1-9][0-9]*)([dw])$`)
+
+type certificationUsecase struct {
+	certificationRepo certification.CertificationRepository
+	profileUs         profile.ProfileUsecase
+	skillUs           skill.SkillUsecase
+	attachmentUs      attachment.AttachmentUsecase
+	notifier          certification.EventNotifier
+	// reminderDays are the days before expiry reminders are sent, the earliest first
+	reminderDays []int
+}
+
+// FetchCertifications implements certification.CertificationUsecase.
+func (c *certificationUsecase) FetchCertifications(profileId *uuid.UUID) ([]*models.Certification, error) {
+	if err := c.checkProfileExists(profileId); err != nil {
+		return nil, err
+	}
+
+	return c.certificationRepo.FetchCertifications(profileId)
+}
+
+// CreateCertification implements certification.CertificationUsecase.
+func (c *certificationUsecase) CreateCertification(profileId *uuid.UUID, newCertification certification.UpsertCertification) (*models.Certification, error) {
+	if err := c.checkProfileExists(profileId); err != nil {
+		return nil, err
+	}
+
+	created := &models.Certification{ProfileID: profileId}
+	if err := c.setCertification(created, newCertification); err != nil {
+		return nil, err
+	}
+	created.GenUUID()
+	created.SetCreatedAt()
+	created.SetUpdatedAt()
+
+	if err := c.certificationRepo.CreateCertification(created); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// UpdateCertification implements certification.CertificationUsecase.
+func (c *certificationUsecase) UpdateCertification(profileId *uuid.UUID, certificationId *uuid.UUID, updateCertification certification.UpsertCertification) (*models.Certification, error) {
+	updated, err := c.certificationRepo.FetchCertificationById(profileId, certificationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if updated == nil {
+		return nil, constants.ErrCertificationNotFound
+	}
+
+	if err := c.setCertification(updated, updateCertification); err != nil {
+		return nil, err
+	}
+	updated.SetUpdatedAt()
+
+	if err := c.certificationRepo.UpdateCertification(updated); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// DeleteCertification implements certification.CertificationUsecase.
+func (c *certificationUsecase) DeleteCertification(profileId *uuid.UUID, certificationId *uuid.UUID) error {
+	return c.certificationRepo.DeleteCertification(profileId, certificationId)
+}
+
+// FetchExpiringCertifications implements certification.CertificationUsecase.
+// A certification is expiring when it is still valid today and its last day
+// falls within the period.
+func (c *certificationUsecase) FetchExpiringCertifications(params certification.GetCertificationsExpiringParams, paginator *models.Paginator) ([]*models.Certification, error) {
+	days := defaultExpiryWindowDays
+	if params.Within != nil {
+		var err error
+		if days, err = parseExpiryWindow(*params.Within); err != nil {
+			return nil, err
+		}
+	}
+
+	today := models.DateOf(time.Now())
+	return c.certificationRepo.FetchExpiringCertifications(today, today.AddDate(0, 0, days), paginator)
+}
+
+// RemindExpiringCertifications implements certification.CertificationUsecase.
+// Each certification gets the latest reminder it has reached, so one created a
+// few days before its expiry skips the earlier reminders, and an expired event
+// once its last day has passed. DeliverCertificationEvents sends them.
+func (c *certificationUsecase) RemindExpiringCertifications() error {
+	today := models.DateOf(time.Now())
+	expiringBy := today
+	if len(c.reminderDays) > 0 {
+		expiringBy = today.AddDate(0, 0, c.reminderDays[0])
+	}
+
+	certifications, err := c.certificationRepo.FetchCertificationsToRemind(expiringBy)
+	if err != nil {
+		return err
+	}
+
+	var events []*models.CertificationEvent
+	for _, expiring := range certifications {
+		eventType, daysBefore, ok := c.reminderFor(expiring.DaysUntilExpiry(today))
+		if !ok {
+			continue
+		}
+
+		event, err := models.NewCertificationEvent(expiring, eventType, daysBefore)
+		if err != nil {
+			return err
+		}
+		events = append(events, event)
+	}
+
+	return c.certificationRepo.CreateCertificationEvents(events)
+}
+
+// reminderFor returns the event due for a certification expiring in daysLeft
+// days, false when no reminder has been reached yet.
+func (c *certificationUsecase) reminderFor(daysLeft int) (models.CertificationEventType, int, bool) {
+	if daysLeft < 0 {
+		return models.CertificationEventExpired, 0, true
+	}
+
+	for i := len(c.reminderDays) - 1; i >= 0; i-- {
+		if daysLeft <= c.reminderDays[i] {
+			return models.CertificationEventExpiring, c.reminderDays[i], true
+		}
+	}
+
+	return "", 0, false
+}
+
+// DeliverCertificationEvents implements certification.CertificationUsecase.
+func (c *certificationUsecase) DeliverCertificationEvents() error {
+	return outbox.Deliver(c.certificationRepo.ClaimCertificationEvents, c.certificationRepo.UpdateCertificationEvent, c.notifier)
+}
+
+// parseExpiryWindow returns the number of days in a period such as 30d or 2w.
+func parseExpiryWindow(within string) (int, error) {
+	match := expiryWindowPattern.FindStringSubmatch(strings.TrimSpace(within))
+	if match == nil {
+		return 0, constants.ErrInvalidExpiryWindow
+	}
+
+	days, err := strconv.Atoi(match[1])
+	if err != nil || days > maxExpiryWindowDays {
+		return 0, constants.ErrInvalidExpiryWindow
+	}
+	if match[2] == "w" {
+		days *= 7
+	}
+	if days > maxExpiryWindowDays {
+		return 0, constants.ErrInvalidExpiryWindow
+	}
+
+	return days, nil
+}
+
+// setCertification copies upsertCertification into target once its dates are
+// checked and its skill and attachment are found. The attachment has to be a
+// certificate of the same profile.
+func (c *certificationUsecase) setCertification(target *models.Certification, upsertCertification certification.UpsertCertification) error {
+	issueDate := models.DateOf(upsertCertification.IssueDate.Time)
+	var expiryDate *time.Time
+	if upsertCertification.ExpiryDate != nil {
+		date := models.DateOf(upsertCertification.ExpiryDate.Time)
+		if date.Before(issueDate) {
+			return constants.ErrInvalidCertificationDates
+		}
+		expiryDate = &date
+	}
+
+	var catalogId *uuid.UUID
+	if upsertCertification.CatalogId != nil {
+		id := uuid.FromStringOrNil(upsertCertification.CatalogId.String())
+		catalog, err := c.skillUs.FetchCatalogById(&id)
+		if err != nil {
+			return err
+		}
+		if catalog == nil {
+			return constants.ErrUnknownSkillCatalog
+		}
+		catalogId = &id
+	}
+
+	var attachmentId *uuid.UUID
+	if upsertCertification.AttachmentId != nil {
+		id := uuid.FromStringOrNil(upsertCertification.AttachmentId.String())
+		found, err := c.attachmentUs.FetchAttachmentById(target.ProfileID, &id)
+		if err != nil {
+			return err
+		}
+		if found == nil {
+			return constants.ErrUnknownAttachment
+		}
+		if found.Kind != models.AttachmentKindCertificate {
+			return constants.ErrNotCertificateAttachment
+		}
+		attachmentId = &id
+	}
+
+	target.CatalogID = catalogId
+	target.Name = strings.TrimSpace(upsertCertification.Name)
+	target.Issuer = strings.TrimSpace(upsertCertification.Issuer)
+	target.IssueDate = issueDate
+	target.ExpiryDate = expiryDate
+	target.AttachmentID = attachmentId
+
+	return nil
+}
+
+func (c *certificationUsecase) checkProfileExists(profileId *uuid.UUID) error {
+	found, err := c.profileUs.FetchProfileById(profileId)
+	if err != nil {
+		return err
+	}
+
+	if found == nil {
+		return constants.ErrProfileNotFound
+	}
+
+	return nil
+}
+
+func NewCertificationUsecase(certificationRepo certification.CertificationRepository, profileUs profile.ProfileUsecase, skillUs skill.SkillUsecase, attachmentUs attachment.AttachmentUsecase, notifier certification.EventNotifier, reminderDays []int) certification.CertificationUsecase {
+	// reminders are looked up from the earliest, whatever order they are configured in
+	days := append([]int(nil), reminderDays...)
+	sort.Sort(sort.Reverse(sort.IntSlice(days)))
+
+	return &certificationUsecase{
+		certificationRepo: certificationRepo,
+		profileUs:         profileUs,
+		skillUs:           skillUs,
+		attachmentUs:      attachmentUs,
+		notifier:          notifier,
+		reminderDays:      days,
+	}
+}
@@ -0,0 +1,251 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	attachmentMocks "github.com/jariwat/p_project/profile-service/service/attachment/mocks"
+	"github.com/jariwat/p_project/profile-service/service/certification"
+	"github.com/jariwat/p_project/profile-service/service/certification/mocks"
+	"github.com/jariwat/p_project/profile-service/service/outbox"
+	profileMocks "github.com/jariwat/p_project/profile-service/service/profile/mocks"
+	skillMocks "github.com/jariwat/p_project/profile-service/service/skill/mocks"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type testUsecase struct {
+	certification.CertificationUsecase
+	repo         *mocks.CertificationRepository
+	profileUs    *profileMocks.ProfileUsecase
+	skillUs      *skillMocks.SkillUsecase
+	attachmentUs *attachmentMocks.AttachmentUsecase
+	notifier     *mocks.EventNotifier
+}
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func newUsecase(reminderDays ...int) testUsecase {
+	u := testUsecase{
+		repo:         new(mocks.CertificationRepository),
+		profileUs:    new(profileMocks.ProfileUsecase),
+		skillUs:      new(skillMocks.SkillUsecase),
+		attachmentUs: new(attachmentMocks.AttachmentUsecase),
+		notifier:     new(mocks.EventNotifier),
+	}
+	u.CertificationUsecase = NewCertificationUsecase(u.repo, u.profileUs, u.skillUs, u.attachmentUs, u.notifier, reminderDays)
+	return u
+}
+
+func daysFromToday(days int) *time.Time {
+	date := models.DateOf(time.Now()).AddDate(0, 0, days)
+	return &date
+}
+
+func apiUUID(id *uuid.UUID) *types.UUID {
+	value := types.UUID(*id)
+	return &value
+}
+
+func date(year int, month time.Month, day int) types.Date {
+	return types.Date{Time: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+func TestCreateCertification_Success(t *testing.T) {
+	u := newUsecase(30)
+
+	profileId, catalogId, attachmentId := ptrUUID(), ptrUUID(), ptrUUID()
+	expiryDate := date(2027, time.January, 14)
+	u.profileUs.On("FetchProfileById", profileId).Return(&models.Profile{ID: profileId}, nil)
+	u.skillUs.On("FetchCatalogById", catalogId).Return(&models.SkillCatalog{ID: catalogId}, nil)
+	u.attachmentUs.On("FetchAttachmentById", profileId, attachmentId).Return(&models.Attachment{ID: attachmentId, Kind: models.AttachmentKindCertificate}, nil)
+	u.repo.On("CreateCertification", mock.AnythingOfType("*models.Certification")).Return(nil)
+
+	created, err := u.CreateCertification(profileId, certification.UpsertCertification{
+		CatalogId:    apiUUID(catalogId),
+		Name:         " Basic First Aid ",
+		Issuer:       "Thai Red Cross Society",
+		IssueDate:    date(2025, time.January, 15),
+		ExpiryDate:   &expiryDate,
+		AttachmentId: apiUUID(attachmentId),
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, created.ID)
+	require.Equal(t, "Basic First Aid", created.Name)
+	require.Equal(t, catalogId, created.CatalogID)
+	require.Equal(t, attachmentId, created.AttachmentID)
+	require.Equal(t, "2027-01-14", created.ExpiryDate.Format(models.DateFormat))
+	u.repo.AssertExpectations(t)
+}
+
+func TestCreateCertification_Invalid(t *testing.T) {
+	expiryDate := date(2024, time.January, 14)
+	catalogId, attachmentId := ptrUUID(), ptrUUID()
+
+	cases := []struct {
+		name    string
+		request certification.UpsertCertification
+		setup   func(u testUsecase, profileId *uuid.UUID)
+		err     error
+	}{
+		{
+			name:    "expiry before issue",
+			request: certification.UpsertCertification{ExpiryDate: &expiryDate},
+			setup:   func(u testUsecase, profileId *uuid.UUID) {},
+			err:     constants.ErrInvalidCertificationDates,
+		},
+		{
+			name:    "unknown skill",
+			request: certification.UpsertCertification{CatalogId: apiUUID(catalogId)},
+			setup: func(u testUsecase, profileId *uuid.UUID) {
+				u.skillUs.On("FetchCatalogById", catalogId).Return(nil, nil)
+			},
+			err: constants.ErrUnknownSkillCatalog,
+		},
+		{
+			name:    "attachment of another profile",
+			request: certification.UpsertCertification{AttachmentId: apiUUID(attachmentId)},
+			setup: func(u testUsecase, profileId *uuid.UUID) {
+				u.attachmentUs.On("FetchAttachmentById", profileId, attachmentId).Return(nil, nil)
+			},
+			err: constants.ErrUnknownAttachment,
+		},
+		{
+			name:    "photo attachment",
+			request: certification.UpsertCertification{AttachmentId: apiUUID(attachmentId)},
+			setup: func(u testUsecase, profileId *uuid.UUID) {
+				u.attachmentUs.On("FetchAttachmentById", profileId, attachmentId).Return(&models.Attachment{Kind: models.AttachmentKindPhoto}, nil)
+			},
+			err: constants.ErrNotCertificateAttachment,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			u := newUsecase(30)
+
+			profileId := ptrUUID()
+			u.profileUs.On("FetchProfileById", profileId).Return(&models.Profile{ID: profileId}, nil)
+			tc.setup(u, profileId)
+			tc.request.Name, tc.request.Issuer = "Basic First Aid", "Thai Red Cross Society"
+			tc.request.IssueDate = date(2025, time.January, 15)
+
+			_, err := u.CreateCertification(profileId, tc.request)
+
+			require.ErrorIs(t, err, tc.err)
+			u.repo.AssertNotCalled(t, "CreateCertification", mock.Anything)
+		})
+	}
+}
+
+func TestFetchExpiringCertifications_Window(t *testing.T) {
+	cases := []struct {
+		within *string
+		days   int
+		err    error
+	}{
+		{nil, 30, nil},
+		{strPtr("7d"), 7, nil},
+		{strPtr("2w"), 14, nil},
+		{strPtr("3650d"), 3650, nil},
+		{strPtr("522w"), 0, constants.ErrInvalidExpiryWindow},
+		{strPtr("1m"), 0, constants.ErrInvalidExpiryWindow},
+		{strPtr("0d"), 0, constants.ErrInvalidExpiryWindow},
+	}
+
+	for _, tc := range cases {
+		u := newUsecase(30)
+		paginator := models.NewPaginator(1, 10)
+		today := models.DateOf(time.Now())
+		u.repo.On("FetchExpiringCertifications", today, today.AddDate(0, 0, tc.days), paginator).Return([]*models.Certification{}, nil)
+
+		_, err := u.FetchExpiringCertifications(certification.GetCertificationsExpiringParams{Within: tc.within}, paginator)
+
+		if tc.err != nil {
+			require.ErrorIs(t, err, tc.err)
+			u.repo.AssertNotCalled(t, "FetchExpiringCertifications", mock.Anything, mock.Anything, mock.Anything)
+			continue
+		}
+		require.NoError(t, err)
+		u.repo.AssertExpectations(t)
+	}
+}
+
+func TestRemindExpiringCertifications(t *testing.T) {
+	u := newUsecase(1, 30, 7)
+
+	notYet := &models.Certification{ID: ptrUUID(), ExpiryDate: daysFromToday(31)}
+	monthAhead := &models.Certification{ID: ptrUUID(), ExpiryDate: daysFromToday(30)}
+	createdLate := &models.Certification{ID: ptrUUID(), ExpiryDate: daysFromToday(5)}
+	lastDay := &models.Certification{ID: ptrUUID(), ExpiryDate: daysFromToday(0)}
+	expired := &models.Certification{ID: ptrUUID(), ExpiryDate: daysFromToday(-1)}
+	u.repo.On("FetchCertificationsToRemind", *daysFromToday(30)).
+		Return([]*models.Certification{notYet, monthAhead, createdLate, lastDay, expired}, nil)
+
+	var events []*models.CertificationEvent
+	u.repo.On("CreateCertificationEvents", mock.Anything).Run(func(args mock.Arguments) {
+		events = args.Get(0).([]*models.CertificationEvent)
+	}).Return(nil)
+
+	require.NoError(t, u.RemindExpiringCertifications())
+
+	require.Len(t, events, 4)
+	expected := []struct {
+		certification *models.Certification
+		eventType     models.CertificationEventType
+		daysBefore    int
+	}{
+		{monthAhead, models.CertificationEventExpiring, 30},
+		{createdLate, models.CertificationEventExpiring, 7},
+		{lastDay, models.CertificationEventExpiring, 1},
+		{expired, models.CertificationEventExpired, 0},
+	}
+	for i, want := range expected {
+		require.Equal(t, want.certification.ID, events[i].CertificationID)
+		require.Equal(t, want.eventType, events[i].Type)
+		require.Equal(t, want.daysBefore, events[i].DaysBefore)
+		require.Equal(t, *want.certification.ExpiryDate, events[i].ExpiryDate)
+		require.NotNil(t, events[i].NextAttemptAt)
+	}
+}
+
+func TestDeliverCertificationEvents(t *testing.T) {
+	u := newUsecase(30)
+
+	delivered := &models.CertificationEvent{ID: ptrUUID(), EventDelivery: models.EventDelivery{Attempts: 1}}
+	retried := &models.CertificationEvent{ID: ptrUUID(), EventDelivery: models.EventDelivery{Attempts: 3}}
+	givenUp := &models.CertificationEvent{ID: ptrUUID(), EventDelivery: models.EventDelivery{Attempts: outbox.MaxAttempts}}
+	u.repo.On("ClaimCertificationEvents", mock.AnythingOfType("time.Time"), outbox.BatchSize).
+		Return([]*models.CertificationEvent{delivered, retried, givenUp}, nil)
+	u.notifier.On("Notify", delivered).Return(nil)
+	u.notifier.On("Notify", retried).Return(errors.New("webhook 503 Service Unavailable"))
+	u.notifier.On("Notify", givenUp).Return(errors.New("webhook 503 Service Unavailable"))
+	u.repo.On("UpdateCertificationEvent", mock.Anything).Return(nil)
+
+	before := time.Now()
+	require.NoError(t, u.DeliverCertificationEvents())
+
+	require.NotNil(t, delivered.DeliveredAt)
+	require.Nil(t, delivered.LastError)
+
+	require.Nil(t, retried.DeliveredAt)
+	require.Equal(t, "webhook 503 Service Unavailable", *retried.LastError)
+	require.WithinDuration(t, before.Add(4*time.Minute), *retried.NextAttemptAt, time.Second)
+
+	require.Nil(t, givenUp.DeliveredAt)
+	require.Nil(t, givenUp.NextAttemptAt)
+	u.repo.AssertNumberOfCalls(t, "UpdateCertificationEvent", 3)
+}
+
+func strPtr(value string) *string {
+	return &value
+}
@@ -0,0 +1,2 @@
+package class 
+//go:generate oapi-codegen --config=./server.cfg.yaml ../../../api-spec/class/openapi_bundle.yml
@@ -0,0 +1,236 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_class "github.com/jariwat/p_project/profile-service/service/class"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type classHandler struct {
+	classUs _class.ClassUsecase
+}
+
+// respondError maps domain errors to their HTTP status.
+func respondError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, constants.ErrClassNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrClassForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrInvalidClassCode),
+		errors.Is(err, constants.ErrInvalidPromotion),
+		errors.Is(err, constants.ErrPromotionBeforeEnrollment):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrClassCodeConflict),
+		errors.Is(err, constants.ErrClassCapacityTooLow),
+		errors.Is(err, constants.ErrClassHasProfiles),
+		errors.Is(err, constants.ErrClassHasEnrollments),
+		errors.Is(err, constants.ErrClassFull):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// convert copies a model into its generated response type.
+func convert(c *gin.Context, from interface{}, to interface{}) bool {
+	bu, err := json.Marshal(from)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal response"})
+		return false
+	}
+
+	if err := json.Unmarshal(bu, to); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal response"})
+		return false
+	}
+
+	return true
+}
+
+// GetClasses implements class.ServerInterface.
+func (h *classHandler) GetClasses(c *gin.Context, params _class.GetClassesParams) {
+	var page, perPage int
+	if params.Page != nil && params.PerPage != nil {
+		page = *params.Page
+		perPage = *params.PerPage
+	}
+	var paginator = models.NewPaginator(page, perPage)
+
+	classes, err := h.classUs.FetchClasses(params, paginator)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var data []_class.Class
+	if !convert(c, classes, &data) {
+		return
+	}
+
+	response := _class.ClassesPaginationResponse{
+		Data:       &data,
+		Page:       &paginator.Page,
+		PerPage:    &paginator.PerPage,
+		TotalPages: &paginator.TotalPages,
+		TotalRows:  &paginator.TotalRows,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// PostClass implements class.ServerInterface.
+func (h *classHandler) PostClass(c *gin.Context) {
+	var newClass _class.UpsertClass
+	if err := c.ShouldBindJSON(&newClass); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
+		return
+	}
+
+	var class = new(models.Class)
+	class.GenUUID()
+	if err := h.classUs.CreateClass(class, newClass); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var data _class.Class
+	if !convert(c, class, &data) {
+		return
+	}
+
+	c.JSON(http.StatusCreated, _class.ClassResponse{Data: &data})
+}
+
+// GetClassId implements class.ServerInterface.
+func (h *classHandler) GetClassId(c *gin.Context, id types.UUID) {
+	var classId = uuid.FromStringOrNil(id.String())
+
+	class, err := h.classUs.FetchClassById(&classId)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if class == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Class not found"})
+		return
+	}
+
+	var data _class.Class
+	if !convert(c, class, &data) {
+		return
+	}
+
+	c.JSON(http.StatusOK, _class.ClassResponse{Data: &data})
+}
+
+// PutClassId implements class.ServerInterface.
+func (h *classHandler) PutClassId(c *gin.Context, id types.UUID, params _class.PutClassIdParams) {
+	var classId = uuid.FromStringOrNil(id.String())
+
+	var updateClass _class.UpsertClass
+	if err := c.ShouldBindJSON(&updateClass); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
+		return
+	}
+
+	class, err := h.classUs.UpdateClass(&classId, updateClass, models.NewViewer(params.XUserId, params.XUserRole))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var data _class.Class
+	if !convert(c, class, &data) {
+		return
+	}
+
+	c.JSON(http.StatusOK, _class.ClassResponse{Data: &data})
+}
+
+// DeleteClassId implements class.ServerInterface.
+func (h *classHandler) DeleteClassId(c *gin.Context, id types.UUID) {
+	var classId = uuid.FromStringOrNil(id.String())
+
+	if err := h.classUs.DeleteClass(&classId); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	response := _class.Success{
+		Message: "Class deleted successfully",
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetClassIdProfiles implements class.ServerInterface.
+func (h *classHandler) GetClassIdProfiles(c *gin.Context, id types.UUID, params _class.GetClassIdProfilesParams) {
+	var classId = uuid.FromStringOrNil(id.String())
+
+	var page, perPage int
+	if params.Page != nil && params.PerPage != nil {
+		page = *params.Page
+		perPage = *params.PerPage
+	}
+	var paginator = models.NewPaginator(page, perPage)
+
+	profiles, err := h.classUs.FetchRoster(&classId, paginator)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var data []_class.Profile
+	if !convert(c, profiles, &data) {
+		return
+	}
+
+	response := _class.ClassRosterPaginationResponse{
+		Data:       &data,
+		Page:       &paginator.Page,
+		PerPage:    &paginator.PerPage,
+		TotalPages: &paginator.TotalPages,
+		TotalRows:  &paginator.TotalRows,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// PostClassIdPromote implements class.ServerInterface.
+func (h *classHandler) PostClassIdPromote(c *gin.Context, id types.UUID, params _class.PostClassIdPromoteParams) {
+	var classId = uuid.FromStringOrNil(id.String())
+
+	var request _class.PromoteClassRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
+		return
+	}
+
+	promotion, err := h.classUs.PromoteClass(&classId, request, models.NewViewer(params.XUserId, params.XUserRole))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var data _class.ClassPromotion
+	if !convert(c, promotion, &data) {
+		return
+	}
+
+	c.JSON(http.StatusOK, _class.ClassPromotionResponse{Data: &data})
+}
+
+func NewClassHandler(classUs _class.ClassUsecase) _class.ServerInterface {
+	return &classHandler{
+		classUs: classUs,
+	}
+}
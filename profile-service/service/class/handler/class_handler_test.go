@@ -0,0 +1,266 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_class "github.com/jariwat/p_project/profile-service/service/class"
+	"github.com/jariwat/p_project/profile-service/service/class/mocks"
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func staffParams() _class.PutClassIdParams {
+	return _class.PutClassIdParams{XUserId: "teacher-42", XUserRole: _class.UserRoleStaff}
+}
+
+func staffPromoteParams() _class.PostClassIdPromoteParams {
+	return _class.PostClassIdPromoteParams{XUserId: "teacher-42", XUserRole: _class.UserRoleStaff}
+}
+
+var staffViewer = &models.Viewer{UserID: "teacher-42", Role: models.UserRoleStaff}
+
+func TestPostClass_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	capacity := 40
+	newClass := _class.UpsertClass{Code: "M1/1", Capacity: &capacity}
+	body, _ := json.Marshal(newClass)
+
+	req, _ := http.NewRequest(http.MethodPost, "/class", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.ClassUsecase)
+	mockUsecase.
+		On("CreateClass", mock.AnythingOfType("*models.Class"), newClass).
+		Run(func(args mock.Arguments) {
+			class := args.Get(0).(*models.Class)
+			class.SetCode("M1/1")
+			class.Name = "M1/1"
+			class.Capacity = &capacity
+		}).
+		Return(nil)
+
+	handler := NewClassHandler(mockUsecase)
+	handler.PostClass(c)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var resp _class.ClassResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "M1/1", *resp.Data.Code)
+	assert.Equal(t, 40, *resp.Data.Capacity)
+	assert.NotNil(t, resp.Data.Id)
+}
+
+func TestPostClass_Conflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	body, _ := json.Marshal(_class.UpsertClass{Code: "M1/1"})
+	req, _ := http.NewRequest(http.MethodPost, "/class", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.ClassUsecase)
+	mockUsecase.
+		On("CreateClass", mock.Anything, mock.Anything).
+		Return(constants.ErrClassCodeConflict)
+
+	handler := NewClassHandler(mockUsecase)
+	handler.PostClass(c)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestGetClassId_NotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	classID := ptrUUID()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/class/"+classID.String(), nil)
+
+	mockUsecase := new(mocks.ClassUsecase)
+	mockUsecase.On("FetchClassById", classID).Return(nil, nil)
+
+	handler := NewClassHandler(mockUsecase)
+	handler.GetClassId(c, types.UUID(*classID))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestPutClassId_CapacityTooLow(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	classID := ptrUUID()
+	capacity := 1
+	body, _ := json.Marshal(_class.UpsertClass{Code: "M1/1", Capacity: &capacity})
+	req, _ := http.NewRequest(http.MethodPut, "/class/"+classID.String(), bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.ClassUsecase)
+	mockUsecase.
+		On("UpdateClass", classID, mock.Anything, staffViewer).
+		Return(nil, constants.ErrClassCapacityTooLow)
+
+	handler := NewClassHandler(mockUsecase)
+	handler.PutClassId(c, types.UUID(*classID), staffParams())
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestDeleteClassId_HasProfiles(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	classID := ptrUUID()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodDelete, "/class/"+classID.String(), nil)
+
+	mockUsecase := new(mocks.ClassUsecase)
+	mockUsecase.On("DeleteClass", classID).Return(constants.ErrClassHasProfiles)
+
+	handler := NewClassHandler(mockUsecase)
+	handler.DeleteClassId(c, types.UUID(*classID))
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestGetClassIdProfiles_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	classID := ptrUUID()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/class/"+classID.String()+"/profiles", nil)
+
+	page, perPage := 1, 10
+	mockUsecase := new(mocks.ClassUsecase)
+	mockUsecase.
+		On("FetchRoster", classID, mock.AnythingOfType("*models.Paginator")).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*models.Paginator).SetTotal(1)
+		}).
+		Return([]*models.Profile{{ID: ptrUUID(), FirstName: "SeiA", LastName: "Phanes", ClassID: classID, Class: "M1/1"}}, nil)
+
+	handler := NewClassHandler(mockUsecase)
+	handler.GetClassIdProfiles(c, types.UUID(*classID), _class.GetClassIdProfilesParams{Page: &page, PerPage: &perPage})
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp _class.ClassRosterPaginationResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, *resp.Data, 1)
+	assert.Equal(t, "SeiA", *(*resp.Data)[0].FirstName)
+	assert.Equal(t, classID.String(), (*resp.Data)[0].ClassId.String())
+	assert.Equal(t, 1, *resp.TotalRows)
+}
+
+func TestGetClassIdProfiles_NotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	classID := ptrUUID()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/class/"+classID.String()+"/profiles", nil)
+
+	mockUsecase := new(mocks.ClassUsecase)
+	mockUsecase.On("FetchRoster", classID, mock.Anything).Return(nil, constants.ErrClassNotFound)
+
+	handler := NewClassHandler(mockUsecase)
+	handler.GetClassIdProfiles(c, types.UUID(*classID), _class.GetClassIdProfilesParams{})
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestPostClassIdPromote_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	fromID, toID := ptrUUID(), ptrUUID()
+	request := _class.PromoteClassRequest{ToClassId: types.UUID(*toID), AcademicYear: "2026"}
+	body, _ := json.Marshal(request)
+	req, _ := http.NewRequest(http.MethodPost, "/class/"+fromID.String()+"/promote", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.ClassUsecase)
+	mockUsecase.On("PromoteClass", fromID, request, staffViewer).Return(&models.ClassPromotion{
+		FromClassID:   fromID,
+		ToClassID:     toID,
+		AcademicYear:  "2026",
+		EffectiveDate: "2026-05-16",
+		Promoted:      32,
+	}, nil)
+
+	handler := NewClassHandler(mockUsecase)
+	handler.PostClassIdPromote(c, types.UUID(*fromID), staffPromoteParams())
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp _class.ClassPromotionResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, 32, *resp.Data.Promoted)
+	assert.Equal(t, "2026-05-16", resp.Data.EffectiveDate.String())
+}
+
+func TestPostClassIdPromote_Errors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		err    error
+		status int
+	}{
+		{constants.ErrInvalidPromotion, http.StatusBadRequest},
+		{constants.ErrClassForbidden, http.StatusForbidden},
+		{constants.ErrClassNotFound, http.StatusNotFound},
+		{constants.ErrClassFull, http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		fromID := ptrUUID()
+		body, _ := json.Marshal(_class.PromoteClassRequest{ToClassId: types.UUID(*ptrUUID()), AcademicYear: "2026"})
+		req, _ := http.NewRequest(http.MethodPost, "/class/"+fromID.String()+"/promote", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+
+		mockUsecase := new(mocks.ClassUsecase)
+		mockUsecase.On("PromoteClass", fromID, mock.Anything, staffViewer).Return(nil, tt.err)
+
+		handler := NewClassHandler(mockUsecase)
+		handler.PostClassIdPromote(c, types.UUID(*fromID), staffPromoteParams())
+
+		assert.Equal(t, tt.status, w.Code, tt.err.Error())
+	}
+}
@@ -0,0 +1,235 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	class "github.com/jariwat/p_project/profile-service/service/class"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/jariwat/p_project/profile-service/models"
+
+	time "time"
+
+	uuid "github.com/gofrs/uuid"
+)
+
+// ClassRepository is an autogenerated mock type for the ClassRepository type
+type ClassRepository struct {
+	mock.Mock
+}
+
+// CreateClass provides a mock function with given fields: _a0
+func (_m *ClassRepository) CreateClass(_a0 *models.Class) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateClass")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Class) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteClass provides a mock function with given fields: classId
+func (_m *ClassRepository) DeleteClass(classId *uuid.UUID) error {
+	ret := _m.Called(classId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteClass")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) error); ok {
+		r0 = rf(classId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FetchClassByCode provides a mock function with given fields: normalizedCode
+func (_m *ClassRepository) FetchClassByCode(normalizedCode string) (*models.Class, error) {
+	ret := _m.Called(normalizedCode)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchClassByCode")
+	}
+
+	var r0 *models.Class
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.Class, error)); ok {
+		return rf(normalizedCode)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.Class); ok {
+		r0 = rf(normalizedCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Class)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(normalizedCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchClassById provides a mock function with given fields: classId
+func (_m *ClassRepository) FetchClassById(classId *uuid.UUID) (*models.Class, error) {
+	ret := _m.Called(classId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchClassById")
+	}
+
+	var r0 *models.Class
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) (*models.Class, error)); ok {
+		return rf(classId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) *models.Class); ok {
+		r0 = rf(classId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Class)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(classId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchClasses provides a mock function with given fields: params, paginator
+func (_m *ClassRepository) FetchClasses(params class.GetClassesParams, paginator *models.Paginator) ([]*models.Class, error) {
+	ret := _m.Called(params, paginator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchClasses")
+	}
+
+	var r0 []*models.Class
+	var r1 error
+	if rf, ok := ret.Get(0).(func(class.GetClassesParams, *models.Paginator) ([]*models.Class, error)); ok {
+		return rf(params, paginator)
+	}
+	if rf, ok := ret.Get(0).(func(class.GetClassesParams, *models.Paginator) []*models.Class); ok {
+		r0 = rf(params, paginator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Class)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(class.GetClassesParams, *models.Paginator) error); ok {
+		r1 = rf(params, paginator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchRoster provides a mock function with given fields: classId, paginator
+func (_m *ClassRepository) FetchRoster(classId *uuid.UUID, paginator *models.Paginator) ([]*models.Profile, error) {
+	ret := _m.Called(classId, paginator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchRoster")
+	}
+
+	var r0 []*models.Profile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *models.Paginator) ([]*models.Profile, error)); ok {
+		return rf(classId, paginator)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *models.Paginator) []*models.Profile); ok {
+		r0 = rf(classId, paginator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Profile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *models.Paginator) error); ok {
+		r1 = rf(classId, paginator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PromoteClass provides a mock function with given fields: fromClassId, toClassId, academicYear, effectiveDate
+func (_m *ClassRepository) PromoteClass(fromClassId *uuid.UUID, toClassId *uuid.UUID, academicYear string, effectiveDate time.Time) (int64, error) {
+	ret := _m.Called(fromClassId, toClassId, academicYear, effectiveDate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PromoteClass")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID, string, time.Time) (int64, error)); ok {
+		return rf(fromClassId, toClassId, academicYear, effectiveDate)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID, string, time.Time) int64); ok {
+		r0 = rf(fromClassId, toClassId, academicYear, effectiveDate)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *uuid.UUID, string, time.Time) error); ok {
+		r1 = rf(fromClassId, toClassId, academicYear, effectiveDate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateClass provides a mock function with given fields: _a0
+func (_m *ClassRepository) UpdateClass(_a0 *models.Class) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateClass")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Class) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewClassRepository creates a new instance of ClassRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewClassRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ClassRepository {
+	mock := &ClassRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
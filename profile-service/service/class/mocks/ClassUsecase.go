@@ -0,0 +1,247 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	class "github.com/jariwat/p_project/profile-service/service/class"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/jariwat/p_project/profile-service/models"
+
+	uuid "github.com/gofrs/uuid"
+)
+
+// ClassUsecase is an autogenerated mock type for the ClassUsecase type
+type ClassUsecase struct {
+	mock.Mock
+}
+
+// CreateClass provides a mock function with given fields: _a0, newClass
+func (_m *ClassUsecase) CreateClass(_a0 *models.Class, newClass class.UpsertClass) error {
+	ret := _m.Called(_a0, newClass)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateClass")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Class, class.UpsertClass) error); ok {
+		r0 = rf(_a0, newClass)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteClass provides a mock function with given fields: classId
+func (_m *ClassUsecase) DeleteClass(classId *uuid.UUID) error {
+	ret := _m.Called(classId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteClass")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) error); ok {
+		r0 = rf(classId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FetchClassById provides a mock function with given fields: classId
+func (_m *ClassUsecase) FetchClassById(classId *uuid.UUID) (*models.Class, error) {
+	ret := _m.Called(classId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchClassById")
+	}
+
+	var r0 *models.Class
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) (*models.Class, error)); ok {
+		return rf(classId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) *models.Class); ok {
+		r0 = rf(classId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Class)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(classId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchClasses provides a mock function with given fields: params, paginator
+func (_m *ClassUsecase) FetchClasses(params class.GetClassesParams, paginator *models.Paginator) ([]*models.Class, error) {
+	ret := _m.Called(params, paginator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchClasses")
+	}
+
+	var r0 []*models.Class
+	var r1 error
+	if rf, ok := ret.Get(0).(func(class.GetClassesParams, *models.Paginator) ([]*models.Class, error)); ok {
+		return rf(params, paginator)
+	}
+	if rf, ok := ret.Get(0).(func(class.GetClassesParams, *models.Paginator) []*models.Class); ok {
+		r0 = rf(params, paginator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Class)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(class.GetClassesParams, *models.Paginator) error); ok {
+		r1 = rf(params, paginator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchRoster provides a mock function with given fields: classId, paginator
+func (_m *ClassUsecase) FetchRoster(classId *uuid.UUID, paginator *models.Paginator) ([]*models.Profile, error) {
+	ret := _m.Called(classId, paginator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchRoster")
+	}
+
+	var r0 []*models.Profile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *models.Paginator) ([]*models.Profile, error)); ok {
+		return rf(classId, paginator)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *models.Paginator) []*models.Profile); ok {
+		r0 = rf(classId, paginator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Profile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *models.Paginator) error); ok {
+		r1 = rf(classId, paginator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PromoteClass provides a mock function with given fields: fromClassId, request, viewer
+func (_m *ClassUsecase) PromoteClass(fromClassId *uuid.UUID, request class.PromoteClassRequest, viewer *models.Viewer) (*models.ClassPromotion, error) {
+	ret := _m.Called(fromClassId, request, viewer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PromoteClass")
+	}
+
+	var r0 *models.ClassPromotion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, class.PromoteClassRequest, *models.Viewer) (*models.ClassPromotion, error)); ok {
+		return rf(fromClassId, request, viewer)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, class.PromoteClassRequest, *models.Viewer) *models.ClassPromotion); ok {
+		r0 = rf(fromClassId, request, viewer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ClassPromotion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, class.PromoteClassRequest, *models.Viewer) error); ok {
+		r1 = rf(fromClassId, request, viewer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResolveClass provides a mock function with given fields: classId, code
+func (_m *ClassUsecase) ResolveClass(classId *uuid.UUID, code string) (*models.Class, error) {
+	ret := _m.Called(classId, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveClass")
+	}
+
+	var r0 *models.Class
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, string) (*models.Class, error)); ok {
+		return rf(classId, code)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, string) *models.Class); ok {
+		r0 = rf(classId, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Class)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, string) error); ok {
+		r1 = rf(classId, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateClass provides a mock function with given fields: classId, updateClass, viewer
+func (_m *ClassUsecase) UpdateClass(classId *uuid.UUID, updateClass class.UpsertClass, viewer *models.Viewer) (*models.Class, error) {
+	ret := _m.Called(classId, updateClass, viewer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateClass")
+	}
+
+	var r0 *models.Class
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, class.UpsertClass, *models.Viewer) (*models.Class, error)); ok {
+		return rf(classId, updateClass, viewer)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, class.UpsertClass, *models.Viewer) *models.Class); ok {
+		r0 = rf(classId, updateClass, viewer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Class)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, class.UpsertClass, *models.Viewer) error); ok {
+		r1 = rf(classId, updateClass, viewer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewClassUsecase creates a new instance of ClassUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewClassUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ClassUsecase {
+	mock := &ClassUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,66 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	class "github.com/jariwat/p_project/profile-service/service/class"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ServerInterface is an autogenerated mock type for the ServerInterface type
+type ServerInterface struct {
+	mock.Mock
+}
+
+// DeleteClassId provides a mock function with given fields: c, id
+func (_m *ServerInterface) DeleteClassId(c *gin.Context, id uuid.UUID) {
+	_m.Called(c, id)
+}
+
+// GetClassId provides a mock function with given fields: c, id
+func (_m *ServerInterface) GetClassId(c *gin.Context, id uuid.UUID) {
+	_m.Called(c, id)
+}
+
+// GetClassIdProfiles provides a mock function with given fields: c, id, params
+func (_m *ServerInterface) GetClassIdProfiles(c *gin.Context, id uuid.UUID, params class.GetClassIdProfilesParams) {
+	_m.Called(c, id, params)
+}
+
+// GetClasses provides a mock function with given fields: c, params
+func (_m *ServerInterface) GetClasses(c *gin.Context, params class.GetClassesParams) {
+	_m.Called(c, params)
+}
+
+// PostClass provides a mock function with given fields: c
+func (_m *ServerInterface) PostClass(c *gin.Context) {
+	_m.Called(c)
+}
+
+// PostClassIdPromote provides a mock function with given fields: c, id, params
+func (_m *ServerInterface) PostClassIdPromote(c *gin.Context, id uuid.UUID, params class.PostClassIdPromoteParams) {
+	_m.Called(c, id, params)
+}
+
+// PutClassId provides a mock function with given fields: c, id, params
+func (_m *ServerInterface) PutClassId(c *gin.Context, id uuid.UUID, params class.PutClassIdParams) {
+	_m.Called(c, id, params)
+}
+
+// NewServerInterface creates a new instance of ServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServerInterface {
+	mock := &ServerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
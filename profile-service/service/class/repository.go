@@ -0,0 +1,20 @@
+package class
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/models"
+)
+
+type ClassRepository interface {
+	FetchClasses(params GetClassesParams, paginator *models.Paginator) ([]*models.Class, error)
+	FetchClassById(classId *uuid.UUID) (*models.Class, error)
+	FetchClassByCode(normalizedCode string) (*models.Class, error)
+	CreateClass(class *models.Class) error
+	UpdateClass(class *models.Class) error
+	DeleteClass(classId *uuid.UUID) error
+
+	FetchRoster(classId *uuid.UUID, paginator *models.Paginator) ([]*models.Profile, error)
+	PromoteClass(fromClassId *uuid.UUID, toClassId *uuid.UUID, academicYear string, effectiveDate time.Time) (int64, error)
+}
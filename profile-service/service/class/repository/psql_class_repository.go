@@ -0,0 +1,286 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/helper"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/class"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	uniqueViolationCode     = "23505"
+	foreignKeyViolationCode = "23503"
+
+	// enrollmentClassConstraint is the foreign key of the enrollment history on the class
+	enrollmentClassConstraint = "enrollment_class_id_fkey"
+)
+
+type classRepository struct {
+	client *gorm.DB
+}
+
+// translateError maps a duplicate code and deleting a class that still has
+// profiles or enrollment history to domain errors.
+func translateError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case uniqueViolationCode:
+			return constants.ErrClassCodeConflict
+		case foreignKeyViolationCode:
+			if pgErr.ConstraintName == enrollmentClassConstraint {
+				return constants.ErrClassHasEnrollments
+			}
+			return constants.ErrClassHasProfiles
+		}
+	}
+
+	return err
+}
+
+// FetchClasses implements class.ClassRepository.
+func (c *classRepository) FetchClasses(params class.GetClassesParams, paginator *models.Paginator) ([]*models.Class, error) {
+	var classes []*models.Class
+	var totalRows int64
+	var limit = paginator.PerPage
+	var offset = (paginator.Page - 1) * paginator.PerPage
+
+	query := c.client.Model(&models.Class{})
+
+	if params.Q != nil && *params.Q != "" {
+		query = query.Where(`normalized_code LIKE ? ESCAPE '\' OR name ILIKE ? ESCAPE '\'`,
+			helper.ContainsPattern(models.NormalizeClassCode(*params.Q)), helper.ContainsPattern(*params.Q))
+	}
+
+	if params.AcademicYear != nil && *params.AcademicYear != "" {
+		query = query.Where("academic_year = ?", *params.AcademicYear)
+	}
+
+	if err := query.Count(&totalRows).Error; err != nil {
+		return nil, err
+	}
+
+	if err := query.Order("normalized_code").
+		Limit(limit).
+		Offset(offset).
+		Find(&classes).Error; err != nil {
+		return nil, err
+	}
+
+	paginator.SetTotal(int(totalRows))
+
+	return classes, nil
+}
+
+// FetchClassById implements class.ClassRepository.
+func (c *classRepository) FetchClassById(classId *uuid.UUID) (*models.Class, error) {
+	var class models.Class
+	if err := c.client.First(&class, "id = ?", classId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &class, nil
+}
+
+// FetchClassByCode implements class.ClassRepository.
+func (c *classRepository) FetchClassByCode(normalizedCode string) (*models.Class, error) {
+	var class models.Class
+	if err := c.client.First(&class, "normalized_code = ?", normalizedCode).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &class, nil
+}
+
+// CreateClass implements class.ClassRepository.
+func (c *classRepository) CreateClass(class *models.Class) error {
+	return translateError(c.client.Create(class).Error)
+}
+
+// UpdateClass implements class.ClassRepository.
+// The class row is locked so no profile can join while the capacity is
+// checked, and the code is copied to the profiles in the class.
+func (c *classRepository) UpdateClass(class *models.Class) error {
+	err := c.client.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&models.Class{}, "id = ?", class.ID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return constants.ErrClassNotFound
+			}
+			return err
+		}
+
+		if class.Capacity != nil {
+			var enrolled int64
+			if err := tx.Model(&models.Enrollment{}).Where("class_id = ? AND end_date IS NULL", class.ID).Count(&enrolled).Error; err != nil {
+				return err
+			}
+			if enrolled > int64(*class.Capacity) {
+				return constants.ErrClassCapacityTooLow
+			}
+		}
+
+		if err := tx.Model(&models.Class{}).Where("id = ?", class.ID).Updates(map[string]interface{}{
+			"code":             class.Code,
+			"normalized_code":  class.NormalizedCode,
+			"name":             class.Name,
+			"homeroom_teacher": class.HomeroomTeacher,
+			"academic_year":    class.AcademicYear,
+			"capacity":         class.Capacity,
+			"updated_at":       class.UpdatedAt,
+		}).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&models.Profile{}).Where("class_id = ? AND class IS DISTINCT FROM ?", class.ID, class.Code).
+			Update("class", class.Code).Error
+	})
+
+	return translateError(err)
+}
+
+// DeleteClass implements class.ClassRepository.
+func (c *classRepository) DeleteClass(classId *uuid.UUID) error {
+	return translateError(c.client.Delete(&models.Class{}, classId).Error)
+}
+
+// FetchRoster implements class.ClassRepository.
+func (c *classRepository) FetchRoster(classId *uuid.UUID, paginator *models.Paginator) ([]*models.Profile, error) {
+	var profiles []*models.Profile
+	var totalRows int64
+	var limit = paginator.PerPage
+	var offset = (paginator.Page - 1) * paginator.PerPage
+
+	query := c.client.Model(&models.Profile{}).Where("id IN (?)",
+		c.client.Model(&models.Enrollment{}).Select("profile_id").Where("class_id = ? AND end_date IS NULL", classId))
+
+	if err := query.Count(&totalRows).Error; err != nil {
+		return nil, err
+	}
+
+	if err := query.Preload("Skills").
+		Order("last_name, first_name, id").
+		Limit(limit).
+		Offset(offset).
+		Find(&profiles).Error; err != nil {
+		return nil, err
+	}
+
+	paginator.SetTotal(int(totalRows))
+
+	return profiles, nil
+}
+
+// PromoteClass implements class.ClassRepository.
+// Every profile currently in the from class ends its enrollment on
+// effectiveDate and starts one in the to class on the same day. Both
+// class rows are locked, in id order to avoid deadlocks, so the capacity of
+// the to class holds.
+func (c *classRepository) PromoteClass(fromClassId *uuid.UUID, toClassId *uuid.UUID, academicYear string, effectiveDate time.Time) (int64, error) {
+	var promoted int64
+	err := c.client.Transaction(func(tx *gorm.DB) error {
+		var classes []*models.Class
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ?", []*uuid.UUID{fromClassId, toClassId}).
+			Order("id").
+			Find(&classes).Error; err != nil {
+			return err
+		}
+
+		var toClass *models.Class
+		for _, class := range classes {
+			if *class.ID == *toClassId {
+				toClass = class
+			}
+		}
+		if len(classes) != 2 || toClass == nil {
+			return constants.ErrClassNotFound
+		}
+
+		var profileIds []uuid.UUID
+		if err := tx.Model(&models.Enrollment{}).
+			Where("class_id = ? AND end_date IS NULL", fromClassId).
+			Order("profile_id").
+			Pluck("profile_id", &profileIds).Error; err != nil {
+			return err
+		}
+		if len(profileIds) == 0 {
+			return nil
+		}
+
+		// the enrollments ended must not end before they started
+		startDate := models.DateOf(effectiveDate)
+		var startedLater int64
+		if err := tx.Model(&models.Enrollment{}).
+			Where("class_id = ? AND end_date IS NULL AND start_date > ?", fromClassId, startDate).
+			Count(&startedLater).Error; err != nil {
+			return err
+		}
+		if startedLater > 0 {
+			return constants.ErrPromotionBeforeEnrollment
+		}
+
+		if toClass.Capacity != nil {
+			var enrolled int64
+			if err := tx.Model(&models.Enrollment{}).Where("class_id = ? AND end_date IS NULL", toClassId).Count(&enrolled).Error; err != nil {
+				return err
+			}
+			if enrolled+int64(len(profileIds)) > int64(*toClass.Capacity) {
+				return constants.ErrClassFull
+			}
+		}
+
+		now := time.Now()
+		if err := tx.Model(&models.Enrollment{}).
+			Where("class_id = ? AND end_date IS NULL", fromClassId).
+			Updates(map[string]interface{}{
+				"end_date":   startDate,
+				"updated_at": now,
+			}).Error; err != nil {
+			return err
+		}
+
+		enrollments := make([]*models.Enrollment, 0, len(profileIds))
+		for i := range profileIds {
+			enrollments = append(enrollments, models.NewEnrollment(&profileIds[i], toClass, &academicYear, startDate))
+		}
+		if err := tx.Create(enrollments).Error; err != nil {
+			return err
+		}
+
+		updated := tx.Model(&models.Profile{}).Where("id IN ?", profileIds).Updates(map[string]interface{}{
+			"class_id":   toClass.ID,
+			"class":      toClass.Code,
+			"updated_at": now,
+		})
+		if updated.Error != nil {
+			return updated.Error
+		}
+		promoted = updated.RowsAffected
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return promoted, nil
+}
+
+func NewPsqlClassRepository(client *gorm.DB) class.ClassRepository {
+	return &classRepository{
+		client: client,
+	}
+}
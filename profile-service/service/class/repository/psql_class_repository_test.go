@@ -0,0 +1,265 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	return gormDB, mock
+}
+
+func newClass(code string, capacity *int) *models.Class {
+	class := &models.Class{Name: code, Capacity: capacity}
+	class.GenUUID()
+	class.SetCode(code)
+	class.SetCreatedAt()
+	class.SetUpdatedAt()
+	return class
+}
+
+func TestFetchClassByCode_NotFound(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlClassRepository(gormDB)
+
+	query := `SELECT * FROM "class" WHERE normalized_code = $1 ORDER BY "class"."id" LIMIT $2`
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs("m1/1", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	class, err := repo.FetchClassByCode("m1/1")
+
+	assert.NoError(t, err)
+	assert.Nil(t, class)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateClass_CodeConflict(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlClassRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO "class"`).
+		WillReturnError(&pgconn.PgError{Code: uniqueViolationCode, ConstraintName: "idx_class_normalized_code"})
+	mock.ExpectRollback()
+
+	err := repo.CreateClass(newClass("M1/1", nil))
+
+	assert.ErrorIs(t, err, constants.ErrClassCodeConflict)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateClass_RenamesProfiles(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlClassRepository(gormDB)
+
+	capacity := 2
+	class := newClass("M1/1", &capacity)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "class" WHERE id = $1 ORDER BY "class"."id" LIMIT $2 FOR UPDATE`)).
+		WithArgs(class.ID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(class.ID.String()))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "enrollment" WHERE class_id = $1 AND end_date IS NULL`)).
+		WithArgs(class.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "class" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "profile" SET "class"=$1,"updated_at"=$2 WHERE class_id = $3 AND class IS DISTINCT FROM $4`)).
+		WithArgs("M1/1", sqlmock.AnyArg(), class.ID, "M1/1").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	err := repo.UpdateClass(class)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateClass_CapacityTooLow(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlClassRepository(gormDB)
+
+	capacity := 1
+	class := newClass("M1/1", &capacity)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "class" WHERE id = $1`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "updated_at"}).AddRow(class.ID.String(), time.Now()))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "enrollment" WHERE class_id = $1 AND end_date IS NULL`)).
+		WithArgs(class.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectRollback()
+
+	err := repo.UpdateClass(class)
+
+	assert.ErrorIs(t, err, constants.ErrClassCapacityTooLow)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteClass_HasProfiles(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlClassRepository(gormDB)
+
+	classID := ptrUUID()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "class" WHERE "class"."id" = $1`)).
+		WithArgs(classID).
+		WillReturnError(&pgconn.PgError{Code: foreignKeyViolationCode, ConstraintName: "profile_class_id_fkey"})
+	mock.ExpectRollback()
+
+	err := repo.DeleteClass(classID)
+
+	assert.ErrorIs(t, err, constants.ErrClassHasProfiles)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteClass_HasEnrollments(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlClassRepository(gormDB)
+
+	classID := ptrUUID()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "class" WHERE "class"."id" = $1`)).
+		WithArgs(classID).
+		WillReturnError(&pgconn.PgError{Code: foreignKeyViolationCode, ConstraintName: "enrollment_class_id_fkey"})
+	mock.ExpectRollback()
+
+	err := repo.DeleteClass(classID)
+
+	assert.ErrorIs(t, err, constants.ErrClassHasEnrollments)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPromoteClass_Success(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlClassRepository(gormDB)
+
+	from, to := newClass("M1/1", nil), newClass("M2/1", nil)
+	profileIDs := []*uuid.UUID{ptrUUID(), ptrUUID()}
+	effectiveDate := time.Date(2026, 5, 16, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "class" WHERE id IN ($1,$2) ORDER BY id FOR UPDATE`)).
+		WithArgs(from.ID, to.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "code"}).AddRow(from.ID.String(), from.Code).AddRow(to.ID.String(), to.Code))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "profile_id" FROM "enrollment" WHERE class_id = $1 AND end_date IS NULL ORDER BY profile_id`)).
+		WithArgs(from.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"profile_id"}).AddRow(profileIDs[0].String()).AddRow(profileIDs[1].String()))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "enrollment" WHERE class_id = $1 AND end_date IS NULL AND start_date > $2`)).
+		WithArgs(from.ID, effectiveDate).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "enrollment" SET "end_date"=$1,"updated_at"=$2 WHERE class_id = $3 AND end_date IS NULL`)).
+		WithArgs(effectiveDate, sqlmock.AnyArg(), from.ID).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "enrollment"`)).
+		WithArgs(sqlmock.AnyArg(), profileIDs[0], to.ID, "2026", effectiveDate, nil, sqlmock.AnyArg(), sqlmock.AnyArg(),
+			sqlmock.AnyArg(), profileIDs[1], to.ID, "2026", effectiveDate, nil, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "profile" SET "class"=$1,"class_id"=$2,"updated_at"=$3 WHERE id IN ($4,$5)`)).
+		WithArgs("M2/1", to.ID, sqlmock.AnyArg(), *profileIDs[0], *profileIDs[1]).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	promoted, err := repo.PromoteClass(from.ID, to.ID, "2026", effectiveDate)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), promoted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPromoteClass_ClassFull(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlClassRepository(gormDB)
+
+	from, to := newClass("M1/1", nil), newClass("M2/1", nil)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "class" WHERE id IN ($1,$2) ORDER BY id FOR UPDATE`)).
+		WithArgs(from.ID, to.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "code", "capacity"}).AddRow(from.ID.String(), from.Code, nil).AddRow(to.ID.String(), to.Code, 30))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "profile_id" FROM "enrollment" WHERE class_id = $1 AND end_date IS NULL ORDER BY profile_id`)).
+		WithArgs(from.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"profile_id"}).AddRow(ptrUUID().String()).AddRow(ptrUUID().String()))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "enrollment" WHERE class_id = $1 AND end_date IS NULL AND start_date > $2`)).
+		WithArgs(from.ID, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "enrollment" WHERE class_id = $1 AND end_date IS NULL`)).
+		WithArgs(to.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(29))
+	mock.ExpectRollback()
+
+	promoted, err := repo.PromoteClass(from.ID, to.ID, "2026", time.Now())
+
+	assert.ErrorIs(t, err, constants.ErrClassFull)
+	assert.Equal(t, int64(0), promoted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPromoteClass_BeforeEnrollment(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlClassRepository(gormDB)
+
+	from, to := newClass("M1/1", nil), newClass("M2/1", nil)
+	effectiveDate := time.Date(2026, 5, 16, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "class" WHERE id IN ($1,$2) ORDER BY id FOR UPDATE`)).
+		WithArgs(from.ID, to.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "code"}).AddRow(from.ID.String(), from.Code).AddRow(to.ID.String(), to.Code))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "profile_id" FROM "enrollment" WHERE class_id = $1 AND end_date IS NULL ORDER BY profile_id`)).
+		WithArgs(from.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"profile_id"}).AddRow(ptrUUID().String()))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "enrollment" WHERE class_id = $1 AND end_date IS NULL AND start_date > $2`)).
+		WithArgs(from.ID, effectiveDate).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectRollback()
+
+	promoted, err := repo.PromoteClass(from.ID, to.ID, "2026", effectiveDate)
+
+	assert.ErrorIs(t, err, constants.ErrPromotionBeforeEnrollment)
+	assert.Equal(t, int64(0), promoted)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPromoteClass_NotFound(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlClassRepository(gormDB)
+
+	fromID, toID := ptrUUID(), ptrUUID()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "class" WHERE id IN ($1,$2) ORDER BY id FOR UPDATE`)).
+		WithArgs(fromID, toID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(fromID.String()))
+	mock.ExpectRollback()
+
+	_, err := repo.PromoteClass(fromID, toID, "2026", time.Now())
+
+	assert.ErrorIs(t, err, constants.ErrClassNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,18 @@
+package class
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/models"
+)
+
+type ClassUsecase interface {
+	FetchClasses(params GetClassesParams, paginator *models.Paginator) ([]*models.Class, error)
+	FetchClassById(classId *uuid.UUID) (*models.Class, error)
+	CreateClass(class *models.Class, newClass UpsertClass) error
+	UpdateClass(classId *uuid.UUID, updateClass UpsertClass, viewer *models.Viewer) (*models.Class, error)
+	DeleteClass(classId *uuid.UUID) error
+
+	FetchRoster(classId *uuid.UUID, paginator *models.Paginator) ([]*models.Profile, error)
+	ResolveClass(classId *uuid.UUID, code string) (*models.Class, error)
+	PromoteClass(fromClassId *uuid.UUID, request PromoteClassRequest, viewer *models.Viewer) (*models.ClassPromotion, error)
+}
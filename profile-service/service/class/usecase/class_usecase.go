@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/class"
+)
+
+type classUsecase struct {
+	classRepo class.ClassRepository
+}
+
+// FetchClasses implements class.ClassUsecase.
+func (c *classUsecase) FetchClasses(params class.GetClassesParams, paginator *models.Paginator) ([]*models.Class, error) {
+	return c.classRepo.FetchClasses(params, paginator)
+}
+
+// FetchClassById implements class.ClassUsecase.
+func (c *classUsecase) FetchClassById(classId *uuid.UUID) (*models.Class, error) {
+	return c.classRepo.FetchClassById(classId)
+}
+
+// CreateClass implements class.ClassUsecase.
+func (c *classUsecase) CreateClass(class *models.Class, newClass class.UpsertClass) error {
+	if err := setClass(class, newClass); err != nil {
+		return err
+	}
+	class.SetCreatedAt()
+	class.SetUpdatedAt()
+
+	return c.classRepo.CreateClass(class)
+}
+
+// UpdateClass implements class.ClassUsecase.
+// Only staff members update classes.
+func (c *classUsecase) UpdateClass(classId *uuid.UUID, updateClass class.UpsertClass, viewer *models.Viewer) (*models.Class, error) {
+	if !viewer.IsStaff() {
+		return nil, constants.ErrClassForbidden
+	}
+
+	class, err := c.classRepo.FetchClassById(classId)
+	if err != nil {
+		return nil, err
+	}
+
+	if class == nil {
+		return nil, constants.ErrClassNotFound
+	}
+
+	if err := setClass(class, updateClass); err != nil {
+		return nil, err
+	}
+	class.SetUpdatedAt()
+
+	if err := c.classRepo.UpdateClass(class); err != nil {
+		return nil, err
+	}
+
+	return class, nil
+}
+
+// setClass copies the input onto class, the name defaults to the code.
+func setClass(class *models.Class, upsertClass class.UpsertClass) error {
+	class.SetCode(upsertClass.Code)
+	if class.NormalizedCode == "" {
+		return constants.ErrInvalidClassCode
+	}
+
+	class.Name = class.Code
+	if upsertClass.Name != nil && strings.TrimSpace(*upsertClass.Name) != "" {
+		class.Name = strings.TrimSpace(*upsertClass.Name)
+	}
+	class.HomeroomTeacher = trimmedOrNil(upsertClass.HomeroomTeacher)
+	class.AcademicYear = trimmedOrNil(upsertClass.AcademicYear)
+	class.Capacity = upsertClass.Capacity
+
+	return nil
+}
+
+// trimmedOrNil drops blank optional values.
+func trimmedOrNil(value *string) *string {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*value)
+	return &trimmed
+}
+
+// DeleteClass implements class.ClassUsecase.
+// Classes that still have profiles cannot be deleted.
+func (c *classUsecase) DeleteClass(classId *uuid.UUID) error {
+	return c.classRepo.DeleteClass(classId)
+}
+
+// FetchRoster implements class.ClassUsecase.
+func (c *classUsecase) FetchRoster(classId *uuid.UUID, paginator *models.Paginator) ([]*models.Profile, error) {
+	class, err := c.classRepo.FetchClassById(classId)
+	if err != nil {
+		return nil, err
+	}
+
+	if class == nil {
+		return nil, constants.ErrClassNotFound
+	}
+
+	return c.classRepo.FetchRoster(classId, paginator)
+}
+
+// ResolveClass implements class.ClassUsecase.
+// It finds the class a profile is assigned to by id, or by code when no id is
+// given. Neither means the profile has no class and nil is returned.
+func (c *classUsecase) ResolveClass(classId *uuid.UUID, code string) (*models.Class, error) {
+	var class *models.Class
+	var err error
+	switch {
+	case classId != nil:
+		class, err = c.classRepo.FetchClassById(classId)
+	case models.NormalizeClassCode(code) != "":
+		class, err = c.classRepo.FetchClassByCode(models.NormalizeClassCode(code))
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if class == nil {
+		return nil, constants.ErrUnknownClass
+	}
+
+	return class, nil
+}
+
+// PromoteClass implements class.ClassUsecase.
+// Only staff members promote classes.
+func (c *classUsecase) PromoteClass(fromClassId *uuid.UUID, request class.PromoteClassRequest, viewer *models.Viewer) (*models.ClassPromotion, error) {
+	if !viewer.IsStaff() {
+		return nil, constants.ErrClassForbidden
+	}
+
+	toClassId := uuid.FromStringOrNil(request.ToClassId.String())
+	academicYear := strings.TrimSpace(request.AcademicYear)
+	if toClassId == *fromClassId || academicYear == "" {
+		return nil, constants.ErrInvalidPromotion
+	}
+
+	effectiveDate := models.DateOf(time.Now())
+	if request.EffectiveDate != nil {
+		effectiveDate = models.DateOf(request.EffectiveDate.Time)
+	}
+
+	promoted, err := c.classRepo.PromoteClass(fromClassId, &toClassId, academicYear, effectiveDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ClassPromotion{
+		FromClassID:   fromClassId,
+		ToClassID:     &toClassId,
+		AcademicYear:  academicYear,
+		EffectiveDate: effectiveDate.Format(models.DateFormat),
+		Promoted:      promoted,
+	}, nil
+}
+
+func NewClassUsecase(classRepo class.ClassRepository) class.ClassUsecase {
+	return &classUsecase{
+		classRepo: classRepo,
+	}
+}
@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_class "github.com/jariwat/p_project/profile-service/service/class"
+	"github.com/jariwat/p_project/profile-service/service/class/mocks"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	staffViewer   = &models.Viewer{UserID: "teacher-42", Role: models.UserRoleStaff}
+	studentViewer = &models.Viewer{UserID: "student-1024", Role: models.UserRoleStudent}
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func TestCreateClass_Success(t *testing.T) {
+	mockRepo := new(mocks.ClassRepository)
+	usecase := NewClassUsecase(mockRepo)
+
+	teacher := " Somchai Jaidee "
+	academicYear := ""
+	capacity := 40
+	newClass := _class.UpsertClass{Code: " M1 /1 ", HomeroomTeacher: &teacher, AcademicYear: &academicYear, Capacity: &capacity}
+
+	mockRepo.On("CreateClass", mock.MatchedBy(func(c *models.Class) bool {
+		return c.Code == "M1 /1" && c.NormalizedCode == "m1 /1" && c.Name == "M1 /1" &&
+			*c.HomeroomTeacher == "Somchai Jaidee" && c.AcademicYear == nil && *c.Capacity == 40 &&
+			c.CreatedAt != nil
+	})).Return(nil)
+
+	err := usecase.CreateClass(&models.Class{ID: ptrUUID()}, newClass)
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateClass_BlankCode(t *testing.T) {
+	mockRepo := new(mocks.ClassRepository)
+	usecase := NewClassUsecase(mockRepo)
+
+	err := usecase.CreateClass(&models.Class{ID: ptrUUID()}, _class.UpsertClass{Code: "   "})
+
+	require.ErrorIs(t, err, constants.ErrInvalidClassCode)
+	mockRepo.AssertNotCalled(t, "CreateClass", mock.Anything)
+}
+
+func TestUpdateClass_NotFound(t *testing.T) {
+	mockRepo := new(mocks.ClassRepository)
+	usecase := NewClassUsecase(mockRepo)
+
+	classID := ptrUUID()
+	mockRepo.On("FetchClassById", classID).Return(nil, nil)
+
+	class, err := usecase.UpdateClass(classID, _class.UpsertClass{Code: "M1/1"}, staffViewer)
+
+	require.ErrorIs(t, err, constants.ErrClassNotFound)
+	require.Nil(t, class)
+}
+
+func TestUpdateClass_Success(t *testing.T) {
+	mockRepo := new(mocks.ClassRepository)
+	usecase := NewClassUsecase(mockRepo)
+
+	classID := ptrUUID()
+	name := "Mathayom 1 Room 1"
+	mockRepo.On("FetchClassById", classID).Return(&models.Class{ID: classID, Code: "m1/1"}, nil)
+	mockRepo.On("UpdateClass", mock.MatchedBy(func(c *models.Class) bool {
+		return c.ID == classID && c.Code == "M1/1" && c.Name == name && c.Capacity == nil && c.UpdatedAt != nil
+	})).Return(nil)
+
+	class, err := usecase.UpdateClass(classID, _class.UpsertClass{Code: "M1/1", Name: &name}, staffViewer)
+
+	require.NoError(t, err)
+	require.Equal(t, "M1/1", class.Code)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateClass_NotStaff(t *testing.T) {
+	mockRepo := new(mocks.ClassRepository)
+	usecase := NewClassUsecase(mockRepo)
+
+	class, err := usecase.UpdateClass(ptrUUID(), _class.UpsertClass{Code: "M1/1"}, studentViewer)
+
+	require.ErrorIs(t, err, constants.ErrClassForbidden)
+	require.Nil(t, class)
+	mockRepo.AssertNotCalled(t, "FetchClassById", mock.Anything)
+	mockRepo.AssertNotCalled(t, "UpdateClass", mock.Anything)
+}
+
+func TestFetchRoster_ClassNotFound(t *testing.T) {
+	mockRepo := new(mocks.ClassRepository)
+	usecase := NewClassUsecase(mockRepo)
+
+	classID := ptrUUID()
+	mockRepo.On("FetchClassById", classID).Return(nil, nil)
+
+	profiles, err := usecase.FetchRoster(classID, models.NewPaginator(1, 10))
+
+	require.ErrorIs(t, err, constants.ErrClassNotFound)
+	require.Nil(t, profiles)
+	mockRepo.AssertNotCalled(t, "FetchRoster", mock.Anything, mock.Anything)
+}
+
+func TestResolveClass(t *testing.T) {
+	mockRepo := new(mocks.ClassRepository)
+	usecase := NewClassUsecase(mockRepo)
+
+	m1 := &models.Class{ID: ptrUUID(), Code: "M1/1"}
+	unknownID := ptrUUID()
+	mockRepo.On("FetchClassById", m1.ID).Return(m1, nil)
+	mockRepo.On("FetchClassById", unknownID).Return(nil, nil)
+	mockRepo.On("FetchClassByCode", "m1/1").Return(m1, nil)
+	mockRepo.On("FetchClassByCode", "m9/9").Return(nil, nil)
+
+	// the id wins over the code
+	class, err := usecase.ResolveClass(m1.ID, "M9/9")
+	require.NoError(t, err)
+	require.Equal(t, m1, class)
+
+	class, err = usecase.ResolveClass(nil, "  m1/1 ")
+	require.NoError(t, err)
+	require.Equal(t, m1, class)
+
+	_, err = usecase.ResolveClass(unknownID, "")
+	require.ErrorIs(t, err, constants.ErrUnknownClass)
+
+	_, err = usecase.ResolveClass(nil, "M9/9")
+	require.ErrorIs(t, err, constants.ErrUnknownClass)
+
+	class, err = usecase.ResolveClass(nil, " ")
+	require.NoError(t, err)
+	require.Nil(t, class)
+}
+
+func TestPromoteClass_SameClass(t *testing.T) {
+	mockRepo := new(mocks.ClassRepository)
+	usecase := NewClassUsecase(mockRepo)
+
+	classID := ptrUUID()
+	promotion, err := usecase.PromoteClass(classID, _class.PromoteClassRequest{ToClassId: types.UUID(*classID), AcademicYear: "2026"}, staffViewer)
+
+	require.ErrorIs(t, err, constants.ErrInvalidPromotion)
+	require.Nil(t, promotion)
+	mockRepo.AssertNotCalled(t, "PromoteClass", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPromoteClass_NotStaff(t *testing.T) {
+	mockRepo := new(mocks.ClassRepository)
+	usecase := NewClassUsecase(mockRepo)
+
+	promotion, err := usecase.PromoteClass(ptrUUID(), _class.PromoteClassRequest{ToClassId: types.UUID(*ptrUUID()), AcademicYear: "2026"}, studentViewer)
+
+	require.ErrorIs(t, err, constants.ErrClassForbidden)
+	require.Nil(t, promotion)
+	mockRepo.AssertNotCalled(t, "PromoteClass", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPromoteClass_Success(t *testing.T) {
+	mockRepo := new(mocks.ClassRepository)
+	usecase := NewClassUsecase(mockRepo)
+
+	fromID, toID := ptrUUID(), ptrUUID()
+	effectiveDate := types.Date{Time: time.Date(2026, 5, 16, 0, 0, 0, 0, time.UTC)}
+	mockRepo.On("PromoteClass", fromID, toID, "2026", effectiveDate.Time).Return(int64(32), nil)
+
+	promotion, err := usecase.PromoteClass(fromID, _class.PromoteClassRequest{ToClassId: types.UUID(*toID), AcademicYear: " 2026 ", EffectiveDate: &effectiveDate}, staffViewer)
+
+	require.NoError(t, err)
+	require.Equal(t, &models.ClassPromotion{
+		FromClassID:   fromID,
+		ToClassID:     toID,
+		AcademicYear:  "2026",
+		EffectiveDate: "2026-05-16",
+		Promoted:      32,
+	}, promotion)
+	mockRepo.AssertExpectations(t)
+}
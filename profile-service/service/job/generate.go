@@ -0,0 +1,2 @@
+package job 
+//go:generate oapi-codegen --config=./server.cfg.yaml ../../../api-spec/job/openapi_bundle.yml
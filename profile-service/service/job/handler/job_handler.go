@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_job "github.com/jariwat/p_project/profile-service/service/job"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type jobHandler struct {
+	jobUs _job.JobUsecase
+}
+
+// PostJobs implements job.ServerInterface.
+func (j *jobHandler) PostJobs(c *gin.Context) {
+	var newJob _job.CreateJob
+	if err := c.ShouldBindJSON(&newJob); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
+		return
+	}
+
+	var job = new(models.Job)
+	job.GenUUID()
+	if err := j.jobUs.CreateJob(job, newJob); err != nil {
+		if errors.Is(err, constants.ErrInvalidJobPayload) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	j.respondJob(c, http.StatusAccepted, job)
+}
+
+// GetJobsId implements job.ServerInterface.
+func (j *jobHandler) GetJobsId(c *gin.Context, id types.UUID) {
+	var jobId = uuid.FromStringOrNil(id.String())
+
+	job, err := j.jobUs.FetchJobById(&jobId)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if job == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Job not found"})
+		return
+	}
+
+	j.respondJob(c, http.StatusOK, job)
+}
+
+// PostJobsIdCancel implements job.ServerInterface.
+func (j *jobHandler) PostJobsIdCancel(c *gin.Context, id types.UUID) {
+	var jobId = uuid.FromStringOrNil(id.String())
+
+	job, err := j.jobUs.CancelJob(&jobId)
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrJobNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case errors.Is(err, constants.ErrJobFinished):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	j.respondJob(c, http.StatusOK, job)
+}
+
+// GetJobsIdResult implements job.ServerInterface.
+func (j *jobHandler) GetJobsIdResult(c *gin.Context, id types.UUID) {
+	var jobId = uuid.FromStringOrNil(id.String())
+
+	result, err := j.jobUs.FetchJobResult(&jobId)
+	if err != nil {
+		switch {
+		case errors.Is(err, constants.ErrJobNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		case errors.Is(err, constants.ErrJobResultNotReady):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": result})
+}
+
+func (j *jobHandler) respondJob(c *gin.Context, status int, job *models.Job) {
+	var data _job.Job
+	bu, err := json.Marshal(job)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal job"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal job"})
+		return
+	}
+
+	c.JSON(status, _job.JobResponse{
+		Data: &data,
+	})
+}
+
+func NewJobHandler(jobUs _job.JobUsecase) _job.ServerInterface {
+	return &jobHandler{
+		jobUs: jobUs,
+	}
+}
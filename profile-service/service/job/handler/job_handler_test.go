@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_job "github.com/jariwat/p_project/profile-service/service/job"
+	"github.com/jariwat/p_project/profile-service/service/job/mocks"
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func TestPostJobs_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newJob := _job.CreateJob{
+		Type:    _job.CreateJobTypeExport,
+		Payload: map[string]interface{}{"search_word": "Phanes"},
+	}
+	body, _ := json.Marshal(newJob)
+
+	req, _ := http.NewRequest(http.MethodPost, "/jobs", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.JobUsecase)
+	mockUsecase.
+		On("CreateJob", mock.AnythingOfType("*models.Job"), newJob).
+		Run(func(args mock.Arguments) {
+			job := args.Get(0).(*models.Job)
+			job.Type = models.JobTypeExport
+			job.Status = models.JobStatusPending
+		}).
+		Return(nil)
+
+	handler := NewJobHandler(mockUsecase)
+	handler.PostJobs(c)
+
+	require.Equal(t, http.StatusAccepted, w.Code)
+
+	var resp _job.JobResponse
+	err := json.Unmarshal(w.Body.Bytes(), &resp)
+	require.NoError(t, err)
+	assert.Equal(t, _job.Pending, *resp.Data.Status)
+	assert.NotNil(t, resp.Data.Id)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestPostJobs_InvalidPayload(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	body := []byte(`{"type":"purge","payload":{}}`)
+
+	req, _ := http.NewRequest(http.MethodPost, "/jobs", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.JobUsecase)
+	mockUsecase.
+		On("CreateJob", mock.AnythingOfType("*models.Job"), mock.Anything).
+		Return(constants.ErrInvalidJobPayload)
+
+	handler := NewJobHandler(mockUsecase)
+	handler.PostJobs(c)
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGetJobsId_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	jobID := ptrUUID()
+	job := &models.Job{
+		ID:        jobID,
+		Type:      models.JobTypeImport,
+		Status:    models.JobStatusRunning,
+		Total:     10,
+		Processed: 4,
+		Failed:    1,
+	}
+
+	mockUsecase := new(mocks.JobUsecase)
+	mockUsecase.On("FetchJobById", jobID).Return(job, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/jobs/"+jobID.String(), nil)
+
+	handler := NewJobHandler(mockUsecase)
+	handler.GetJobsId(c, (types.UUID)(*jobID))
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp _job.JobResponse
+	err := json.Unmarshal(w.Body.Bytes(), &resp)
+	require.NoError(t, err)
+	assert.Equal(t, 10, *resp.Data.Progress.Total)
+	assert.Equal(t, 4, *resp.Data.Progress.Processed)
+	assert.Equal(t, 1, *resp.Data.Progress.Failed)
+	assert.False(t, *resp.Data.HasResult)
+}
+
+func TestGetJobsId_NotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	jobID := ptrUUID()
+
+	mockUsecase := new(mocks.JobUsecase)
+	mockUsecase.On("FetchJobById", jobID).Return(nil, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/jobs/"+jobID.String(), nil)
+
+	handler := NewJobHandler(mockUsecase)
+	handler.GetJobsId(c, (types.UUID)(*jobID))
+
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestPostJobsIdCancel_Finished(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	jobID := ptrUUID()
+
+	mockUsecase := new(mocks.JobUsecase)
+	mockUsecase.On("CancelJob", jobID).Return(nil, constants.ErrJobFinished)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/jobs/"+jobID.String()+"/cancel", nil)
+
+	handler := NewJobHandler(mockUsecase)
+	handler.PostJobsIdCancel(c, (types.UUID)(*jobID))
+
+	require.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestGetJobsIdResult_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	jobID := ptrUUID()
+
+	mockUsecase := new(mocks.JobUsecase)
+	mockUsecase.On("FetchJobResult", jobID).Return(json.RawMessage(`[{"first_name":"SeiA"}]`), nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/jobs/"+jobID.String()+"/result", nil)
+
+	handler := NewJobHandler(mockUsecase)
+	handler.GetJobsIdResult(c, (types.UUID)(*jobID))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"data":[{"first_name":"SeiA"}]}`, w.Body.String())
+}
+
+func TestGetJobsIdResult_Error(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	jobID := ptrUUID()
+
+	mockUsecase := new(mocks.JobUsecase)
+	mockUsecase.On("FetchJobResult", jobID).Return(nil, errors.New("fetch error"))
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/jobs/"+jobID.String()+"/result", nil)
+
+	handler := NewJobHandler(mockUsecase)
+	handler.GetJobsIdResult(c, (types.UUID)(*jobID))
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Contains(t, w.Body.String(), "fetch error")
+}
@@ -0,0 +1,173 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	time "time"
+
+	models "github.com/jariwat/p_project/profile-service/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/gofrs/uuid"
+)
+
+// JobRepository is an autogenerated mock type for the JobRepository type
+type JobRepository struct {
+	mock.Mock
+}
+
+// ClaimJob provides a mock function with given fields: workerId, staleBefore, maxAttempts
+func (_m *JobRepository) ClaimJob(workerId string, staleBefore time.Time, maxAttempts int) (*models.Job, error) {
+	ret := _m.Called(workerId, staleBefore, maxAttempts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimJob")
+	}
+
+	var r0 *models.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time, int) (*models.Job, error)); ok {
+		return rf(workerId, staleBefore, maxAttempts)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time, int) *models.Job); ok {
+		r0 = rf(workerId, staleBefore, maxAttempts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time, int) error); ok {
+		r1 = rf(workerId, staleBefore, maxAttempts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateJob provides a mock function with given fields: _a0
+func (_m *JobRepository) CreateJob(_a0 *models.Job) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Job) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FetchJobById provides a mock function with given fields: jobId
+func (_m *JobRepository) FetchJobById(jobId *uuid.UUID) (*models.Job, error) {
+	ret := _m.Called(jobId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchJobById")
+	}
+
+	var r0 *models.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) (*models.Job, error)); ok {
+		return rf(jobId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) *models.Job); ok {
+		r0 = rf(jobId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(jobId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FinishJob provides a mock function with given fields: _a0
+func (_m *JobRepository) FinishJob(_a0 *models.Job) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FinishJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Job) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RequestJobCancel provides a mock function with given fields: jobId
+func (_m *JobRepository) RequestJobCancel(jobId *uuid.UUID) (bool, error) {
+	ret := _m.Called(jobId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestJobCancel")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) (bool, error)); ok {
+		return rf(jobId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) bool); ok {
+		r0 = rf(jobId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(jobId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateJobProgress provides a mock function with given fields: _a0
+func (_m *JobRepository) UpdateJobProgress(_a0 *models.Job) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateJobProgress")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Job) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewJobRepository creates a new instance of JobRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobRepository {
+	mock := &JobRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,208 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	jsontext "encoding/json/jsontext"
+
+	job "github.com/jariwat/p_project/profile-service/service/job"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/jariwat/p_project/profile-service/models"
+
+	uuid "github.com/gofrs/uuid"
+)
+
+// JobUsecase is an autogenerated mock type for the JobUsecase type
+type JobUsecase struct {
+	mock.Mock
+}
+
+// CancelJob provides a mock function with given fields: jobId
+func (_m *JobUsecase) CancelJob(jobId *uuid.UUID) (*models.Job, error) {
+	ret := _m.Called(jobId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CancelJob")
+	}
+
+	var r0 *models.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) (*models.Job, error)); ok {
+		return rf(jobId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) *models.Job); ok {
+		r0 = rf(jobId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(jobId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClaimJob provides a mock function with given fields: workerId
+func (_m *JobUsecase) ClaimJob(workerId string) (*models.Job, error) {
+	ret := _m.Called(workerId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimJob")
+	}
+
+	var r0 *models.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*models.Job, error)); ok {
+		return rf(workerId)
+	}
+	if rf, ok := ret.Get(0).(func(string) *models.Job); ok {
+		r0 = rf(workerId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(workerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateJob provides a mock function with given fields: _a0, newJob
+func (_m *JobUsecase) CreateJob(_a0 *models.Job, newJob job.CreateJob) error {
+	ret := _m.Called(_a0, newJob)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Job, job.CreateJob) error); ok {
+		r0 = rf(_a0, newJob)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FailJob provides a mock function with given fields: _a0, reason
+func (_m *JobUsecase) FailJob(_a0 *models.Job, reason string) error {
+	ret := _m.Called(_a0, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FailJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Job, string) error); ok {
+		r0 = rf(_a0, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FetchJobById provides a mock function with given fields: jobId
+func (_m *JobUsecase) FetchJobById(jobId *uuid.UUID) (*models.Job, error) {
+	ret := _m.Called(jobId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchJobById")
+	}
+
+	var r0 *models.Job
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) (*models.Job, error)); ok {
+		return rf(jobId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) *models.Job); ok {
+		r0 = rf(jobId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(jobId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchJobResult provides a mock function with given fields: jobId
+func (_m *JobUsecase) FetchJobResult(jobId *uuid.UUID) (jsontext.Value, error) {
+	ret := _m.Called(jobId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchJobResult")
+	}
+
+	var r0 jsontext.Value
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) (jsontext.Value, error)); ok {
+		return rf(jobId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) jsontext.Value); ok {
+		r0 = rf(jobId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(jsontext.Value)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(jobId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RunJob provides a mock function with given fields: _a0
+func (_m *JobUsecase) RunJob(_a0 *models.Job) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Job) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewJobUsecase creates a new instance of JobUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewJobUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *JobUsecase {
+	mock := &JobUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
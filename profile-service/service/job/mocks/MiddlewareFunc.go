@@ -0,0 +1,33 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MiddlewareFunc is an autogenerated mock type for the MiddlewareFunc type
+type MiddlewareFunc struct {
+	mock.Mock
+}
+
+// Execute provides a mock function with given fields: c
+func (_m *MiddlewareFunc) Execute(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewMiddlewareFunc creates a new instance of MiddlewareFunc. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMiddlewareFunc(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MiddlewareFunc {
+	mock := &MiddlewareFunc{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
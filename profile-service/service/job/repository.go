@@ -0,0 +1,17 @@
+package job
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/models"
+)
+
+type JobRepository interface {
+	CreateJob(job *models.Job) error
+	FetchJobById(jobId *uuid.UUID) (*models.Job, error)
+	RequestJobCancel(jobId *uuid.UUID) (bool, error)
+	ClaimJob(workerId string, staleBefore time.Time, maxAttempts int) (*models.Job, error)
+	UpdateJobProgress(job *models.Job) error
+	FinishJob(job *models.Job) error
+}
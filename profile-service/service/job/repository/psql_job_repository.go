@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/job"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type jobRepository struct {
+	client *gorm.DB
+}
+
+// CreateJob implements job.JobRepository.
+func (j *jobRepository) CreateJob(job *models.Job) error {
+	return j.client.Create(job).Error
+}
+
+// FetchJobById implements job.JobRepository.
+func (j *jobRepository) FetchJobById(jobId *uuid.UUID) (*models.Job, error) {
+	var job models.Job
+	if err := j.client.First(&job, "id = ?", jobId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// RequestJobCancel implements job.JobRepository.
+// A pending job is cancelled right away, a running one is flagged for its worker.
+// It reports false when the job does not exist or already finished.
+func (j *jobRepository) RequestJobCancel(jobId *uuid.UUID) (bool, error) {
+	now := time.Now()
+	result := j.client.Model(&models.Job{}).
+		Where("id = ? AND status IN ?", jobId, []models.JobStatus{models.JobStatusPending, models.JobStatusRunning}).
+		Updates(map[string]interface{}{
+			"cancel_requested": true,
+			"status":           gorm.Expr("CASE WHEN status = ? THEN ?::JOB_STATUS ELSE status END", models.JobStatusPending, models.JobStatusCancelled),
+			"finished_at":      gorm.Expr("CASE WHEN status = ? THEN ? ELSE finished_at END", models.JobStatusPending, now),
+			"updated_at":       now,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// ClaimJob implements job.JobRepository.
+// It locks the oldest pending job, or a running job whose worker stopped sending
+// heartbeats before staleBefore, skipping rows other replicas already locked.
+// A stale job already claimed maxAttempts times is failed instead of claimed.
+func (j *jobRepository) ClaimJob(workerId string, staleBefore time.Time, maxAttempts int) (*models.Job, error) {
+	var claimed *models.Job
+	err := j.client.Transaction(func(tx *gorm.DB) error {
+		// a job that keeps taking its workers down would otherwise be claimed forever
+		now := time.Now()
+		if err := tx.Model(&models.Job{}).
+			Where("status = ? AND heartbeat_at < ? AND attempts >= ?", models.JobStatusRunning, staleBefore, maxAttempts).
+			Updates(map[string]interface{}{
+				"status":      models.JobStatusFailed,
+				"failed":      gorm.Expr("failed + 1"),
+				"errors":      gorm.Expr("COALESCE(errors, '[]'::JSONB) || JSONB_BUILD_ARRAY(JSONB_BUILD_OBJECT('index', -1, 'message', ?::TEXT))", constants.ErrJobAttemptsExhausted.Error()),
+				"locked_by":   nil,
+				"finished_at": now,
+				"updated_at":  now,
+			}).Error; err != nil {
+			return err
+		}
+
+		var job models.Job
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? OR (status = ? AND heartbeat_at < ?)", models.JobStatusPending, models.JobStatusRunning, staleBefore).
+			Order("created_at").
+			First(&job).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		job.Status = models.JobStatusRunning
+		job.LockedBy = &workerId
+		job.HeartbeatAt = &now
+		job.Attempts++
+		if job.StartedAt == nil {
+			job.StartedAt = &now
+		}
+		job.UpdatedAt = &now
+
+		if err := tx.Model(&models.Job{}).Where("id = ?", job.ID).Updates(map[string]interface{}{
+			"status":       job.Status,
+			"locked_by":    job.LockedBy,
+			"heartbeat_at": job.HeartbeatAt,
+			"attempts":     job.Attempts,
+			"started_at":   job.StartedAt,
+			"updated_at":   job.UpdatedAt,
+		}).Error; err != nil {
+			return err
+		}
+
+		claimed = &job
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return claimed, nil
+}
+
+// UpdateJobProgress implements job.JobRepository.
+// It also refreshes the heartbeat and reads back cancel_requested.
+func (j *jobRepository) UpdateJobProgress(job *models.Job) error {
+	now := time.Now()
+	job.HeartbeatAt = &now
+	job.SetUpdatedAt()
+
+	result := j.client.Model(&models.Job{}).
+		Where("id = ? AND locked_by = ?", job.ID, job.LockedBy).
+		Updates(map[string]interface{}{
+			"total":        job.Total,
+			"processed":    job.Processed,
+			"failed":       job.Failed,
+			"errors":       job.Errors,
+			"heartbeat_at": job.HeartbeatAt,
+			"updated_at":   job.UpdatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return constants.ErrJobLeaseLost
+	}
+
+	return j.client.Model(&models.Job{}).
+		Select("cancel_requested").
+		Where("id = ?", job.ID).
+		Scan(&job.CancelRequested).Error
+}
+
+// FinishJob implements job.JobRepository.
+func (j *jobRepository) FinishJob(job *models.Job) error {
+	job.SetFinishedAt()
+	job.SetUpdatedAt()
+
+	result := j.client.Model(&models.Job{}).
+		Where("id = ? AND locked_by = ?", job.ID, job.LockedBy).
+		Updates(map[string]interface{}{
+			"status":      job.Status,
+			"total":       job.Total,
+			"processed":   job.Processed,
+			"failed":      job.Failed,
+			"errors":      job.Errors,
+			"result":      job.Result,
+			"locked_by":   nil,
+			"finished_at": job.FinishedAt,
+			"updated_at":  job.UpdatedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return constants.ErrJobLeaseLost
+	}
+
+	return nil
+}
+
+func NewPsqlJobRepository(client *gorm.DB) job.JobRepository {
+	return &jobRepository{
+		client: client,
+	}
+}
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	return gormDB, mock
+}
+
+func TestFetchJobById_NotFound(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlJobRepository(gormDB)
+
+	jobID := ptrUUID()
+	query := `SELECT * FROM "job" WHERE id = $1 ORDER BY "job"."id" LIMIT $2`
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(jobID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	job, err := repo.FetchJobById(jobID)
+
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRequestJobCancel_Finished(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlJobRepository(gormDB)
+
+	jobID := ptrUUID()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "job" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	ok, err := repo.RequestJobCancel(jobID)
+
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClaimJob_NoneAvailable(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlJobRepository(gormDB)
+
+	staleBefore := time.Now().Add(-time.Minute)
+	query := `SELECT * FROM "job" WHERE status = $1 OR (status = $2 AND heartbeat_at < $3) ORDER BY created_at,"job"."id" LIMIT $4 FOR UPDATE SKIP LOCKED`
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "job" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(models.JobStatusPending, models.JobStatusRunning, staleBefore, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectCommit()
+
+	job, err := repo.ClaimJob("worker-1", staleBefore, 3)
+
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClaimJob_FailsExhaustedJobs(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlJobRepository(gormDB)
+
+	staleBefore := time.Now().Add(-time.Minute)
+	update := `UPDATE "job" SET "errors"=COALESCE(errors, '[]'::JSONB) || JSONB_BUILD_ARRAY(JSONB_BUILD_OBJECT('index', -1, 'message', $1::TEXT)),"failed"=failed + 1,"finished_at"=$2,"locked_by"=$3,"status"=$4,"updated_at"=$5 WHERE status = $6 AND heartbeat_at < $7 AND attempts >= $8`
+	query := `SELECT * FROM "job" WHERE status = $1 OR (status = $2 AND heartbeat_at < $3) ORDER BY created_at,"job"."id" LIMIT $4 FOR UPDATE SKIP LOCKED`
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(update)).
+		WithArgs(constants.ErrJobAttemptsExhausted.Error(), sqlmock.AnyArg(), nil, models.JobStatusFailed, sqlmock.AnyArg(), models.JobStatusRunning, staleBefore, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(models.JobStatusPending, models.JobStatusRunning, staleBefore, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectCommit()
+
+	job, err := repo.ClaimJob("worker-1", staleBefore, 3)
+
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateJobProgress_LeaseLost(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlJobRepository(gormDB)
+
+	workerId := "worker-1"
+	job := &models.Job{ID: ptrUUID(), LockedBy: &workerId}
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "job" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	err := repo.UpdateJobProgress(job)
+
+	assert.Equal(t, constants.ErrJobLeaseLost, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFinishJob_Success(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlJobRepository(gormDB)
+
+	workerId := "worker-1"
+	job := &models.Job{ID: ptrUUID(), Status: models.JobStatusSucceeded, LockedBy: &workerId}
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "job" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.FinishJob(job)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, job.FinishedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,19 @@
+package job
+
+import (
+	"encoding/json"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/models"
+)
+
+type JobUsecase interface {
+	CreateJob(job *models.Job, newJob CreateJob) error
+	FetchJobById(jobId *uuid.UUID) (*models.Job, error)
+	CancelJob(jobId *uuid.UUID) (*models.Job, error)
+	FetchJobResult(jobId *uuid.UUID) (json.RawMessage, error)
+
+	ClaimJob(workerId string) (*models.Job, error)
+	RunJob(job *models.Job) error
+	FailJob(job *models.Job, reason string) error
+}
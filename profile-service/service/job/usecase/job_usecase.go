@@ -0,0 +1,357 @@
+package usecase
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/job"
+	"github.com/jariwat/p_project/profile-service/service/profile"
+)
+
+const (
+	// jobCheckpointEvery is how many items are processed between progress updates,
+	// which also renew the lease and pick up cancellation requests.
+	jobCheckpointEvery = 100
+	// jobHeartbeatsPerLease is how many times the lease is renewed within the
+	// lease timeout when items are too slow to reach jobCheckpointEvery.
+	jobHeartbeatsPerLease = 4
+	// jobPageSize is the page size used to read profiles for exports and purges.
+	jobPageSize = 500
+	// jobMaxAttempts is how many times a job is claimed before a job whose
+	// lease keeps lapsing is failed.
+	jobMaxAttempts = 3
+)
+
+type importPayload struct {
+	Profiles []profile.UpsertProfile `json:"profiles"`
+}
+
+type exportPayload struct {
+	profile.GetProfilesParams
+}
+
+type purgePayload struct {
+	ProfileIds []uuid.UUID `json:"profile_ids"`
+	profile.GetProfilesParams
+}
+
+type jobUsecase struct {
+	jobRepo      job.JobRepository
+	profileUs    profile.ProfileUsecase
+	leaseTimeout time.Duration
+}
+
+// CreateJob implements job.JobUsecase.
+func (j *jobUsecase) CreateJob(job *models.Job, newJob job.CreateJob) error {
+	payload, err := json.Marshal(newJob.Payload)
+	if err != nil {
+		return constants.ErrInvalidJobPayload
+	}
+
+	job.Type = models.JobType(newJob.Type)
+	job.Payload = payload
+	if err := validateJobPayload(job); err != nil {
+		return err
+	}
+
+	job.Status = models.JobStatusPending
+	job.SetCreatedAt()
+	job.SetUpdatedAt()
+
+	return j.jobRepo.CreateJob(job)
+}
+
+// FetchJobById implements job.JobUsecase.
+func (j *jobUsecase) FetchJobById(jobId *uuid.UUID) (*models.Job, error) {
+	return j.jobRepo.FetchJobById(jobId)
+}
+
+// CancelJob implements job.JobUsecase.
+func (j *jobUsecase) CancelJob(jobId *uuid.UUID) (*models.Job, error) {
+	requested, err := j.jobRepo.RequestJobCancel(jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := j.jobRepo.FetchJobById(jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	if job == nil {
+		return nil, constants.ErrJobNotFound
+	}
+
+	if !requested {
+		return nil, constants.ErrJobFinished
+	}
+
+	return job, nil
+}
+
+// FetchJobResult implements job.JobUsecase.
+func (j *jobUsecase) FetchJobResult(jobId *uuid.UUID) (json.RawMessage, error) {
+	job, err := j.jobRepo.FetchJobById(jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	if job == nil {
+		return nil, constants.ErrJobNotFound
+	}
+
+	if !job.HasResult() {
+		return nil, constants.ErrJobResultNotReady
+	}
+
+	return job.Result, nil
+}
+
+// ClaimJob implements job.JobUsecase.
+func (j *jobUsecase) ClaimJob(workerId string) (*models.Job, error) {
+	return j.jobRepo.ClaimJob(workerId, time.Now().Add(-j.leaseTimeout), jobMaxAttempts)
+}
+
+// RunJob implements job.JobUsecase.
+// Progress is stored at every checkpoint, so a job picked up again after a
+// restart continues from the last checkpoint instead of starting over.
+func (j *jobUsecase) RunJob(job *models.Job) error {
+	var err error
+	switch job.Type {
+	case models.JobTypeImport:
+		err = j.runImport(job)
+	case models.JobTypeExport:
+		err = j.runExport(job)
+	case models.JobTypePurge:
+		err = j.runPurge(job)
+	default:
+		err = constants.ErrInvalidJobPayload
+	}
+
+	switch {
+	case errors.Is(err, constants.ErrJobLeaseLost):
+		// another worker took the job over, it is no longer ours to finish
+		return err
+	case errors.Is(err, constants.ErrJobCancelled):
+		job.Status = models.JobStatusCancelled
+	case err != nil:
+		log.Printf("Job %s failed: %v", job.ID, err)
+		job.Status = models.JobStatusFailed
+		job.AddError(-1, err.Error())
+	default:
+		job.Status = models.JobStatusSucceeded
+	}
+
+	return j.jobRepo.FinishJob(job)
+}
+
+// FailJob implements job.JobUsecase.
+// It finishes a job that stopped without finishing, such as a job that
+// panicked, as failed with reason as its error.
+func (j *jobUsecase) FailJob(job *models.Job, reason string) error {
+	job.Status = models.JobStatusFailed
+	job.AddError(-1, reason)
+
+	return j.jobRepo.FinishJob(job)
+}
+
+// checkpoint stores the progress and stops the job when cancellation was requested.
+func (j *jobUsecase) checkpoint(job *models.Job) error {
+	if err := j.jobRepo.UpdateJobProgress(job); err != nil {
+		return err
+	}
+
+	if job.CancelRequested {
+		return constants.ErrJobCancelled
+	}
+
+	return nil
+}
+
+// checkpointDue reports whether the progress is stored after the current item:
+// every jobCheckpointEvery items, or sooner once the lease has run for a
+// fraction of its timeout so slow items cannot let it lapse.
+func (j *jobUsecase) checkpointDue(job *models.Job) bool {
+	if job.Processed%jobCheckpointEvery == 0 {
+		return true
+	}
+
+	return job.HeartbeatAt != nil && time.Since(*job.HeartbeatAt) >= j.leaseTimeout/jobHeartbeatsPerLease
+}
+
+// runImport only creates profiles, an item whose profile exists already is
+// skipped, so no first name, last name or class change bypasses approval.
+func (j *jobUsecase) runImport(job *models.Job) error {
+	var payload importPayload
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return constants.ErrInvalidJobPayload
+	}
+
+	job.Total = len(payload.Profiles)
+	for i := job.Processed; i < len(payload.Profiles); i++ {
+		item := payload.Profiles[i]
+		if item.FirstName == "" || item.LastName == "" {
+			job.AddError(i, "first_name and last_name are required")
+		} else {
+			// the id is derived from the job and the item position, so an item
+			// imported again after a restart is recognised instead of duplicated
+			profileId := uuid.NewV5(*job.ID, strconv.Itoa(i))
+			err := j.profileUs.CreateProfile(&models.Profile{ID: &profileId}, item)
+			if err != nil && !errors.Is(err, constants.ErrProfileAlreadyExists) {
+				job.AddError(i, err.Error())
+			}
+		}
+
+		job.Processed++
+		if j.checkpointDue(job) {
+			if err := j.checkpoint(job); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (j *jobUsecase) runExport(job *models.Job) error {
+	var payload exportPayload
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return constants.ErrInvalidJobPayload
+	}
+
+	// an export is cheap to redo, so a resumed export starts from the beginning
+	job.Processed = 0
+	profiles := make([]*models.Profile, 0)
+	for page := 1; ; page++ {
+		paginator := models.NewPaginator(page, jobPageSize)
+		items, err := j.profileUs.FetchProfiles(payload.GetProfilesParams, paginator)
+		if err != nil {
+			return err
+		}
+
+		job.Total = paginator.TotalRows
+		profiles = append(profiles, items...)
+		job.Processed += len(items)
+		if err := j.checkpoint(job); err != nil {
+			return err
+		}
+
+		if page >= paginator.TotalPages || len(items) == 0 {
+			break
+		}
+	}
+
+	result, err := json.Marshal(profiles)
+	if err != nil {
+		return err
+	}
+	job.Result = result
+
+	return nil
+}
+
+func (j *jobUsecase) runPurge(job *models.Job) error {
+	var payload purgePayload
+	if err := json.Unmarshal(job.Payload, &payload); err != nil {
+		return constants.ErrInvalidJobPayload
+	}
+
+	profileIds := payload.ProfileIds
+	if len(profileIds) == 0 {
+		// collect the ids first, deleting while paging would shift the pages
+		for page := 1; ; page++ {
+			paginator := models.NewPaginator(page, jobPageSize)
+			items, err := j.profileUs.FetchProfiles(payload.GetProfilesParams, paginator)
+			if err != nil {
+				return err
+			}
+
+			for _, item := range items {
+				profileIds = append(profileIds, *item.ID)
+			}
+
+			if page >= paginator.TotalPages || len(items) == 0 {
+				break
+			}
+		}
+
+		// a resumed purge re-reads the remaining profiles, so it starts over
+		job.Processed = 0
+	}
+
+	job.Total = len(profileIds)
+	for i := job.Processed; i < len(profileIds); i++ {
+		if err := j.profileUs.DeleteProfile(&profileIds[i]); err != nil {
+			job.AddError(i, err.Error())
+		}
+
+		job.Processed++
+		if j.checkpointDue(job) {
+			if err := j.checkpoint(job); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateJobPayload(job *models.Job) error {
+	switch job.Type {
+	case models.JobTypeImport:
+		var payload importPayload
+		if err := json.Unmarshal(job.Payload, &payload); err != nil || len(payload.Profiles) == 0 {
+			return constants.ErrInvalidJobPayload
+		}
+	case models.JobTypeExport:
+		var payload exportPayload
+		if err := json.Unmarshal(job.Payload, &payload); err != nil {
+			return constants.ErrInvalidJobPayload
+		}
+	case models.JobTypePurge:
+		var payload purgePayload
+		if err := json.Unmarshal(job.Payload, &payload); err != nil {
+			return constants.ErrInvalidJobPayload
+		}
+		// refuse a purge without any criteria, it would delete every profile
+		if len(payload.ProfileIds) == 0 && !hasProfileFilter(payload.GetProfilesParams) {
+			return constants.ErrInvalidJobPayload
+		}
+	default:
+		return constants.ErrInvalidJobPayload
+	}
+
+	return nil
+}
+
+// hasProfileFilter reports whether params narrows the profile list, it checks
+// the same filters the profile repository applies. A blank search word matches
+// every profile, so it does not count.
+func hasProfileFilter(params profile.GetProfilesParams) bool {
+	nonBlank := func(value *string) bool {
+		return value != nil && strings.TrimSpace(*value) != ""
+	}
+	nonEmpty := func(values *[]string) bool {
+		return values != nil && len(*values) > 0
+	}
+
+	return nonBlank(params.SearchWord) || nonBlank(params.ExternalId) || nonBlank(params.Email) ||
+		nonEmpty(params.Gender) || params.Status != nil && len(*params.Status) > 0 ||
+		nonEmpty(params.SkillLevel) || nonEmpty(params.Attribute) ||
+		nonEmpty(params.TagAny) || nonEmpty(params.TagAll) || nonEmpty(params.TagNone)
+}
+
+func NewJobUsecase(jobRepo job.JobRepository, profileUs profile.ProfileUsecase, leaseTimeout time.Duration) job.JobUsecase {
+	return &jobUsecase{
+		jobRepo:      jobRepo,
+		profileUs:    profileUs,
+		leaseTimeout: leaseTimeout,
+	}
+}
@@ -0,0 +1,338 @@
+package usecase
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_job "github.com/jariwat/p_project/profile-service/service/job"
+	"github.com/jariwat/p_project/profile-service/service/job/mocks"
+	_profile "github.com/jariwat/p_project/profile-service/service/profile"
+	profileMocks "github.com/jariwat/p_project/profile-service/service/profile/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func TestCreateJob_Success(t *testing.T) {
+	mockRepo := new(mocks.JobRepository)
+	mockProfileUs := new(profileMocks.ProfileUsecase)
+	usecase := NewJobUsecase(mockRepo, mockProfileUs, time.Minute)
+
+	job := &models.Job{}
+	job.GenUUID()
+	newJob := _job.CreateJob{
+		Type: _job.CreateJobTypeImport,
+		Payload: map[string]interface{}{
+			"profiles": []interface{}{
+				map[string]interface{}{"first_name": "SeiA", "last_name": "Phanes", "gender": "MALE", "class": "Yuusha"},
+			},
+		},
+	}
+
+	mockRepo.On("CreateJob", mock.MatchedBy(func(j *models.Job) bool {
+		return j.Type == models.JobTypeImport && j.Status == models.JobStatusPending && len(j.Payload) > 0
+	})).Return(nil)
+
+	err := usecase.CreateJob(job, newJob)
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateJob_PurgeWithoutCriteria(t *testing.T) {
+	mockRepo := new(mocks.JobRepository)
+	usecase := NewJobUsecase(mockRepo, new(profileMocks.ProfileUsecase), time.Minute)
+
+	err := usecase.CreateJob(&models.Job{}, _job.CreateJob{Type: _job.CreateJobTypePurge, Payload: map[string]interface{}{}})
+
+	require.Equal(t, constants.ErrInvalidJobPayload, err)
+	mockRepo.AssertNotCalled(t, "CreateJob", mock.Anything)
+}
+
+func TestCreateJob_PurgeBlankSearchWord(t *testing.T) {
+	mockRepo := new(mocks.JobRepository)
+	usecase := NewJobUsecase(mockRepo, new(profileMocks.ProfileUsecase), time.Minute)
+
+	err := usecase.CreateJob(&models.Job{}, _job.CreateJob{Type: _job.CreateJobTypePurge, Payload: map[string]interface{}{
+		"search_word": "  ",
+	}})
+
+	require.Equal(t, constants.ErrInvalidJobPayload, err)
+	mockRepo.AssertNotCalled(t, "CreateJob", mock.Anything)
+}
+
+func TestCreateJob_PurgeByTag(t *testing.T) {
+	mockRepo := new(mocks.JobRepository)
+	usecase := NewJobUsecase(mockRepo, new(profileMocks.ProfileUsecase), time.Minute)
+
+	mockRepo.On("CreateJob", mock.MatchedBy(func(j *models.Job) bool {
+		return j.Type == models.JobTypePurge
+	})).Return(nil)
+
+	err := usecase.CreateJob(&models.Job{}, _job.CreateJob{Type: _job.CreateJobTypePurge, Payload: map[string]interface{}{
+		"tag_any": []interface{}{"alumni"},
+	}})
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCancelJob_Running(t *testing.T) {
+	mockRepo := new(mocks.JobRepository)
+	usecase := NewJobUsecase(mockRepo, new(profileMocks.ProfileUsecase), time.Minute)
+
+	jobID := ptrUUID()
+	job := &models.Job{ID: jobID, Status: models.JobStatusRunning, CancelRequested: true}
+
+	mockRepo.On("RequestJobCancel", jobID).Return(true, nil)
+	mockRepo.On("FetchJobById", jobID).Return(job, nil)
+
+	result, err := usecase.CancelJob(jobID)
+
+	require.NoError(t, err)
+	require.Equal(t, job, result)
+}
+
+func TestCancelJob_NotFound(t *testing.T) {
+	mockRepo := new(mocks.JobRepository)
+	usecase := NewJobUsecase(mockRepo, new(profileMocks.ProfileUsecase), time.Minute)
+
+	jobID := ptrUUID()
+	mockRepo.On("RequestJobCancel", jobID).Return(false, nil)
+	mockRepo.On("FetchJobById", jobID).Return(nil, nil)
+
+	_, err := usecase.CancelJob(jobID)
+
+	require.Equal(t, constants.ErrJobNotFound, err)
+}
+
+func TestCancelJob_Finished(t *testing.T) {
+	mockRepo := new(mocks.JobRepository)
+	usecase := NewJobUsecase(mockRepo, new(profileMocks.ProfileUsecase), time.Minute)
+
+	jobID := ptrUUID()
+	mockRepo.On("RequestJobCancel", jobID).Return(false, nil)
+	mockRepo.On("FetchJobById", jobID).Return(&models.Job{ID: jobID, Status: models.JobStatusSucceeded}, nil)
+
+	_, err := usecase.CancelJob(jobID)
+
+	require.Equal(t, constants.ErrJobFinished, err)
+}
+
+func TestFetchJobResult_NotReady(t *testing.T) {
+	mockRepo := new(mocks.JobRepository)
+	usecase := NewJobUsecase(mockRepo, new(profileMocks.ProfileUsecase), time.Minute)
+
+	jobID := ptrUUID()
+	mockRepo.On("FetchJobById", jobID).Return(&models.Job{ID: jobID, Status: models.JobStatusRunning}, nil)
+
+	result, err := usecase.FetchJobResult(jobID)
+
+	require.Nil(t, result)
+	require.Equal(t, constants.ErrJobResultNotReady, err)
+}
+
+func TestClaimJob_UsesLeaseTimeout(t *testing.T) {
+	mockRepo := new(mocks.JobRepository)
+	usecase := NewJobUsecase(mockRepo, new(profileMocks.ProfileUsecase), time.Minute)
+
+	mockRepo.On("ClaimJob", "worker-1", mock.MatchedBy(func(staleBefore time.Time) bool {
+		return staleBefore.Before(time.Now().Add(-59*time.Second)) && staleBefore.After(time.Now().Add(-61*time.Second))
+	}), jobMaxAttempts).Return(nil, nil)
+
+	job, err := usecase.ClaimJob("worker-1")
+
+	require.NoError(t, err)
+	require.Nil(t, job)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRunJob_ImportResumes(t *testing.T) {
+	mockRepo := new(mocks.JobRepository)
+	mockProfileUs := new(profileMocks.ProfileUsecase)
+	usecase := NewJobUsecase(mockRepo, mockProfileUs, time.Minute)
+
+	payload, _ := json.Marshal(importPayload{Profiles: []_profile.UpsertProfile{
+		{FirstName: "SeiA", LastName: "Phanes"},
+		{FirstName: "AliZe", LastName: "Phanes"},
+		{FirstName: "", LastName: "Phanes"},
+		{FirstName: "Lyria", LastName: "Phanes"},
+	}})
+	job := &models.Job{Type: models.JobTypeImport, Payload: payload, Processed: 1}
+	job.GenUUID()
+
+	secondID := uuid.NewV5(*job.ID, "1")
+	mockProfileUs.On("CreateProfile", mock.MatchedBy(func(p *models.Profile) bool {
+		return *p.ID == secondID
+	}), mock.Anything).Return(constants.ErrProfileAlreadyExists)
+	mockProfileUs.On("CreateProfile", mock.MatchedBy(func(p *models.Profile) bool {
+		return *p.ID == uuid.NewV5(*job.ID, "3")
+	}), mock.Anything).Return(errors.New("db error"))
+	mockRepo.On("FinishJob", job).Return(nil)
+
+	err := usecase.RunJob(job)
+
+	require.NoError(t, err)
+	require.Equal(t, models.JobStatusSucceeded, job.Status)
+	require.Equal(t, 4, job.Total)
+	require.Equal(t, 4, job.Processed)
+	require.Equal(t, 2, job.Failed)
+	mockProfileUs.AssertNumberOfCalls(t, "CreateProfile", 2)
+}
+
+func TestRunJob_ExportCancelled(t *testing.T) {
+	mockRepo := new(mocks.JobRepository)
+	mockProfileUs := new(profileMocks.ProfileUsecase)
+	usecase := NewJobUsecase(mockRepo, mockProfileUs, time.Minute)
+
+	job := &models.Job{Type: models.JobTypeExport, Payload: json.RawMessage(`{}`)}
+	job.GenUUID()
+
+	mockProfileUs.On("FetchProfiles", _profile.GetProfilesParams{}, mock.AnythingOfType("*models.Paginator")).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*models.Paginator).SetTotal(1000)
+		}).
+		Return([]*models.Profile{{ID: ptrUUID()}}, nil)
+	mockRepo.On("UpdateJobProgress", job).
+		Run(func(args mock.Arguments) {
+			args.Get(0).(*models.Job).CancelRequested = true
+		}).
+		Return(nil)
+	mockRepo.On("FinishJob", job).Return(nil)
+
+	err := usecase.RunJob(job)
+
+	require.NoError(t, err)
+	require.Equal(t, models.JobStatusCancelled, job.Status)
+	require.Nil(t, job.Result)
+	mockProfileUs.AssertNumberOfCalls(t, "FetchProfiles", 1)
+}
+
+func TestRunJob_Export(t *testing.T) {
+	mockRepo := new(mocks.JobRepository)
+	mockProfileUs := new(profileMocks.ProfileUsecase)
+	usecase := NewJobUsecase(mockRepo, mockProfileUs, time.Minute)
+
+	searchWord := "Phanes"
+	job := &models.Job{Type: models.JobTypeExport, Payload: json.RawMessage(`{"search_word":"Phanes"}`)}
+	job.GenUUID()
+
+	mockProfileUs.On("FetchProfiles", _profile.GetProfilesParams{SearchWord: &searchWord}, mock.AnythingOfType("*models.Paginator")).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*models.Paginator).SetTotal(1)
+		}).
+		Return([]*models.Profile{{FirstName: "SeiA"}}, nil)
+	mockRepo.On("UpdateJobProgress", job).Return(nil)
+	mockRepo.On("FinishJob", job).Return(nil)
+
+	err := usecase.RunJob(job)
+
+	require.NoError(t, err)
+	require.Equal(t, models.JobStatusSucceeded, job.Status)
+	require.True(t, job.HasResult())
+	require.Contains(t, string(job.Result), `"first_name":"SeiA"`)
+}
+
+func TestRunJob_PurgeLeaseLost(t *testing.T) {
+	mockRepo := new(mocks.JobRepository)
+	mockProfileUs := new(profileMocks.ProfileUsecase)
+	usecase := NewJobUsecase(mockRepo, mockProfileUs, time.Minute)
+
+	ids := make([]uuid.UUID, jobCheckpointEvery+1)
+	for i := range ids {
+		ids[i] = *ptrUUID()
+	}
+	payload, _ := json.Marshal(purgePayload{ProfileIds: ids})
+	job := &models.Job{Type: models.JobTypePurge, Payload: payload}
+	job.GenUUID()
+
+	mockProfileUs.On("DeleteProfile", mock.Anything).Return(nil)
+	mockRepo.On("UpdateJobProgress", job).Return(constants.ErrJobLeaseLost)
+
+	err := usecase.RunJob(job)
+
+	require.Equal(t, constants.ErrJobLeaseLost, err)
+	mockRepo.AssertNotCalled(t, "FinishJob", mock.Anything)
+	mockProfileUs.AssertNumberOfCalls(t, "DeleteProfile", jobCheckpointEvery)
+}
+
+func TestRunJob_PurgeByStatus(t *testing.T) {
+	mockRepo := new(mocks.JobRepository)
+	mockProfileUs := new(profileMocks.ProfileUsecase)
+	usecase := NewJobUsecase(mockRepo, mockProfileUs, time.Minute)
+
+	status := _profile.FilterStatus{_profile.ProfileStatusArchived}
+	payload, _ := json.Marshal(purgePayload{GetProfilesParams: _profile.GetProfilesParams{Status: &status}})
+	job := &models.Job{Type: models.JobTypePurge, Payload: payload}
+	job.GenUUID()
+
+	archived := &models.Profile{ID: ptrUUID()}
+	mockProfileUs.On("FetchProfiles", mock.MatchedBy(func(params _profile.GetProfilesParams) bool {
+		return params.Status != nil && len(*params.Status) == 1 && (*params.Status)[0] == _profile.ProfileStatusArchived
+	}), mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*models.Paginator).SetTotal(1)
+		}).
+		Return([]*models.Profile{archived}, nil)
+	mockProfileUs.On("DeleteProfile", archived.ID).Return(nil)
+	mockRepo.On("FinishJob", job).Return(nil)
+
+	err := usecase.RunJob(job)
+
+	require.NoError(t, err)
+	require.Equal(t, models.JobStatusSucceeded, job.Status)
+	mockProfileUs.AssertExpectations(t)
+}
+
+func TestRunJob_ImportHeartbeatOnElapsedTime(t *testing.T) {
+	mockRepo := new(mocks.JobRepository)
+	mockProfileUs := new(profileMocks.ProfileUsecase)
+	usecase := NewJobUsecase(mockRepo, mockProfileUs, time.Minute)
+
+	payload, _ := json.Marshal(importPayload{Profiles: []_profile.UpsertProfile{
+		{FirstName: "SeiA", LastName: "Phanes", Gender: "MALE"},
+		{FirstName: "AliZe", LastName: "Phanes", Gender: "MALE"},
+	}})
+	heartbeatAt := time.Now().Add(-time.Minute)
+	job := &models.Job{Type: models.JobTypeImport, Payload: payload, HeartbeatAt: &heartbeatAt}
+	job.GenUUID()
+
+	mockProfileUs.On("CreateProfile", mock.AnythingOfType("*models.Profile"), mock.Anything).Return(nil)
+	mockRepo.On("UpdateJobProgress", job).
+		Run(func(args mock.Arguments) {
+			now := time.Now()
+			args.Get(0).(*models.Job).HeartbeatAt = &now
+		}).
+		Return(nil).Once()
+	mockRepo.On("FinishJob", job).Return(nil)
+
+	err := usecase.RunJob(job)
+
+	require.NoError(t, err)
+	require.Equal(t, models.JobStatusSucceeded, job.Status)
+	mockRepo.AssertNumberOfCalls(t, "UpdateJobProgress", 1)
+}
+
+func TestFailJob(t *testing.T) {
+	mockRepo := new(mocks.JobRepository)
+	usecase := NewJobUsecase(mockRepo, new(profileMocks.ProfileUsecase), time.Minute)
+
+	job := &models.Job{ID: ptrUUID(), Status: models.JobStatusRunning}
+	mockRepo.On("FinishJob", job).Return(nil)
+
+	require.NoError(t, usecase.FailJob(job, "job panicked: boom"))
+
+	require.Equal(t, models.JobStatusFailed, job.Status)
+	require.Equal(t, 1, job.Failed)
+	require.JSONEq(t, `[{"index":-1,"message":"job panicked: boom"}]`, string(job.Errors))
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,78 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/job"
+)
+
+// WorkerPool runs jobs in the background. Each worker polls for the next
+// claimable job, so several replicas can share the same job table.
+type WorkerPool struct {
+	jobUs        job.JobUsecase
+	workers      int
+	pollInterval time.Duration
+}
+
+// Start launches the workers, they stop when ctx is done.
+func (w *WorkerPool) Start(ctx context.Context) {
+	hostname, _ := os.Hostname()
+	for i := 0; i < w.workers; i++ {
+		workerId := fmt.Sprintf("%s-%d-%d", hostname, os.Getpid(), i)
+		go w.run(ctx, workerId)
+	}
+}
+
+func (w *WorkerPool) run(ctx context.Context, workerId string) {
+	var claimed *models.Job
+	defer func() {
+		// a job that panics must not take the worker down with it, the job is
+		// failed with the panic as its error and the worker restarts
+		if r := recover(); r != nil {
+			log.Printf("Worker %s recovered from panic: %v", workerId, r)
+			if claimed != nil {
+				if err := w.jobUs.FailJob(claimed, fmt.Sprintf("job panicked: %v", r)); err != nil {
+					log.Printf("Worker %s failed to fail job %s: %v", workerId, claimed.ID, err)
+				}
+			}
+			go w.run(ctx, workerId)
+		}
+	}()
+
+	for ctx.Err() == nil {
+		var err error
+		claimed, err = w.jobUs.ClaimJob(workerId)
+		if err != nil {
+			log.Printf("Worker %s failed to claim job: %v", workerId, err)
+		}
+
+		if claimed != nil {
+			log.Printf("Worker %s running %s job %s", workerId, claimed.Type, claimed.ID)
+			if err := w.jobUs.RunJob(claimed); err != nil {
+				log.Printf("Worker %s failed to finish job %s: %v", workerId, claimed.ID, err)
+			}
+			claimed = nil
+			// look for the next job right away while there is work queued
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(w.pollInterval):
+		}
+	}
+}
+
+func NewWorkerPool(jobUs job.JobUsecase, workers int, pollInterval time.Duration) *WorkerPool {
+	return &WorkerPool{
+		jobUs:        jobUs,
+		workers:      workers,
+		pollInterval: pollInterval,
+	}
+}
@@ -0,0 +1,40 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/job/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun_PanickingJobFails(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	id, _ := uuid.NewV4()
+	claimed := &models.Job{ID: &id, Type: models.JobTypeImport}
+
+	failed := make(chan string, 1)
+	jobUs := new(mocks.JobUsecase)
+	jobUs.On("ClaimJob", "worker-1").Return(claimed, nil).Once()
+	jobUs.On("ClaimJob", "worker-1").Return(nil, nil)
+	jobUs.On("RunJob", claimed).Run(func(mock.Arguments) { panic("boom") })
+	jobUs.On("FailJob", claimed, mock.AnythingOfType("string")).
+		Run(func(args mock.Arguments) { failed <- args.String(1) }).
+		Return(nil)
+
+	pool := NewWorkerPool(jobUs, 1, time.Millisecond)
+	go pool.run(ctx, "worker-1")
+
+	select {
+	case reason := <-failed:
+		require.Equal(t, "job panicked: boom", reason)
+	case <-time.After(time.Second):
+		t.Fatal("the job was not failed")
+	}
+}
@@ -0,0 +1,2 @@
+package lifecycle 
+//go:generate oapi-codegen --config=./server.cfg.yaml ../../../api-spec/lifecycle/openapi_bundle.yml
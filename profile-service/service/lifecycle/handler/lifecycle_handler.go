@@ -0,0 +1,94 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	_lifecycle "github.com/jariwat/p_project/profile-service/service/lifecycle"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type lifecycleHandler struct {
+	lifecycleUs _lifecycle.LifecycleUsecase
+}
+
+// respondError maps domain errors to their HTTP status.
+func respondError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, constants.ErrProfileNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrEmptyTransitionReason):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrInvalidStatusTransition),
+		errors.Is(err, constants.ErrProfileStatusChanged):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// GetProfileIdTransitions implements lifecycle.ServerInterface.
+func (l *lifecycleHandler) GetProfileIdTransitions(c *gin.Context, id types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+
+	transitions, err := l.lifecycleUs.FetchStatusTransitions(&profileId)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var data []_lifecycle.StatusTransition
+	bu, err := json.Marshal(transitions)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal transitions"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal transitions"})
+		return
+	}
+
+	c.JSON(http.StatusOK, _lifecycle.StatusTransitionsResponse{Data: &data})
+}
+
+// PostProfileIdTransitions implements lifecycle.ServerInterface.
+func (l *lifecycleHandler) PostProfileIdTransitions(c *gin.Context, id types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+
+	var request _lifecycle.CreateStatusTransition
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	transition, err := l.lifecycleUs.TransitionProfileStatus(&profileId, request)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var data _lifecycle.StatusTransition
+	bu, err := json.Marshal(transition)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal transition"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal transition"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, _lifecycle.StatusTransitionResponse{Data: &data})
+}
+
+func NewLifecycleHandler(lifecycleUs _lifecycle.LifecycleUsecase) _lifecycle.ServerInterface {
+	return &lifecycleHandler{
+		lifecycleUs: lifecycleUs,
+	}
+}
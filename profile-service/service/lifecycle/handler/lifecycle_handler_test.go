@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_lifecycle "github.com/jariwat/p_project/profile-service/service/lifecycle"
+	"github.com/jariwat/p_project/profile-service/service/lifecycle/mocks"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func postRequest(profileId *uuid.UUID, body string) *http.Request {
+	req, _ := http.NewRequest(http.MethodPost, "/profile/"+profileId.String()+"/transitions", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestPostProfileIdTransitions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileId := ptrUUID()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = postRequest(profileId, `{"status":"withdrawn","reason":"Moved abroad"}`)
+
+	mockUsecase := new(mocks.LifecycleUsecase)
+	mockUsecase.On("TransitionProfileStatus", profileId, _lifecycle.CreateStatusTransition{Status: _lifecycle.Withdrawn, Reason: "Moved abroad"}).
+		Return(&models.StatusTransition{ID: ptrUUID(), ProfileID: profileId, FromStatus: models.ProfileStatusActive, ToStatus: models.ProfileStatusWithdrawn, Reason: "Moved abroad"}, nil)
+
+	handler := NewLifecycleHandler(mockUsecase)
+	handler.PostProfileIdTransitions(c, types.UUID(*profileId))
+
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var resp _lifecycle.StatusTransitionResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, _lifecycle.Active, *resp.Data.FromStatus)
+	assert.Equal(t, _lifecycle.Withdrawn, *resp.Data.ToStatus)
+	assert.Equal(t, "Moved abroad", *resp.Data.Reason)
+}
+
+func TestPostProfileIdTransitions_Errors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"invalid transition", fmt.Errorf("%w from archived to active, archived is final", constants.ErrInvalidStatusTransition), http.StatusConflict},
+		{"changed meanwhile", constants.ErrProfileStatusChanged, http.StatusConflict},
+		{"blank reason", constants.ErrEmptyTransitionReason, http.StatusBadRequest},
+		{"profile not found", constants.ErrProfileNotFound, http.StatusNotFound},
+		{"database", errors.New("connection refused"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profileId := ptrUUID()
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = postRequest(profileId, `{"status":"active","reason":"Re-enrolled"}`)
+
+			mockUsecase := new(mocks.LifecycleUsecase)
+			mockUsecase.On("TransitionProfileStatus", profileId, mock.Anything).Return(nil, tt.err)
+
+			handler := NewLifecycleHandler(mockUsecase)
+			handler.PostProfileIdTransitions(c, types.UUID(*profileId))
+
+			assert.Equal(t, tt.status, w.Code)
+			assert.Contains(t, w.Body.String(), tt.err.Error())
+		})
+	}
+}
+
+func TestGetProfileIdTransitions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileId := ptrUUID()
+	req, _ := http.NewRequest(http.MethodGet, "/profile/"+profileId.String()+"/transitions", nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.LifecycleUsecase)
+	mockUsecase.On("FetchStatusTransitions", profileId).Return([]*models.StatusTransition{
+		{ID: ptrUUID(), ProfileID: profileId, FromStatus: models.ProfileStatusDraft, ToStatus: models.ProfileStatusActive, Reason: "Enrolled"},
+	}, nil)
+
+	handler := NewLifecycleHandler(mockUsecase)
+	handler.GetProfileIdTransitions(c, types.UUID(*profileId))
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp _lifecycle.StatusTransitionsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, *resp.Data, 1)
+	assert.Equal(t, "Enrolled", *(*resp.Data)[0].Reason)
+}
@@ -0,0 +1,45 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jariwat/p_project/profile-service/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EventNotifier is an autogenerated mock type for the EventNotifier type
+type EventNotifier struct {
+	mock.Mock
+}
+
+// Notify provides a mock function with given fields: event
+func (_m *EventNotifier) Notify(event *models.ProfileEvent) error {
+	ret := _m.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Notify")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ProfileEvent) error); ok {
+		r0 = rf(event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewEventNotifier creates a new instance of EventNotifier. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEventNotifier(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EventNotifier {
+	mock := &EventNotifier{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,127 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	time "time"
+
+	models "github.com/jariwat/p_project/profile-service/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/gofrs/uuid"
+)
+
+// LifecycleRepository is an autogenerated mock type for the LifecycleRepository type
+type LifecycleRepository struct {
+	mock.Mock
+}
+
+// ClaimProfileEvents provides a mock function with given fields: leaseUntil, limit
+func (_m *LifecycleRepository) ClaimProfileEvents(leaseUntil time.Time, limit int) ([]*models.ProfileEvent, error) {
+	ret := _m.Called(leaseUntil, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimProfileEvents")
+	}
+
+	var r0 []*models.ProfileEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, int) ([]*models.ProfileEvent, error)); ok {
+		return rf(leaseUntil, limit)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, int) []*models.ProfileEvent); ok {
+		r0 = rf(leaseUntil, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ProfileEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, int) error); ok {
+		r1 = rf(leaseUntil, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateStatusTransition provides a mock function with given fields: transition, event
+func (_m *LifecycleRepository) CreateStatusTransition(transition *models.StatusTransition, event *models.ProfileEvent) error {
+	ret := _m.Called(transition, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateStatusTransition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.StatusTransition, *models.ProfileEvent) error); ok {
+		r0 = rf(transition, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FetchStatusTransitions provides a mock function with given fields: profileId
+func (_m *LifecycleRepository) FetchStatusTransitions(profileId *uuid.UUID) ([]*models.StatusTransition, error) {
+	ret := _m.Called(profileId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchStatusTransitions")
+	}
+
+	var r0 []*models.StatusTransition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) ([]*models.StatusTransition, error)); ok {
+		return rf(profileId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) []*models.StatusTransition); ok {
+		r0 = rf(profileId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.StatusTransition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(profileId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateProfileEvent provides a mock function with given fields: event
+func (_m *LifecycleRepository) UpdateProfileEvent(event *models.ProfileEvent) error {
+	ret := _m.Called(event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfileEvent")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ProfileEvent) error); ok {
+		r0 = rf(event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewLifecycleRepository creates a new instance of LifecycleRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLifecycleRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LifecycleRepository {
+	mock := &LifecycleRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
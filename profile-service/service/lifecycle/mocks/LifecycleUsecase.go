@@ -0,0 +1,109 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	lifecycle "github.com/jariwat/p_project/profile-service/service/lifecycle"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/jariwat/p_project/profile-service/models"
+
+	uuid "github.com/gofrs/uuid"
+)
+
+// LifecycleUsecase is an autogenerated mock type for the LifecycleUsecase type
+type LifecycleUsecase struct {
+	mock.Mock
+}
+
+// DeliverProfileEvents provides a mock function with no fields
+func (_m *LifecycleUsecase) DeliverProfileEvents() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeliverProfileEvents")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FetchStatusTransitions provides a mock function with given fields: profileId
+func (_m *LifecycleUsecase) FetchStatusTransitions(profileId *uuid.UUID) ([]*models.StatusTransition, error) {
+	ret := _m.Called(profileId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchStatusTransitions")
+	}
+
+	var r0 []*models.StatusTransition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) ([]*models.StatusTransition, error)); ok {
+		return rf(profileId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) []*models.StatusTransition); ok {
+		r0 = rf(profileId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.StatusTransition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(profileId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransitionProfileStatus provides a mock function with given fields: profileId, newTransition
+func (_m *LifecycleUsecase) TransitionProfileStatus(profileId *uuid.UUID, newTransition lifecycle.CreateStatusTransition) (*models.StatusTransition, error) {
+	ret := _m.Called(profileId, newTransition)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransitionProfileStatus")
+	}
+
+	var r0 *models.StatusTransition
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, lifecycle.CreateStatusTransition) (*models.StatusTransition, error)); ok {
+		return rf(profileId, newTransition)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, lifecycle.CreateStatusTransition) *models.StatusTransition); ok {
+		r0 = rf(profileId, newTransition)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.StatusTransition)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, lifecycle.CreateStatusTransition) error); ok {
+		r1 = rf(profileId, newTransition)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewLifecycleUsecase creates a new instance of LifecycleUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLifecycleUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LifecycleUsecase {
+	mock := &LifecycleUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
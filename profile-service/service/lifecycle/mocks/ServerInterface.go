@@ -0,0 +1,40 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// ServerInterface is an autogenerated mock type for the ServerInterface type
+type ServerInterface struct {
+	mock.Mock
+}
+
+// GetProfileIdTransitions provides a mock function with given fields: c, id
+func (_m *ServerInterface) GetProfileIdTransitions(c *gin.Context, id uuid.UUID) {
+	_m.Called(c, id)
+}
+
+// PostProfileIdTransitions provides a mock function with given fields: c, id
+func (_m *ServerInterface) PostProfileIdTransitions(c *gin.Context, id uuid.UUID) {
+	_m.Called(c, id)
+}
+
+// NewServerInterface creates a new instance of ServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServerInterface {
+	mock := &ServerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,16 @@
+package lifecycle
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/models"
+)
+
+type LifecycleRepository interface {
+	FetchStatusTransitions(profileId *uuid.UUID) ([]*models.StatusTransition, error)
+	CreateStatusTransition(transition *models.StatusTransition, event *models.ProfileEvent) error
+
+	ClaimProfileEvents(leaseUntil time.Time, limit int) ([]*models.ProfileEvent, error)
+	UpdateProfileEvent(event *models.ProfileEvent) error
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/lifecycle"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type lifecycleRepository struct {
+	client *gorm.DB
+}
+
+// FetchStatusTransitions implements lifecycle.LifecycleRepository.
+// It lists the status changes of the profile, the latest first.
+func (l *lifecycleRepository) FetchStatusTransitions(profileId *uuid.UUID) ([]*models.StatusTransition, error) {
+	var transitions []*models.StatusTransition
+	if err := l.client.Where("profile_id = ?", profileId).Order("created_at DESC, id").Find(&transitions).Error; err != nil {
+		return nil, err
+	}
+
+	return transitions, nil
+}
+
+// CreateStatusTransition implements lifecycle.LifecycleRepository.
+// The profile only moves while it still has the status the transition starts
+// from, so of two concurrent transitions the second one fails with
+// ErrProfileStatusChanged. The event is saved with the transition.
+func (l *lifecycleRepository) CreateStatusTransition(transition *models.StatusTransition, event *models.ProfileEvent) error {
+	return l.client.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Profile{}).Where("id = ? AND status = ?", transition.ProfileID, transition.FromStatus).
+			Updates(map[string]interface{}{
+				"status":     transition.ToStatus,
+				"updated_at": transition.CreatedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return constants.ErrProfileStatusChanged
+		}
+
+		if err := tx.Create(transition).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(event).Error
+	})
+}
+
+// ClaimProfileEvents implements lifecycle.LifecycleRepository.
+// Up to limit undelivered events that are due are counted as attempted and held
+// until leaseUntil, so other instances skip them while they are being delivered.
+func (l *lifecycleRepository) ClaimProfileEvents(leaseUntil time.Time, limit int) ([]*models.ProfileEvent, error) {
+	var events []*models.ProfileEvent
+	err := l.client.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("delivered_at IS NULL AND next_attempt_at <= ?", time.Now()).
+			Order("next_attempt_at, created_at").
+			Limit(limit).
+			Find(&events).Error; err != nil {
+			return err
+		}
+		if len(events) == 0 {
+			return nil
+		}
+
+		ids := make([]*uuid.UUID, 0, len(events))
+		for _, event := range events {
+			event.Attempts++
+			event.NextAttemptAt = &leaseUntil
+			ids = append(ids, event.ID)
+		}
+
+		return tx.Model(&models.ProfileEvent{}).Where("id IN ?", ids).Updates(map[string]interface{}{
+			"attempts":        gorm.Expr("attempts + 1"),
+			"next_attempt_at": leaseUntil,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+// UpdateProfileEvent implements lifecycle.LifecycleRepository.
+func (l *lifecycleRepository) UpdateProfileEvent(event *models.ProfileEvent) error {
+	return l.client.Model(&models.ProfileEvent{}).Where("id = ?", event.ID).Updates(map[string]interface{}{
+		"attempts":        event.Attempts,
+		"next_attempt_at": event.NextAttemptAt,
+		"delivered_at":    event.DeliveredAt,
+		"last_error":      event.LastError,
+	}).Error
+}
+
+func NewPsqlLifecycleRepository(client *gorm.DB) lifecycle.LifecycleRepository {
+	return &lifecycleRepository{
+		client: client,
+	}
+}
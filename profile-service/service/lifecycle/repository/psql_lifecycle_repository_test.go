@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	return gormDB, mock
+}
+
+func newTransition(t *testing.T) (*models.StatusTransition, *models.ProfileEvent) {
+	transition := &models.StatusTransition{ProfileID: ptrUUID(), FromStatus: models.ProfileStatusDraft, ToStatus: models.ProfileStatusActive, Reason: "Enrolled"}
+	transition.GenUUID()
+	transition.SetCreatedAt()
+
+	event, err := models.NewStatusTransitionEvent(transition)
+	require.NoError(t, err)
+
+	return transition, event
+}
+
+func TestCreateStatusTransition(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlLifecycleRepository(gormDB)
+	transition, event := newTransition(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "profile" SET "status"=$1,"updated_at"=$2 WHERE id = $3 AND status = $4`)).
+		WithArgs(models.ProfileStatusActive, transition.CreatedAt, transition.ProfileID, models.ProfileStatusDraft).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "status_transition" ("id","profile_id","from_status","to_status","reason","created_at") VALUES ($1,$2,$3,$4,$5,$6)`)).
+		WithArgs(transition.ID, transition.ProfileID, models.ProfileStatusDraft, models.ProfileStatusActive, "Enrolled", transition.CreatedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "profile_event"`)).
+		WithArgs(event.ID, transition.ProfileID, models.ProfileEventActivated, sqlmock.AnyArg(), 0, event.NextAttemptAt, nil, nil, event.CreatedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.CreateStatusTransition(transition, event))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateStatusTransition_StatusChanged(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlLifecycleRepository(gormDB)
+	transition, event := newTransition(t)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "profile" SET "status"=$1,"updated_at"=$2 WHERE id = $3 AND status = $4`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	assert.ErrorIs(t, repo.CreateStatusTransition(transition, event), constants.ErrProfileStatusChanged)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchStatusTransitions(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlLifecycleRepository(gormDB)
+	profileId := ptrUUID()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "status_transition" WHERE profile_id = $1 ORDER BY created_at DESC, id`)).
+		WithArgs(profileId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "profile_id", "from_status", "to_status", "reason"}).
+			AddRow(ptrUUID().String(), profileId.String(), "active", "graduated", "Completed the 2025 programme").
+			AddRow(ptrUUID().String(), profileId.String(), "draft", "active", "Enrolled"))
+
+	transitions, err := repo.FetchStatusTransitions(profileId)
+	require.NoError(t, err)
+	require.Len(t, transitions, 2)
+	assert.Equal(t, models.ProfileStatusGraduated, transitions[0].ToStatus)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
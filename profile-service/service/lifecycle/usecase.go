@@ -0,0 +1,13 @@
+package lifecycle
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/models"
+)
+
+type LifecycleUsecase interface {
+	FetchStatusTransitions(profileId *uuid.UUID) ([]*models.StatusTransition, error)
+	TransitionProfileStatus(profileId *uuid.UUID, newTransition CreateStatusTransition) (*models.StatusTransition, error)
+
+	DeliverProfileEvents() error
+}
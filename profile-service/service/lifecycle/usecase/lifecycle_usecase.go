@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/lifecycle"
+	"github.com/jariwat/p_project/profile-service/service/outbox"
+	"github.com/jariwat/p_project/profile-service/service/profile"
+)
+
+type lifecycleUsecase struct {
+	lifecycleRepo lifecycle.LifecycleRepository
+	profileUs     profile.ProfileUsecase
+	notifier      lifecycle.EventNotifier
+}
+
+// FetchStatusTransitions implements lifecycle.LifecycleUsecase.
+func (l *lifecycleUsecase) FetchStatusTransitions(profileId *uuid.UUID) ([]*models.StatusTransition, error) {
+	if _, err := l.fetchProfile(profileId); err != nil {
+		return nil, err
+	}
+
+	return l.lifecycleRepo.FetchStatusTransitions(profileId)
+}
+
+// TransitionProfileStatus implements lifecycle.LifecycleUsecase.
+// The profile must be able to move from its status to the new one, and the
+// lifecycle event of the change is sent by DeliverProfileEvents.
+func (l *lifecycleUsecase) TransitionProfileStatus(profileId *uuid.UUID, newTransition lifecycle.CreateStatusTransition) (*models.StatusTransition, error) {
+	reason := strings.TrimSpace(newTransition.Reason)
+	if reason == "" {
+		return nil, constants.ErrEmptyTransitionReason
+	}
+
+	found, err := l.fetchProfile(profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	to := models.ProfileStatus(newTransition.Status)
+	if !found.Status.CanTransitionTo(to) {
+		return nil, invalidTransition(found.Status, to)
+	}
+
+	transition := &models.StatusTransition{ProfileID: profileId, FromStatus: found.Status, ToStatus: to, Reason: reason}
+	transition.GenUUID()
+	transition.SetCreatedAt()
+
+	event, err := models.NewStatusTransitionEvent(transition)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := l.lifecycleRepo.CreateStatusTransition(transition, event); err != nil {
+		return nil, err
+	}
+
+	return transition, nil
+}
+
+// invalidTransition explains why a profile cannot move from one status to another.
+func invalidTransition(from models.ProfileStatus, to models.ProfileStatus) error {
+	next := from.NextStatuses()
+	if len(next) == 0 {
+		return fmt.Errorf("%w from %s to %s, %s is final", constants.ErrInvalidStatusTransition, from, to, from)
+	}
+
+	expected := make([]string, 0, len(next))
+	for _, status := range next {
+		expected = append(expected, string(status))
+	}
+
+	return fmt.Errorf("%w from %s to %s, expected %s", constants.ErrInvalidStatusTransition, from, to, strings.Join(expected, " or "))
+}
+
+// DeliverProfileEvents implements lifecycle.LifecycleUsecase.
+func (l *lifecycleUsecase) DeliverProfileEvents() error {
+	return outbox.Deliver(l.lifecycleRepo.ClaimProfileEvents, l.lifecycleRepo.UpdateProfileEvent, l.notifier)
+}
+
+func (l *lifecycleUsecase) fetchProfile(profileId *uuid.UUID) (*models.Profile, error) {
+	found, err := l.profileUs.FetchProfileById(profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		return nil, constants.ErrProfileNotFound
+	}
+
+	return found, nil
+}
+
+func NewLifecycleUsecase(lifecycleRepo lifecycle.LifecycleRepository, profileUs profile.ProfileUsecase, notifier lifecycle.EventNotifier) lifecycle.LifecycleUsecase {
+	return &lifecycleUsecase{
+		lifecycleRepo: lifecycleRepo,
+		profileUs:     profileUs,
+		notifier:      notifier,
+	}
+}
@@ -0,0 +1,133 @@
+package usecase
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/lifecycle"
+	"github.com/jariwat/p_project/profile-service/service/lifecycle/mocks"
+	"github.com/jariwat/p_project/profile-service/service/outbox"
+	profileMocks "github.com/jariwat/p_project/profile-service/service/profile/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type testUsecase struct {
+	lifecycle.LifecycleUsecase
+	repo      *mocks.LifecycleRepository
+	profileUs *profileMocks.ProfileUsecase
+	notifier  *mocks.EventNotifier
+}
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func newUsecase() testUsecase {
+	u := testUsecase{
+		repo:      new(mocks.LifecycleRepository),
+		profileUs: new(profileMocks.ProfileUsecase),
+		notifier:  new(mocks.EventNotifier),
+	}
+	u.LifecycleUsecase = NewLifecycleUsecase(u.repo, u.profileUs, u.notifier)
+	return u
+}
+
+func TestTransitionProfileStatus(t *testing.T) {
+	u := newUsecase()
+	profileId := ptrUUID()
+	u.profileUs.On("FetchProfileById", profileId).Return(&models.Profile{ID: profileId, Status: models.ProfileStatusActive}, nil)
+
+	var event *models.ProfileEvent
+	u.repo.On("CreateStatusTransition", mock.AnythingOfType("*models.StatusTransition"), mock.AnythingOfType("*models.ProfileEvent")).
+		Run(func(args mock.Arguments) { event = args.Get(1).(*models.ProfileEvent) }).
+		Return(nil)
+
+	transition, err := u.TransitionProfileStatus(profileId, lifecycle.CreateStatusTransition{Status: lifecycle.Graduated, Reason: "  Completed the 2025 programme "})
+	require.NoError(t, err)
+
+	assert.Equal(t, models.ProfileStatusActive, transition.FromStatus)
+	assert.Equal(t, models.ProfileStatusGraduated, transition.ToStatus)
+	assert.Equal(t, "Completed the 2025 programme", transition.Reason)
+
+	require.NotNil(t, event)
+	assert.Equal(t, models.ProfileEventGraduated, event.Type)
+	assert.Equal(t, profileId, event.ProfileID)
+
+	var payload map[string]interface{}
+	require.NoError(t, json.Unmarshal(event.Payload, &payload))
+	assert.Equal(t, transition.ID.String(), payload["id"])
+	assert.Equal(t, "graduated", payload["to_status"])
+}
+
+func TestTransitionProfileStatus_Invalid(t *testing.T) {
+	tests := []struct {
+		from    models.ProfileStatus
+		to      lifecycle.ProfileStatus
+		message string
+	}{
+		{models.ProfileStatusDraft, lifecycle.Graduated, "invalid status transition from draft to graduated, expected active"},
+		{models.ProfileStatusActive, lifecycle.Active, "invalid status transition from active to active, expected graduated or withdrawn"},
+		{models.ProfileStatusWithdrawn, lifecycle.Active, "invalid status transition from withdrawn to active, expected archived"},
+		{models.ProfileStatusArchived, lifecycle.Active, "invalid status transition from archived to active, archived is final"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.from)+" to "+string(tt.to), func(t *testing.T) {
+			u := newUsecase()
+			profileId := ptrUUID()
+			u.profileUs.On("FetchProfileById", profileId).Return(&models.Profile{ID: profileId, Status: tt.from}, nil)
+
+			_, err := u.TransitionProfileStatus(profileId, lifecycle.CreateStatusTransition{Status: tt.to, Reason: "Re-enrolled"})
+
+			assert.ErrorIs(t, err, constants.ErrInvalidStatusTransition)
+			assert.EqualError(t, err, tt.message)
+			u.repo.AssertNotCalled(t, "CreateStatusTransition", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestTransitionProfileStatus_BlankReason(t *testing.T) {
+	u := newUsecase()
+
+	_, err := u.TransitionProfileStatus(ptrUUID(), lifecycle.CreateStatusTransition{Status: lifecycle.Active, Reason: " \n "})
+
+	assert.ErrorIs(t, err, constants.ErrEmptyTransitionReason)
+	u.profileUs.AssertNotCalled(t, "FetchProfileById", mock.Anything)
+}
+
+func TestTransitionProfileStatus_ProfileNotFound(t *testing.T) {
+	u := newUsecase()
+	profileId := ptrUUID()
+	u.profileUs.On("FetchProfileById", profileId).Return(nil, nil)
+
+	_, err := u.TransitionProfileStatus(profileId, lifecycle.CreateStatusTransition{Status: lifecycle.Active, Reason: "Enrolled"})
+
+	assert.ErrorIs(t, err, constants.ErrProfileNotFound)
+}
+
+func TestDeliverProfileEvents(t *testing.T) {
+	u := newUsecase()
+
+	delivered := &models.ProfileEvent{ID: ptrUUID(), EventDelivery: models.EventDelivery{Attempts: 1}}
+	givenUp := &models.ProfileEvent{ID: ptrUUID(), EventDelivery: models.EventDelivery{Attempts: outbox.MaxAttempts}}
+	u.repo.On("ClaimProfileEvents", mock.AnythingOfType("time.Time"), outbox.BatchSize).
+		Return([]*models.ProfileEvent{delivered, givenUp}, nil)
+	u.notifier.On("Notify", delivered).Return(nil)
+	u.notifier.On("Notify", givenUp).Return(errors.New("webhook 503 Service Unavailable"))
+	u.repo.On("UpdateProfileEvent", mock.Anything).Return(nil)
+
+	require.NoError(t, u.DeliverProfileEvents())
+
+	require.NotNil(t, delivered.DeliveredAt)
+	require.Nil(t, givenUp.DeliveredAt)
+	require.Nil(t, givenUp.NextAttemptAt)
+	require.Equal(t, "webhook 503 Service Unavailable", *givenUp.LastError)
+	u.repo.AssertNumberOfCalls(t, "UpdateProfileEvent", 2)
+}
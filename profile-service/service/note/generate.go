@@ -0,0 +1,2 @@
+package note 
+//go:generate oapi-codegen --config=./server.cfg.yaml ../../../api-spec/note/openapi_bundle.yml
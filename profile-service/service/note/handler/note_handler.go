@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_note "github.com/jariwat/p_project/profile-service/service/note"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type noteHandler struct {
+	noteUs _note.NoteUsecase
+}
+
+// respondError maps domain errors to their HTTP status.
+func respondError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, constants.ErrProfileNotFound),
+		errors.Is(err, constants.ErrNoteNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrEmptyNote):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrNotStaff),
+		errors.Is(err, constants.ErrNoteForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// GetProfileIdNotes implements note.ServerInterface.
+func (n *noteHandler) GetProfileIdNotes(c *gin.Context, id types.UUID, params _note.GetProfileIdNotesParams) {
+	var profileId = uuid.FromStringOrNil(id.String())
+	var page, perPage int
+	if params.Page != nil && params.PerPage != nil {
+		page = *params.Page
+		perPage = *params.PerPage
+	}
+	var paginator = models.NewPaginator(page, perPage)
+
+	notes, err := n.noteUs.FetchNotes(&profileId, models.NewViewer(params.XUserId, params.XUserRole), params, paginator)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var data []_note.Note
+	bu, err := json.Marshal(notes)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal notes"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal notes"})
+		return
+	}
+
+	response := _note.NotesPaginationResponse{
+		Data:       &data,
+		Page:       &paginator.Page,
+		PerPage:    &paginator.PerPage,
+		TotalPages: &paginator.TotalPages,
+		TotalRows:  &paginator.TotalRows,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// PostProfileIdNotes implements note.ServerInterface.
+func (n *noteHandler) PostProfileIdNotes(c *gin.Context, id types.UUID, params _note.PostProfileIdNotesParams) {
+	var profileId = uuid.FromStringOrNil(id.String())
+
+	var request _note.UpsertNote
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	created, err := n.noteUs.CreateNote(&profileId, models.NewViewer(params.XUserId, params.XUserRole), request)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	respondNote(c, http.StatusCreated, created)
+}
+
+// GetProfileIdNotesNoteId implements note.ServerInterface.
+func (n *noteHandler) GetProfileIdNotesNoteId(c *gin.Context, id types.UUID, noteId types.UUID, params _note.GetProfileIdNotesNoteIdParams) {
+	var profileId = uuid.FromStringOrNil(id.String())
+	var fetchNoteId = uuid.FromStringOrNil(noteId.String())
+
+	found, err := n.noteUs.FetchNote(&profileId, &fetchNoteId, models.NewViewer(params.XUserId, params.XUserRole))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	respondNote(c, http.StatusOK, found)
+}
+
+// PutProfileIdNotesNoteId implements note.ServerInterface.
+func (n *noteHandler) PutProfileIdNotesNoteId(c *gin.Context, id types.UUID, noteId types.UUID, params _note.PutProfileIdNotesNoteIdParams) {
+	var profileId = uuid.FromStringOrNil(id.String())
+	var updateNoteId = uuid.FromStringOrNil(noteId.String())
+
+	var request _note.UpsertNote
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	updated, err := n.noteUs.UpdateNote(&profileId, &updateNoteId, models.NewViewer(params.XUserId, params.XUserRole), request)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	respondNote(c, http.StatusOK, updated)
+}
+
+// DeleteProfileIdNotesNoteId implements note.ServerInterface.
+func (n *noteHandler) DeleteProfileIdNotesNoteId(c *gin.Context, id types.UUID, noteId types.UUID, params _note.DeleteProfileIdNotesNoteIdParams) {
+	var profileId = uuid.FromStringOrNil(id.String())
+	var deleteNoteId = uuid.FromStringOrNil(noteId.String())
+
+	if err := n.noteUs.DeleteNote(&profileId, &deleteNoteId, models.NewViewer(params.XUserId, params.XUserRole)); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, _note.Success{Message: "Note deleted successfully"})
+}
+
+// GetProfileIdNotesNoteIdHistory implements note.ServerInterface.
+func (n *noteHandler) GetProfileIdNotesNoteIdHistory(c *gin.Context, id types.UUID, noteId types.UUID, params _note.GetProfileIdNotesNoteIdHistoryParams) {
+	var profileId = uuid.FromStringOrNil(id.String())
+	var historyNoteId = uuid.FromStringOrNil(noteId.String())
+
+	revisions, err := n.noteUs.FetchNoteRevisions(&profileId, &historyNoteId, models.NewViewer(params.XUserId, params.XUserRole))
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var data []_note.NoteRevision
+	bu, err := json.Marshal(revisions)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal note history"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal note history"})
+		return
+	}
+
+	c.JSON(http.StatusOK, _note.NoteRevisionsResponse{Data: &data})
+}
+
+func respondNote(c *gin.Context, status int, note *models.Note) {
+	var data _note.Note
+	bu, err := json.Marshal(note)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal note"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal note"})
+		return
+	}
+
+	c.JSON(status, _note.NoteResponse{Data: &data})
+}
+
+func NewNoteHandler(noteUs _note.NoteUsecase) _note.ServerInterface {
+	return &noteHandler{
+		noteUs: noteUs,
+	}
+}
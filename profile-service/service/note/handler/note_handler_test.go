@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_note "github.com/jariwat/p_project/profile-service/service/note"
+	"github.com/jariwat/p_project/profile-service/service/note/mocks"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+var staffViewer = &models.Viewer{UserID: "teacher-42", Role: models.UserRoleStaff}
+
+func TestPostProfileIdNotes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileId := ptrUUID()
+	req, _ := http.NewRequest(http.MethodPost, "/profile/"+profileId.String()+"/notes", bytes.NewBufferString(`{"body":"Met with parents, **follow up** in May","visibility":"private"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	now := time.Now()
+	mockUsecase := new(mocks.NoteUsecase)
+	mockUsecase.On("CreateNote", profileId, staffViewer, mock.AnythingOfType("note.UpsertNote")).
+		Return(&models.Note{ID: ptrUUID(), ProfileID: profileId, AuthorID: "teacher-42", Body: "Met with parents, **follow up** in May", Visibility: models.NoteVisibilityPrivate, CreatedAt: &now, UpdatedAt: &now}, nil)
+
+	handler := NewNoteHandler(mockUsecase)
+	handler.PostProfileIdNotes(c, types.UUID(*profileId), _note.PostProfileIdNotesParams{XUserId: "teacher-42", XUserRole: _note.UserRoleStaff})
+
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var resp _note.NoteResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "teacher-42", *resp.Data.AuthorId)
+	assert.Equal(t, _note.NoteVisibilityPrivate, *resp.Data.Visibility)
+}
+
+func TestGetProfileIdNotes(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileId := ptrUUID()
+	req, _ := http.NewRequest(http.MethodGet, "/profile/"+profileId.String()+"/notes?page=2&per_page=1", nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.NoteUsecase)
+	mockUsecase.On("FetchNotes", profileId, staffViewer, mock.Anything, mock.AnythingOfType("*models.Paginator")).
+		Run(func(args mock.Arguments) {
+			args.Get(3).(*models.Paginator).SetTotal(3)
+		}).
+		Return([]*models.Note{{ID: ptrUUID(), ProfileID: profileId, AuthorID: "teacher-7", Body: "Bus pass renewed", Visibility: models.NoteVisibilityAll}}, nil)
+
+	page, perPage := 2, 1
+	handler := NewNoteHandler(mockUsecase)
+	handler.GetProfileIdNotes(c, types.UUID(*profileId), _note.GetProfileIdNotesParams{Page: &page, PerPage: &perPage, XUserId: "teacher-42", XUserRole: _note.UserRoleStaff})
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp _note.NotesPaginationResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Len(t, *resp.Data, 1)
+	assert.Equal(t, 2, *resp.Page)
+	assert.Equal(t, 3, *resp.TotalPages)
+	assert.Equal(t, 3, *resp.TotalRows)
+}
+
+func TestPutProfileIdNotesNoteId_Errors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"not author", constants.ErrNoteForbidden, http.StatusForbidden},
+		{"not staff", constants.ErrNotStaff, http.StatusForbidden},
+		{"not visible", constants.ErrNoteNotFound, http.StatusNotFound},
+		{"blank body", constants.ErrEmptyNote, http.StatusBadRequest},
+		{"database", errors.New("connection refused"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profileId, noteId := ptrUUID(), ptrUUID()
+			req, _ := http.NewRequest(http.MethodPut, "/profile/"+profileId.String()+"/notes/"+noteId.String(), bytes.NewBufferString(`{"body":"rewritten"}`))
+			req.Header.Set("Content-Type", "application/json")
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+
+			mockUsecase := new(mocks.NoteUsecase)
+			mockUsecase.On("UpdateNote", profileId, noteId, staffViewer, mock.Anything).Return(nil, tt.err)
+
+			handler := NewNoteHandler(mockUsecase)
+			handler.PutProfileIdNotesNoteId(c, types.UUID(*profileId), types.UUID(*noteId), _note.PutProfileIdNotesNoteIdParams{XUserId: "teacher-42", XUserRole: _note.UserRoleStaff})
+
+			assert.Equal(t, tt.status, w.Code)
+			assert.Contains(t, w.Body.String(), tt.err.Error())
+		})
+	}
+}
+
+func TestDeleteProfileIdNotesNoteId(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileId, noteId := ptrUUID(), ptrUUID()
+	req, _ := http.NewRequest(http.MethodDelete, "/profile/"+profileId.String()+"/notes/"+noteId.String(), nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	admin := &models.Viewer{UserID: "admin-1", Role: models.UserRoleAdmin}
+	mockUsecase := new(mocks.NoteUsecase)
+	mockUsecase.On("DeleteNote", profileId, noteId, admin).Return(nil)
+
+	handler := NewNoteHandler(mockUsecase)
+	handler.DeleteProfileIdNotesNoteId(c, types.UUID(*profileId), types.UUID(*noteId), _note.DeleteProfileIdNotesNoteIdParams{XUserId: "admin-1", XUserRole: _note.UserRoleAdmin})
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "Note deleted successfully")
+}
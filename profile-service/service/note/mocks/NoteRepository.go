@@ -0,0 +1,173 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jariwat/p_project/profile-service/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/gofrs/uuid"
+)
+
+// NoteRepository is an autogenerated mock type for the NoteRepository type
+type NoteRepository struct {
+	mock.Mock
+}
+
+// CreateNote provides a mock function with given fields: _a0
+func (_m *NoteRepository) CreateNote(_a0 *models.Note) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Note) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteNote provides a mock function with given fields: _a0, deletedBy
+func (_m *NoteRepository) DeleteNote(_a0 *models.Note, deletedBy string) error {
+	ret := _m.Called(_a0, deletedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteNote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Note, string) error); ok {
+		r0 = rf(_a0, deletedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FetchNoteById provides a mock function with given fields: profileId, noteId
+func (_m *NoteRepository) FetchNoteById(profileId *uuid.UUID, noteId *uuid.UUID) (*models.Note, error) {
+	ret := _m.Called(profileId, noteId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchNoteById")
+	}
+
+	var r0 *models.Note
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) (*models.Note, error)); ok {
+		return rf(profileId, noteId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) *models.Note); ok {
+		r0 = rf(profileId, noteId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Note)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *uuid.UUID) error); ok {
+		r1 = rf(profileId, noteId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchNoteRevisions provides a mock function with given fields: noteId
+func (_m *NoteRepository) FetchNoteRevisions(noteId *uuid.UUID) ([]*models.NoteRevision, error) {
+	ret := _m.Called(noteId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchNoteRevisions")
+	}
+
+	var r0 []*models.NoteRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) ([]*models.NoteRevision, error)); ok {
+		return rf(noteId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) []*models.NoteRevision); ok {
+		r0 = rf(noteId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.NoteRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(noteId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchNotes provides a mock function with given fields: profileId, viewer, q, paginator
+func (_m *NoteRepository) FetchNotes(profileId *uuid.UUID, viewer *models.Viewer, q string, paginator *models.Paginator) ([]*models.Note, error) {
+	ret := _m.Called(profileId, viewer, q, paginator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchNotes")
+	}
+
+	var r0 []*models.Note
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *models.Viewer, string, *models.Paginator) ([]*models.Note, error)); ok {
+		return rf(profileId, viewer, q, paginator)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *models.Viewer, string, *models.Paginator) []*models.Note); ok {
+		r0 = rf(profileId, viewer, q, paginator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Note)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *models.Viewer, string, *models.Paginator) error); ok {
+		r1 = rf(profileId, viewer, q, paginator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateNote provides a mock function with given fields: _a0, editedBy
+func (_m *NoteRepository) UpdateNote(_a0 *models.Note, editedBy string) error {
+	ret := _m.Called(_a0, editedBy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Note, string) error); ok {
+		r0 = rf(_a0, editedBy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewNoteRepository creates a new instance of NoteRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNoteRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NoteRepository {
+	mock := &NoteRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
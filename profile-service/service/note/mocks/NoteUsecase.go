@@ -0,0 +1,198 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jariwat/p_project/profile-service/models"
+	note "github.com/jariwat/p_project/profile-service/service/note"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/gofrs/uuid"
+)
+
+// NoteUsecase is an autogenerated mock type for the NoteUsecase type
+type NoteUsecase struct {
+	mock.Mock
+}
+
+// CreateNote provides a mock function with given fields: profileId, viewer, newNote
+func (_m *NoteUsecase) CreateNote(profileId *uuid.UUID, viewer *models.Viewer, newNote note.UpsertNote) (*models.Note, error) {
+	ret := _m.Called(profileId, viewer, newNote)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateNote")
+	}
+
+	var r0 *models.Note
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *models.Viewer, note.UpsertNote) (*models.Note, error)); ok {
+		return rf(profileId, viewer, newNote)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *models.Viewer, note.UpsertNote) *models.Note); ok {
+		r0 = rf(profileId, viewer, newNote)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Note)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *models.Viewer, note.UpsertNote) error); ok {
+		r1 = rf(profileId, viewer, newNote)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteNote provides a mock function with given fields: profileId, noteId, viewer
+func (_m *NoteUsecase) DeleteNote(profileId *uuid.UUID, noteId *uuid.UUID, viewer *models.Viewer) error {
+	ret := _m.Called(profileId, noteId, viewer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteNote")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID, *models.Viewer) error); ok {
+		r0 = rf(profileId, noteId, viewer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FetchNote provides a mock function with given fields: profileId, noteId, viewer
+func (_m *NoteUsecase) FetchNote(profileId *uuid.UUID, noteId *uuid.UUID, viewer *models.Viewer) (*models.Note, error) {
+	ret := _m.Called(profileId, noteId, viewer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchNote")
+	}
+
+	var r0 *models.Note
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID, *models.Viewer) (*models.Note, error)); ok {
+		return rf(profileId, noteId, viewer)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID, *models.Viewer) *models.Note); ok {
+		r0 = rf(profileId, noteId, viewer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Note)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *uuid.UUID, *models.Viewer) error); ok {
+		r1 = rf(profileId, noteId, viewer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchNoteRevisions provides a mock function with given fields: profileId, noteId, viewer
+func (_m *NoteUsecase) FetchNoteRevisions(profileId *uuid.UUID, noteId *uuid.UUID, viewer *models.Viewer) ([]*models.NoteRevision, error) {
+	ret := _m.Called(profileId, noteId, viewer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchNoteRevisions")
+	}
+
+	var r0 []*models.NoteRevision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID, *models.Viewer) ([]*models.NoteRevision, error)); ok {
+		return rf(profileId, noteId, viewer)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID, *models.Viewer) []*models.NoteRevision); ok {
+		r0 = rf(profileId, noteId, viewer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.NoteRevision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *uuid.UUID, *models.Viewer) error); ok {
+		r1 = rf(profileId, noteId, viewer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchNotes provides a mock function with given fields: profileId, viewer, params, paginator
+func (_m *NoteUsecase) FetchNotes(profileId *uuid.UUID, viewer *models.Viewer, params note.GetProfileIdNotesParams, paginator *models.Paginator) ([]*models.Note, error) {
+	ret := _m.Called(profileId, viewer, params, paginator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchNotes")
+	}
+
+	var r0 []*models.Note
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *models.Viewer, note.GetProfileIdNotesParams, *models.Paginator) ([]*models.Note, error)); ok {
+		return rf(profileId, viewer, params, paginator)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *models.Viewer, note.GetProfileIdNotesParams, *models.Paginator) []*models.Note); ok {
+		r0 = rf(profileId, viewer, params, paginator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Note)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *models.Viewer, note.GetProfileIdNotesParams, *models.Paginator) error); ok {
+		r1 = rf(profileId, viewer, params, paginator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateNote provides a mock function with given fields: profileId, noteId, viewer, updateNote
+func (_m *NoteUsecase) UpdateNote(profileId *uuid.UUID, noteId *uuid.UUID, viewer *models.Viewer, updateNote note.UpsertNote) (*models.Note, error) {
+	ret := _m.Called(profileId, noteId, viewer, updateNote)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNote")
+	}
+
+	var r0 *models.Note
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID, *models.Viewer, note.UpsertNote) (*models.Note, error)); ok {
+		return rf(profileId, noteId, viewer, updateNote)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID, *models.Viewer, note.UpsertNote) *models.Note); ok {
+		r0 = rf(profileId, noteId, viewer, updateNote)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Note)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *uuid.UUID, *models.Viewer, note.UpsertNote) error); ok {
+		r1 = rf(profileId, noteId, viewer, updateNote)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewNoteUsecase creates a new instance of NoteUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNoteUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NoteUsecase {
+	mock := &NoteUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,61 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+
+	note "github.com/jariwat/p_project/profile-service/service/note"
+
+	uuid "github.com/google/uuid"
+)
+
+// ServerInterface is an autogenerated mock type for the ServerInterface type
+type ServerInterface struct {
+	mock.Mock
+}
+
+// DeleteProfileIdNotesNoteId provides a mock function with given fields: c, id, noteId, params
+func (_m *ServerInterface) DeleteProfileIdNotesNoteId(c *gin.Context, id uuid.UUID, noteId uuid.UUID, params note.DeleteProfileIdNotesNoteIdParams) {
+	_m.Called(c, id, noteId, params)
+}
+
+// GetProfileIdNotes provides a mock function with given fields: c, id, params
+func (_m *ServerInterface) GetProfileIdNotes(c *gin.Context, id uuid.UUID, params note.GetProfileIdNotesParams) {
+	_m.Called(c, id, params)
+}
+
+// GetProfileIdNotesNoteId provides a mock function with given fields: c, id, noteId, params
+func (_m *ServerInterface) GetProfileIdNotesNoteId(c *gin.Context, id uuid.UUID, noteId uuid.UUID, params note.GetProfileIdNotesNoteIdParams) {
+	_m.Called(c, id, noteId, params)
+}
+
+// GetProfileIdNotesNoteIdHistory provides a mock function with given fields: c, id, noteId, params
+func (_m *ServerInterface) GetProfileIdNotesNoteIdHistory(c *gin.Context, id uuid.UUID, noteId uuid.UUID, params note.GetProfileIdNotesNoteIdHistoryParams) {
+	_m.Called(c, id, noteId, params)
+}
+
+// PostProfileIdNotes provides a mock function with given fields: c, id, params
+func (_m *ServerInterface) PostProfileIdNotes(c *gin.Context, id uuid.UUID, params note.PostProfileIdNotesParams) {
+	_m.Called(c, id, params)
+}
+
+// PutProfileIdNotesNoteId provides a mock function with given fields: c, id, noteId, params
+func (_m *ServerInterface) PutProfileIdNotesNoteId(c *gin.Context, id uuid.UUID, noteId uuid.UUID, params note.PutProfileIdNotesNoteIdParams) {
+	_m.Called(c, id, noteId, params)
+}
+
+// NewServerInterface creates a new instance of ServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServerInterface {
+	mock := &ServerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,15 @@
+package note
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/models"
+)
+
+type NoteRepository interface {
+	FetchNotes(profileId *uuid.UUID, viewer *models.Viewer, q string, paginator *models.Paginator) ([]*models.Note, error)
+	FetchNoteById(profileId *uuid.UUID, noteId *uuid.UUID) (*models.Note, error)
+	CreateNote(note *models.Note) error
+	UpdateNote(note *models.Note, editedBy string) error
+	DeleteNote(note *models.Note, deletedBy string) error
+	FetchNoteRevisions(noteId *uuid.UUID) ([]*models.NoteRevision, error)
+}
@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/helper"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/note"
+	"gorm.io/gorm"
+)
+
+const (
+	foreignKeyViolationCode = "23503"
+	profileForeignKey       = "note_profile_id_fkey"
+
+	// noteOrder lists the notes of a profile as a timeline, the newest first
+	noteOrder = "created_at DESC, id"
+)
+
+type noteRepository struct {
+	client *gorm.DB
+}
+
+// translateError maps a profile removed while a note was written about it to
+// domain errors.
+func translateError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode && pgErr.ConstraintName == profileForeignKey {
+		return constants.ErrProfileNotFound
+	}
+
+	return err
+}
+
+// FetchNotes implements note.NoteRepository.
+// Only the notes viewer can read are listed, those whose body has q when it is set.
+func (n *noteRepository) FetchNotes(profileId *uuid.UUID, viewer *models.Viewer, q string, paginator *models.Paginator) ([]*models.Note, error) {
+	var notes []*models.Note
+	var totalRows int64
+	var limit = paginator.PerPage
+	var offset = (paginator.Page - 1) * paginator.PerPage
+
+	query := n.client.Model(&models.Note{}).
+		Where("profile_id = ? AND deleted_at IS NULL", profileId).
+		Where("visibility IN ? OR (visibility = ? AND author_id = ?)",
+			viewer.SharedNoteVisibilities(), models.NoteVisibilityPrivate, viewer.UserID)
+
+	if q = strings.TrimSpace(q); q != "" {
+		query = query.Where(`body ILIKE ? ESCAPE '\'`, helper.ContainsPattern(q))
+	}
+
+	if err := query.Count(&totalRows).Error; err != nil {
+		return nil, err
+	}
+
+	if err := query.Order(noteOrder).Limit(limit).Offset(offset).Find(&notes).Error; err != nil {
+		return nil, err
+	}
+
+	paginator.SetTotal(int(totalRows))
+
+	return notes, nil
+}
+
+// FetchNoteById implements note.NoteRepository.
+// A deleted note is not found.
+func (n *noteRepository) FetchNoteById(profileId *uuid.UUID, noteId *uuid.UUID) (*models.Note, error) {
+	var found models.Note
+	if err := n.client.First(&found, "id = ? AND profile_id = ? AND deleted_at IS NULL", noteId, profileId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &found, nil
+}
+
+// CreateNote implements note.NoteRepository.
+// The note is saved with its first revision.
+func (n *noteRepository) CreateNote(note *models.Note) error {
+	err := n.client.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(note).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(note.Revision(note.AuthorID)).Error
+	})
+
+	return translateError(err)
+}
+
+// UpdateNote implements note.NoteRepository.
+// The edit is kept as a revision by editedBy.
+func (n *noteRepository) UpdateNote(note *models.Note, editedBy string) error {
+	return n.client.Transaction(func(tx *gorm.DB) error {
+		updated := tx.Model(&models.Note{}).Where("id = ? AND deleted_at IS NULL", note.ID).Updates(map[string]interface{}{
+			"body":       note.Body,
+			"visibility": note.Visibility,
+			"updated_at": note.UpdatedAt,
+		})
+		if updated.Error != nil {
+			return updated.Error
+		}
+		if updated.RowsAffected == 0 {
+			return constants.ErrNoteNotFound
+		}
+
+		return tx.Create(note.Revision(editedBy)).Error
+	})
+}
+
+// DeleteNote implements note.NoteRepository.
+// The note is only marked deleted, so its history is kept.
+func (n *noteRepository) DeleteNote(note *models.Note, deletedBy string) error {
+	result := n.client.Model(&models.Note{}).Where("id = ? AND deleted_at IS NULL", note.ID).UpdateColumns(map[string]interface{}{
+		"deleted_at": time.Now(),
+		"deleted_by": deletedBy,
+	})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return constants.ErrNoteNotFound
+	}
+
+	return nil
+}
+
+// FetchNoteRevisions implements note.NoteRepository.
+func (n *noteRepository) FetchNoteRevisions(noteId *uuid.UUID) ([]*models.NoteRevision, error) {
+	var revisions []*models.NoteRevision
+	if err := n.client.Where("note_id = ?", noteId).Order("created_at DESC, id").Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+func NewPsqlNoteRepository(client *gorm.DB) note.NoteRepository {
+	return &noteRepository{
+		client: client,
+	}
+}
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	return gormDB, mock
+}
+
+func TestFetchNotes(t *testing.T) {
+	profileId := ptrUUID()
+
+	tests := []struct {
+		name   string
+		viewer *models.Viewer
+		filter string
+		args   []driver.Value
+	}{
+		{"staff", &models.Viewer{UserID: "teacher-42", Role: models.UserRoleStaff},
+			`(visibility IN ($2,$3) OR (visibility = $4 AND author_id = $5)) AND body ILIKE $6 ESCAPE '\'`,
+			[]driver.Value{profileId, "all", "staff", "private", "teacher-42", "%scholarship%"}},
+		{"student", &models.Viewer{UserID: "student-7", Role: models.UserRoleStudent},
+			`(visibility IN ($2) OR (visibility = $3 AND author_id = $4)) AND body ILIKE $5 ESCAPE '\'`,
+			[]driver.Value{profileId, "all", "private", "student-7", "%scholarship%"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gormDB, mock := newMockDB(t)
+			repo := NewPsqlNoteRepository(gormDB)
+
+			where := `WHERE (profile_id = $1 AND deleted_at IS NULL) AND ` + tt.filter
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "note" ` + where)).
+				WithArgs(tt.args...).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "note" ` + where + ` ORDER BY created_at DESC, id LIMIT`)).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "body", "visibility"}).AddRow(ptrUUID().String(), "Applied for a scholarship", "all"))
+
+			paginator := models.NewPaginator(1, 10)
+			notes, err := repo.FetchNotes(profileId, tt.viewer, " scholarship ", paginator)
+			require.NoError(t, err)
+			assert.Len(t, notes, 1)
+			assert.Equal(t, 1, paginator.TotalRows)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestFetchNotes_EscapesWildcards(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlNoteRepository(gormDB)
+
+	profileId := ptrUUID()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "note"`)).
+		WithArgs(profileId, "all", "private", "student-7", `%100\% in quiz\_2 C:\\%`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "note"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	viewer := &models.Viewer{UserID: "student-7", Role: models.UserRoleStudent}
+	_, err := repo.FetchNotes(profileId, viewer, `100% in quiz_2 C:\`, models.NewPaginator(1, 10))
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateNote(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlNoteRepository(gormDB)
+
+	now := time.Now()
+	note := &models.Note{ID: ptrUUID(), ProfileID: ptrUUID(), AuthorID: "teacher-42", Body: "Needs help with *calculus*",
+		Visibility: models.NoteVisibilityStaff, CreatedAt: &now, UpdatedAt: &now}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "note"`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "note_revision" ("id","note_id","body","visibility","edited_by","created_at") VALUES ($1,$2,$3,$4,$5,$6)`)).
+		WithArgs(sqlmock.AnyArg(), note.ID, note.Body, models.NoteVisibilityStaff, "teacher-42", now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.CreateNote(note))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateNote_ProfileDeleted(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlNoteRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "note"`)).
+		WillReturnError(&pgconn.PgError{Code: "23503", ConstraintName: "note_profile_id_fkey"})
+	mock.ExpectRollback()
+
+	err := repo.CreateNote(&models.Note{ID: ptrUUID(), ProfileID: ptrUUID(), AuthorID: "teacher-42", Body: "note"})
+	assert.ErrorIs(t, err, constants.ErrProfileNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateNote_Deleted(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlNoteRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "note" SET "body"=$1,"updated_at"=$2,"visibility"=$3 WHERE id = $4 AND deleted_at IS NULL`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	now := time.Now()
+	err := repo.UpdateNote(&models.Note{ID: ptrUUID(), Body: "edited", Visibility: models.NoteVisibilityAll, UpdatedAt: &now}, "teacher-42")
+	assert.ErrorIs(t, err, constants.ErrNoteNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteNote(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlNoteRepository(gormDB)
+
+	noteId := ptrUUID()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "note" SET "deleted_at"=$1,"deleted_by"=$2 WHERE id = $3 AND deleted_at IS NULL`)).
+		WithArgs(sqlmock.AnyArg(), "admin-1", noteId).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.DeleteNote(&models.Note{ID: noteId}, "admin-1"))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,15 @@
+package note
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/models"
+)
+
+type NoteUsecase interface {
+	FetchNotes(profileId *uuid.UUID, viewer *models.Viewer, params GetProfileIdNotesParams, paginator *models.Paginator) ([]*models.Note, error)
+	FetchNote(profileId *uuid.UUID, noteId *uuid.UUID, viewer *models.Viewer) (*models.Note, error)
+	CreateNote(profileId *uuid.UUID, viewer *models.Viewer, newNote UpsertNote) (*models.Note, error)
+	UpdateNote(profileId *uuid.UUID, noteId *uuid.UUID, viewer *models.Viewer, updateNote UpsertNote) (*models.Note, error)
+	DeleteNote(profileId *uuid.UUID, noteId *uuid.UUID, viewer *models.Viewer) error
+	FetchNoteRevisions(profileId *uuid.UUID, noteId *uuid.UUID, viewer *models.Viewer) ([]*models.NoteRevision, error)
+}
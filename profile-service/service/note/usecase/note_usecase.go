@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/note"
+	"github.com/jariwat/p_project/profile-service/service/profile"
+)
+
+type noteUsecase struct {
+	noteRepo  note.NoteRepository
+	profileUs profile.ProfileUsecase
+}
+
+// FetchNotes implements note.NoteUsecase.
+func (n *noteUsecase) FetchNotes(profileId *uuid.UUID, viewer *models.Viewer, params note.GetProfileIdNotesParams, paginator *models.Paginator) ([]*models.Note, error) {
+	if err := n.checkProfileExists(profileId); err != nil {
+		return nil, err
+	}
+
+	var q string
+	if params.Q != nil {
+		q = *params.Q
+	}
+
+	return n.noteRepo.FetchNotes(profileId, viewer, q, paginator)
+}
+
+// FetchNote implements note.NoteUsecase.
+func (n *noteUsecase) FetchNote(profileId *uuid.UUID, noteId *uuid.UUID, viewer *models.Viewer) (*models.Note, error) {
+	return n.fetchVisibleNote(profileId, noteId, viewer)
+}
+
+// CreateNote implements note.NoteUsecase.
+// Only staff members write notes, visible to staff unless told otherwise.
+func (n *noteUsecase) CreateNote(profileId *uuid.UUID, viewer *models.Viewer, newNote note.UpsertNote) (*models.Note, error) {
+	if !viewer.IsStaff() {
+		return nil, constants.ErrNotStaff
+	}
+
+	if err := n.checkProfileExists(profileId); err != nil {
+		return nil, err
+	}
+
+	created := &models.Note{
+		ProfileID:  profileId,
+		AuthorID:   viewer.UserID,
+		Visibility: models.NoteVisibilityStaff,
+	}
+	if err := setNote(created, newNote); err != nil {
+		return nil, err
+	}
+	created.GenUUID()
+	created.SetCreatedAt()
+	created.SetUpdatedAt()
+
+	if err := n.noteRepo.CreateNote(created); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// UpdateNote implements note.NoteUsecase.
+// Only the author edits a note, the visibility is kept when none is given.
+func (n *noteUsecase) UpdateNote(profileId *uuid.UUID, noteId *uuid.UUID, viewer *models.Viewer, updateNote note.UpsertNote) (*models.Note, error) {
+	updated, err := n.fetchVisibleNote(profileId, noteId, viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	if !updated.EditableBy(viewer) {
+		return nil, constants.ErrNoteForbidden
+	}
+
+	if err := setNote(updated, updateNote); err != nil {
+		return nil, err
+	}
+	updated.SetUpdatedAt()
+
+	if err := n.noteRepo.UpdateNote(updated, viewer.UserID); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// DeleteNote implements note.NoteUsecase.
+func (n *noteUsecase) DeleteNote(profileId *uuid.UUID, noteId *uuid.UUID, viewer *models.Viewer) error {
+	deleted, err := n.fetchVisibleNote(profileId, noteId, viewer)
+	if err != nil {
+		return err
+	}
+
+	if !deleted.DeletableBy(viewer) {
+		return constants.ErrNoteForbidden
+	}
+
+	return n.noteRepo.DeleteNote(deleted, viewer.UserID)
+}
+
+// FetchNoteRevisions implements note.NoteUsecase.
+func (n *noteUsecase) FetchNoteRevisions(profileId *uuid.UUID, noteId *uuid.UUID, viewer *models.Viewer) ([]*models.NoteRevision, error) {
+	found, err := n.fetchVisibleNote(profileId, noteId, viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.noteRepo.FetchNoteRevisions(found.ID)
+}
+
+// fetchVisibleNote finds the note of the profile, a note viewer cannot read
+// is not found so its existence is not disclosed.
+func (n *noteUsecase) fetchVisibleNote(profileId *uuid.UUID, noteId *uuid.UUID, viewer *models.Viewer) (*models.Note, error) {
+	found, err := n.noteRepo.FetchNoteById(profileId, noteId)
+	if err != nil {
+		return nil, err
+	}
+
+	if found == nil || !found.VisibleTo(viewer) {
+		return nil, constants.ErrNoteNotFound
+	}
+
+	return found, nil
+}
+
+func (n *noteUsecase) checkProfileExists(profileId *uuid.UUID) error {
+	found, err := n.profileUs.FetchProfileById(profileId)
+	if err != nil {
+		return err
+	}
+
+	if found == nil {
+		return constants.ErrProfileNotFound
+	}
+
+	return nil
+}
+
+// setNote copies the fields of upsertNote to target.
+func setNote(target *models.Note, upsertNote note.UpsertNote) error {
+	// the body is kept as written, leading spaces are meaningful in Markdown
+	if strings.TrimSpace(upsertNote.Body) == "" {
+		return constants.ErrEmptyNote
+	}
+
+	target.Body = upsertNote.Body
+	if upsertNote.Visibility != nil {
+		target.Visibility = models.NoteVisibility(*upsertNote.Visibility)
+	}
+
+	return nil
+}
+
+func NewNoteUsecase(noteRepo note.NoteRepository, profileUs profile.ProfileUsecase) note.NoteUsecase {
+	return &noteUsecase{
+		noteRepo:  noteRepo,
+		profileUs: profileUs,
+	}
+}
@@ -0,0 +1,189 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_note "github.com/jariwat/p_project/profile-service/service/note"
+	"github.com/jariwat/p_project/profile-service/service/note/mocks"
+	profileMocks "github.com/jariwat/p_project/profile-service/service/profile/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	author  = &models.Viewer{UserID: "teacher-42", Role: models.UserRoleStaff}
+	teacher = &models.Viewer{UserID: "teacher-7", Role: models.UserRoleStaff}
+	admin   = &models.Viewer{UserID: "admin-1", Role: models.UserRoleAdmin}
+	student = &models.Viewer{UserID: "student-3", Role: models.UserRoleStudent}
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func visibilityPtr(visibility _note.NoteVisibility) *_note.NoteVisibility {
+	return &visibility
+}
+
+func TestCreateNote(t *testing.T) {
+	profileId := ptrUUID()
+	mockRepo := new(mocks.NoteRepository)
+	mockRepo.On("CreateNote", mock.AnythingOfType("*models.Note")).Return(nil)
+	mockProfileUs := new(profileMocks.ProfileUsecase)
+	mockProfileUs.On("FetchProfileById", profileId).Return(&models.Profile{ID: profileId}, nil)
+
+	usecase := NewNoteUsecase(mockRepo, mockProfileUs)
+	created, err := usecase.CreateNote(profileId, author, _note.UpsertNote{Body: "    indented code\n\nFollow up in May."})
+
+	require.NoError(t, err)
+	assert.NotNil(t, created.ID)
+	assert.Equal(t, "teacher-42", created.AuthorID)
+	assert.Equal(t, models.NoteVisibilityStaff, created.Visibility)
+	assert.Equal(t, "    indented code\n\nFollow up in May.", created.Body)
+}
+
+func TestCreateNote_Rejected(t *testing.T) {
+	tests := []struct {
+		name   string
+		viewer *models.Viewer
+		body   string
+		err    error
+	}{
+		{"not staff", student, "I am doing fine", constants.ErrNotStaff},
+		{"blank body", author, " \n\t", constants.ErrEmptyNote},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profileId := ptrUUID()
+			mockRepo := new(mocks.NoteRepository)
+			mockProfileUs := new(profileMocks.ProfileUsecase)
+			mockProfileUs.On("FetchProfileById", profileId).Return(&models.Profile{ID: profileId}, nil)
+
+			usecase := NewNoteUsecase(mockRepo, mockProfileUs)
+			_, err := usecase.CreateNote(profileId, tt.viewer, _note.UpsertNote{Body: tt.body})
+
+			assert.ErrorIs(t, err, tt.err)
+			mockRepo.AssertNotCalled(t, "CreateNote", mock.Anything)
+		})
+	}
+}
+
+func TestFetchNote_Visibility(t *testing.T) {
+	tests := []struct {
+		visibility models.NoteVisibility
+		viewer     *models.Viewer
+		visible    bool
+	}{
+		{models.NoteVisibilityPrivate, author, true},
+		{models.NoteVisibilityPrivate, teacher, false},
+		{models.NoteVisibilityPrivate, admin, false},
+		{models.NoteVisibilityStaff, teacher, true},
+		{models.NoteVisibilityStaff, admin, true},
+		{models.NoteVisibilityStaff, student, false},
+		{models.NoteVisibilityAll, student, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.visibility)+" to "+tt.viewer.UserID, func(t *testing.T) {
+			profileId, noteId := ptrUUID(), ptrUUID()
+			mockRepo := new(mocks.NoteRepository)
+			mockRepo.On("FetchNoteById", profileId, noteId).
+				Return(&models.Note{ID: noteId, ProfileID: profileId, AuthorID: author.UserID, Visibility: tt.visibility}, nil)
+
+			usecase := NewNoteUsecase(mockRepo, new(profileMocks.ProfileUsecase))
+			found, err := usecase.FetchNote(profileId, noteId, tt.viewer)
+
+			if tt.visible {
+				require.NoError(t, err)
+				assert.Equal(t, noteId, found.ID)
+			} else {
+				assert.ErrorIs(t, err, constants.ErrNoteNotFound)
+			}
+		})
+	}
+}
+
+func TestUpdateNote(t *testing.T) {
+	profileId, noteId := ptrUUID(), ptrUUID()
+	mockRepo := new(mocks.NoteRepository)
+	mockRepo.On("FetchNoteById", profileId, noteId).
+		Return(&models.Note{ID: noteId, ProfileID: profileId, AuthorID: author.UserID, Body: "draft", Visibility: models.NoteVisibilityPrivate}, nil)
+	mockRepo.On("UpdateNote", mock.MatchedBy(func(note *models.Note) bool {
+		return note.Body == "final" && note.Visibility == models.NoteVisibilityPrivate && note.UpdatedAt != nil
+	}), author.UserID).Return(nil)
+
+	usecase := NewNoteUsecase(mockRepo, new(profileMocks.ProfileUsecase))
+	updated, err := usecase.UpdateNote(profileId, noteId, author, _note.UpsertNote{Body: "final"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "final", updated.Body)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateNote_NotAuthor(t *testing.T) {
+	profileId, noteId := ptrUUID(), ptrUUID()
+	mockRepo := new(mocks.NoteRepository)
+	mockRepo.On("FetchNoteById", profileId, noteId).
+		Return(&models.Note{ID: noteId, ProfileID: profileId, AuthorID: author.UserID, Visibility: models.NoteVisibilityStaff}, nil)
+
+	usecase := NewNoteUsecase(mockRepo, new(profileMocks.ProfileUsecase))
+
+	for _, viewer := range []*models.Viewer{teacher, admin} {
+		_, err := usecase.UpdateNote(profileId, noteId, viewer, _note.UpsertNote{Body: "rewritten", Visibility: visibilityPtr(_note.NoteVisibilityAll)})
+		assert.ErrorIs(t, err, constants.ErrNoteForbidden, viewer.UserID)
+	}
+	mockRepo.AssertNotCalled(t, "UpdateNote", mock.Anything, mock.Anything)
+}
+
+func TestDeleteNote(t *testing.T) {
+	tests := []struct {
+		name   string
+		viewer *models.Viewer
+		err    error
+	}{
+		{"author", author, nil},
+		{"admin", admin, nil},
+		{"other staff", teacher, constants.ErrNoteForbidden},
+		{"student", student, constants.ErrNoteForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profileId, noteId := ptrUUID(), ptrUUID()
+			mockRepo := new(mocks.NoteRepository)
+			mockRepo.On("FetchNoteById", profileId, noteId).
+				Return(&models.Note{ID: noteId, ProfileID: profileId, AuthorID: author.UserID, Visibility: models.NoteVisibilityAll}, nil)
+			mockRepo.On("DeleteNote", mock.AnythingOfType("*models.Note"), tt.viewer.UserID).Return(nil)
+
+			usecase := NewNoteUsecase(mockRepo, new(profileMocks.ProfileUsecase))
+			err := usecase.DeleteNote(profileId, noteId, tt.viewer)
+
+			if tt.err == nil {
+				assert.NoError(t, err)
+				mockRepo.AssertCalled(t, "DeleteNote", mock.Anything, tt.viewer.UserID)
+			} else {
+				assert.ErrorIs(t, err, tt.err)
+				mockRepo.AssertNotCalled(t, "DeleteNote", mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+func TestFetchNoteRevisions_NotVisible(t *testing.T) {
+	profileId, noteId := ptrUUID(), ptrUUID()
+	mockRepo := new(mocks.NoteRepository)
+	mockRepo.On("FetchNoteById", profileId, noteId).
+		Return(&models.Note{ID: noteId, ProfileID: profileId, AuthorID: author.UserID, Visibility: models.NoteVisibilityStaff}, nil)
+
+	usecase := NewNoteUsecase(mockRepo, new(profileMocks.ProfileUsecase))
+	_, err := usecase.FetchNoteRevisions(profileId, noteId, student)
+
+	assert.ErrorIs(t, err, constants.ErrNoteNotFound)
+	mockRepo.AssertNotCalled(t, "FetchNoteRevisions", mock.Anything)
+}
@@ -0,0 +1,24 @@
+package outbox
+
+import (
+	"encoding/json"
+	"log"
+)
+
+type logNotifier[E Event] struct{}
+
+// Notify implements Notifier.
+func (logNotifier[E]) Notify(event E) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Event %s: %s %s", event.EventID(), event.EventType(), body)
+	return nil
+}
+
+// NewLogNotifier returns a notifier that only logs the events, for when no webhook is configured.
+func NewLogNotifier[E Event]() Notifier[E] {
+	return logNotifier[E]{}
+}
@@ -0,0 +1,84 @@
+package outbox
+
+import (
+	"log"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+const (
+	// BatchSize is how many events one delivery round claims at a time
+	BatchSize = 50
+	// Lease is how long a claimed event is held before another instance may deliver it
+	Lease = 5 * time.Minute
+	// MaxAttempts is how many times an event is sent before it is given up
+	MaxAttempts = 10
+	// MaxBackoff caps the wait between two attempts of an event
+	MaxBackoff = 6 * time.Hour
+)
+
+// Event is an event kept in an outbox table until it is delivered outside the service.
+type Event interface {
+	EventID() *uuid.UUID
+	EventType() string
+	DeliveryAttempts() int
+	MarkDelivered(at time.Time)
+	MarkFailed(err error, nextAttemptAt *time.Time)
+}
+
+// Notifier delivers events outside the service, such as to a webhook.
+// An event that fails to be delivered is retried later, so Notify may see it more than once.
+type Notifier[E Event] interface {
+	Notify(event E) error
+}
+
+// Deliver sends the events claim returns until none are left, and stores the
+// outcome of each with update. An event that fails is retried with a growing
+// delay, and given up after MaxAttempts attempts.
+func Deliver[E Event](claim func(leaseUntil time.Time, limit int) ([]E, error), update func(event E) error, notifier Notifier[E]) error {
+	for {
+		events, err := claim(time.Now().Add(Lease), BatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			now := time.Now()
+			if err := notifier.Notify(event); err != nil {
+				log.Printf("Failed to deliver %s event %s (attempt %d): %v", event.EventType(), event.EventID(), event.DeliveryAttempts(), err)
+				var nextAttemptAt *time.Time
+				if event.DeliveryAttempts() < MaxAttempts {
+					next := now.Add(Backoff(event.DeliveryAttempts()))
+					nextAttemptAt = &next
+				}
+				event.MarkFailed(err, nextAttemptAt)
+			} else {
+				event.MarkDelivered(now)
+			}
+
+			if err := update(event); err != nil {
+				return err
+			}
+		}
+
+		if len(events) < BatchSize {
+			return nil
+		}
+	}
+}
+
+// Backoff returns the wait after the attempts-th failed attempt, a minute
+// doubling each time up to MaxBackoff.
+func Backoff(attempts int) time.Duration {
+	if attempts > 10 {
+		return MaxBackoff
+	}
+
+	backoff := time.Minute << (attempts - 1)
+	if backoff > MaxBackoff {
+		return MaxBackoff
+	}
+
+	return backoff
+}
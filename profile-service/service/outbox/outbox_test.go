@@ -0,0 +1,85 @@
+package outbox
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/stretchr/testify/require"
+)
+
+type stubNotifier map[*models.ProfileEvent]error
+
+func (s stubNotifier) Notify(event *models.ProfileEvent) error {
+	return s[event]
+}
+
+func newProfileEvent(attempts int) *models.ProfileEvent {
+	id, _ := uuid.NewV4()
+	return &models.ProfileEvent{ID: &id, Type: models.ProfileEventChangeApproved, EventDelivery: models.EventDelivery{Attempts: attempts}}
+}
+
+func TestDeliver(t *testing.T) {
+	delivered := newProfileEvent(1)
+	retried := newProfileEvent(3)
+	givenUp := newProfileEvent(MaxAttempts)
+	notifier := stubNotifier{
+		retried: errors.New("webhook 503 Service Unavailable"),
+		givenUp: errors.New("webhook 503 Service Unavailable"),
+	}
+
+	var updated []*models.ProfileEvent
+	claim := func(leaseUntil time.Time, limit int) ([]*models.ProfileEvent, error) {
+		require.Equal(t, BatchSize, limit)
+		require.WithinDuration(t, time.Now().Add(Lease), leaseUntil, time.Second)
+		return []*models.ProfileEvent{delivered, retried, givenUp}, nil
+	}
+	update := func(event *models.ProfileEvent) error {
+		updated = append(updated, event)
+		return nil
+	}
+
+	before := time.Now()
+	require.NoError(t, Deliver(claim, update, Notifier[*models.ProfileEvent](notifier)))
+
+	require.NotNil(t, delivered.DeliveredAt)
+	require.Nil(t, delivered.LastError)
+
+	require.Nil(t, retried.DeliveredAt)
+	require.Equal(t, "webhook 503 Service Unavailable", *retried.LastError)
+	require.WithinDuration(t, before.Add(4*time.Minute), *retried.NextAttemptAt, time.Second)
+
+	require.Nil(t, givenUp.DeliveredAt)
+	require.Nil(t, givenUp.NextAttemptAt)
+	require.Len(t, updated, 3)
+}
+
+func TestDeliver_ClaimsUntilEmpty(t *testing.T) {
+	full := make([]*models.ProfileEvent, BatchSize)
+	for i := range full {
+		full[i] = newProfileEvent(1)
+	}
+
+	rounds := 0
+	claim := func(time.Time, int) ([]*models.ProfileEvent, error) {
+		rounds++
+		if rounds == 1 {
+			return full, nil
+		}
+		return nil, nil
+	}
+
+	err := Deliver(claim, func(*models.ProfileEvent) error { return nil }, Notifier[*models.ProfileEvent](stubNotifier{}))
+
+	require.NoError(t, err)
+	require.Equal(t, 2, rounds)
+}
+
+func TestBackoff(t *testing.T) {
+	require.Equal(t, time.Minute, Backoff(1))
+	require.Equal(t, 8*time.Minute, Backoff(4))
+	require.Equal(t, MaxBackoff, Backoff(10))
+	require.Equal(t, MaxBackoff, Backoff(100))
+}
@@ -0,0 +1,92 @@
+package outbox
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// signatureHeader carries the HMAC-SHA256 of the body, keyed with the webhook secret
+	signatureHeader = "X-Webhook-Signature"
+	// webhookErrorBodyLimit is how much of a rejected response is kept in the error
+	webhookErrorBodyLimit = 1024
+)
+
+type WebhookConfig struct {
+	URL string
+	// Secret signs the requests so the receiver can check they come from the service, unsigned when empty
+	Secret  string
+	Timeout time.Duration
+}
+
+type webhookNotifier[E Event] struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+// Notify implements Notifier.
+// The event is posted as JSON, any 2xx response accepts it.
+func (w *webhookNotifier[E]) Notify(event E) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Webhook-Id", event.EventID().String())
+	req.Header.Set("X-Webhook-Event", event.EventType())
+	if w.secret != "" {
+		req.Header.Set(signatureHeader, Sign(w.secret, body))
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, webhookErrorBodyLimit))
+		return fmt.Errorf("webhook %s: %s", resp.Status, strings.TrimSpace(string(message)))
+	}
+
+	return nil
+}
+
+// Sign returns the value of the X-Webhook-Signature header for body, sha256=
+// followed by the hex HMAC-SHA256 of body keyed with secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func NewWebhookNotifier[E Event](config WebhookConfig) (Notifier[E], error) {
+	endpoint, err := url.Parse(config.URL)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" || endpoint.Host == "" {
+		return nil, fmt.Errorf("webhook URL must be an http or https URL")
+	}
+
+	return &webhookNotifier[E]{
+		url:    config.URL,
+		secret: config.Secret,
+		client: &http.Client{Timeout: config.Timeout},
+	}, nil
+}
@@ -0,0 +1,72 @@
+package outbox
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newEvent(t *testing.T) *models.CertificationEvent {
+	id, _ := uuid.NewV4()
+	profileId, _ := uuid.NewV4()
+	expiryDate := time.Date(2026, 5, 31, 0, 0, 0, 0, time.UTC)
+	event, err := models.NewCertificationEvent(&models.Certification{ID: &id, ProfileID: &profileId, Name: "Basic First Aid",
+		Issuer: "Thai Red Cross Society", IssueDate: expiryDate.AddDate(-2, 0, 0), ExpiryDate: &expiryDate}, models.CertificationEventExpiring, 7)
+	require.NoError(t, err)
+	return event
+}
+
+func TestWebhookNotifier_Notify(t *testing.T) {
+	event := newEvent(t)
+
+	var received *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	notifier, err := NewWebhookNotifier[*models.CertificationEvent](WebhookConfig{URL: server.URL, Secret: "s3cret", Timeout: time.Second})
+	require.NoError(t, err)
+	require.NoError(t, notifier.Notify(event))
+
+	assert.Equal(t, http.MethodPost, received.Method)
+	assert.Equal(t, event.ID.String(), received.Header.Get("X-Webhook-Id"))
+	assert.Equal(t, "certification.expiring", received.Header.Get("X-Webhook-Event"))
+	assert.Equal(t, Sign("s3cret", body), received.Header.Get(signatureHeader))
+
+	var payload map[string]any
+	require.NoError(t, json.Unmarshal(body, &payload))
+	assert.Equal(t, "certification.expiring", payload["type"])
+}
+
+func TestWebhookNotifier_Rejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get(signatureHeader))
+		http.Error(w, "try again later", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	notifier, err := NewWebhookNotifier[*models.CertificationEvent](WebhookConfig{URL: server.URL, Timeout: time.Second})
+	require.NoError(t, err)
+
+	err = notifier.Notify(newEvent(t))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "503")
+	assert.Contains(t, err.Error(), "try again later")
+}
+
+func TestNewWebhookNotifier_InvalidURL(t *testing.T) {
+	_, err := NewWebhookNotifier[*models.CertificationEvent](WebhookConfig{URL: "ftp://hooks.example.com"})
+	assert.Error(t, err)
+}
@@ -3,16 +3,24 @@ package handler
 import (
 	"encoding/json"
 	"errors"
+	"log"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gofrs/uuid"
 	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/helper"
 	"github.com/jariwat/p_project/profile-service/models"
 	_profile "github.com/jariwat/p_project/profile-service/service/profile"
 	"github.com/oapi-codegen/runtime/types"
 )
 
+// defaultDuplicateMinScore mirrors the min_score default of GET /profiles/duplicates.
+const defaultDuplicateMinScore = 0.6
+
+// similarCacheControl lets clients reuse similar profiles for a while, skills change rarely.
+const similarCacheControl = "private, max-age=300"
+
 type profileHandler struct {
 	profileUs _profile.ProfileUsecase
 }
@@ -22,6 +30,10 @@ func (p *profileHandler) DeleteProfileId(c *gin.Context, id types.UUID) {
 	var profileId = uuid.FromStringOrNil(id.String())
 
 	if err := p.profileUs.DeleteProfile(&profileId); err != nil {
+		if errors.Is(err, constants.ErrProfileNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -33,8 +45,33 @@ func (p *profileHandler) DeleteProfileId(c *gin.Context, id types.UUID) {
 	c.JSON(http.StatusOK, response)
 }
 
+// isFilterError reports whether err is about a list filter shared by the
+// list, stats and match endpoints.
+func isFilterError(err error) bool {
+	return errors.Is(err, constants.ErrInvalidSkillLevelFilter) || errors.Is(err, constants.ErrInvalidCustomAttributeFilter) ||
+		errors.Is(err, constants.ErrInvalidTag)
+}
+
+// setDisplayNames names the profiles in the language asked for by the
+// Accept-Language header and tells caches the response depends on it.
+func setDisplayNames(c *gin.Context, acceptLanguage *string, profiles ...*models.Profile) {
+	var locale string
+	if acceptLanguage != nil {
+		locale = models.MatchNameLocale(*acceptLanguage)
+	}
+
+	for _, profile := range profiles {
+		profile.SetDisplayName(locale)
+	}
+
+	c.Header("Vary", "Accept-Language")
+	if locale != "" {
+		c.Header("Content-Language", locale)
+	}
+}
+
 // GetProfileId implements profile.ServerInterface.
-func (p *profileHandler) GetProfileId(c *gin.Context, id types.UUID) {
+func (p *profileHandler) GetProfileId(c *gin.Context, id types.UUID, params _profile.GetProfileIdParams) {
 	var profileId = uuid.FromStringOrNil(id.String())
 
 	profile, err := p.profileUs.FetchProfileById(&profileId)
@@ -44,10 +81,31 @@ func (p *profileHandler) GetProfileId(c *gin.Context, id types.UUID) {
 	}
 
 	if profile == nil {
+		merge, err := p.profileUs.FetchProfileMerge(&profileId)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		if merge != nil {
+			c.Header("Location", "/profile/"+merge.SurvivorID.String())
+			c.JSON(http.StatusMovedPermanently, gin.H{"error": "Profile was merged into " + merge.SurvivorID.String()})
+			return
+		}
+
 		c.JSON(http.StatusNotFound, gin.H{"error": "Profile not found"})
 		return
 	}
 
+	if params.Include != nil {
+		if err := p.profileUs.IncludeProfileHistory(profile, *params.Include); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	setDisplayNames(c, params.AcceptLanguage, profile)
+
 	var data _profile.Profile
 	bu, err := json.Marshal(profile)
 	if err != nil {
@@ -78,6 +136,10 @@ func (p *profileHandler) GetProfiles(c *gin.Context, params _profile.GetProfiles
 
 	profiles, err := p.profileUs.FetchProfiles(params, paginator)
 	if err != nil {
+		if isFilterError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -87,6 +149,8 @@ func (p *profileHandler) GetProfiles(c *gin.Context, params _profile.GetProfiles
 		return
 	}
 
+	setDisplayNames(c, params.AcceptLanguage, profiles...)
+
 	var data []_profile.Profiles
 	bu, err := json.Marshal(profiles)
 	if err != nil {
@@ -111,17 +175,68 @@ func (p *profileHandler) GetProfiles(c *gin.Context, params _profile.GetProfiles
 }
 
 // PostProfile implements profile.ServerInterface.
-func (p *profileHandler) PostProfile(c *gin.Context) {
+func (p *profileHandler) PostProfile(c *gin.Context, params _profile.PostProfileParams) {
 	var newProfile _profile.UpsertProfile
 	if err := c.ShouldBindJSON(&newProfile); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
 		return
 	}
 
+	var userId, idempotencyKey, requestHash string
+	if params.IdempotencyKey != nil && *params.IdempotencyKey != "" {
+		idempotencyKey = *params.IdempotencyKey
+
+		// keys are kept per user, so two users picking the same key do not collide
+		if params.XUserId == nil || *params.XUserId == "" {
+			c.JSON(http.StatusBadRequest, gin.H{"error": constants.ErrIdempotencyKeyNoUser.Error()})
+			return
+		}
+		userId = *params.XUserId
+
+		var err error
+		requestHash, err = helper.HashJSON(newProfile)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to hash request"})
+			return
+		}
+
+		stored, err := p.profileUs.ReserveIdempotencyKey(userId, idempotencyKey, requestHash)
+		if err != nil {
+			if errors.Is(err, constants.ErrIdempotencyKeyMismatch) {
+				c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+				return
+			}
+			if errors.Is(err, constants.ErrIdempotencyKeyInProgress) {
+				c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+				return
+			}
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		if stored != nil {
+			c.Header("Idempotent-Replayed", "true")
+			c.Data(stored.ResponseStatus, "application/json; charset=utf-8", stored.ResponseBody)
+			return
+		}
+	}
+
 	var profile = new(models.Profile)
 	profile.GenUUID()
 	if err := p.profileUs.CreateProfile(profile, newProfile); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		status := upsertErrorStatus(err)
+		if status >= http.StatusInternalServerError {
+			// a server error may not happen again, so a retry runs the request again
+			if idempotencyKey != "" {
+				if err := p.profileUs.ReleaseIdempotencyKey(userId, idempotencyKey, requestHash); err != nil {
+					log.Printf("Failed to release idempotency key %s: %v", idempotencyKey, err)
+				}
+			}
+			c.JSON(status, gin.H{"error": err.Error()})
+			return
+		}
+		// a rejected request is final too, its retries get the same rejection
+		p.respondIdempotent(c, userId, idempotencyKey, requestHash, status, gin.H{"error": err.Error()})
 		return
 	}
 
@@ -130,34 +245,826 @@ func (p *profileHandler) PostProfile(c *gin.Context) {
 		Id:      (*types.UUID)(profile.ID),
 	}
 
-	c.JSON(http.StatusOK, response)
+	p.respondIdempotent(c, userId, idempotencyKey, requestHash, http.StatusOK, response)
 }
 
-// PutProfileId implements profile.ServerInterface.
-func (p *profileHandler) PutProfileId(c *gin.Context, id types.UUID) {
-	var profileId = uuid.FromStringOrNil(id.String())
+// respondIdempotent sends the response of a request and stores it as the
+// outcome of its idempotency key when it has one, retries of the request are
+// answered with it.
+func (p *profileHandler) respondIdempotent(c *gin.Context, userId string, idempotencyKey string, requestHash string, status int, response interface{}) {
+	if idempotencyKey != "" {
+		bu, err := json.Marshal(response)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal response"})
+			return
+		}
 
-	var updateProfile _profile.UpsertProfile
-	if err := c.ShouldBindJSON(&updateProfile); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
+		// the request already ran at this point, so a failure to store the
+		// response is only logged, retries get a conflict until the key lapses
+		if err := p.profileUs.SaveIdempotencyKey(userId, idempotencyKey, requestHash, status, bu); err != nil {
+			log.Printf("Failed to save idempotency key %s: %v", idempotencyKey, err)
+		}
+	}
+
+	c.JSON(status, response)
+}
+
+// GetGenders implements profile.ServerInterface.
+func (p *profileHandler) GetGenders(c *gin.Context) {
+	genders, err := p.profileUs.FetchGenders()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var data []_profile.GenderOption
+	bu, err := json.Marshal(genders)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal genders"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal genders"})
 		return
 	}
 
-	if err := p.profileUs.UpdateProfile(&profileId, updateProfile); err != nil {
+	c.JSON(http.StatusOK, _profile.GendersResponse{Data: &data})
+}
+
+// GetProfileIdEnrollments implements profile.ServerInterface.
+func (p *profileHandler) GetProfileIdEnrollments(c *gin.Context, id types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+
+	enrollments, err := p.profileUs.FetchEnrollments(&profileId)
+	if err != nil {
 		if errors.Is(err, constants.ErrProfileNotFound) {
-			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			c.JSON(http.StatusNotFound, gin.H{"error": "Profile not found"})
 			return
 		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	response := _profile.Success{
-		Message: "Profile updated successfully",
-		Id:      (*types.UUID)(&profileId),
+	var data []_profile.Enrollment
+	bu, err := json.Marshal(enrollments)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal enrollments"})
+		return
 	}
 
-	c.JSON(http.StatusOK, response)
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal enrollments"})
+		return
+	}
+
+	c.JSON(http.StatusOK, _profile.EnrollmentsResponse{Data: &data})
+}
+
+// GetProfileIdContacts implements profile.ServerInterface.
+func (p *profileHandler) GetProfileIdContacts(c *gin.Context, id types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+
+	contacts, err := p.profileUs.FetchContacts(&profileId)
+	if err != nil {
+		respondContactError(c, err)
+		return
+	}
+
+	var data []_profile.Contact
+	bu, err := json.Marshal(contacts)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal contacts"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal contacts"})
+		return
+	}
+
+	c.JSON(http.StatusOK, _profile.ContactsResponse{Data: &data})
+}
+
+// PostProfileIdContacts implements profile.ServerInterface.
+func (p *profileHandler) PostProfileIdContacts(c *gin.Context, id types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+
+	var request _profile.UpsertContact
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	contact, err := p.profileUs.CreateContact(&profileId, request)
+	if err != nil {
+		respondContactError(c, err)
+		return
+	}
+
+	respondContact(c, http.StatusCreated, contact)
+}
+
+// PutProfileIdContactsContactId implements profile.ServerInterface.
+func (p *profileHandler) PutProfileIdContactsContactId(c *gin.Context, id types.UUID, contactId types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+	var updateContactId = uuid.FromStringOrNil(contactId.String())
+
+	var request _profile.UpsertContact
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	contact, err := p.profileUs.UpdateContact(&profileId, &updateContactId, request)
+	if err != nil {
+		respondContactError(c, err)
+		return
+	}
+
+	respondContact(c, http.StatusOK, contact)
+}
+
+// PostProfileIdContactsContactIdVerify implements profile.ServerInterface.
+func (p *profileHandler) PostProfileIdContactsContactIdVerify(c *gin.Context, id types.UUID, contactId types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+	var verifyContactId = uuid.FromStringOrNil(contactId.String())
+
+	contact, err := p.profileUs.VerifyContact(&profileId, &verifyContactId)
+	if err != nil {
+		respondContactError(c, err)
+		return
+	}
+
+	respondContact(c, http.StatusOK, contact)
+}
+
+// DeleteProfileIdContactsContactId implements profile.ServerInterface.
+func (p *profileHandler) DeleteProfileIdContactsContactId(c *gin.Context, id types.UUID, contactId types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+	var deleteContactId = uuid.FromStringOrNil(contactId.String())
+
+	if err := p.profileUs.DeleteContact(&profileId, &deleteContactId); err != nil {
+		respondContactError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, _profile.Success{Message: "Contact deleted successfully"})
+}
+
+func respondContact(c *gin.Context, status int, contact *models.Contact) {
+	var data _profile.Contact
+	bu, err := json.Marshal(contact)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal contact"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal contact"})
+		return
+	}
+
+	c.JSON(status, _profile.ContactResponse{Data: &data})
+}
+
+func respondContactError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, constants.ErrProfileNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Profile not found"})
+	case errors.Is(err, constants.ErrContactNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Contact not found"})
+	case errors.Is(err, constants.ErrInvalidContactType), errors.Is(err, constants.ErrInvalidEmail),
+		errors.Is(err, constants.ErrInvalidPhone), errors.Is(err, constants.ErrInvalidGuardianContact):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrContactEmailConflict):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// GetProfileIdEducation implements profile.ServerInterface.
+func (p *profileHandler) GetProfileIdEducation(c *gin.Context, id types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+
+	education, err := p.profileUs.FetchEducation(&profileId)
+	if err != nil {
+		respondHistoryError(c, err)
+		return
+	}
+
+	var data []_profile.Education
+	bu, err := json.Marshal(education)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal education"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal education"})
+		return
+	}
+
+	c.JSON(http.StatusOK, _profile.EducationListResponse{Data: &data})
+}
+
+// PostProfileIdEducation implements profile.ServerInterface.
+func (p *profileHandler) PostProfileIdEducation(c *gin.Context, id types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+
+	var request _profile.UpsertEducation
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	education, err := p.profileUs.CreateEducation(&profileId, request)
+	if err != nil {
+		respondHistoryError(c, err)
+		return
+	}
+
+	respondEducation(c, http.StatusCreated, education)
+}
+
+// PutProfileIdEducationEducationId implements profile.ServerInterface.
+func (p *profileHandler) PutProfileIdEducationEducationId(c *gin.Context, id types.UUID, educationId types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+	var updateEducationId = uuid.FromStringOrNil(educationId.String())
+
+	var request _profile.UpsertEducation
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	education, err := p.profileUs.UpdateEducation(&profileId, &updateEducationId, request)
+	if err != nil {
+		respondHistoryError(c, err)
+		return
+	}
+
+	respondEducation(c, http.StatusOK, education)
+}
+
+// DeleteProfileIdEducationEducationId implements profile.ServerInterface.
+func (p *profileHandler) DeleteProfileIdEducationEducationId(c *gin.Context, id types.UUID, educationId types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+	var deleteEducationId = uuid.FromStringOrNil(educationId.String())
+
+	if err := p.profileUs.DeleteEducation(&profileId, &deleteEducationId); err != nil {
+		respondHistoryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, _profile.Success{Message: "Education deleted successfully"})
+}
+
+func respondEducation(c *gin.Context, status int, education *models.Education) {
+	var data _profile.Education
+	bu, err := json.Marshal(education)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal education"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal education"})
+		return
+	}
+
+	c.JSON(status, _profile.EducationResponse{Data: &data})
+}
+
+// GetProfileIdExperience implements profile.ServerInterface.
+func (p *profileHandler) GetProfileIdExperience(c *gin.Context, id types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+
+	experience, err := p.profileUs.FetchExperience(&profileId)
+	if err != nil {
+		respondHistoryError(c, err)
+		return
+	}
+
+	var data []_profile.Experience
+	bu, err := json.Marshal(experience)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal experience"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal experience"})
+		return
+	}
+
+	c.JSON(http.StatusOK, _profile.ExperienceListResponse{Data: &data})
+}
+
+// PostProfileIdExperience implements profile.ServerInterface.
+func (p *profileHandler) PostProfileIdExperience(c *gin.Context, id types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+
+	var request _profile.UpsertExperience
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	experience, err := p.profileUs.CreateExperience(&profileId, request)
+	if err != nil {
+		respondHistoryError(c, err)
+		return
+	}
+
+	respondExperience(c, http.StatusCreated, experience)
+}
+
+// PutProfileIdExperienceExperienceId implements profile.ServerInterface.
+func (p *profileHandler) PutProfileIdExperienceExperienceId(c *gin.Context, id types.UUID, experienceId types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+	var updateExperienceId = uuid.FromStringOrNil(experienceId.String())
+
+	var request _profile.UpsertExperience
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	experience, err := p.profileUs.UpdateExperience(&profileId, &updateExperienceId, request)
+	if err != nil {
+		respondHistoryError(c, err)
+		return
+	}
+
+	respondExperience(c, http.StatusOK, experience)
+}
+
+// DeleteProfileIdExperienceExperienceId implements profile.ServerInterface.
+func (p *profileHandler) DeleteProfileIdExperienceExperienceId(c *gin.Context, id types.UUID, experienceId types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+	var deleteExperienceId = uuid.FromStringOrNil(experienceId.String())
+
+	if err := p.profileUs.DeleteExperience(&profileId, &deleteExperienceId); err != nil {
+		respondHistoryError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, _profile.Success{Message: "Experience deleted successfully"})
+}
+
+func respondExperience(c *gin.Context, status int, experience *models.Experience) {
+	var data _profile.Experience
+	bu, err := json.Marshal(experience)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal experience"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal experience"})
+		return
+	}
+
+	c.JSON(status, _profile.ExperienceResponse{Data: &data})
+}
+
+func respondHistoryError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, constants.ErrProfileNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "Profile not found"})
+	case errors.Is(err, constants.ErrEducationNotFound), errors.Is(err, constants.ErrExperienceNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrInvalidDateRange):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrEducationOverlap), errors.Is(err, constants.ErrExperienceOverlap):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// GetProfileIdSimilar implements profile.ServerInterface.
+func (p *profileHandler) GetProfileIdSimilar(c *gin.Context, id types.UUID, params _profile.GetProfileIdSimilarParams) {
+	var profileId = uuid.FromStringOrNil(id.String())
+
+	similar, err := p.profileUs.FetchSimilarProfiles(&profileId, params)
+	if err != nil {
+		if errors.Is(err, constants.ErrProfileNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "Profile not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var data []_profile.SimilarProfile
+	bu, err := json.Marshal(similar)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal similar profiles"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal similar profiles"})
+		return
+	}
+
+	response := _profile.SimilarProfilesResponse{
+		Data: &data,
+	}
+
+	hash, err := helper.HashJSON(response)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to hash similar profiles"})
+		return
+	}
+	etag := `"` + hash + `"`
+
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", similarCacheControl)
+	if params.IfNoneMatch != nil && *params.IfNoneMatch == etag {
+		c.AbortWithStatus(http.StatusNotModified)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// upsertErrorStatus is the status of the response to a profile that cannot be saved.
+func upsertErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, constants.ErrUnknownClass), errors.Is(err, constants.ErrUnknownGender),
+		errors.Is(err, constants.ErrDuplicateNameLocale), errors.Is(err, constants.ErrInvalidCustomAttributes),
+		errors.Is(err, constants.ErrInvalidInitialStatus):
+		return http.StatusBadRequest
+	case errors.Is(err, constants.ErrExternalIdConflict), errors.Is(err, constants.ErrClassFull),
+		errors.Is(err, constants.ErrInvalidStatusTransition), errors.Is(err, constants.ErrPromotionBeforeEnrollment):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// PutProfileId implements profile.ServerInterface.
+// The first name, last name, names in other languages and class of an existing
+// profile changed by a user other than a staff member wait for approval as a
+// change request.
+func (p *profileHandler) PutProfileId(c *gin.Context, id types.UUID, params _profile.PutProfileIdParams) {
+	var profileId = uuid.FromStringOrNil(id.String())
+
+	var upsertProfile _profile.UpsertProfile
+	if err := c.ShouldBindJSON(&upsertProfile); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
+		return
+	}
+
+	request, err := p.profileUs.UpdateProfileWithApproval(&profileId, upsertProfile, models.NewViewer(params.XUserId, params.XUserRole))
+	if errors.Is(err, constants.ErrProfileNotFound) {
+		// a new profile has no name or class to approve a change of yet
+		p.putProfile(c, &profileId, upsertProfile)
+		return
+	}
+	if err != nil {
+		c.JSON(upsertErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	if request == nil {
+		c.JSON(http.StatusOK, _profile.Success{
+			Message: "Profile updated successfully",
+			Id:      (*types.UUID)(&profileId),
+		})
+		return
+	}
+
+	respondChangeRequest(c, http.StatusAccepted, request)
+}
+
+func (p *profileHandler) putProfile(c *gin.Context, profileId *uuid.UUID, upsertProfile _profile.UpsertProfile) {
+	created, err := p.profileUs.UpsertProfile(profileId, upsertProfile)
+	if err != nil {
+		c.JSON(upsertErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	if created {
+		c.JSON(http.StatusCreated, _profile.Success{
+			Message: "Profile created successfully",
+			Id:      (*types.UUID)(profileId),
+		})
+		return
+	}
+
+	response := _profile.Success{
+		Message: "Profile updated successfully",
+		Id:      (*types.UUID)(profileId),
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// PostProfilesBatch implements profile.ServerInterface.
+func (p *profileHandler) PostProfilesBatch(c *gin.Context, params _profile.PostProfilesBatchParams) {
+	var batch _profile.ProfileBatchRequest
+	if err := c.ShouldBindJSON(&batch); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
+		return
+	}
+
+	var atomic bool
+	if params.Atomic != nil {
+		atomic = *params.Atomic
+	}
+
+	result, err := p.profileUs.ExecuteBatch(batch.Operations, atomic, models.NewViewer(params.XUserId, params.XUserRole))
+	if err != nil {
+		if errors.Is(err, constants.ErrBatchTooLarge) {
+			c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var response _profile.ProfileBatchResponse
+	bu, err := json.Marshal(result)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal batch result"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &response); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal batch result"})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetProfilesStats implements profile.ServerInterface.
+func (p *profileHandler) GetProfilesStats(c *gin.Context, params _profile.GetProfilesStatsParams) {
+	stats, err := p.profileUs.FetchProfileStats(params)
+	if err != nil {
+		if isFilterError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var data _profile.ProfileStats
+	bu, err := json.Marshal(stats)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal stats"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal stats"})
+		return
+	}
+
+	c.JSON(http.StatusOK, _profile.ProfileStatsResponse{Data: &data})
+}
+
+// GetProfilesDuplicates implements profile.ServerInterface.
+func (p *profileHandler) GetProfilesDuplicates(c *gin.Context, params _profile.GetProfilesDuplicatesParams) {
+	var page, perPage int
+	if params.Page != nil && params.PerPage != nil {
+		page = *params.Page
+		perPage = *params.PerPage
+	}
+	var paginator = models.NewPaginator(page, perPage)
+
+	var minScore = defaultDuplicateMinScore
+	if params.MinScore != nil {
+		minScore = *params.MinScore
+	}
+
+	duplicates, err := p.profileUs.FetchDuplicates(minScore, paginator)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var data []_profile.ProfileDuplicate
+	bu, err := json.Marshal(duplicates)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal duplicates"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal duplicates"})
+		return
+	}
+
+	response := _profile.ProfileDuplicatesPaginationResponse{
+		Data:       &data,
+		Page:       &paginator.Page,
+		PerPage:    &paginator.PerPage,
+		TotalPages: &paginator.TotalPages,
+		TotalRows:  &paginator.TotalRows,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// PostProfilesMatch implements profile.ServerInterface.
+func (p *profileHandler) PostProfilesMatch(c *gin.Context, params _profile.PostProfilesMatchParams) {
+	var page, perPage int
+	if params.Page != nil && params.PerPage != nil {
+		page = *params.Page
+		perPage = *params.PerPage
+	}
+	var paginator = models.NewPaginator(page, perPage)
+
+	var request _profile.ProfileMatchRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
+		return
+	}
+
+	matches, err := p.profileUs.MatchProfiles(params, request, paginator)
+	if err != nil {
+		if errors.Is(err, constants.ErrInvalidMatchRequest) || isFilterError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var data []_profile.ProfileMatch
+	bu, err := json.Marshal(matches)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal matches"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal matches"})
+		return
+	}
+
+	response := _profile.ProfileMatchPaginationResponse{
+		Data:       &data,
+		Page:       &paginator.Page,
+		PerPage:    &paginator.PerPage,
+		TotalPages: &paginator.TotalPages,
+		TotalRows:  &paginator.TotalRows,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// PostProfilesMerge implements profile.ServerInterface.
+func (p *profileHandler) PostProfilesMerge(c *gin.Context, params _profile.PostProfilesMergeParams) {
+	var request _profile.ProfileMergeRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
+		return
+	}
+
+	merge, err := p.profileUs.MergeProfiles(request, models.NewViewer(params.XUserId, params.XUserRole))
+	if err != nil {
+		if errors.Is(err, constants.ErrMergeSameProfile) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, constants.ErrMergeForbidden) {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+		if errors.Is(err, constants.ErrProfileNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var data _profile.ProfileMerge
+	bu, err := json.Marshal(merge)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal merge"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal merge"})
+		return
+	}
+
+	response := _profile.ProfileMergeResponse{
+		Data: &data,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// changeRequestErrorStatus is the status of the response to a change request
+// that cannot be reviewed.
+func changeRequestErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, constants.ErrChangeRequestForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, constants.ErrChangeRequestNotFound), errors.Is(err, constants.ErrProfileNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, constants.ErrChangeRequestResolved):
+		return http.StatusConflict
+	default:
+		return upsertErrorStatus(err)
+	}
+}
+
+func respondChangeRequest(c *gin.Context, status int, request *models.ChangeRequest) {
+	var data _profile.ChangeRequest
+	bu, err := json.Marshal(request)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal change request"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal change request"})
+		return
+	}
+
+	c.JSON(status, _profile.ChangeRequestResponse{Data: &data})
+}
+
+// GetProfilesChangeRequests implements profile.ServerInterface.
+func (p *profileHandler) GetProfilesChangeRequests(c *gin.Context, params _profile.GetProfilesChangeRequestsParams) {
+	var page, perPage int
+	if params.Page != nil && params.PerPage != nil {
+		page = *params.Page
+		perPage = *params.PerPage
+	}
+	var paginator = models.NewPaginator(page, perPage)
+
+	requests, err := p.profileUs.FetchChangeRequests(models.NewViewer(params.XUserId, params.XUserRole), params, paginator)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var data []_profile.ChangeRequest
+	bu, err := json.Marshal(requests)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal change requests"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal change requests"})
+		return
+	}
+
+	response := _profile.ChangeRequestsPaginationResponse{
+		Data:       &data,
+		Page:       &paginator.Page,
+		PerPage:    &paginator.PerPage,
+		TotalPages: &paginator.TotalPages,
+		TotalRows:  &paginator.TotalRows,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// PostProfilesChangeRequestsIdApprove implements profile.ServerInterface.
+func (p *profileHandler) PostProfilesChangeRequestsIdApprove(c *gin.Context, id types.UUID, params _profile.PostProfilesChangeRequestsIdApproveParams) {
+	var requestId = uuid.FromStringOrNil(id.String())
+
+	request, err := p.profileUs.ApproveChangeRequest(&requestId, models.NewViewer(params.XUserId, params.XUserRole))
+	if err != nil {
+		c.JSON(changeRequestErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	respondChangeRequest(c, http.StatusOK, request)
+}
+
+// PostProfilesChangeRequestsIdReject implements profile.ServerInterface.
+func (p *profileHandler) PostProfilesChangeRequestsIdReject(c *gin.Context, id types.UUID, params _profile.PostProfilesChangeRequestsIdRejectParams) {
+	var requestId = uuid.FromStringOrNil(id.String())
+
+	var rejection _profile.RejectChangeRequest
+	if err := c.ShouldBindJSON(&rejection); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
+		return
+	}
+
+	request, err := p.profileUs.RejectChangeRequest(&requestId, models.NewViewer(params.XUserId, params.XUserRole), rejection)
+	if err != nil {
+		c.JSON(changeRequestErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	respondChangeRequest(c, http.StatusOK, request)
 }
 
 func NewProfileHandler(profileUs _profile.ProfileUsecase) _profile.ServerInterface {
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/jariwat/p_project/profile-service/constants"
 	"github.com/jariwat/p_project/profile-service/models"
@@ -26,6 +27,10 @@ func ptrUUID() *uuid.UUID {
 	return &id
 }
 
+func strPtr(s string) *string {
+	return &s
+}
+
 func TestDeleteProfileId_Success(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -52,6 +57,28 @@ func TestDeleteProfileId_Success(t *testing.T) {
 	mockUsecase.AssertExpectations(t)
 }
 
+func TestDeleteProfileId_NotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockUsecase := new(mocks.ProfileUsecase)
+
+	profileID := ptrUUID()
+	mockUsecase.
+		On("DeleteProfile", mock.AnythingOfType("*uuid.UUID")).
+		Return(constants.ErrProfileNotFound)
+
+	req := httptest.NewRequest(http.MethodDelete, "/profile/"+profileID.String(), nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Params = gin.Params{{Key: "id", Value: profileID.String()}}
+	c.Request = req
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.DeleteProfileId(c, (types.UUID)(*profileID))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
 func TestDeleteProfileId_Error(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -100,7 +127,7 @@ func TestGetProfileId_Success(t *testing.T) {
 	c.Request = req
 
 	handler := NewProfileHandler(mockUsecase)
-	handler.GetProfileId(c, (types.UUID)(*profileID))
+	handler.GetProfileId(c, (types.UUID)(*profileID), _profile.GetProfileIdParams{})
 
 	assert.Equal(t, http.StatusOK, w.Code)
 
@@ -121,6 +148,9 @@ func TestGetProfileId_NotFound(t *testing.T) {
 	mockUsecase.
 		On("FetchProfileById", mock.AnythingOfType("*uuid.UUID")).
 		Return(nil, nil)
+	mockUsecase.
+		On("FetchProfileMerge", mock.AnythingOfType("*uuid.UUID")).
+		Return(nil, nil)
 
 	req := httptest.NewRequest(http.MethodGet, "/profile/"+profileID.String(), nil)
 	w := httptest.NewRecorder()
@@ -128,12 +158,39 @@ func TestGetProfileId_NotFound(t *testing.T) {
 	c.Request = req
 
 	handler := NewProfileHandler(mockUsecase)
-	handler.GetProfileId(c, (types.UUID)(*profileID))
+	handler.GetProfileId(c, (types.UUID)(*profileID), _profile.GetProfileIdParams{})
 
 	assert.Equal(t, http.StatusNotFound, w.Code)
 	assert.Contains(t, w.Body.String(), "Profile not found")
 }
 
+func TestGetProfileId_Merged(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockUsecase := new(mocks.ProfileUsecase)
+
+	profileID := ptrUUID()
+	survivorID := ptrUUID()
+
+	mockUsecase.
+		On("FetchProfileById", profileID).
+		Return(nil, nil)
+	mockUsecase.
+		On("FetchProfileMerge", profileID).
+		Return(&models.ProfileMerge{MergedID: profileID, SurvivorID: survivorID}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/profile/"+profileID.String(), nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.GetProfileId(c, (types.UUID)(*profileID), _profile.GetProfileIdParams{})
+
+	assert.Equal(t, http.StatusMovedPermanently, w.Code)
+	assert.Equal(t, "/profile/"+survivorID.String(), w.Header().Get("Location"))
+}
+
 func TestGetProfileId_FetchError(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -151,7 +208,7 @@ func TestGetProfileId_FetchError(t *testing.T) {
 	c.Request = req
 
 	handler := NewProfileHandler(mockUsecase)
-	handler.GetProfileId(c, (types.UUID)(*profileID))
+	handler.GetProfileId(c, (types.UUID)(*profileID), _profile.GetProfileIdParams{})
 
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
 	assert.Contains(t, w.Body.String(), "fetch error")
@@ -251,6 +308,32 @@ func TestGetProfiles_FetchError(t *testing.T) {
 	assert.Equal(t, http.StatusInternalServerError, w.Code)
 }
 
+func TestGetProfiles_InvalidSkillLevel(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockUsecase := new(mocks.ProfileUsecase)
+
+	skillLevel := []string{"Go>=9"}
+	params := _profile.GetProfilesParams{
+		SkillLevel: &skillLevel,
+	}
+
+	mockUsecase.
+		On("FetchProfiles", params, mock.AnythingOfType("*models.Paginator")).
+		Return(nil, constants.ErrInvalidSkillLevelFilter)
+
+	req := httptest.NewRequest(http.MethodGet, "/profiles?skill_level=Go%3E%3D9", nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.GetProfiles(c, params)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	mockUsecase.AssertExpectations(t)
+}
+
 func TestPostProfile_Success(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
@@ -258,7 +341,7 @@ func TestPostProfile_Success(t *testing.T) {
 	newProfile := _profile.UpsertProfile{
 		FirstName: "ทดสอบ",
 		LastName:  "ทดสอบ",
-		Class:     "ทดสอบ",
+		Class:     strPtr("ทดสอบ"),
 		Gender:    "MALE",
 		Skills: []_profile.UpsertSkill{
 			{
@@ -296,7 +379,7 @@ func TestPostProfile_Success(t *testing.T) {
 
 	// Call handler
 	handler := NewProfileHandler(mockUsecase)
-	handler.PostProfile(c)
+	handler.PostProfile(c, _profile.PostProfileParams{})
 
 	// Assertions
 	require.Equal(t, http.StatusOK, w.Code)
@@ -325,7 +408,7 @@ func TestPostProfile_InvalidInput(t *testing.T) {
 	// Setup mock and handler
 	mockUsecase := new(mocks.ProfileUsecase)
 	handler := NewProfileHandler(mockUsecase)
-	handler.PostProfile(c)
+	handler.PostProfile(c, _profile.PostProfileParams{})
 
 	require.Equal(t, http.StatusBadRequest, w.Code)
 
@@ -341,7 +424,7 @@ func TestPostProfile_InternalError(t *testing.T) {
 	newProfile := _profile.UpsertProfile{
 		FirstName: "ทดสอบ",
 		LastName:  "ทดสอบ",
-		Class:     "ทดสอบ",
+		Class:     strPtr("ทดสอบ"),
 		Gender:    "MALE",
 		Skills: []_profile.UpsertSkill{
 			{
@@ -366,7 +449,7 @@ func TestPostProfile_InternalError(t *testing.T) {
 		Return(errors.New("create error"))
 
 	handler := NewProfileHandler(mockUsecase)
-	handler.PostProfile(c)
+	handler.PostProfile(c, _profile.PostProfileParams{})
 
 	require.Equal(t, http.StatusInternalServerError, w.Code)
 
@@ -382,7 +465,7 @@ func TestPutProfileId_Success(t *testing.T) {
 	updateProfile := _profile.UpsertProfile{
 		FirstName: "ทดสอบ",
 		LastName:  "ทดสอบ",
-		Class:     "ทดสอบ",
+		Class:     strPtr("ทดสอบ"),
 		Gender:    "MALE",
 		Skills: []_profile.UpsertSkill{
 			{
@@ -403,12 +486,12 @@ func TestPutProfileId_Success(t *testing.T) {
 
 	mockUsecase := new(mocks.ProfileUsecase)
 
-	mockUsecase.On("UpdateProfile", mock.MatchedBy(func(pID *uuid.UUID) bool {
+	mockUsecase.On("UpdateProfileWithApproval", mock.MatchedBy(func(pID *uuid.UUID) bool {
 		return *pID == *profileId
-	}), updateProfile).Return(nil)
+	}), updateProfile, staffViewer).Return(nil, nil)
 
 	handler := NewProfileHandler(mockUsecase)
-	handler.PutProfileId(c, (types.UUID)(*profileId))
+	handler.PutProfileId(c, (types.UUID)(*profileId), staffParams())
 
 	require.Equal(t, http.StatusOK, w.Code)
 
@@ -436,7 +519,7 @@ func TestPutProfileId_InvalidInput(t *testing.T) {
 
 	mockUsecase := new(mocks.ProfileUsecase)
 	handler := NewProfileHandler(mockUsecase)
-	handler.PutProfileId(c, (types.UUID)(*profileId))
+	handler.PutProfileId(c, (types.UUID)(*profileId), staffParams())
 
 	require.Equal(t, http.StatusBadRequest, w.Code)
 
@@ -445,14 +528,14 @@ func TestPutProfileId_InvalidInput(t *testing.T) {
 	assert.Equal(t, "Invalid input", resp["error"])
 }
 
-func TestPutProfileId_NotFound(t *testing.T) {
+func TestPutProfileId_Created(t *testing.T) {
 	gin.SetMode(gin.TestMode)
 
 	profileId := ptrUUID()
-	updateProfile := _profile.UpsertProfile{
+	upsertProfile := _profile.UpsertProfile{
 		FirstName: "ทดสอบ",
 		LastName:  "ทดสอบ",
-		Class:     "ทดสอบ",
+		Class:     strPtr("ทดสอบ"),
 		Gender:    "MALE",
 		Skills: []_profile.UpsertSkill{
 			{
@@ -461,7 +544,7 @@ func TestPutProfileId_NotFound(t *testing.T) {
 			},
 		},
 	}
-	body, _ := json.Marshal(updateProfile)
+	body, _ := json.Marshal(upsertProfile)
 
 	req, _ := http.NewRequest(http.MethodPut, "/profile/"+profileId.String(), bytes.NewBuffer(body))
 	req.Header.Set("Content-Type", "application/json")
@@ -474,17 +557,61 @@ func TestPutProfileId_NotFound(t *testing.T) {
 	mockUsecase := new(mocks.ProfileUsecase)
 
 	mockUsecase.
-		On("UpdateProfile", mock.AnythingOfType("*uuid.UUID"), updateProfile).
-		Return(constants.ErrProfileNotFound)
+		On("UpdateProfileWithApproval", mock.AnythingOfType("*uuid.UUID"), upsertProfile, staffViewer).
+		Return(nil, constants.ErrProfileNotFound)
+	mockUsecase.
+		On("UpsertProfile", mock.AnythingOfType("*uuid.UUID"), upsertProfile).
+		Return(true, nil)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.PutProfileId(c, (types.UUID)(*profileId), staffParams())
+
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var resp _profile.Success
+	err := json.Unmarshal(w.Body.Bytes(), &resp)
+	require.NoError(t, err)
+	assert.Equal(t, "Profile created successfully", resp.Message)
+	assert.Equal(t, profileId.String(), resp.Id.String())
+}
+
+func TestPutProfileId_ExternalIdConflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileId := ptrUUID()
+	externalId := "STU-000123"
+	upsertProfile := _profile.UpsertProfile{
+		ExternalId: &externalId,
+		FirstName:  "ทดสอบ",
+		LastName:   "ทดสอบ",
+		Class:      strPtr("ทดสอบ"),
+		Gender:     "MALE",
+		Skills:     []_profile.UpsertSkill{},
+	}
+	body, _ := json.Marshal(upsertProfile)
+
+	req, _ := http.NewRequest(http.MethodPut, "/profile/"+profileId.String(), bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+	c.Params = gin.Params{gin.Param{Key: "id", Value: profileId.String()}}
+
+	mockUsecase := new(mocks.ProfileUsecase)
+
+	mockUsecase.
+		On("UpdateProfileWithApproval", mock.AnythingOfType("*uuid.UUID"), upsertProfile, staffViewer).
+		Return(nil, constants.ErrExternalIdConflict)
 
 	handler := NewProfileHandler(mockUsecase)
-	handler.PutProfileId(c, (types.UUID)(*profileId))
+	handler.PutProfileId(c, (types.UUID)(*profileId), staffParams())
 
 	require.Equal(t, http.StatusConflict, w.Code)
 
 	var resp map[string]string
 	_ = json.Unmarshal(w.Body.Bytes(), &resp)
-	assert.Equal(t, constants.ErrProfileNotFound.Error(), resp["error"])
+	assert.Equal(t, constants.ErrExternalIdConflict.Error(), resp["error"])
 }
 
 func TestPutProfileId_InternalError(t *testing.T) {
@@ -494,7 +621,7 @@ func TestPutProfileId_InternalError(t *testing.T) {
 	updateProfile := _profile.UpsertProfile{
 		FirstName: "ทดสอบ",
 		LastName:  "ทดสอบ",
-		Class:     "ทดสอบ",
+		Class:     strPtr("ทดสอบ"),
 		Gender:    "MALE",
 		Skills: []_profile.UpsertSkill{
 			{
@@ -516,11 +643,11 @@ func TestPutProfileId_InternalError(t *testing.T) {
 	mockUsecase := new(mocks.ProfileUsecase)
 
 	mockUsecase.
-		On("UpdateProfile", mock.AnythingOfType("*uuid.UUID"), updateProfile).
-		Return(errors.New("unexpected DB error"))
+		On("UpdateProfileWithApproval", mock.AnythingOfType("*uuid.UUID"), updateProfile, staffViewer).
+		Return(nil, errors.New("unexpected DB error"))
 
 	handler := NewProfileHandler(mockUsecase)
-	handler.PutProfileId(c, (types.UUID)(*profileId))
+	handler.PutProfileId(c, (types.UUID)(*profileId), staffParams())
 
 	require.Equal(t, http.StatusInternalServerError, w.Code)
 
@@ -528,3 +655,1214 @@ func TestPutProfileId_InternalError(t *testing.T) {
 	_ = json.Unmarshal(w.Body.Bytes(), &resp)
 	assert.Equal(t, "unexpected DB error", resp["error"])
 }
+
+func TestPostProfile_ClassErrors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	for _, tc := range []struct {
+		err    error
+		status int
+	}{
+		{constants.ErrUnknownClass, http.StatusBadRequest},
+		{constants.ErrUnknownGender, http.StatusBadRequest},
+		{constants.ErrClassFull, http.StatusConflict},
+	} {
+		newProfile := _profile.UpsertProfile{FirstName: "SeiA", LastName: "Phanes", Class: strPtr("M1/1"), Gender: "MALE"}
+		body, _ := json.Marshal(newProfile)
+
+		req, _ := http.NewRequest(http.MethodPost, "/profile", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = req
+
+		mockUsecase := new(mocks.ProfileUsecase)
+		mockUsecase.
+			On("CreateProfile", mock.AnythingOfType("*models.Profile"), newProfile).
+			Return(tc.err)
+
+		handler := NewProfileHandler(mockUsecase)
+		handler.PostProfile(c, _profile.PostProfileParams{})
+
+		require.Equal(t, tc.status, w.Code)
+
+		var resp map[string]string
+		_ = json.Unmarshal(w.Body.Bytes(), &resp)
+		assert.Equal(t, tc.err.Error(), resp["error"])
+	}
+}
+
+func TestPostProfile_IdempotentReplay(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newProfile := _profile.UpsertProfile{
+		FirstName: "SeiA",
+		LastName:  "Phanes",
+		Class:     strPtr("Yuusha"),
+		Gender:    "MALE",
+		Skills:    []_profile.UpsertSkill{},
+	}
+	body, _ := json.Marshal(newProfile)
+
+	req, _ := http.NewRequest(http.MethodPost, "/profile", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	storedBody := []byte(`{"id":"123e4567-e89b-12d3-a456-426614174000","message":"Profile created successfully"}`)
+
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.
+		On("ReserveIdempotencyKey", "teacher-42", "retry-1", mock.AnythingOfType("string")).
+		Return(&models.IdempotencyKey{Key: "retry-1", ResponseStatus: http.StatusOK, ResponseBody: storedBody}, nil)
+
+	key, userId := "retry-1", "teacher-42"
+	handler := NewProfileHandler(mockUsecase)
+	handler.PostProfile(c, _profile.PostProfileParams{IdempotencyKey: &key, XUserId: &userId})
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "true", w.Header().Get("Idempotent-Replayed"))
+	assert.JSONEq(t, string(storedBody), w.Body.String())
+	mockUsecase.AssertNotCalled(t, "CreateProfile", mock.Anything, mock.Anything)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestPostProfile_IdempotencyKeyMismatch(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	body, _ := json.Marshal(_profile.UpsertProfile{FirstName: "SeiA", LastName: "Phanes", Class: strPtr("Yuusha"), Gender: "MALE"})
+
+	req, _ := http.NewRequest(http.MethodPost, "/profile", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.
+		On("ReserveIdempotencyKey", "teacher-42", "retry-1", mock.AnythingOfType("string")).
+		Return(nil, constants.ErrIdempotencyKeyMismatch)
+
+	key, userId := "retry-1", "teacher-42"
+	handler := NewProfileHandler(mockUsecase)
+	handler.PostProfile(c, _profile.PostProfileParams{IdempotencyKey: &key, XUserId: &userId})
+
+	require.Equal(t, http.StatusUnprocessableEntity, w.Code)
+	mockUsecase.AssertNotCalled(t, "CreateProfile", mock.Anything, mock.Anything)
+}
+
+func TestPostProfile_IdempotencyKeyStored(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newProfile := _profile.UpsertProfile{FirstName: "SeiA", LastName: "Phanes", Class: strPtr("Yuusha"), Gender: "MALE"}
+	body, _ := json.Marshal(newProfile)
+
+	req, _ := http.NewRequest(http.MethodPost, "/profile", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.
+		On("ReserveIdempotencyKey", "teacher-42", "retry-1", mock.AnythingOfType("string")).
+		Return(nil, nil)
+	mockUsecase.
+		On("CreateProfile", mock.AnythingOfType("*models.Profile"), newProfile).
+		Return(nil)
+	mockUsecase.
+		On("SaveIdempotencyKey", "teacher-42", "retry-1", mock.AnythingOfType("string"), http.StatusOK, mock.MatchedBy(func(bu []byte) bool {
+			return bytes.Contains(bu, []byte("Profile created successfully"))
+		})).
+		Return(nil)
+
+	key, userId := "retry-1", "teacher-42"
+	handler := NewProfileHandler(mockUsecase)
+	handler.PostProfile(c, _profile.PostProfileParams{IdempotencyKey: &key, XUserId: &userId})
+
+	require.Equal(t, http.StatusOK, w.Code)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestPostProfile_IdempotencyKeyInProgress(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	body, _ := json.Marshal(_profile.UpsertProfile{FirstName: "SeiA", LastName: "Phanes", Class: strPtr("Yuusha"), Gender: "MALE"})
+
+	req, _ := http.NewRequest(http.MethodPost, "/profile", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.
+		On("ReserveIdempotencyKey", "teacher-42", "retry-1", mock.AnythingOfType("string")).
+		Return(nil, constants.ErrIdempotencyKeyInProgress)
+
+	key, userId := "retry-1", "teacher-42"
+	handler := NewProfileHandler(mockUsecase)
+	handler.PostProfile(c, _profile.PostProfileParams{IdempotencyKey: &key, XUserId: &userId})
+
+	require.Equal(t, http.StatusConflict, w.Code)
+	mockUsecase.AssertNotCalled(t, "CreateProfile", mock.Anything, mock.Anything)
+}
+
+func TestPostProfile_IdempotencyKeyReleasedOnFailure(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newProfile := _profile.UpsertProfile{FirstName: "SeiA", LastName: "Phanes", Class: strPtr("Yuusha"), Gender: "MALE"}
+	body, _ := json.Marshal(newProfile)
+
+	req, _ := http.NewRequest(http.MethodPost, "/profile", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.
+		On("ReserveIdempotencyKey", "teacher-42", "retry-1", mock.AnythingOfType("string")).
+		Return(nil, nil)
+	mockUsecase.
+		On("CreateProfile", mock.AnythingOfType("*models.Profile"), newProfile).
+		Return(errors.New("db down"))
+	mockUsecase.
+		On("ReleaseIdempotencyKey", "teacher-42", "retry-1", mock.AnythingOfType("string")).
+		Return(nil)
+
+	key, userId := "retry-1", "teacher-42"
+	handler := NewProfileHandler(mockUsecase)
+	handler.PostProfile(c, _profile.PostProfileParams{IdempotencyKey: &key, XUserId: &userId})
+
+	require.Equal(t, http.StatusInternalServerError, w.Code)
+	mockUsecase.AssertExpectations(t)
+	mockUsecase.AssertNotCalled(t, "SaveIdempotencyKey", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPostProfile_IdempotencyKeyStoresRejection(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newProfile := _profile.UpsertProfile{FirstName: "SeiA", LastName: "Phanes", Class: strPtr("Yuusha"), Gender: "MALE"}
+	body, _ := json.Marshal(newProfile)
+
+	req, _ := http.NewRequest(http.MethodPost, "/profile", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.
+		On("ReserveIdempotencyKey", "teacher-42", "retry-1", mock.AnythingOfType("string")).
+		Return(nil, nil)
+	mockUsecase.
+		On("CreateProfile", mock.AnythingOfType("*models.Profile"), newProfile).
+		Return(constants.ErrUnknownClass)
+	mockUsecase.
+		On("SaveIdempotencyKey", "teacher-42", "retry-1", mock.AnythingOfType("string"), http.StatusBadRequest, mock.MatchedBy(func(bu []byte) bool {
+			return bytes.Contains(bu, []byte(constants.ErrUnknownClass.Error()))
+		})).
+		Return(nil)
+
+	key, userId := "retry-1", "teacher-42"
+	handler := NewProfileHandler(mockUsecase)
+	handler.PostProfile(c, _profile.PostProfileParams{IdempotencyKey: &key, XUserId: &userId})
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	mockUsecase.AssertExpectations(t)
+	mockUsecase.AssertNotCalled(t, "ReleaseIdempotencyKey", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestPostProfile_IdempotencyKeyWithoutUser(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	body, _ := json.Marshal(_profile.UpsertProfile{FirstName: "SeiA", LastName: "Phanes", Class: strPtr("Yuusha"), Gender: "MALE"})
+
+	req, _ := http.NewRequest(http.MethodPost, "/profile", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.ProfileUsecase)
+
+	key := "retry-1"
+	handler := NewProfileHandler(mockUsecase)
+	handler.PostProfile(c, _profile.PostProfileParams{IdempotencyKey: &key})
+
+	require.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "X-User-Id")
+	mockUsecase.AssertNotCalled(t, "ReserveIdempotencyKey", mock.Anything, mock.Anything, mock.Anything)
+	mockUsecase.AssertNotCalled(t, "CreateProfile", mock.Anything, mock.Anything)
+}
+
+func TestPostProfilesBatch_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileID := ptrUUID()
+	batch := _profile.ProfileBatchRequest{
+		Operations: []_profile.ProfileBatchOperation{
+			{Op: _profile.Delete, Id: (*types.UUID)(profileID)},
+		},
+	}
+	body, _ := json.Marshal(batch)
+
+	req, _ := http.NewRequest(http.MethodPost, "/profiles/batch?atomic=true", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.
+		On("ExecuteBatch", batch.Operations, true, staffViewer).
+		Return(&models.BatchResult{
+			Atomic:    true,
+			Committed: true,
+			Succeeded: 1,
+			Results: []*models.BatchOperationResult{
+				{Index: 0, Op: "delete", ID: profileID, Status: http.StatusOK},
+			},
+		}, nil)
+
+	atomic := true
+	handler := NewProfileHandler(mockUsecase)
+	handler.PostProfilesBatch(c, _profile.PostProfilesBatchParams{Atomic: &atomic, XUserId: "teacher-42", XUserRole: _profile.Staff})
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp _profile.ProfileBatchResponse
+	err := json.Unmarshal(w.Body.Bytes(), &resp)
+	require.NoError(t, err)
+	assert.True(t, *resp.Committed)
+	assert.Equal(t, 1, *resp.Succeeded)
+	require.Len(t, *resp.Results, 1)
+	assert.Equal(t, profileID.String(), (*resp.Results)[0].Id.String())
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestPostProfilesBatch_TooLarge(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	body := []byte(`{"operations":[{"op":"delete","id":"123e4567-e89b-12d3-a456-426614174000"}]}`)
+
+	req, _ := http.NewRequest(http.MethodPost, "/profiles/batch", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.
+		On("ExecuteBatch", mock.Anything, false, staffViewer).
+		Return(nil, constants.ErrBatchTooLarge)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.PostProfilesBatch(c, _profile.PostProfilesBatchParams{XUserId: "teacher-42", XUserRole: _profile.Staff})
+
+	require.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+}
+
+func TestGetProfilesDuplicates_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockUsecase := new(mocks.ProfileUsecase)
+
+	duplicates := []*models.ProfileDuplicate{
+		{
+			Profile:        &models.Profile{ID: ptrUUID(), FirstName: "SeiA", LastName: "Phanes"},
+			Duplicate:      &models.Profile{ID: ptrUUID(), FirstName: "Seia", LastName: "Phanes"},
+			Score:          0.92,
+			NameSimilarity: 0.87,
+			SameClass:      true,
+			SharedSkills:   []string{"Go"},
+		},
+	}
+
+	mockUsecase.
+		On("FetchDuplicates", 0.8, mock.AnythingOfType("*models.Paginator")).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*models.Paginator).SetTotal(1)
+		}).
+		Return(duplicates, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/profiles/duplicates?min_score=0.8", nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	minScore := 0.8
+	handler := NewProfileHandler(mockUsecase)
+	handler.GetProfilesDuplicates(c, _profile.GetProfilesDuplicatesParams{MinScore: &minScore})
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var response _profile.ProfileDuplicatesPaginationResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Len(t, *response.Data, 1)
+	assert.Equal(t, 0.92, *(*response.Data)[0].Score)
+	assert.Equal(t, 1, *response.TotalRows)
+}
+
+func TestPostProfilesMerge_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockUsecase := new(mocks.ProfileUsecase)
+
+	survivorID := ptrUUID()
+	mergedID := ptrUUID()
+	request := _profile.ProfileMergeRequest{
+		SurvivorId: types.UUID(*survivorID),
+		MergedId:   types.UUID(*mergedID),
+	}
+
+	mockUsecase.
+		On("MergeProfiles", request, staffViewer).
+		Return(&models.ProfileMerge{
+			ID:          ptrUUID(),
+			SurvivorID:  survivorID,
+			MergedID:    mergedID,
+			Fields:      json.RawMessage(`{"first_name":"survivor"}`),
+			MovedSkills: 2,
+			Profile:     &models.Profile{ID: survivorID, FirstName: "SeiA"},
+		}, nil)
+
+	body, _ := json.Marshal(request)
+	req := httptest.NewRequest(http.MethodPost, "/profiles/merge", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.PostProfilesMerge(c, _profile.PostProfilesMergeParams{XUserId: "teacher-42", XUserRole: _profile.Staff})
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var response _profile.ProfileMergeResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 2, *response.Data.MovedSkills)
+	assert.Equal(t, "SeiA", *response.Data.Profile.FirstName)
+}
+
+func TestPostProfilesMerge_NotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockUsecase := new(mocks.ProfileUsecase)
+
+	mockUsecase.
+		On("MergeProfiles", mock.Anything, staffViewer).
+		Return(nil, constants.ErrProfileNotFound)
+
+	body, _ := json.Marshal(_profile.ProfileMergeRequest{
+		SurvivorId: types.UUID(*ptrUUID()),
+		MergedId:   types.UUID(*ptrUUID()),
+	})
+	req := httptest.NewRequest(http.MethodPost, "/profiles/merge", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.PostProfilesMerge(c, _profile.PostProfilesMergeParams{XUserId: "teacher-42", XUserRole: _profile.Staff})
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestPostProfilesMerge_NotStaff(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockUsecase := new(mocks.ProfileUsecase)
+
+	mockUsecase.
+		On("MergeProfiles", mock.Anything, studentViewer).
+		Return(nil, constants.ErrMergeForbidden)
+
+	body, _ := json.Marshal(_profile.ProfileMergeRequest{
+		SurvivorId: types.UUID(*ptrUUID()),
+		MergedId:   types.UUID(*ptrUUID()),
+	})
+	req := httptest.NewRequest(http.MethodPost, "/profiles/merge", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.PostProfilesMerge(c, _profile.PostProfilesMergeParams{XUserId: "student-1024", XUserRole: _profile.Student})
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestPostProfilesMatch_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	request := _profile.ProfileMatchRequest{
+		Required: &[]_profile.SkillRequirement{{Skill: "Go"}},
+		Optional: &[]_profile.SkillRequirement{{Skill: "Docker"}},
+	}
+	body, _ := json.Marshal(request)
+
+	req := httptest.NewRequest(http.MethodPost, "/profiles/match", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	goSkill := &models.SkillRequirement{Skill: "Go", Key: "go", Weight: 1, Required: true}
+	docker := &models.SkillRequirement{Skill: "Docker", Key: "docker", Weight: 1}
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.
+		On("MatchProfiles", _profile.PostProfilesMatchParams{}, request, mock.AnythingOfType("*models.Paginator")).
+		Return([]*models.ProfileMatch{
+			models.NewProfileMatch(&models.Profile{ID: ptrUUID(), FirstName: "SeiA"}, []*models.SkillRequirement{goSkill, docker}, 1),
+		}, nil)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.PostProfilesMatch(c, _profile.PostProfilesMatchParams{})
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp _profile.ProfileMatchPaginationResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, *resp.Data, 1)
+	match := (*resp.Data)[0]
+	assert.Equal(t, "SeiA", *match.Profile.FirstName)
+	assert.Equal(t, 0.5, *match.Score)
+	assert.Equal(t, "Go", *(*match.MatchedSkills)[0].Skill)
+	assert.True(t, *(*match.MatchedSkills)[0].Required)
+	assert.Equal(t, "Docker", *(*match.MissingSkills)[0].Skill)
+}
+
+func TestPostProfilesMatch_InvalidRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req := httptest.NewRequest(http.MethodPost, "/profiles/match", bytes.NewBufferString(`{}`))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.
+		On("MatchProfiles", _profile.PostProfilesMatchParams{}, _profile.ProfileMatchRequest{}, mock.AnythingOfType("*models.Paginator")).
+		Return(nil, constants.ErrInvalidMatchRequest)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.PostProfilesMatch(c, _profile.PostProfilesMatchParams{})
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGetProfileIdSimilar_ETag(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileID := ptrUUID()
+	similar := []*models.SimilarProfile{
+		{Profile: &models.Profile{ID: ptrUUID(), FirstName: "AliZe"}, Score: 0.5, SharedSkills: []string{"Go"}},
+	}
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.On("FetchSimilarProfiles", profileID, mock.Anything).Return(similar, nil)
+	handler := NewProfileHandler(mockUsecase)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/profile/"+profileID.String()+"/similar", nil)
+	handler.GetProfileIdSimilar(c, types.UUID(*profileID), _profile.GetProfileIdSimilarParams{})
+
+	require.Equal(t, http.StatusOK, w.Code)
+	etag := w.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	assert.Equal(t, similarCacheControl, w.Header().Get("Cache-Control"))
+
+	var resp _profile.SimilarProfilesResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, *resp.Data, 1)
+	assert.Equal(t, []string{"Go"}, *(*resp.Data)[0].SharedSkills)
+
+	// the same result is not sent again
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/profile/"+profileID.String()+"/similar", nil)
+	handler.GetProfileIdSimilar(c, types.UUID(*profileID), _profile.GetProfileIdSimilarParams{IfNoneMatch: &etag})
+
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Empty(t, w.Body.String())
+}
+
+func TestGetProfileIdSimilar_NotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileID := ptrUUID()
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.On("FetchSimilarProfiles", profileID, mock.Anything).Return(nil, constants.ErrProfileNotFound)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/profile/"+profileID.String()+"/similar", nil)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.GetProfileIdSimilar(c, types.UUID(*profileID), _profile.GetProfileIdSimilarParams{})
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGetProfilesStats_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.On("FetchProfileStats", _profile.GetProfilesStatsParams{}).Return(&models.ProfileStats{
+		Total:    3,
+		ByGender: []*models.ProfileStatCount{{Key: "MALE", Count: 2}, {Key: "FEMALE", Count: 1}},
+		ByClass:  []*models.ProfileStatCount{{Key: "Yuusha", Count: 3}},
+		BySkill:  []*models.ProfileStatCount{{Key: "Go", Count: 3}},
+		ByMonth:  []*models.ProfileStatCount{{Key: "2024-05", Count: 3}},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/profiles/stats", nil)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.GetProfilesStats(c, _profile.GetProfilesStatsParams{})
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp _profile.ProfileStatsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, 3, *resp.Data.Total)
+	assert.Equal(t, "FEMALE", (*resp.Data.ByGender)[1].Key)
+	assert.Equal(t, 3, (*resp.Data.BySkill)[0].Count)
+	assert.Equal(t, "2024-05", (*resp.Data.ByMonth)[0].Key)
+}
+
+func TestGetProfilesStats_InvalidSkillLevel(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.On("FetchProfileStats", mock.Anything).Return(nil, constants.ErrInvalidSkillLevelFilter)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/profiles/stats?skill_level=Go%3E%3D0", nil)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.GetProfilesStats(c, _profile.GetProfilesStatsParams{})
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGetProfilesStats_InvalidAttribute(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.On("FetchProfileStats", mock.Anything).Return(nil, constants.ErrInvalidCustomAttributeFilter)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/profiles/stats?attribute=%3D12", nil)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.GetProfilesStats(c, _profile.GetProfilesStatsParams{})
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestGetProfileIdEnrollments_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileID := ptrUUID()
+	academicYear := "2025"
+	endDate := time.Date(2026, 5, 16, 0, 0, 0, 0, time.UTC)
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.On("FetchEnrollments", profileID).Return([]*models.Enrollment{
+		{ID: ptrUUID(), ProfileID: profileID, ClassID: ptrUUID(), ClassCode: "M2/1", StartDate: endDate},
+		{ID: ptrUUID(), ProfileID: profileID, ClassID: ptrUUID(), ClassCode: "M1/1", AcademicYear: &academicYear,
+			StartDate: time.Date(2025, 5, 16, 0, 0, 0, 0, time.UTC), EndDate: &endDate},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/profile/"+profileID.String()+"/enrollments", nil)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.GetProfileIdEnrollments(c, types.UUID(*profileID))
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp _profile.EnrollmentsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, *resp.Data, 2)
+	assert.Equal(t, "M2/1", *(*resp.Data)[0].ClassCode)
+	assert.Nil(t, (*resp.Data)[0].EndDate)
+	assert.Equal(t, "2026-05-16", (*resp.Data)[1].EndDate.String())
+	assert.Equal(t, "2025", *(*resp.Data)[1].AcademicYear)
+}
+
+func TestGetProfileIdEnrollments_NotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileID := ptrUUID()
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.On("FetchEnrollments", profileID).Return(nil, constants.ErrProfileNotFound)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/profile/"+profileID.String()+"/enrollments", nil)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.GetProfileIdEnrollments(c, types.UUID(*profileID))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGetGenders_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.On("FetchGenders").Return([]*models.GenderOption{
+		{Code: models.GenderMale, Label: "Male", SortOrder: 1},
+		{Code: models.GenderNonBinary, Label: "Non-binary", SortOrder: 3},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/genders", nil)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.GetGenders(c)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp _profile.GendersResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, *resp.Data, 2)
+	assert.Equal(t, "NON_BINARY", *(*resp.Data)[1].Code)
+	assert.Equal(t, "Non-binary", *(*resp.Data)[1].Label)
+}
+
+func TestGetProfileId_DisplayName(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileID := ptrUUID()
+	profile := &models.Profile{
+		ID:        profileID,
+		FirstName: "Somchai",
+		LastName:  "Jaidee",
+		Names:     []*models.ProfileName{{Locale: models.NameLocaleThai, FirstName: "สมชาย", LastName: "ใจดี"}},
+	}
+
+	for _, tc := range []struct {
+		acceptLanguage  *string
+		displayName     string
+		contentLanguage string
+	}{
+		{strPtr("th-TH,th;q=0.9,en;q=0.8"), "สมชาย ใจดี", "th"},
+		{strPtr("en-GB"), "Somchai Jaidee", "en"},
+		{strPtr("ja"), "Somchai Jaidee", ""},
+		{nil, "Somchai Jaidee", ""},
+	} {
+		mockUsecase := new(mocks.ProfileUsecase)
+		mockUsecase.On("FetchProfileById", profileID).Return(profile, nil)
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Request = httptest.NewRequest(http.MethodGet, "/profile/"+profileID.String(), nil)
+
+		handler := NewProfileHandler(mockUsecase)
+		handler.GetProfileId(c, types.UUID(*profileID), _profile.GetProfileIdParams{AcceptLanguage: tc.acceptLanguage})
+
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var resp _profile.ProfileResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, tc.displayName, *resp.Data.DisplayName)
+		assert.Len(t, *resp.Data.Names, 1)
+		assert.Equal(t, tc.contentLanguage, w.Header().Get("Content-Language"))
+		assert.Equal(t, "Accept-Language", w.Header().Get("Vary"))
+	}
+}
+
+func TestGetProfileIdContacts_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileID := ptrUUID()
+	verifiedAt := time.Date(2026, 5, 16, 9, 0, 0, 0, time.UTC)
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.On("FetchContacts", profileID).Return([]*models.Contact{
+		{ID: ptrUUID(), ProfileID: profileID, Type: models.ContactTypeEmail, Value: "seia@example.com",
+			NormalizedValue: "seia@example.com", IsPrimary: true, VerifiedAt: &verifiedAt},
+		{ID: ptrUUID(), ProfileID: profileID, Type: models.ContactTypeGuardian, Value: "+66812345678",
+			NormalizedValue: "+66812345678", Name: strPtr("Somsri Jaidee")},
+	}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/profile/"+profileID.String()+"/contacts", nil)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.GetProfileIdContacts(c, types.UUID(*profileID))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), "normalized_value")
+
+	var resp _profile.ContactsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, *resp.Data, 2)
+	assert.Equal(t, _profile.ContactTypeEmail, *(*resp.Data)[0].Type)
+	assert.True(t, *(*resp.Data)[0].IsPrimary)
+	assert.True(t, verifiedAt.Equal(*(*resp.Data)[0].VerifiedAt))
+	assert.Equal(t, "Somsri Jaidee", *(*resp.Data)[1].Name)
+	assert.Nil(t, (*resp.Data)[1].VerifiedAt)
+}
+
+func TestPostProfileIdContacts_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileID := ptrUUID()
+	request := _profile.UpsertContact{Type: _profile.UpsertContactTypePhone, Value: "+66 81 234 5678"}
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.On("CreateContact", profileID, request).Return(&models.Contact{
+		ID: ptrUUID(), ProfileID: profileID, Type: models.ContactTypePhone, Value: "+66812345678",
+	}, nil)
+
+	body, _ := json.Marshal(request)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/profile/"+profileID.String()+"/contacts", bytes.NewReader(body))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.PostProfileIdContacts(c, types.UUID(*profileID))
+
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var resp _profile.ContactResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "+66812345678", *resp.Data.Value)
+}
+
+func TestPostProfileIdContacts_Errors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{constants.ErrProfileNotFound, http.StatusNotFound},
+		{constants.ErrInvalidEmail, http.StatusBadRequest},
+		{constants.ErrInvalidPhone, http.StatusBadRequest},
+		{constants.ErrInvalidGuardianContact, http.StatusBadRequest},
+		{constants.ErrContactEmailConflict, http.StatusConflict},
+		{errors.New("db error"), http.StatusInternalServerError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.err.Error(), func(t *testing.T) {
+			profileID := ptrUUID()
+			mockUsecase := new(mocks.ProfileUsecase)
+			mockUsecase.On("CreateContact", profileID, mock.Anything).Return(nil, tc.err)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/profile/"+profileID.String()+"/contacts",
+				bytes.NewReader([]byte(`{"type":"email","value":"seia@example.com"}`)))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler := NewProfileHandler(mockUsecase)
+			handler.PostProfileIdContacts(c, types.UUID(*profileID))
+
+			assert.Equal(t, tc.status, w.Code)
+		})
+	}
+}
+
+func TestPostProfileIdContactsContactIdVerify_NotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileID, contactID := ptrUUID(), ptrUUID()
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.On("VerifyContact", profileID, contactID).Return(nil, constants.ErrContactNotFound)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/profile/"+profileID.String()+"/contacts/"+contactID.String()+"/verify", nil)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.PostProfileIdContactsContactIdVerify(c, types.UUID(*profileID), types.UUID(*contactID))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestDeleteProfileIdContactsContactId_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileID, contactID := ptrUUID(), ptrUUID()
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.On("DeleteContact", profileID, contactID).Return(nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodDelete, "/profile/"+profileID.String()+"/contacts/"+contactID.String(), nil)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.DeleteProfileIdContactsContactId(c, types.UUID(*profileID), types.UUID(*contactID))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestGetProfileId_IncludeHistory(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileID := ptrUUID()
+	include := []_profile.GetProfileIdParamsInclude{_profile.GetProfileIdParamsIncludeEducation}
+	profile := &models.Profile{ID: profileID, FirstName: "Somchai", LastName: "Jaidee"}
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.On("FetchProfileById", profileID).Return(profile, nil)
+	mockUsecase.On("IncludeProfileHistory", profile, include).Run(func(args mock.Arguments) {
+		endDate := time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC)
+		args.Get(0).(*models.Profile).Education = &[]*models.Education{
+			{ID: ptrUUID(), Institution: "Chulalongkorn University", StartDate: time.Date(2020, 6, 1, 0, 0, 0, 0, time.UTC), EndDate: &endDate},
+		}
+	}).Return(nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/profile/"+profileID.String()+"?include=education", nil)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.GetProfileId(c, types.UUID(*profileID), _profile.GetProfileIdParams{Include: &include})
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), `"experience"`)
+
+	var resp _profile.ProfileResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, *resp.Data.Education, 1)
+	assert.Equal(t, "2020-06-01", (*resp.Data.Education)[0].StartDate.String())
+	assert.Equal(t, "2024-05-31", (*resp.Data.Education)[0].EndDate.String())
+}
+
+func TestGetProfileId_WithoutInclude(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileID := ptrUUID()
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.On("FetchProfileById", profileID).Return(&models.Profile{ID: profileID}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/profile/"+profileID.String(), nil)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.GetProfileId(c, types.UUID(*profileID), _profile.GetProfileIdParams{})
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.NotContains(t, w.Body.String(), `"education"`)
+	mockUsecase.AssertNotCalled(t, "IncludeProfileHistory", mock.Anything, mock.Anything)
+}
+
+func TestPostProfileIdExperience_Errors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cases := []struct {
+		err    error
+		status int
+	}{
+		{constants.ErrProfileNotFound, http.StatusNotFound},
+		{constants.ErrInvalidDateRange, http.StatusBadRequest},
+		{constants.ErrExperienceOverlap, http.StatusConflict},
+		{errors.New("db error"), http.StatusInternalServerError},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.err.Error(), func(t *testing.T) {
+			profileID := ptrUUID()
+			mockUsecase := new(mocks.ProfileUsecase)
+			mockUsecase.On("CreateExperience", profileID, mock.Anything).Return(nil, tc.err)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/profile/"+profileID.String()+"/experience",
+				bytes.NewReader([]byte(`{"organization":"SCB","role":"Intern","start_date":"2023-06-01","end_date":"2023-05-31"}`)))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			handler := NewProfileHandler(mockUsecase)
+			handler.PostProfileIdExperience(c, types.UUID(*profileID))
+
+			assert.Equal(t, tc.status, w.Code)
+		})
+	}
+}
+
+func TestPutProfileIdEducationEducationId_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileID, educationID := ptrUUID(), ptrUUID()
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.On("UpdateEducation", profileID, educationID, mock.AnythingOfType("profile.UpsertEducation")).Return(&models.Education{
+		ID: educationID, Institution: "Mahidol University", StartDate: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+	}, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPut, "/profile/"+profileID.String()+"/education/"+educationID.String(),
+		bytes.NewReader([]byte(`{"institution":"Mahidol University","start_date":"2024-06-01"}`)))
+	c.Request.Header.Set("Content-Type", "application/json")
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.PutProfileIdEducationEducationId(c, types.UUID(*profileID), types.UUID(*educationID))
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp _profile.EducationResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "Mahidol University", *resp.Data.Institution)
+	assert.Nil(t, resp.Data.EndDate)
+}
+
+func TestDeleteProfileIdExperienceExperienceId_NotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileID, experienceID := ptrUUID(), ptrUUID()
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.On("DeleteExperience", profileID, experienceID).Return(constants.ErrExperienceNotFound)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodDelete, "/profile/"+profileID.String()+"/experience/"+experienceID.String(), nil)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.DeleteProfileIdExperienceExperienceId(c, types.UUID(*profileID), types.UUID(*experienceID))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+var (
+	staffViewer   = &models.Viewer{UserID: "teacher-42", Role: models.UserRoleStaff}
+	studentViewer = &models.Viewer{UserID: "student-1024", Role: models.UserRoleStudent}
+)
+
+func staffParams() _profile.PutProfileIdParams {
+	return _profile.PutProfileIdParams{XUserId: "teacher-42", XUserRole: _profile.Staff}
+}
+
+func studentParams() _profile.PutProfileIdParams {
+	return _profile.PutProfileIdParams{XUserId: "student-1024", XUserRole: _profile.Student}
+}
+
+func TestPutProfileId_ChangeRequest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileId := ptrUUID()
+	updateProfile := _profile.UpsertProfile{FirstName: "Seia", LastName: "Phanes", Gender: "FEMALE"}
+	body, _ := json.Marshal(updateProfile)
+
+	req, _ := http.NewRequest(http.MethodPut, "/profile/"+profileId.String(), bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	firstName := "Seia"
+	request, _ := models.NewChangeRequest(profileId, &models.ProfileChanges{FirstName: &firstName}, "student-1024")
+
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.On("UpdateProfileWithApproval", profileId, updateProfile, studentViewer).Return(request, nil)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.PutProfileId(c, (types.UUID)(*profileId), studentParams())
+
+	require.Equal(t, http.StatusAccepted, w.Code)
+
+	var resp _profile.ChangeRequestResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, _profile.ChangeRequestStatusPending, *resp.Data.Status)
+	assert.Equal(t, "Seia", *resp.Data.Changes.FirstName)
+	assert.Equal(t, "student-1024", *resp.Data.RequestedBy)
+	mockUsecase.AssertNotCalled(t, "UpsertProfile", mock.Anything, mock.Anything)
+}
+
+func TestPutProfileId_NothingToApprove(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileId := ptrUUID()
+	updateProfile := _profile.UpsertProfile{FirstName: "SeiA", LastName: "Phanes", Gender: "FEMALE"}
+	body, _ := json.Marshal(updateProfile)
+
+	req, _ := http.NewRequest(http.MethodPut, "/profile/"+profileId.String(), bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.On("UpdateProfileWithApproval", profileId, updateProfile, studentViewer).Return(nil, nil)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.PutProfileId(c, (types.UUID)(*profileId), studentParams())
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "Profile updated successfully")
+}
+
+func TestPutProfileId_NewProfileByStudent(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileId := ptrUUID()
+	updateProfile := _profile.UpsertProfile{FirstName: "SeiA", LastName: "Phanes", Gender: "FEMALE"}
+	body, _ := json.Marshal(updateProfile)
+
+	req, _ := http.NewRequest(http.MethodPut, "/profile/"+profileId.String(), bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.On("UpdateProfileWithApproval", profileId, updateProfile, studentViewer).Return(nil, constants.ErrProfileNotFound)
+	mockUsecase.On("UpsertProfile", profileId, updateProfile).Return(true, nil)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.PutProfileId(c, (types.UUID)(*profileId), studentParams())
+
+	require.Equal(t, http.StatusCreated, w.Code)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestPutProfileId_Staff(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileId := ptrUUID()
+	updateProfile := _profile.UpsertProfile{FirstName: "Seia", LastName: "Phanes", Gender: "FEMALE"}
+	body, _ := json.Marshal(updateProfile)
+
+	req, _ := http.NewRequest(http.MethodPut, "/profile/"+profileId.String(), bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.On("UpdateProfileWithApproval", profileId, updateProfile, staffViewer).Return(nil, nil)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.PutProfileId(c, (types.UUID)(*profileId), staffParams())
+
+	require.Equal(t, http.StatusOK, w.Code)
+	mockUsecase.AssertExpectations(t)
+}
+
+func TestGetProfilesChangeRequests(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, _ := http.NewRequest(http.MethodGet, "/profiles/change-requests", nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	lastName := "Phanes-Lee"
+	request, _ := models.NewChangeRequest(ptrUUID(), &models.ProfileChanges{LastName: &lastName}, "student-1024")
+	page, perPage := 1, 10
+	params := _profile.GetProfilesChangeRequestsParams{Page: &page, PerPage: &perPage, XUserId: "teacher-42", XUserRole: _profile.Staff}
+
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.On("FetchChangeRequests", &models.Viewer{UserID: "teacher-42", Role: models.UserRoleStaff}, params, mock.AnythingOfType("*models.Paginator")).
+		Return([]*models.ChangeRequest{request}, nil)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.GetProfilesChangeRequests(c, params)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp _profile.ChangeRequestsPaginationResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, *resp.Data, 1)
+	assert.Equal(t, "Phanes-Lee", *(*resp.Data)[0].Changes.LastName)
+}
+
+func TestPostProfilesChangeRequestsIdApprove_Errors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"not staff", constants.ErrChangeRequestForbidden, http.StatusForbidden},
+		{"not found", constants.ErrChangeRequestNotFound, http.StatusNotFound},
+		{"already reviewed", constants.ErrChangeRequestResolved, http.StatusConflict},
+		{"class full", constants.ErrClassFull, http.StatusConflict},
+		{"unknown class", constants.ErrUnknownClass, http.StatusBadRequest},
+		{"database", errors.New("connection refused"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requestId := ptrUUID()
+			req, _ := http.NewRequest(http.MethodPost, "/profiles/change-requests/"+requestId.String()+"/approve", nil)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+
+			mockUsecase := new(mocks.ProfileUsecase)
+			mockUsecase.On("ApproveChangeRequest", requestId, mock.AnythingOfType("*models.Viewer")).Return(nil, tt.err)
+
+			handler := NewProfileHandler(mockUsecase)
+			handler.PostProfilesChangeRequestsIdApprove(c, (types.UUID)(*requestId), _profile.PostProfilesChangeRequestsIdApproveParams{XUserId: "teacher-42", XUserRole: _profile.Staff})
+
+			assert.Equal(t, tt.status, w.Code)
+			assert.Contains(t, w.Body.String(), tt.err.Error())
+		})
+	}
+}
+
+func TestPostProfilesChangeRequestsIdReject(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	requestId := ptrUUID()
+	req, _ := http.NewRequest(http.MethodPost, "/profiles/change-requests/"+requestId.String()+"/reject", bytes.NewBufferString(`{"reason":"Needs a form signed by a guardian"}`))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	firstName := "Seia"
+	request, _ := models.NewChangeRequest(ptrUUID(), &models.ProfileChanges{FirstName: &firstName}, "student-1024")
+	request.Review(models.ChangeRequestStatusRejected, "teacher-42", strPtr("Needs a form signed by a guardian"))
+
+	mockUsecase := new(mocks.ProfileUsecase)
+	mockUsecase.On("RejectChangeRequest", requestId, &models.Viewer{UserID: "teacher-42", Role: models.UserRoleStaff},
+		_profile.RejectChangeRequest{Reason: strPtr("Needs a form signed by a guardian")}).Return(request, nil)
+
+	handler := NewProfileHandler(mockUsecase)
+	handler.PostProfilesChangeRequestsIdReject(c, (types.UUID)(*requestId), _profile.PostProfilesChangeRequestsIdRejectParams{XUserId: "teacher-42", XUserRole: _profile.Staff})
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp _profile.ChangeRequestResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, _profile.ChangeRequestStatusRejected, *resp.Data.Status)
+	assert.Equal(t, "Needs a form signed by a guardian", *resp.Data.ReviewReason)
+}
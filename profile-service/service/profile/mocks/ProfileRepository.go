@@ -15,40 +15,562 @@ type ProfileRepository struct {
 	mock.Mock
 }
 
+// CompleteIdempotencyKey provides a mock function with given fields: idempotencyKey
+func (_m *ProfileRepository) CompleteIdempotencyKey(idempotencyKey *models.IdempotencyKey) error {
+	ret := _m.Called(idempotencyKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteIdempotencyKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.IdempotencyKey) error); ok {
+		r0 = rf(idempotencyKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateChangeRequest provides a mock function with given fields: request
+func (_m *ProfileRepository) CreateChangeRequest(request *models.ChangeRequest) error {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateChangeRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ChangeRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateContact provides a mock function with given fields: contact
+func (_m *ProfileRepository) CreateContact(contact *models.Contact) error {
+	ret := _m.Called(contact)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateContact")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Contact) error); ok {
+		r0 = rf(contact)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateEducation provides a mock function with given fields: education
+func (_m *ProfileRepository) CreateEducation(education *models.Education) error {
+	ret := _m.Called(education)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEducation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Education) error); ok {
+		r0 = rf(education)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateExperience provides a mock function with given fields: experience
+func (_m *ProfileRepository) CreateExperience(experience *models.Experience) error {
+	ret := _m.Called(experience)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExperience")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Experience) error); ok {
+		r0 = rf(experience)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateProfile provides a mock function with given fields: _a0
 func (_m *ProfileRepository) CreateProfile(_a0 *models.Profile) error {
 	ret := _m.Called(_a0)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateProfile")
+		panic("no return value specified for CreateProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Profile) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteContact provides a mock function with given fields: profileId, contactId
+func (_m *ProfileRepository) DeleteContact(profileId *uuid.UUID, contactId *uuid.UUID) error {
+	ret := _m.Called(profileId, contactId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteContact")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) error); ok {
+		r0 = rf(profileId, contactId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteEducation provides a mock function with given fields: profileId, educationId
+func (_m *ProfileRepository) DeleteEducation(profileId *uuid.UUID, educationId *uuid.UUID) error {
+	ret := _m.Called(profileId, educationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEducation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) error); ok {
+		r0 = rf(profileId, educationId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteExperience provides a mock function with given fields: profileId, experienceId
+func (_m *ProfileRepository) DeleteExperience(profileId *uuid.UUID, experienceId *uuid.UUID) error {
+	ret := _m.Called(profileId, experienceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExperience")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) error); ok {
+		r0 = rf(profileId, experienceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteExpiredIdempotencyKeys provides a mock function with no fields
+func (_m *ProfileRepository) DeleteExpiredIdempotencyKeys() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExpiredIdempotencyKeys")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteProfile provides a mock function with given fields: profileId
+func (_m *ProfileRepository) DeleteProfile(profileId *uuid.UUID) error {
+	ret := _m.Called(profileId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) error); ok {
+		r0 = rf(profileId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FetchChangeRequestById provides a mock function with given fields: requestId
+func (_m *ProfileRepository) FetchChangeRequestById(requestId *uuid.UUID) (*models.ChangeRequest, error) {
+	ret := _m.Called(requestId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchChangeRequestById")
+	}
+
+	var r0 *models.ChangeRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) (*models.ChangeRequest, error)); ok {
+		return rf(requestId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) *models.ChangeRequest); ok {
+		r0 = rf(requestId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ChangeRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(requestId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchChangeRequests provides a mock function with given fields: params, paginator
+func (_m *ProfileRepository) FetchChangeRequests(params profile.GetProfilesChangeRequestsParams, paginator *models.Paginator) ([]*models.ChangeRequest, error) {
+	ret := _m.Called(params, paginator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchChangeRequests")
+	}
+
+	var r0 []*models.ChangeRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(profile.GetProfilesChangeRequestsParams, *models.Paginator) ([]*models.ChangeRequest, error)); ok {
+		return rf(params, paginator)
+	}
+	if rf, ok := ret.Get(0).(func(profile.GetProfilesChangeRequestsParams, *models.Paginator) []*models.ChangeRequest); ok {
+		r0 = rf(params, paginator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ChangeRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(profile.GetProfilesChangeRequestsParams, *models.Paginator) error); ok {
+		r1 = rf(params, paginator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchContactById provides a mock function with given fields: profileId, contactId
+func (_m *ProfileRepository) FetchContactById(profileId *uuid.UUID, contactId *uuid.UUID) (*models.Contact, error) {
+	ret := _m.Called(profileId, contactId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchContactById")
+	}
+
+	var r0 *models.Contact
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) (*models.Contact, error)); ok {
+		return rf(profileId, contactId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) *models.Contact); ok {
+		r0 = rf(profileId, contactId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Contact)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *uuid.UUID) error); ok {
+		r1 = rf(profileId, contactId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchContacts provides a mock function with given fields: profileId
+func (_m *ProfileRepository) FetchContacts(profileId *uuid.UUID) ([]*models.Contact, error) {
+	ret := _m.Called(profileId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchContacts")
+	}
+
+	var r0 []*models.Contact
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) ([]*models.Contact, error)); ok {
+		return rf(profileId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) []*models.Contact); ok {
+		r0 = rf(profileId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Contact)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(profileId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchDuplicateCandidates provides a mock function with given fields: minNameSimilarity, limit
+func (_m *ProfileRepository) FetchDuplicateCandidates(minNameSimilarity float64, limit int) ([]*models.DuplicateCandidate, error) {
+	ret := _m.Called(minNameSimilarity, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchDuplicateCandidates")
+	}
+
+	var r0 []*models.DuplicateCandidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(float64, int) ([]*models.DuplicateCandidate, error)); ok {
+		return rf(minNameSimilarity, limit)
+	}
+	if rf, ok := ret.Get(0).(func(float64, int) []*models.DuplicateCandidate); ok {
+		r0 = rf(minNameSimilarity, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.DuplicateCandidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(float64, int) error); ok {
+		r1 = rf(minNameSimilarity, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchEducation provides a mock function with given fields: profileId
+func (_m *ProfileRepository) FetchEducation(profileId *uuid.UUID) ([]*models.Education, error) {
+	ret := _m.Called(profileId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchEducation")
+	}
+
+	var r0 []*models.Education
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) ([]*models.Education, error)); ok {
+		return rf(profileId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) []*models.Education); ok {
+		r0 = rf(profileId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Education)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(profileId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchEducationById provides a mock function with given fields: profileId, educationId
+func (_m *ProfileRepository) FetchEducationById(profileId *uuid.UUID, educationId *uuid.UUID) (*models.Education, error) {
+	ret := _m.Called(profileId, educationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchEducationById")
+	}
+
+	var r0 *models.Education
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) (*models.Education, error)); ok {
+		return rf(profileId, educationId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) *models.Education); ok {
+		r0 = rf(profileId, educationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Education)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *uuid.UUID) error); ok {
+		r1 = rf(profileId, educationId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchEnrollments provides a mock function with given fields: profileId
+func (_m *ProfileRepository) FetchEnrollments(profileId *uuid.UUID) ([]*models.Enrollment, error) {
+	ret := _m.Called(profileId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchEnrollments")
+	}
+
+	var r0 []*models.Enrollment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) ([]*models.Enrollment, error)); ok {
+		return rf(profileId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) []*models.Enrollment); ok {
+		r0 = rf(profileId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Enrollment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(profileId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchExperience provides a mock function with given fields: profileId
+func (_m *ProfileRepository) FetchExperience(profileId *uuid.UUID) ([]*models.Experience, error) {
+	ret := _m.Called(profileId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchExperience")
+	}
+
+	var r0 []*models.Experience
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) ([]*models.Experience, error)); ok {
+		return rf(profileId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) []*models.Experience); ok {
+		r0 = rf(profileId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Experience)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(profileId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchExperienceById provides a mock function with given fields: profileId, experienceId
+func (_m *ProfileRepository) FetchExperienceById(profileId *uuid.UUID, experienceId *uuid.UUID) (*models.Experience, error) {
+	ret := _m.Called(profileId, experienceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchExperienceById")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*models.Profile) error); ok {
-		r0 = rf(_a0)
+	var r0 *models.Experience
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) (*models.Experience, error)); ok {
+		return rf(profileId, experienceId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) *models.Experience); ok {
+		r0 = rf(profileId, experienceId)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Experience)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *uuid.UUID) error); ok {
+		r1 = rf(profileId, experienceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// DeleteProfile provides a mock function with given fields: profileId
-func (_m *ProfileRepository) DeleteProfile(profileId *uuid.UUID) error {
-	ret := _m.Called(profileId)
+// FetchGenders provides a mock function with no fields
+func (_m *ProfileRepository) FetchGenders() ([]*models.GenderOption, error) {
+	ret := _m.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteProfile")
+		panic("no return value specified for FetchGenders")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*uuid.UUID) error); ok {
-		r0 = rf(profileId)
+	var r0 []*models.GenderOption
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*models.GenderOption, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*models.GenderOption); ok {
+		r0 = rf()
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.GenderOption)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchIdempotencyKey provides a mock function with given fields: userId, key
+func (_m *ProfileRepository) FetchIdempotencyKey(userId string, key string) (*models.IdempotencyKey, error) {
+	ret := _m.Called(userId, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchIdempotencyKey")
+	}
+
+	var r0 *models.IdempotencyKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*models.IdempotencyKey, error)); ok {
+		return rf(userId, key)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *models.IdempotencyKey); ok {
+		r0 = rf(userId, key)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.IdempotencyKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(userId, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // FetchProfileById provides a mock function with given fields: profileId
@@ -81,6 +603,66 @@ func (_m *ProfileRepository) FetchProfileById(profileId *uuid.UUID) (*models.Pro
 	return r0, r1
 }
 
+// FetchProfileMergeByMergedId provides a mock function with given fields: mergedId
+func (_m *ProfileRepository) FetchProfileMergeByMergedId(mergedId *uuid.UUID) (*models.ProfileMerge, error) {
+	ret := _m.Called(mergedId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchProfileMergeByMergedId")
+	}
+
+	var r0 *models.ProfileMerge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) (*models.ProfileMerge, error)); ok {
+		return rf(mergedId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) *models.ProfileMerge); ok {
+		r0 = rf(mergedId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProfileMerge)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(mergedId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchProfileStats provides a mock function with given fields: params, skillLimit
+func (_m *ProfileRepository) FetchProfileStats(params profile.GetProfilesParams, skillLimit int) (*models.ProfileStats, error) {
+	ret := _m.Called(params, skillLimit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchProfileStats")
+	}
+
+	var r0 *models.ProfileStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(profile.GetProfilesParams, int) (*models.ProfileStats, error)); ok {
+		return rf(params, skillLimit)
+	}
+	if rf, ok := ret.Get(0).(func(profile.GetProfilesParams, int) *models.ProfileStats); ok {
+		r0 = rf(params, skillLimit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProfileStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(profile.GetProfilesParams, int) error); ok {
+		r1 = rf(params, skillLimit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FetchProfiles provides a mock function with given fields: params, paginator
 func (_m *ProfileRepository) FetchProfiles(params profile.GetProfilesParams, paginator *models.Paginator) ([]*models.Profile, error) {
 	ret := _m.Called(params, paginator)
@@ -111,6 +693,232 @@ func (_m *ProfileRepository) FetchProfiles(params profile.GetProfilesParams, pag
 	return r0, r1
 }
 
+// FetchProfilesByIds provides a mock function with given fields: profileIds
+func (_m *ProfileRepository) FetchProfilesByIds(profileIds []uuid.UUID) ([]*models.Profile, error) {
+	ret := _m.Called(profileIds)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchProfilesByIds")
+	}
+
+	var r0 []*models.Profile
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) ([]*models.Profile, error)); ok {
+		return rf(profileIds)
+	}
+	if rf, ok := ret.Get(0).(func([]uuid.UUID) []*models.Profile); ok {
+		r0 = rf(profileIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Profile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uuid.UUID) error); ok {
+		r1 = rf(profileIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchSimilarCandidates provides a mock function with given fields: profileId, sameClass, limit
+func (_m *ProfileRepository) FetchSimilarCandidates(profileId *uuid.UUID, sameClass *bool, limit int) ([]*models.SimilarCandidate, error) {
+	ret := _m.Called(profileId, sameClass, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchSimilarCandidates")
+	}
+
+	var r0 []*models.SimilarCandidate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *bool, int) ([]*models.SimilarCandidate, error)); ok {
+		return rf(profileId, sameClass, limit)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *bool, int) []*models.SimilarCandidate); ok {
+		r0 = rf(profileId, sameClass, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SimilarCandidate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *bool, int) error); ok {
+		r1 = rf(profileId, sameClass, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MatchProfiles provides a mock function with given fields: params, requirements, paginator
+func (_m *ProfileRepository) MatchProfiles(params profile.GetProfilesParams, requirements []*models.SkillRequirement, paginator *models.Paginator) ([]*models.ProfileMatch, error) {
+	ret := _m.Called(params, requirements, paginator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MatchProfiles")
+	}
+
+	var r0 []*models.ProfileMatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(profile.GetProfilesParams, []*models.SkillRequirement, *models.Paginator) ([]*models.ProfileMatch, error)); ok {
+		return rf(params, requirements, paginator)
+	}
+	if rf, ok := ret.Get(0).(func(profile.GetProfilesParams, []*models.SkillRequirement, *models.Paginator) []*models.ProfileMatch); ok {
+		r0 = rf(params, requirements, paginator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ProfileMatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(profile.GetProfilesParams, []*models.SkillRequirement, *models.Paginator) error); ok {
+		r1 = rf(params, requirements, paginator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergeProfiles provides a mock function with given fields: merge, survivor
+func (_m *ProfileRepository) MergeProfiles(merge *models.ProfileMerge, survivor *models.Profile) error {
+	ret := _m.Called(merge, survivor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeProfiles")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ProfileMerge, *models.Profile) error); ok {
+		r0 = rf(merge, survivor)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReleaseIdempotencyKey provides a mock function with given fields: userId, key, requestHash
+func (_m *ProfileRepository) ReleaseIdempotencyKey(userId string, key string, requestHash string) error {
+	ret := _m.Called(userId, key, requestHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseIdempotencyKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(userId, key, requestHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReserveIdempotencyKey provides a mock function with given fields: idempotencyKey
+func (_m *ProfileRepository) ReserveIdempotencyKey(idempotencyKey *models.IdempotencyKey) (bool, error) {
+	ret := _m.Called(idempotencyKey)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveIdempotencyKey")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*models.IdempotencyKey) (bool, error)); ok {
+		return rf(idempotencyKey)
+	}
+	if rf, ok := ret.Get(0).(func(*models.IdempotencyKey) bool); ok {
+		r0 = rf(idempotencyKey)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(*models.IdempotencyKey) error); ok {
+		r1 = rf(idempotencyKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReviewChangeRequest provides a mock function with given fields: request, event
+func (_m *ProfileRepository) ReviewChangeRequest(request *models.ChangeRequest, event *models.ProfileEvent) error {
+	ret := _m.Called(request, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReviewChangeRequest")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.ChangeRequest, *models.ProfileEvent) error); ok {
+		r0 = rf(request, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateContact provides a mock function with given fields: contact
+func (_m *ProfileRepository) UpdateContact(contact *models.Contact) error {
+	ret := _m.Called(contact)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateContact")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Contact) error); ok {
+		r0 = rf(contact)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateEducation provides a mock function with given fields: education
+func (_m *ProfileRepository) UpdateEducation(education *models.Education) error {
+	ret := _m.Called(education)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEducation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Education) error); ok {
+		r0 = rf(education)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateExperience provides a mock function with given fields: experience
+func (_m *ProfileRepository) UpdateExperience(experience *models.Experience) error {
+	ret := _m.Called(experience)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateExperience")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Experience) error); ok {
+		r0 = rf(experience)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateProfile provides a mock function with given fields: _a0
 func (_m *ProfileRepository) UpdateProfile(_a0 *models.Profile) error {
 	ret := _m.Called(_a0)
@@ -129,6 +937,24 @@ func (_m *ProfileRepository) UpdateProfile(_a0 *models.Profile) error {
 	return r0
 }
 
+// WithTransaction provides a mock function with given fields: fn
+func (_m *ProfileRepository) WithTransaction(fn func(profile.ProfileRepository) error) error {
+	ret := _m.Called(fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(func(profile.ProfileRepository) error) error); ok {
+		r0 = rf(fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewProfileRepository creates a new instance of ProfileRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewProfileRepository(t interface {
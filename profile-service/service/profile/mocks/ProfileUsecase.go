@@ -15,6 +15,126 @@ type ProfileUsecase struct {
 	mock.Mock
 }
 
+// ApproveChangeRequest provides a mock function with given fields: requestId, viewer
+func (_m *ProfileUsecase) ApproveChangeRequest(requestId *uuid.UUID, viewer *models.Viewer) (*models.ChangeRequest, error) {
+	ret := _m.Called(requestId, viewer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApproveChangeRequest")
+	}
+
+	var r0 *models.ChangeRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *models.Viewer) (*models.ChangeRequest, error)); ok {
+		return rf(requestId, viewer)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *models.Viewer) *models.ChangeRequest); ok {
+		r0 = rf(requestId, viewer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ChangeRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *models.Viewer) error); ok {
+		r1 = rf(requestId, viewer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateContact provides a mock function with given fields: profileId, newContact
+func (_m *ProfileUsecase) CreateContact(profileId *uuid.UUID, newContact profile.UpsertContact) (*models.Contact, error) {
+	ret := _m.Called(profileId, newContact)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateContact")
+	}
+
+	var r0 *models.Contact
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, profile.UpsertContact) (*models.Contact, error)); ok {
+		return rf(profileId, newContact)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, profile.UpsertContact) *models.Contact); ok {
+		r0 = rf(profileId, newContact)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Contact)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, profile.UpsertContact) error); ok {
+		r1 = rf(profileId, newContact)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateEducation provides a mock function with given fields: profileId, newEducation
+func (_m *ProfileUsecase) CreateEducation(profileId *uuid.UUID, newEducation profile.UpsertEducation) (*models.Education, error) {
+	ret := _m.Called(profileId, newEducation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateEducation")
+	}
+
+	var r0 *models.Education
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, profile.UpsertEducation) (*models.Education, error)); ok {
+		return rf(profileId, newEducation)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, profile.UpsertEducation) *models.Education); ok {
+		r0 = rf(profileId, newEducation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Education)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, profile.UpsertEducation) error); ok {
+		r1 = rf(profileId, newEducation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateExperience provides a mock function with given fields: profileId, newExperience
+func (_m *ProfileUsecase) CreateExperience(profileId *uuid.UUID, newExperience profile.UpsertExperience) (*models.Experience, error) {
+	ret := _m.Called(profileId, newExperience)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateExperience")
+	}
+
+	var r0 *models.Experience
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, profile.UpsertExperience) (*models.Experience, error)); ok {
+		return rf(profileId, newExperience)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, profile.UpsertExperience) *models.Experience); ok {
+		r0 = rf(profileId, newExperience)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Experience)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, profile.UpsertExperience) error); ok {
+		r1 = rf(profileId, newExperience)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateProfile provides a mock function with given fields: _a0, newProfile
 func (_m *ProfileUsecase) CreateProfile(_a0 *models.Profile, newProfile profile.UpsertProfile) error {
 	ret := _m.Called(_a0, newProfile)
@@ -33,6 +153,60 @@ func (_m *ProfileUsecase) CreateProfile(_a0 *models.Profile, newProfile profile.
 	return r0
 }
 
+// DeleteContact provides a mock function with given fields: profileId, contactId
+func (_m *ProfileUsecase) DeleteContact(profileId *uuid.UUID, contactId *uuid.UUID) error {
+	ret := _m.Called(profileId, contactId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteContact")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) error); ok {
+		r0 = rf(profileId, contactId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteEducation provides a mock function with given fields: profileId, educationId
+func (_m *ProfileUsecase) DeleteEducation(profileId *uuid.UUID, educationId *uuid.UUID) error {
+	ret := _m.Called(profileId, educationId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteEducation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) error); ok {
+		r0 = rf(profileId, educationId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteExperience provides a mock function with given fields: profileId, experienceId
+func (_m *ProfileUsecase) DeleteExperience(profileId *uuid.UUID, experienceId *uuid.UUID) error {
+	ret := _m.Called(profileId, experienceId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteExperience")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) error); ok {
+		r0 = rf(profileId, experienceId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteProfile provides a mock function with given fields: profileId
 func (_m *ProfileUsecase) DeleteProfile(profileId *uuid.UUID) error {
 	ret := _m.Called(profileId)
@@ -51,24 +225,84 @@ func (_m *ProfileUsecase) DeleteProfile(profileId *uuid.UUID) error {
 	return r0
 }
 
-// FetchProfileById provides a mock function with given fields: profileId
-func (_m *ProfileUsecase) FetchProfileById(profileId *uuid.UUID) (*models.Profile, error) {
+// ExecuteBatch provides a mock function with given fields: operations, atomic, viewer
+func (_m *ProfileUsecase) ExecuteBatch(operations []profile.ProfileBatchOperation, atomic bool, viewer *models.Viewer) (*models.BatchResult, error) {
+	ret := _m.Called(operations, atomic, viewer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExecuteBatch")
+	}
+
+	var r0 *models.BatchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]profile.ProfileBatchOperation, bool, *models.Viewer) (*models.BatchResult, error)); ok {
+		return rf(operations, atomic, viewer)
+	}
+	if rf, ok := ret.Get(0).(func([]profile.ProfileBatchOperation, bool, *models.Viewer) *models.BatchResult); ok {
+		r0 = rf(operations, atomic, viewer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.BatchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]profile.ProfileBatchOperation, bool, *models.Viewer) error); ok {
+		r1 = rf(operations, atomic, viewer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchChangeRequests provides a mock function with given fields: viewer, params, paginator
+func (_m *ProfileUsecase) FetchChangeRequests(viewer *models.Viewer, params profile.GetProfilesChangeRequestsParams, paginator *models.Paginator) ([]*models.ChangeRequest, error) {
+	ret := _m.Called(viewer, params, paginator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchChangeRequests")
+	}
+
+	var r0 []*models.ChangeRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*models.Viewer, profile.GetProfilesChangeRequestsParams, *models.Paginator) ([]*models.ChangeRequest, error)); ok {
+		return rf(viewer, params, paginator)
+	}
+	if rf, ok := ret.Get(0).(func(*models.Viewer, profile.GetProfilesChangeRequestsParams, *models.Paginator) []*models.ChangeRequest); ok {
+		r0 = rf(viewer, params, paginator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ChangeRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*models.Viewer, profile.GetProfilesChangeRequestsParams, *models.Paginator) error); ok {
+		r1 = rf(viewer, params, paginator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchContacts provides a mock function with given fields: profileId
+func (_m *ProfileUsecase) FetchContacts(profileId *uuid.UUID) ([]*models.Contact, error) {
 	ret := _m.Called(profileId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FetchProfileById")
+		panic("no return value specified for FetchContacts")
 	}
 
-	var r0 *models.Profile
+	var r0 []*models.Contact
 	var r1 error
-	if rf, ok := ret.Get(0).(func(*uuid.UUID) (*models.Profile, error)); ok {
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) ([]*models.Contact, error)); ok {
 		return rf(profileId)
 	}
-	if rf, ok := ret.Get(0).(func(*uuid.UUID) *models.Profile); ok {
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) []*models.Contact); ok {
 		r0 = rf(profileId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Profile)
+			r0 = ret.Get(0).([]*models.Contact)
 		}
 	}
 
@@ -81,29 +315,29 @@ func (_m *ProfileUsecase) FetchProfileById(profileId *uuid.UUID) (*models.Profil
 	return r0, r1
 }
 
-// FetchProfiles provides a mock function with given fields: params, paginator
-func (_m *ProfileUsecase) FetchProfiles(params profile.GetProfilesParams, paginator *models.Paginator) ([]*models.Profile, error) {
-	ret := _m.Called(params, paginator)
+// FetchDuplicates provides a mock function with given fields: minScore, paginator
+func (_m *ProfileUsecase) FetchDuplicates(minScore float64, paginator *models.Paginator) ([]*models.ProfileDuplicate, error) {
+	ret := _m.Called(minScore, paginator)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FetchProfiles")
+		panic("no return value specified for FetchDuplicates")
 	}
 
-	var r0 []*models.Profile
+	var r0 []*models.ProfileDuplicate
 	var r1 error
-	if rf, ok := ret.Get(0).(func(profile.GetProfilesParams, *models.Paginator) ([]*models.Profile, error)); ok {
-		return rf(params, paginator)
+	if rf, ok := ret.Get(0).(func(float64, *models.Paginator) ([]*models.ProfileDuplicate, error)); ok {
+		return rf(minScore, paginator)
 	}
-	if rf, ok := ret.Get(0).(func(profile.GetProfilesParams, *models.Paginator) []*models.Profile); ok {
-		r0 = rf(params, paginator)
+	if rf, ok := ret.Get(0).(func(float64, *models.Paginator) []*models.ProfileDuplicate); ok {
+		r0 = rf(minScore, paginator)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*models.Profile)
+			r0 = ret.Get(0).([]*models.ProfileDuplicate)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(profile.GetProfilesParams, *models.Paginator) error); ok {
-		r1 = rf(params, paginator)
+	if rf, ok := ret.Get(1).(func(float64, *models.Paginator) error); ok {
+		r1 = rf(minScore, paginator)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -111,22 +345,662 @@ func (_m *ProfileUsecase) FetchProfiles(params profile.GetProfilesParams, pagina
 	return r0, r1
 }
 
-// UpdateProfile provides a mock function with given fields: profileId, updateProfile
-func (_m *ProfileUsecase) UpdateProfile(profileId *uuid.UUID, updateProfile profile.UpsertProfile) error {
-	ret := _m.Called(profileId, updateProfile)
+// FetchEducation provides a mock function with given fields: profileId
+func (_m *ProfileUsecase) FetchEducation(profileId *uuid.UUID) ([]*models.Education, error) {
+	ret := _m.Called(profileId)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateProfile")
+		panic("no return value specified for FetchEducation")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*uuid.UUID, profile.UpsertProfile) error); ok {
-		r0 = rf(profileId, updateProfile)
+	var r0 []*models.Education
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) ([]*models.Education, error)); ok {
+		return rf(profileId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) []*models.Education); ok {
+		r0 = rf(profileId)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Education)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(profileId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchEnrollments provides a mock function with given fields: profileId
+func (_m *ProfileUsecase) FetchEnrollments(profileId *uuid.UUID) ([]*models.Enrollment, error) {
+	ret := _m.Called(profileId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchEnrollments")
+	}
+
+	var r0 []*models.Enrollment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) ([]*models.Enrollment, error)); ok {
+		return rf(profileId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) []*models.Enrollment); ok {
+		r0 = rf(profileId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Enrollment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(profileId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchExperience provides a mock function with given fields: profileId
+func (_m *ProfileUsecase) FetchExperience(profileId *uuid.UUID) ([]*models.Experience, error) {
+	ret := _m.Called(profileId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchExperience")
+	}
+
+	var r0 []*models.Experience
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) ([]*models.Experience, error)); ok {
+		return rf(profileId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) []*models.Experience); ok {
+		r0 = rf(profileId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Experience)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(profileId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchGenders provides a mock function with no fields
+func (_m *ProfileUsecase) FetchGenders() ([]*models.GenderOption, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchGenders")
+	}
+
+	var r0 []*models.GenderOption
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*models.GenderOption, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*models.GenderOption); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.GenderOption)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchProfileById provides a mock function with given fields: profileId
+func (_m *ProfileUsecase) FetchProfileById(profileId *uuid.UUID) (*models.Profile, error) {
+	ret := _m.Called(profileId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchProfileById")
+	}
+
+	var r0 *models.Profile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) (*models.Profile, error)); ok {
+		return rf(profileId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) *models.Profile); ok {
+		r0 = rf(profileId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Profile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(profileId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchProfileMerge provides a mock function with given fields: mergedId
+func (_m *ProfileUsecase) FetchProfileMerge(mergedId *uuid.UUID) (*models.ProfileMerge, error) {
+	ret := _m.Called(mergedId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchProfileMerge")
+	}
+
+	var r0 *models.ProfileMerge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) (*models.ProfileMerge, error)); ok {
+		return rf(mergedId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) *models.ProfileMerge); ok {
+		r0 = rf(mergedId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProfileMerge)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(mergedId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchProfileStats provides a mock function with given fields: params
+func (_m *ProfileUsecase) FetchProfileStats(params profile.GetProfilesStatsParams) (*models.ProfileStats, error) {
+	ret := _m.Called(params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchProfileStats")
+	}
+
+	var r0 *models.ProfileStats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(profile.GetProfilesStatsParams) (*models.ProfileStats, error)); ok {
+		return rf(params)
+	}
+	if rf, ok := ret.Get(0).(func(profile.GetProfilesStatsParams) *models.ProfileStats); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProfileStats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(profile.GetProfilesStatsParams) error); ok {
+		r1 = rf(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchProfiles provides a mock function with given fields: params, paginator
+func (_m *ProfileUsecase) FetchProfiles(params profile.GetProfilesParams, paginator *models.Paginator) ([]*models.Profile, error) {
+	ret := _m.Called(params, paginator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchProfiles")
+	}
+
+	var r0 []*models.Profile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(profile.GetProfilesParams, *models.Paginator) ([]*models.Profile, error)); ok {
+		return rf(params, paginator)
+	}
+	if rf, ok := ret.Get(0).(func(profile.GetProfilesParams, *models.Paginator) []*models.Profile); ok {
+		r0 = rf(params, paginator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Profile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(profile.GetProfilesParams, *models.Paginator) error); ok {
+		r1 = rf(params, paginator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchSimilarProfiles provides a mock function with given fields: profileId, params
+func (_m *ProfileUsecase) FetchSimilarProfiles(profileId *uuid.UUID, params profile.GetProfileIdSimilarParams) ([]*models.SimilarProfile, error) {
+	ret := _m.Called(profileId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchSimilarProfiles")
+	}
+
+	var r0 []*models.SimilarProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, profile.GetProfileIdSimilarParams) ([]*models.SimilarProfile, error)); ok {
+		return rf(profileId, params)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, profile.GetProfileIdSimilarParams) []*models.SimilarProfile); ok {
+		r0 = rf(profileId, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SimilarProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, profile.GetProfileIdSimilarParams) error); ok {
+		r1 = rf(profileId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IncludeProfileHistory provides a mock function with given fields: _a0, include
+func (_m *ProfileUsecase) IncludeProfileHistory(_a0 *models.Profile, include []profile.GetProfileIdParamsInclude) error {
+	ret := _m.Called(_a0, include)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IncludeProfileHistory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Profile, []profile.GetProfileIdParamsInclude) error); ok {
+		r0 = rf(_a0, include)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MatchProfiles provides a mock function with given fields: params, request, paginator
+func (_m *ProfileUsecase) MatchProfiles(params profile.PostProfilesMatchParams, request profile.ProfileMatchRequest, paginator *models.Paginator) ([]*models.ProfileMatch, error) {
+	ret := _m.Called(params, request, paginator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MatchProfiles")
+	}
+
+	var r0 []*models.ProfileMatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(profile.PostProfilesMatchParams, profile.ProfileMatchRequest, *models.Paginator) ([]*models.ProfileMatch, error)); ok {
+		return rf(params, request, paginator)
+	}
+	if rf, ok := ret.Get(0).(func(profile.PostProfilesMatchParams, profile.ProfileMatchRequest, *models.Paginator) []*models.ProfileMatch); ok {
+		r0 = rf(params, request, paginator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ProfileMatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(profile.PostProfilesMatchParams, profile.ProfileMatchRequest, *models.Paginator) error); ok {
+		r1 = rf(params, request, paginator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MergeProfiles provides a mock function with given fields: request, viewer
+func (_m *ProfileUsecase) MergeProfiles(request profile.ProfileMergeRequest, viewer *models.Viewer) (*models.ProfileMerge, error) {
+	ret := _m.Called(request, viewer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MergeProfiles")
+	}
+
+	var r0 *models.ProfileMerge
+	var r1 error
+	if rf, ok := ret.Get(0).(func(profile.ProfileMergeRequest, *models.Viewer) (*models.ProfileMerge, error)); ok {
+		return rf(request, viewer)
+	}
+	if rf, ok := ret.Get(0).(func(profile.ProfileMergeRequest, *models.Viewer) *models.ProfileMerge); ok {
+		r0 = rf(request, viewer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ProfileMerge)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(profile.ProfileMergeRequest, *models.Viewer) error); ok {
+		r1 = rf(request, viewer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurgeExpiredIdempotencyKeys provides a mock function with no fields
+func (_m *ProfileUsecase) PurgeExpiredIdempotencyKeys() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PurgeExpiredIdempotencyKeys")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RejectChangeRequest provides a mock function with given fields: requestId, viewer, rejection
+func (_m *ProfileUsecase) RejectChangeRequest(requestId *uuid.UUID, viewer *models.Viewer, rejection profile.RejectChangeRequest) (*models.ChangeRequest, error) {
+	ret := _m.Called(requestId, viewer, rejection)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RejectChangeRequest")
+	}
+
+	var r0 *models.ChangeRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *models.Viewer, profile.RejectChangeRequest) (*models.ChangeRequest, error)); ok {
+		return rf(requestId, viewer, rejection)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *models.Viewer, profile.RejectChangeRequest) *models.ChangeRequest); ok {
+		r0 = rf(requestId, viewer, rejection)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ChangeRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *models.Viewer, profile.RejectChangeRequest) error); ok {
+		r1 = rf(requestId, viewer, rejection)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReleaseIdempotencyKey provides a mock function with given fields: userId, key, requestHash
+func (_m *ProfileUsecase) ReleaseIdempotencyKey(userId string, key string, requestHash string) error {
+	ret := _m.Called(userId, key, requestHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseIdempotencyKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(userId, key, requestHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReserveIdempotencyKey provides a mock function with given fields: userId, key, requestHash
+func (_m *ProfileUsecase) ReserveIdempotencyKey(userId string, key string, requestHash string) (*models.IdempotencyKey, error) {
+	ret := _m.Called(userId, key, requestHash)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveIdempotencyKey")
+	}
+
+	var r0 *models.IdempotencyKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (*models.IdempotencyKey, error)); ok {
+		return rf(userId, key, requestHash)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) *models.IdempotencyKey); ok {
+		r0 = rf(userId, key, requestHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.IdempotencyKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(userId, key, requestHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveIdempotencyKey provides a mock function with given fields: userId, key, requestHash, responseStatus, responseBody
+func (_m *ProfileUsecase) SaveIdempotencyKey(userId string, key string, requestHash string, responseStatus int, responseBody []byte) error {
+	ret := _m.Called(userId, key, requestHash, responseStatus, responseBody)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveIdempotencyKey")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string, int, []byte) error); ok {
+		r0 = rf(userId, key, requestHash, responseStatus, responseBody)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateContact provides a mock function with given fields: profileId, contactId, updateContact
+func (_m *ProfileUsecase) UpdateContact(profileId *uuid.UUID, contactId *uuid.UUID, updateContact profile.UpsertContact) (*models.Contact, error) {
+	ret := _m.Called(profileId, contactId, updateContact)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateContact")
+	}
+
+	var r0 *models.Contact
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID, profile.UpsertContact) (*models.Contact, error)); ok {
+		return rf(profileId, contactId, updateContact)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID, profile.UpsertContact) *models.Contact); ok {
+		r0 = rf(profileId, contactId, updateContact)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Contact)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *uuid.UUID, profile.UpsertContact) error); ok {
+		r1 = rf(profileId, contactId, updateContact)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateEducation provides a mock function with given fields: profileId, educationId, updateEducation
+func (_m *ProfileUsecase) UpdateEducation(profileId *uuid.UUID, educationId *uuid.UUID, updateEducation profile.UpsertEducation) (*models.Education, error) {
+	ret := _m.Called(profileId, educationId, updateEducation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEducation")
+	}
+
+	var r0 *models.Education
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID, profile.UpsertEducation) (*models.Education, error)); ok {
+		return rf(profileId, educationId, updateEducation)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID, profile.UpsertEducation) *models.Education); ok {
+		r0 = rf(profileId, educationId, updateEducation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Education)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *uuid.UUID, profile.UpsertEducation) error); ok {
+		r1 = rf(profileId, educationId, updateEducation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateExperience provides a mock function with given fields: profileId, experienceId, updateExperience
+func (_m *ProfileUsecase) UpdateExperience(profileId *uuid.UUID, experienceId *uuid.UUID, updateExperience profile.UpsertExperience) (*models.Experience, error) {
+	ret := _m.Called(profileId, experienceId, updateExperience)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateExperience")
+	}
+
+	var r0 *models.Experience
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID, profile.UpsertExperience) (*models.Experience, error)); ok {
+		return rf(profileId, experienceId, updateExperience)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID, profile.UpsertExperience) *models.Experience); ok {
+		r0 = rf(profileId, experienceId, updateExperience)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Experience)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *uuid.UUID, profile.UpsertExperience) error); ok {
+		r1 = rf(profileId, experienceId, updateExperience)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateProfile provides a mock function with given fields: profileId, updateProfile
+func (_m *ProfileUsecase) UpdateProfile(profileId *uuid.UUID, updateProfile profile.UpsertProfile) error {
+	ret := _m.Called(profileId, updateProfile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, profile.UpsertProfile) error); ok {
+		r0 = rf(profileId, updateProfile)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateProfileWithApproval provides a mock function with given fields: profileId, updateProfile, viewer
+func (_m *ProfileUsecase) UpdateProfileWithApproval(profileId *uuid.UUID, updateProfile profile.UpsertProfile, viewer *models.Viewer) (*models.ChangeRequest, error) {
+	ret := _m.Called(profileId, updateProfile, viewer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfileWithApproval")
+	}
+
+	var r0 *models.ChangeRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, profile.UpsertProfile, *models.Viewer) (*models.ChangeRequest, error)); ok {
+		return rf(profileId, updateProfile, viewer)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, profile.UpsertProfile, *models.Viewer) *models.ChangeRequest); ok {
+		r0 = rf(profileId, updateProfile, viewer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.ChangeRequest)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, profile.UpsertProfile, *models.Viewer) error); ok {
+		r1 = rf(profileId, updateProfile, viewer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpsertProfile provides a mock function with given fields: profileId, upsertProfile
+func (_m *ProfileUsecase) UpsertProfile(profileId *uuid.UUID, upsertProfile profile.UpsertProfile) (bool, error) {
+	ret := _m.Called(profileId, upsertProfile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertProfile")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, profile.UpsertProfile) (bool, error)); ok {
+		return rf(profileId, upsertProfile)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, profile.UpsertProfile) bool); ok {
+		r0 = rf(profileId, upsertProfile)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, profile.UpsertProfile) error); ok {
+		r1 = rf(profileId, upsertProfile)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VerifyContact provides a mock function with given fields: profileId, contactId
+func (_m *ProfileUsecase) VerifyContact(profileId *uuid.UUID, contactId *uuid.UUID) (*models.Contact, error) {
+	ret := _m.Called(profileId, contactId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyContact")
+	}
+
+	var r0 *models.Contact
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) (*models.Contact, error)); ok {
+		return rf(profileId, contactId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *uuid.UUID) *models.Contact); ok {
+		r0 = rf(profileId, contactId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Contact)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *uuid.UUID) error); ok {
+		r1 = rf(profileId, contactId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // NewProfileUsecase creates a new instance of ProfileUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -21,26 +21,146 @@ func (_m *ServerInterface) DeleteProfileId(c *gin.Context, id uuid.UUID) {
 	_m.Called(c, id)
 }
 
-// GetProfileId provides a mock function with given fields: c, id
-func (_m *ServerInterface) GetProfileId(c *gin.Context, id uuid.UUID) {
+// DeleteProfileIdContactsContactId provides a mock function with given fields: c, id, contactId
+func (_m *ServerInterface) DeleteProfileIdContactsContactId(c *gin.Context, id uuid.UUID, contactId uuid.UUID) {
+	_m.Called(c, id, contactId)
+}
+
+// DeleteProfileIdEducationEducationId provides a mock function with given fields: c, id, educationId
+func (_m *ServerInterface) DeleteProfileIdEducationEducationId(c *gin.Context, id uuid.UUID, educationId uuid.UUID) {
+	_m.Called(c, id, educationId)
+}
+
+// DeleteProfileIdExperienceExperienceId provides a mock function with given fields: c, id, experienceId
+func (_m *ServerInterface) DeleteProfileIdExperienceExperienceId(c *gin.Context, id uuid.UUID, experienceId uuid.UUID) {
+	_m.Called(c, id, experienceId)
+}
+
+// GetGenders provides a mock function with given fields: c
+func (_m *ServerInterface) GetGenders(c *gin.Context) {
+	_m.Called(c)
+}
+
+// GetProfileId provides a mock function with given fields: c, id, params
+func (_m *ServerInterface) GetProfileId(c *gin.Context, id uuid.UUID, params profile.GetProfileIdParams) {
+	_m.Called(c, id, params)
+}
+
+// GetProfileIdContacts provides a mock function with given fields: c, id
+func (_m *ServerInterface) GetProfileIdContacts(c *gin.Context, id uuid.UUID) {
+	_m.Called(c, id)
+}
+
+// GetProfileIdEducation provides a mock function with given fields: c, id
+func (_m *ServerInterface) GetProfileIdEducation(c *gin.Context, id uuid.UUID) {
 	_m.Called(c, id)
 }
 
+// GetProfileIdEnrollments provides a mock function with given fields: c, id
+func (_m *ServerInterface) GetProfileIdEnrollments(c *gin.Context, id uuid.UUID) {
+	_m.Called(c, id)
+}
+
+// GetProfileIdExperience provides a mock function with given fields: c, id
+func (_m *ServerInterface) GetProfileIdExperience(c *gin.Context, id uuid.UUID) {
+	_m.Called(c, id)
+}
+
+// GetProfileIdSimilar provides a mock function with given fields: c, id, params
+func (_m *ServerInterface) GetProfileIdSimilar(c *gin.Context, id uuid.UUID, params profile.GetProfileIdSimilarParams) {
+	_m.Called(c, id, params)
+}
+
 // GetProfiles provides a mock function with given fields: c, params
 func (_m *ServerInterface) GetProfiles(c *gin.Context, params profile.GetProfilesParams) {
 	_m.Called(c, params)
 }
 
-// PostProfile provides a mock function with given fields: c
-func (_m *ServerInterface) PostProfile(c *gin.Context) {
-	_m.Called(c)
+// GetProfilesChangeRequests provides a mock function with given fields: c, params
+func (_m *ServerInterface) GetProfilesChangeRequests(c *gin.Context, params profile.GetProfilesChangeRequestsParams) {
+	_m.Called(c, params)
+}
+
+// GetProfilesDuplicates provides a mock function with given fields: c, params
+func (_m *ServerInterface) GetProfilesDuplicates(c *gin.Context, params profile.GetProfilesDuplicatesParams) {
+	_m.Called(c, params)
+}
+
+// GetProfilesStats provides a mock function with given fields: c, params
+func (_m *ServerInterface) GetProfilesStats(c *gin.Context, params profile.GetProfilesStatsParams) {
+	_m.Called(c, params)
+}
+
+// PostProfile provides a mock function with given fields: c, params
+func (_m *ServerInterface) PostProfile(c *gin.Context, params profile.PostProfileParams) {
+	_m.Called(c, params)
+}
+
+// PostProfileIdContacts provides a mock function with given fields: c, id
+func (_m *ServerInterface) PostProfileIdContacts(c *gin.Context, id uuid.UUID) {
+	_m.Called(c, id)
+}
+
+// PostProfileIdContactsContactIdVerify provides a mock function with given fields: c, id, contactId
+func (_m *ServerInterface) PostProfileIdContactsContactIdVerify(c *gin.Context, id uuid.UUID, contactId uuid.UUID) {
+	_m.Called(c, id, contactId)
+}
+
+// PostProfileIdEducation provides a mock function with given fields: c, id
+func (_m *ServerInterface) PostProfileIdEducation(c *gin.Context, id uuid.UUID) {
+	_m.Called(c, id)
 }
 
-// PutProfileId provides a mock function with given fields: c, id
-func (_m *ServerInterface) PutProfileId(c *gin.Context, id uuid.UUID) {
+// PostProfileIdExperience provides a mock function with given fields: c, id
+func (_m *ServerInterface) PostProfileIdExperience(c *gin.Context, id uuid.UUID) {
 	_m.Called(c, id)
 }
 
+// PostProfilesBatch provides a mock function with given fields: c, params
+func (_m *ServerInterface) PostProfilesBatch(c *gin.Context, params profile.PostProfilesBatchParams) {
+	_m.Called(c, params)
+}
+
+// PostProfilesChangeRequestsIdApprove provides a mock function with given fields: c, id, params
+func (_m *ServerInterface) PostProfilesChangeRequestsIdApprove(c *gin.Context, id uuid.UUID, params profile.PostProfilesChangeRequestsIdApproveParams) {
+	_m.Called(c, id, params)
+}
+
+// PostProfilesChangeRequestsIdReject provides a mock function with given fields: c, id, params
+func (_m *ServerInterface) PostProfilesChangeRequestsIdReject(c *gin.Context, id uuid.UUID, params profile.PostProfilesChangeRequestsIdRejectParams) {
+	_m.Called(c, id, params)
+}
+
+// PostProfilesMatch provides a mock function with given fields: c, params
+func (_m *ServerInterface) PostProfilesMatch(c *gin.Context, params profile.PostProfilesMatchParams) {
+	_m.Called(c, params)
+}
+
+// PostProfilesMerge provides a mock function with given fields: c, params
+func (_m *ServerInterface) PostProfilesMerge(c *gin.Context, params profile.PostProfilesMergeParams) {
+	_m.Called(c, params)
+}
+
+// PutProfileId provides a mock function with given fields: c, id, params
+func (_m *ServerInterface) PutProfileId(c *gin.Context, id uuid.UUID, params profile.PutProfileIdParams) {
+	_m.Called(c, id, params)
+}
+
+// PutProfileIdContactsContactId provides a mock function with given fields: c, id, contactId
+func (_m *ServerInterface) PutProfileIdContactsContactId(c *gin.Context, id uuid.UUID, contactId uuid.UUID) {
+	_m.Called(c, id, contactId)
+}
+
+// PutProfileIdEducationEducationId provides a mock function with given fields: c, id, educationId
+func (_m *ServerInterface) PutProfileIdEducationEducationId(c *gin.Context, id uuid.UUID, educationId uuid.UUID) {
+	_m.Called(c, id, educationId)
+}
+
+// PutProfileIdExperienceExperienceId provides a mock function with given fields: c, id, experienceId
+func (_m *ServerInterface) PutProfileIdExperienceExperienceId(c *gin.Context, id uuid.UUID, experienceId uuid.UUID) {
+	_m.Called(c, id, experienceId)
+}
+
 // NewServerInterface creates a new instance of ServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewServerInterface(t interface {
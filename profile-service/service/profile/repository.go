@@ -7,8 +7,45 @@ import (
 
 type ProfileRepository interface {
 	FetchProfiles(params GetProfilesParams, paginator *models.Paginator) ([]*models.Profile, error)
+	FetchProfileStats(params GetProfilesParams, skillLimit int) (*models.ProfileStats, error)
 	FetchProfileById(profileId *uuid.UUID) (*models.Profile, error)
 	CreateProfile(profile *models.Profile) error
 	UpdateProfile(profile *models.Profile) error
 	DeleteProfile(profileId *uuid.UUID) error
+	WithTransaction(fn func(txRepo ProfileRepository) error) error
+
+	FetchEnrollments(profileId *uuid.UUID) ([]*models.Enrollment, error)
+	FetchContacts(profileId *uuid.UUID) ([]*models.Contact, error)
+	FetchContactById(profileId *uuid.UUID, contactId *uuid.UUID) (*models.Contact, error)
+	CreateContact(contact *models.Contact) error
+	UpdateContact(contact *models.Contact) error
+	DeleteContact(profileId *uuid.UUID, contactId *uuid.UUID) error
+	FetchEducation(profileId *uuid.UUID) ([]*models.Education, error)
+	FetchEducationById(profileId *uuid.UUID, educationId *uuid.UUID) (*models.Education, error)
+	CreateEducation(education *models.Education) error
+	UpdateEducation(education *models.Education) error
+	DeleteEducation(profileId *uuid.UUID, educationId *uuid.UUID) error
+	FetchExperience(profileId *uuid.UUID) ([]*models.Experience, error)
+	FetchExperienceById(profileId *uuid.UUID, experienceId *uuid.UUID) (*models.Experience, error)
+	CreateExperience(experience *models.Experience) error
+	UpdateExperience(experience *models.Experience) error
+	DeleteExperience(profileId *uuid.UUID, experienceId *uuid.UUID) error
+	FetchGenders() ([]*models.GenderOption, error)
+	FetchProfilesByIds(profileIds []uuid.UUID) ([]*models.Profile, error)
+	MatchProfiles(params GetProfilesParams, requirements []*models.SkillRequirement, paginator *models.Paginator) ([]*models.ProfileMatch, error)
+	FetchSimilarCandidates(profileId *uuid.UUID, sameClass *bool, limit int) ([]*models.SimilarCandidate, error)
+	FetchDuplicateCandidates(minNameSimilarity float64, limit int) ([]*models.DuplicateCandidate, error)
+	MergeProfiles(merge *models.ProfileMerge, survivor *models.Profile) error
+	FetchProfileMergeByMergedId(mergedId *uuid.UUID) (*models.ProfileMerge, error)
+
+	FetchChangeRequests(params GetProfilesChangeRequestsParams, paginator *models.Paginator) ([]*models.ChangeRequest, error)
+	FetchChangeRequestById(requestId *uuid.UUID) (*models.ChangeRequest, error)
+	CreateChangeRequest(request *models.ChangeRequest) error
+	ReviewChangeRequest(request *models.ChangeRequest, event *models.ProfileEvent) error
+
+	FetchIdempotencyKey(userId string, key string) (*models.IdempotencyKey, error)
+	ReserveIdempotencyKey(idempotencyKey *models.IdempotencyKey) (bool, error)
+	CompleteIdempotencyKey(idempotencyKey *models.IdempotencyKey) error
+	ReleaseIdempotencyKey(userId string, key string, requestHash string) error
+	DeleteExpiredIdempotencyKeys() error
 }
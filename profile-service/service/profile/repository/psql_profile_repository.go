@@ -1,18 +1,105 @@
 package repository
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/helper"
 	"github.com/jariwat/p_project/profile-service/models"
 	"github.com/jariwat/p_project/profile-service/service/profile"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	uniqueViolationCode     = "23505"
+	foreignKeyViolationCode = "23503"
+	exclusionViolationCode  = "23P01"
+	profileExternalIdIndex  = "idx_profile_external_id"
+	profilePrimaryKeyConstr = "profile_pkey"
+	profileGenderForeignKey = "fk_profile_gender"
+	contactEmailIndex       = "idx_contact_email"
+	educationOverlapConstr  = "education_no_overlap"
+	experienceOverlapConstr = "experience_no_overlap"
+
+	// contactOrder lists the contacts by type, the primary contact of each type first
+	contactOrder = "contact.type, contact.is_primary DESC, contact.created_at, contact.id"
+
+	// historyOrder lists education and experience entries the latest first, ongoing entries before finished ones
+	historyOrder = "end_date DESC NULLS FIRST, start_date DESC, id"
+
+	// historyOverlapExpr is true when the entry overlaps the kept entry of the same table, as the exclusion constraints check
+	historyOverlapExpr = "daterange(kept.start_date, kept.end_date, '[]') && daterange(%[1]s.start_date, %[1]s.end_date, '[]')"
+
+	// normalizedNameExpr matches the expression of idx_profile_normalized_name so
+	// the trigram index can serve the duplicate lookup.
+	normalizedNameExpr = "LOWER(REPLACE(COALESCE(%[1]s.first_name, '') || COALESCE(%[1]s.last_name, ''), ' ', ''))"
+
+	// normalizedLocalNameExpr matches the expression of idx_profile_name_normalized_name
+	normalizedLocalNameExpr = "LOWER(REPLACE(profile_name.first_name || COALESCE(profile_name.middle_name, '') || profile_name.last_name, ' ', ''))"
+
+	// normalizedSkillExpr is models.NormalizeSkillName written in SQL
+	normalizedSkillExpr = `LOWER(REGEXP_REPLACE(TRIM(skill.skill), '\s+', ' ', 'g'))`
+
+	// catalogIdsByKeyQuery lists the catalog entries whose name or alias normalizes to a key
+	catalogIdsByKeyQuery = "SELECT id FROM skill_catalog WHERE normalized_name = ? UNION SELECT catalog_id FROM skill_alias WHERE normalized_alias = ?"
+
+	// mergeRelationshipsQuery copies the relationships of the merged profile to
+	// the survivor, symmetric ones kept in stored order, leaving out those that
+	// would relate the survivor to itself or that it already has
+	mergeRelationshipsQuery = `INSERT INTO relationship (id, type, from_profile_id, to_profile_id, created_at)
+		SELECT uuid_generate_v4(), type,
+			CASE WHEN type IN @symmetric THEN LEAST(from_id, to_id) ELSE from_id END,
+			CASE WHEN type IN @symmetric THEN GREATEST(from_id, to_id) ELSE to_id END,
+			created_at
+		FROM (
+			SELECT type,
+				CASE WHEN from_profile_id = @merged THEN @survivor ELSE from_profile_id END AS from_id,
+				CASE WHEN to_profile_id = @merged THEN @survivor ELSE to_profile_id END AS to_id,
+				created_at
+			FROM relationship WHERE from_profile_id = @merged OR to_profile_id = @merged
+		) moved
+		WHERE from_id <> to_id
+		ON CONFLICT DO NOTHING`
 )
 
 type profileRepository struct {
 	client *gorm.DB
 }
 
+// translateError maps unique violations on profile and contact, overlapping
+// education and experience entries and a gender missing from the gender table
+// to domain errors.
+func translateError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch {
+	case pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == profileExternalIdIndex:
+		return constants.ErrExternalIdConflict
+	case pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == profilePrimaryKeyConstr:
+		return constants.ErrProfileAlreadyExists
+	case pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == contactEmailIndex:
+		return constants.ErrContactEmailConflict
+	case pgErr.Code == exclusionViolationCode && pgErr.ConstraintName == educationOverlapConstr:
+		return constants.ErrEducationOverlap
+	case pgErr.Code == exclusionViolationCode && pgErr.ConstraintName == experienceOverlapConstr:
+		return constants.ErrExperienceOverlap
+	case pgErr.Code == foreignKeyViolationCode && pgErr.ConstraintName == profileGenderForeignKey:
+		return constants.ErrUnknownGender
+	}
+
+	return err
+}
+
 // FetchProfiles implements profile.ProfileRepository.
 func (p *profileRepository) FetchProfiles(params profile.GetProfilesParams, paginator *models.Paginator) ([]*models.Profile, error) {
 	var profiles []*models.Profile
@@ -20,18 +107,29 @@ func (p *profileRepository) FetchProfiles(params profile.GetProfilesParams, pagi
 	var limit = paginator.PerPage
 	var offset = (paginator.Page - 1) * paginator.PerPage
 
-	query := p.client.Model(&models.Profile{})
-
-	if params.SearchWord != nil && *params.SearchWord != "" {
-		likeQuery := "%" + strings.ToLower(strings.ReplaceAll(*params.SearchWord, " ", "")) + "%"
-		query = query.Where(" LOWER(REPLACE(CONCAT_WS('', first_name, middle_name, last_name), ' ', '')) LIKE ?", likeQuery)
+	query, err := p.filterProfiles(p.client.Model(&models.Profile{}), params)
+	if err != nil {
+		return nil, err
 	}
 
 	if err := query.Count(&totalRows).Error; err != nil {
 		return nil, err
 	}
 
-	if err := query.Preload("Skills").
+	if params.Sort != nil && *params.Sort == profile.Name {
+		locale := models.NameLocaleEnglish
+		if params.AcceptLanguage != nil && models.MatchNameLocale(*params.AcceptLanguage) != "" {
+			locale = models.MatchNameLocale(*params.AcceptLanguage)
+		}
+		query = query.Select("profile.*").
+			Joins("LEFT JOIN profile_name AS sort_name ON sort_name.profile_id = profile.id AND sort_name.locale = ?", locale).
+			Order(fmt.Sprintf("COALESCE(sort_name.last_name, profile.last_name) COLLATE %[1]s, COALESCE(sort_name.first_name, profile.first_name) COLLATE %[1]s, profile.id",
+				models.NameCollation(locale)))
+	}
+
+	if err := query.Preload("Contacts", orderContacts).
+		Preload("Skills").
+		Preload("Names").
 		Limit(limit).
 		Offset(offset).
 		Find(&profiles).Error; err != nil {
@@ -43,10 +141,191 @@ func (p *profileRepository) FetchProfiles(params profile.GetProfilesParams, pagi
 	return profiles, nil
 }
 
+// filterProfiles applies the filters of the profile list to query.
+func (p *profileRepository) filterProfiles(query *gorm.DB, params profile.GetProfilesParams) (*gorm.DB, error) {
+	if params.SearchWord != nil && *params.SearchWord != "" {
+		likeQuery := helper.ContainsPattern(strings.ToLower(strings.ReplaceAll(*params.SearchWord, " ", "")))
+		query = query.Where(`(LOWER(REPLACE(CONCAT_WS('', profile.first_name, profile.middle_name, profile.last_name), ' ', '')) LIKE ? ESCAPE '\' OR EXISTS (?))`, likeQuery,
+			p.client.Model(&models.ProfileName{}).Select("1").
+				Where("profile_name.profile_id = profile.id AND "+normalizedLocalNameExpr+` LIKE ? ESCAPE '\'`, likeQuery))
+	}
+
+	if params.ExternalId != nil && *params.ExternalId != "" {
+		query = query.Where("external_id = ?", *params.ExternalId)
+	}
+
+	if params.Email != nil && strings.TrimSpace(*params.Email) != "" {
+		query = query.Where("EXISTS (?)", p.client.Model(&models.Contact{}).Select("1").
+			Where("contact.profile_id = profile.id AND contact.type = ? AND contact.normalized_value = ?",
+				models.ContactTypeEmail, strings.ToLower(strings.TrimSpace(*params.Email))))
+	}
+
+	if params.Gender != nil && len(*params.Gender) > 0 {
+		genders := make([]models.Gender, 0, len(*params.Gender))
+		for _, gender := range *params.Gender {
+			genders = append(genders, models.NormalizeGender(gender))
+		}
+		query = query.Where("gender IN ?", genders)
+	}
+
+	if params.Status != nil && len(*params.Status) > 0 {
+		query = query.Where("profile.status IN ?", *params.Status)
+	}
+
+	if params.SkillLevel != nil {
+		for _, filter := range *params.SkillLevel {
+			skillFilter, ok := models.ParseSkillLevelFilter(filter)
+			if !ok {
+				return nil, constants.ErrInvalidSkillLevelFilter
+			}
+			query = query.Where("EXISTS (?)", p.skillLevelQuery(skillFilter))
+		}
+	}
+
+	if params.Attribute != nil {
+		for _, filter := range *params.Attribute {
+			attributeFilter, ok := models.ParseCustomAttributeFilter(filter)
+			if !ok {
+				return nil, constants.ErrInvalidCustomAttributeFilter
+			}
+			if attributeFilter.Value == nil {
+				query = query.Where("profile.custom_attributes -> ?::text IS NOT NULL", attributeFilter.Key)
+			} else {
+				query = query.Where("profile.custom_attributes ->> ?::text = ?", attributeFilter.Key, *attributeFilter.Value)
+			}
+		}
+	}
+
+	if params.TagAny != nil && len(*params.TagAny) > 0 {
+		tags, ok := models.NormalizeTags(*params.TagAny)
+		if !ok {
+			return nil, constants.ErrInvalidTag
+		}
+		query = query.Where("EXISTS (?)", p.profileTagQuery(tags))
+	}
+
+	if params.TagAll != nil {
+		tags, ok := models.NormalizeTags(*params.TagAll)
+		if !ok {
+			return nil, constants.ErrInvalidTag
+		}
+		for _, tag := range tags {
+			query = query.Where("EXISTS (?)", p.profileTagQuery([]string{tag}))
+		}
+	}
+
+	if params.TagNone != nil && len(*params.TagNone) > 0 {
+		tags, ok := models.NormalizeTags(*params.TagNone)
+		if !ok {
+			return nil, constants.ErrInvalidTag
+		}
+		query = query.Where("NOT EXISTS (?)", p.profileTagQuery(tags))
+	}
+
+	return query, nil
+}
+
+// profileTagQuery selects the profile's tags among tags.
+func (p *profileRepository) profileTagQuery(tags []string) *gorm.DB {
+	return p.client.Model(&models.ProfileTag{}).Select("1").
+		Joins("JOIN tag ON tag.id = profile_tag.tag_id").
+		Where("profile_tag.profile_id = profile.id AND tag.name IN ?", tags)
+}
+
+// skillLevelQuery selects the profile's skills matching filter, by name or
+// through a catalog entry so aliases match the canonical skill.
+func (p *profileRepository) skillLevelQuery(filter *models.SkillLevelFilter) *gorm.DB {
+	query := p.client.Model(&models.Skill{}).Select("1").
+		Where("skill.profile_id = profile.id").
+		Where("("+normalizedSkillExpr+" = ? OR skill.catalog_id IN (?))", filter.Key, gorm.Expr(catalogIdsByKeyQuery, filter.Key, filter.Key))
+
+	if filter.MinProficiency != nil {
+		query = query.Where("skill.proficiency >= ?", *filter.MinProficiency)
+	}
+
+	return query
+}
+
+// FetchProfileStats implements profile.ProfileRepository.
+// Every count is grouped in SQL over the profiles matching the list filters,
+// by_skill counts profiles by normalized skill and keeps the skillLimit most common.
+func (p *profileRepository) FetchProfileStats(params profile.GetProfilesParams, skillLimit int) (*models.ProfileStats, error) {
+	stats := &models.ProfileStats{
+		ByGender: make([]*models.ProfileStatCount, 0),
+		ByClass:  make([]*models.ProfileStatCount, 0),
+		BySkill:  make([]*models.ProfileStatCount, 0),
+		ByMonth:  make([]*models.ProfileStatCount, 0),
+	}
+
+	filtered := func() (*gorm.DB, error) {
+		return p.filterProfiles(p.client.Model(&models.Profile{}), params)
+	}
+
+	query, err := filtered()
+	if err != nil {
+		return nil, err
+	}
+	if err := query.Count(&stats.Total).Error; err != nil {
+		return nil, err
+	}
+
+	groups := []struct {
+		expr   string
+		counts *[]*models.ProfileStatCount
+		order  string
+	}{
+		{"COALESCE(gender, '')", &stats.ByGender, "count DESC, key"},
+		{"COALESCE(class, '')", &stats.ByClass, "count DESC, key"},
+		{"COALESCE(TO_CHAR(DATE_TRUNC('month', created_at), 'YYYY-MM'), '')", &stats.ByMonth, "key"},
+	}
+	for _, group := range groups {
+		query, err := filtered()
+		if err != nil {
+			return nil, err
+		}
+		if err := query.Select(group.expr + " AS key, COUNT(*) AS count").
+			Group("key").
+			Order(group.order).
+			Scan(group.counts).Error; err != nil {
+			return nil, err
+		}
+	}
+
+	query, err = filtered()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.client.Model(&models.Skill{}).
+		Select("MIN(TRIM(skill.skill)) AS key, COUNT(DISTINCT skill.profile_id) AS count").
+		Where("skill.profile_id IN (?)", query.Select("id")).
+		Where("TRIM(skill.skill) <> ''").
+		Group(normalizedSkillExpr).
+		Order("count DESC, key").
+		Limit(skillLimit).
+		Scan(&stats.BySkill).Error; err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// FetchGenders implements profile.ProfileRepository.
+func (p *profileRepository) FetchGenders() ([]*models.GenderOption, error) {
+	var genders []*models.GenderOption
+	if err := p.client.Order("sort_order, code").Find(&genders).Error; err != nil {
+		return nil, err
+	}
+
+	return genders, nil
+}
+
 // FetchProfileById implements profile.ProfileRepository.
 func (p *profileRepository) FetchProfileById(profileId *uuid.UUID) (*models.Profile, error) {
 	var profile models.Profile
-	if err := p.client.Preload("Skills").First(&profile, "id = ?", profileId).Error; err != nil {
+	if err := p.client.Preload("Contacts", orderContacts).Preload("Skills").Preload("Names").First(&profile, "id = ?", profileId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
 		return nil, err
 	}
 
@@ -55,25 +334,31 @@ func (p *profileRepository) FetchProfileById(profileId *uuid.UUID) (*models.Prof
 
 // CreateProfile implements profile.ProfileRepository.
 func (p *profileRepository) CreateProfile(profile *models.Profile) error {
-	return p.client.Transaction(func(tx *gorm.DB) error {
+	err := p.client.Transaction(func(tx *gorm.DB) error {
 		if err := tx.Create(profile).Error; err != nil {
 			return err
 		}
 
-		return nil
+		return enroll(tx, profile)
 	})
+
+	return translateError(err)
 }
 
 // UpdateProfile implements profile.ProfileRepository.
 func (p *profileRepository) UpdateProfile(profile *models.Profile) error {
-	return p.client.Transaction(func(tx *gorm.DB) error {
+	err := p.client.Transaction(func(tx *gorm.DB) error {
 		if err := tx.Model(&models.Profile{}).Where("id = ?", profile.ID).Updates(map[string]interface{}{
-			"first_name":  profile.FirstName,
-			"middle_name": profile.MiddleName,
-			"last_name":   profile.LastName,
-			"gender":      profile.Gender,
-			"class":       profile.Class,
-			"updated_at":  profile.UpdatedAt,
+			"external_id":       profile.ExternalID,
+			"first_name":        profile.FirstName,
+			"middle_name":       profile.MiddleName,
+			"last_name":         profile.LastName,
+			"gender":            profile.Gender,
+			"pronouns":          profile.Pronouns,
+			"class_id":          profile.ClassID,
+			"class":             profile.Class,
+			"custom_attributes": profile.CustomAttributes,
+			"updated_at":        profile.UpdatedAt,
 		}).Error; err != nil {
 			return err
 		}
@@ -88,13 +373,814 @@ func (p *profileRepository) UpdateProfile(profile *models.Profile) error {
 			}
 		}
 
-		return nil
+		if err := tx.Where("profile_id = ?", profile.ID).Delete(&models.ProfileName{}).Error; err != nil {
+			return err
+		}
+
+		for _, name := range profile.Names {
+			if err := tx.Create(name).Error; err != nil {
+				return err
+			}
+		}
+
+		return enroll(tx, profile)
 	})
+
+	return translateError(err)
+}
+
+// enroll makes the class of profile its current enrollment: the previous
+// enrollment ends today and a new one starts in the class once its capacity
+// is checked. The class row is locked until the transaction ends so
+// concurrent enrollments cannot overfill it.
+func enroll(tx *gorm.DB, profile *models.Profile) error {
+	var current models.Enrollment
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("profile_id = ? AND end_date IS NULL", profile.ID).
+		Limit(1).
+		Find(&current).Error; err != nil {
+		return err
+	}
+
+	switch {
+	case current.ID == nil && profile.ClassID == nil:
+		return nil
+	case current.ID != nil && profile.ClassID != nil && *current.ClassID == *profile.ClassID:
+		// already in the class, the seat is kept even if the capacity was lowered since
+		return nil
+	}
+
+	today := models.DateOf(time.Now())
+	// the enrollment ended must not end before it started, as after a promotion dated later
+	if current.ID != nil && current.StartDate.After(today) {
+		return constants.ErrPromotionBeforeEnrollment
+	}
+
+	var enrollment *models.Enrollment
+	if profile.ClassID != nil {
+		var class models.Class
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&class, "id = ?", profile.ClassID).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return constants.ErrUnknownClass
+			}
+			return err
+		}
+
+		if class.Capacity != nil {
+			var enrolled int64
+			if err := tx.Model(&models.Enrollment{}).Where("class_id = ? AND end_date IS NULL", class.ID).Count(&enrolled).Error; err != nil {
+				return err
+			}
+			if enrolled >= int64(*class.Capacity) {
+				return constants.ErrClassFull
+			}
+		}
+
+		enrollment = models.NewEnrollment(profile.ID, &class, class.AcademicYear, today)
+	}
+
+	if current.ID != nil {
+		if err := tx.Model(&models.Enrollment{}).Where("id = ?", current.ID).Updates(map[string]interface{}{
+			"end_date":   today,
+			"updated_at": time.Now(),
+		}).Error; err != nil {
+			return err
+		}
+	}
+
+	if enrollment == nil {
+		return nil
+	}
+
+	return tx.Create(enrollment).Error
 }
 
 // DeleteProfile implements profile.ProfileRepository.
+// It returns ErrProfileNotFound when no profile has the id.
 func (p *profileRepository) DeleteProfile(profileId *uuid.UUID) error {
-	return p.client.Delete(&models.Profile{}, profileId).Error
+	result := p.client.Delete(&models.Profile{}, profileId)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return constants.ErrProfileNotFound
+	}
+
+	return nil
+}
+
+// WithTransaction implements profile.ProfileRepository.
+// fn receives a repository bound to the transaction, which is committed when fn returns nil.
+func (p *profileRepository) WithTransaction(fn func(txRepo profile.ProfileRepository) error) error {
+	return p.client.Transaction(func(tx *gorm.DB) error {
+		return fn(&profileRepository{client: tx})
+	})
+}
+
+// FetchEnrollments implements profile.ProfileRepository.
+func (p *profileRepository) FetchEnrollments(profileId *uuid.UUID) ([]*models.Enrollment, error) {
+	var enrollments []*models.Enrollment
+	if err := p.client.Model(&models.Enrollment{}).
+		Select("enrollment.*, class.code AS class_code").
+		Joins("JOIN class ON class.id = enrollment.class_id").
+		Where("enrollment.profile_id = ?", profileId).
+		Order("enrollment.end_date DESC NULLS FIRST, enrollment.start_date DESC, enrollment.created_at DESC").
+		Find(&enrollments).Error; err != nil {
+		return nil, err
+	}
+
+	return enrollments, nil
+}
+
+func orderContacts(db *gorm.DB) *gorm.DB {
+	return db.Order(contactOrder)
+}
+
+// FetchContacts implements profile.ProfileRepository.
+func (p *profileRepository) FetchContacts(profileId *uuid.UUID) ([]*models.Contact, error) {
+	var contacts []*models.Contact
+	if err := p.client.Where("profile_id = ?", profileId).Order(contactOrder).Find(&contacts).Error; err != nil {
+		return nil, err
+	}
+
+	return contacts, nil
+}
+
+// FetchContactById implements profile.ProfileRepository.
+func (p *profileRepository) FetchContactById(profileId *uuid.UUID, contactId *uuid.UUID) (*models.Contact, error) {
+	var contact models.Contact
+	if err := p.client.First(&contact, "id = ? AND profile_id = ?", contactId, profileId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &contact, nil
+}
+
+// CreateContact implements profile.ProfileRepository.
+// A primary contact takes over from the primary contact of its type.
+func (p *profileRepository) CreateContact(contact *models.Contact) error {
+	err := p.client.Transaction(func(tx *gorm.DB) error {
+		if err := unsetPrimaryContact(tx, contact); err != nil {
+			return err
+		}
+
+		return tx.Create(contact).Error
+	})
+
+	return translateError(err)
+}
+
+// UpdateContact implements profile.ProfileRepository.
+// A primary contact takes over from the primary contact of its type.
+func (p *profileRepository) UpdateContact(contact *models.Contact) error {
+	err := p.client.Transaction(func(tx *gorm.DB) error {
+		if err := unsetPrimaryContact(tx, contact); err != nil {
+			return err
+		}
+
+		return tx.Model(&models.Contact{}).Where("id = ?", contact.ID).Updates(map[string]interface{}{
+			"type":             contact.Type,
+			"value":            contact.Value,
+			"normalized_value": contact.NormalizedValue,
+			"name":             contact.Name,
+			"is_primary":       contact.IsPrimary,
+			"verified_at":      contact.VerifiedAt,
+			"updated_at":       contact.UpdatedAt,
+		}).Error
+	})
+
+	return translateError(err)
+}
+
+// unsetPrimaryContact clears the other primary contact of the type of contact when contact is primary.
+func unsetPrimaryContact(tx *gorm.DB, contact *models.Contact) error {
+	if !contact.IsPrimary {
+		return nil
+	}
+
+	return tx.Model(&models.Contact{}).
+		Where("profile_id = ? AND type = ? AND is_primary AND id <> ?", contact.ProfileID, contact.Type, contact.ID).
+		Updates(map[string]interface{}{
+			"is_primary": false,
+			"updated_at": time.Now(),
+		}).Error
+}
+
+// DeleteContact implements profile.ProfileRepository.
+func (p *profileRepository) DeleteContact(profileId *uuid.UUID, contactId *uuid.UUID) error {
+	result := p.client.Where("id = ? AND profile_id = ?", contactId, profileId).Delete(&models.Contact{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return constants.ErrContactNotFound
+	}
+
+	return nil
+}
+
+// FetchEducation implements profile.ProfileRepository.
+func (p *profileRepository) FetchEducation(profileId *uuid.UUID) ([]*models.Education, error) {
+	education := []*models.Education{}
+	if err := p.client.Where("profile_id = ?", profileId).Order(historyOrder).Find(&education).Error; err != nil {
+		return nil, err
+	}
+
+	return education, nil
+}
+
+// FetchEducationById implements profile.ProfileRepository.
+func (p *profileRepository) FetchEducationById(profileId *uuid.UUID, educationId *uuid.UUID) (*models.Education, error) {
+	var education models.Education
+	if err := p.client.First(&education, "id = ? AND profile_id = ?", educationId, profileId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &education, nil
+}
+
+// CreateEducation implements profile.ProfileRepository.
+func (p *profileRepository) CreateEducation(education *models.Education) error {
+	return translateError(p.client.Create(education).Error)
+}
+
+// UpdateEducation implements profile.ProfileRepository.
+func (p *profileRepository) UpdateEducation(education *models.Education) error {
+	return translateError(p.client.Model(&models.Education{}).Where("id = ?", education.ID).Updates(map[string]interface{}{
+		"institution": education.Institution,
+		"degree":      education.Degree,
+		"start_date":  education.StartDate,
+		"end_date":    education.EndDate,
+		"updated_at":  education.UpdatedAt,
+	}).Error)
+}
+
+// DeleteEducation implements profile.ProfileRepository.
+func (p *profileRepository) DeleteEducation(profileId *uuid.UUID, educationId *uuid.UUID) error {
+	result := p.client.Where("id = ? AND profile_id = ?", educationId, profileId).Delete(&models.Education{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return constants.ErrEducationNotFound
+	}
+
+	return nil
+}
+
+// FetchExperience implements profile.ProfileRepository.
+func (p *profileRepository) FetchExperience(profileId *uuid.UUID) ([]*models.Experience, error) {
+	experience := []*models.Experience{}
+	if err := p.client.Where("profile_id = ?", profileId).Order(historyOrder).Find(&experience).Error; err != nil {
+		return nil, err
+	}
+
+	return experience, nil
+}
+
+// FetchExperienceById implements profile.ProfileRepository.
+func (p *profileRepository) FetchExperienceById(profileId *uuid.UUID, experienceId *uuid.UUID) (*models.Experience, error) {
+	var experience models.Experience
+	if err := p.client.First(&experience, "id = ? AND profile_id = ?", experienceId, profileId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &experience, nil
+}
+
+// CreateExperience implements profile.ProfileRepository.
+func (p *profileRepository) CreateExperience(experience *models.Experience) error {
+	return translateError(p.client.Create(experience).Error)
+}
+
+// UpdateExperience implements profile.ProfileRepository.
+func (p *profileRepository) UpdateExperience(experience *models.Experience) error {
+	return translateError(p.client.Model(&models.Experience{}).Where("id = ?", experience.ID).Updates(map[string]interface{}{
+		"organization": experience.Organization,
+		"role":         experience.Role,
+		"description":  experience.Description,
+		"start_date":   experience.StartDate,
+		"end_date":     experience.EndDate,
+		"updated_at":   experience.UpdatedAt,
+	}).Error)
+}
+
+// DeleteExperience implements profile.ProfileRepository.
+func (p *profileRepository) DeleteExperience(profileId *uuid.UUID, experienceId *uuid.UUID) error {
+	result := p.client.Where("id = ? AND profile_id = ?", experienceId, profileId).Delete(&models.Experience{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return constants.ErrExperienceNotFound
+	}
+
+	return nil
+}
+
+// FetchProfilesByIds implements profile.ProfileRepository.
+func (p *profileRepository) FetchProfilesByIds(profileIds []uuid.UUID) ([]*models.Profile, error) {
+	var profiles []*models.Profile
+	if len(profileIds) == 0 {
+		return profiles, nil
+	}
+
+	if err := p.client.Preload("Skills").Where("id IN ?", profileIds).Find(&profiles).Error; err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// MatchProfiles implements profile.ProfileRepository.
+// Profiles matching the list filters and having every required skill are
+// ranked by the weight of the requirements they match.
+func (p *profileRepository) MatchProfiles(params profile.GetProfilesParams, requirements []*models.SkillRequirement, paginator *models.Paginator) ([]*models.ProfileMatch, error) {
+	var totalRows int64
+	var limit = paginator.PerPage
+	var offset = (paginator.Page - 1) * paginator.PerPage
+
+	query, err := p.filterProfiles(p.client.Model(&models.Profile{}), params)
+	if err != nil {
+		return nil, err
+	}
+
+	// bit i of matched is set when the profile has requirements[i]
+	matchedParts := make([]string, 0, len(requirements))
+	matchedArgs := make([]interface{}, 0, 2*len(requirements))
+	scoreParts := make([]string, 0, len(requirements))
+	scoreArgs := make([]interface{}, 0, 2*len(requirements))
+	for i, requirement := range requirements {
+		skillQuery := p.skillLevelQuery(requirement.LevelFilter())
+		if requirement.Required {
+			query = query.Where("EXISTS (?)", skillQuery)
+		}
+
+		matchedParts = append(matchedParts, "CASE WHEN EXISTS (?) THEN ?::BIGINT ELSE 0 END")
+		matchedArgs = append(matchedArgs, skillQuery, int64(1)<<i)
+		scoreParts = append(scoreParts, "CASE WHEN m.matched & ?::BIGINT <> 0 THEN ?::DOUBLE PRECISION ELSE 0 END")
+		scoreArgs = append(scoreArgs, int64(1)<<i, requirement.Weight)
+	}
+
+	if err := query.Count(&totalRows).Error; err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		ID      uuid.UUID
+		Matched int64
+	}
+	matchedQuery := query.Select("profile.id, ("+strings.Join(matchedParts, " + ")+") AS matched", matchedArgs...)
+	if err := p.client.Table("(?) AS m", matchedQuery).
+		Select("m.id, m.matched").
+		Order(clause.OrderBy{Expression: gorm.Expr("("+strings.Join(scoreParts, " + ")+") DESC, m.id", scoreArgs...)}).
+		Limit(limit).
+		Offset(offset).
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	profileIds := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		profileIds = append(profileIds, row.ID)
+	}
+
+	profiles, err := p.FetchProfilesByIds(profileIds)
+	if err != nil {
+		return nil, err
+	}
+
+	profileById := make(map[uuid.UUID]*models.Profile)
+	for _, profile := range profiles {
+		profileById[*profile.ID] = profile
+	}
+
+	matches := make([]*models.ProfileMatch, 0, len(rows))
+	for _, row := range rows {
+		// skip profiles deleted in between
+		if profile, ok := profileById[row.ID]; ok {
+			matches = append(matches, models.NewProfileMatch(profile, requirements, row.Matched))
+		}
+	}
+
+	paginator.SetTotal(int(totalRows))
+
+	return matches, nil
+}
+
+// FetchSimilarCandidates implements profile.ProfileRepository.
+// Profiles sharing skills with the profile are ranked by the Jaccard similarity
+// of their skill keys, see models.SkillKey. Only profiles in a current status
+// are candidates. sameClass restricts them to the class of the profile, or to
+// the other classes when false.
+func (p *profileRepository) FetchSimilarCandidates(profileId *uuid.UUID, sameClass *bool, limit int) ([]*models.SimilarCandidate, error) {
+	var candidates []*models.SimilarCandidate
+
+	classFilter := ""
+	if sameClass != nil && *sameClass {
+		classFilter = "AND p.class_id = (SELECT class_id FROM profile WHERE id = @id)"
+	} else if sameClass != nil {
+		classFilter = "AND p.class_id IS DISTINCT FROM (SELECT class_id FROM profile WHERE id = @id)"
+	}
+
+	err := p.client.Raw(`WITH skill_key AS (
+SELECT DISTINCT skill.profile_id, COALESCE(skill.catalog_id::TEXT, `+normalizedSkillExpr+`) AS key FROM skill
+),
+target AS (
+SELECT key FROM skill_key WHERE profile_id = @id
+),
+shared AS (
+SELECT k.profile_id, COUNT(*) AS shared_skills FROM skill_key k JOIN target t ON t.key = k.key
+WHERE k.profile_id <> @id GROUP BY k.profile_id
+),
+skill_count AS (
+SELECT profile_id, COUNT(*) AS skills FROM skill_key WHERE profile_id IN (SELECT profile_id FROM shared) GROUP BY profile_id
+)
+SELECT s.profile_id, s.shared_skills, s.shared_skills::DOUBLE PRECISION / (c.skills + (SELECT COUNT(*) FROM target) - s.shared_skills) AS score
+FROM shared s
+JOIN skill_count c ON c.profile_id = s.profile_id
+JOIN profile p ON p.id = s.profile_id AND p.status IN @statuses `+classFilter+`
+ORDER BY score DESC, s.profile_id LIMIT @limit`, map[string]interface{}{"id": profileId, "statuses": models.CurrentProfileStatuses(), "limit": limit}).Scan(&candidates).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return candidates, nil
+}
+
+// FetchDuplicateCandidates implements profile.ProfileRepository.
+// Pairs of profiles in a current status are matched with the pg_trgm similarity
+// operator, each pair is returned once.
+func (p *profileRepository) FetchDuplicateCandidates(minNameSimilarity float64, limit int) ([]*models.DuplicateCandidate, error) {
+	var candidates []*models.DuplicateCandidate
+	nameA := fmt.Sprintf(normalizedNameExpr, "a")
+	nameB := fmt.Sprintf(normalizedNameExpr, "b")
+
+	err := p.client.Transaction(func(tx *gorm.DB) error {
+		// the threshold of the % operator is a setting, scope it to this transaction
+		if err := tx.Exec("SELECT set_config('pg_trgm.similarity_threshold', ?, true)", fmt.Sprintf("%g", minNameSimilarity)).Error; err != nil {
+			return err
+		}
+
+		return tx.Raw(fmt.Sprintf(`SELECT a.id AS profile_id, b.id AS duplicate_id, similarity(%[1]s, %[2]s) AS name_similarity
+FROM profile a JOIN profile b ON a.id < b.id AND %[1]s %% %[2]s
+WHERE a.status IN ? AND b.status IN ?
+ORDER BY name_similarity DESC LIMIT ?`, nameA, nameB), models.CurrentProfileStatuses(), models.CurrentProfileStatuses(), limit).Scan(&candidates).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return candidates, nil
+}
+
+// MergeProfiles implements profile.ProfileRepository.
+// The merged profile's skills that the survivor does not have yet are moved over,
+// the merged profile is deleted, the survivor updated and the merge recorded,
+// all in one transaction. merge.MovedSkills is set to the number of skills moved.
+func (p *profileRepository) MergeProfiles(merge *models.ProfileMerge, survivor *models.Profile) error {
+	err := p.client.Transaction(func(tx *gorm.DB) error {
+		// lock both rows so concurrent merges of the same profiles are serialized
+		var locked []*models.Profile
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ?", []*uuid.UUID{merge.SurvivorID, merge.MergedID}).
+			Find(&locked).Error; err != nil {
+			return err
+		}
+		if len(locked) != 2 {
+			return constants.ErrProfileNotFound
+		}
+
+		var merged models.Profile
+		if err := tx.Preload("Contacts", orderContacts).Preload("Skills").Preload("Names").First(&merged, "id = ?", merge.MergedID).Error; err != nil {
+			return err
+		}
+		var education []*models.Education
+		if err := tx.Where("profile_id = ?", merge.MergedID).Order(historyOrder).Find(&education).Error; err != nil {
+			return err
+		}
+		var experience []*models.Experience
+		if err := tx.Where("profile_id = ?", merge.MergedID).Order(historyOrder).Find(&experience).Error; err != nil {
+			return err
+		}
+		merged.Education, merged.Experience = &education, &experience
+		snapshot, err := json.Marshal(merged)
+		if err != nil {
+			return err
+		}
+		merge.MergedSnapshot = snapshot
+
+		moved := tx.Model(&models.Skill{}).
+			Where("profile_id = ? AND LOWER(TRIM(skill)) NOT IN (?)", merge.MergedID,
+				tx.Model(&models.Skill{}).Select("LOWER(TRIM(skill))").Where("profile_id = ? AND skill IS NOT NULL", merge.SurvivorID)).
+			Updates(map[string]interface{}{
+				"profile_id": merge.SurvivorID,
+				"updated_at": time.Now(),
+			})
+		if moved.Error != nil {
+			return moved.Error
+		}
+		merge.MovedSkills = int(moved.RowsAffected)
+
+		// the survivor inherits the past classes, the current one follows the merged class field
+		if err := tx.Model(&models.Enrollment{}).
+			Where("profile_id = ? AND end_date IS NOT NULL", merge.MergedID).
+			Update("profile_id", merge.SurvivorID).Error; err != nil {
+			return err
+		}
+
+		// as do the names in languages the survivor has no name in
+		if err := tx.Model(&models.ProfileName{}).
+			Where("profile_id = ? AND locale NOT IN (?)", merge.MergedID,
+				tx.Model(&models.ProfileName{}).Select("locale").Where("profile_id = ?", merge.SurvivorID)).
+			Updates(map[string]interface{}{
+				"profile_id": merge.SurvivorID,
+				"updated_at": time.Now(),
+			}).Error; err != nil {
+			return err
+		}
+
+		// and every contact, a contact stays primary only when the survivor has no primary contact of its type
+		if err := tx.Model(&models.Contact{}).
+			Where("profile_id = ? AND is_primary AND type IN (?)", merge.MergedID,
+				tx.Model(&models.Contact{}).Select("type").Where("profile_id = ? AND is_primary", merge.SurvivorID)).
+			Update("is_primary", false).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&models.Contact{}).
+			Where("profile_id = ?", merge.MergedID).
+			Updates(map[string]interface{}{
+				"profile_id": merge.SurvivorID,
+				"updated_at": time.Now(),
+			}).Error; err != nil {
+			return err
+		}
+
+		// and the education and experience entries that do not overlap the survivor's
+		if err := moveHistory(tx, &models.Education{}, merge); err != nil {
+			return err
+		}
+		if err := moveHistory(tx, &models.Experience{}, merge); err != nil {
+			return err
+		}
+
+		// and every certification
+		if err := tx.Model(&models.Certification{}).
+			Where("profile_id = ?", merge.MergedID).
+			Updates(map[string]interface{}{
+				"profile_id": merge.SurvivorID,
+				"updated_at": time.Now(),
+			}).Error; err != nil {
+			return err
+		}
+
+		// and every attachment, the merged profile's photo only when the survivor has none
+		if err := tx.Model(&models.Attachment{}).
+			Where("profile_id = ? AND (kind <> ? OR NOT EXISTS (?))", merge.MergedID, models.AttachmentKindPhoto,
+				tx.Model(&models.Attachment{}).Select("1").Where("profile_id = ? AND kind = ?", merge.SurvivorID, models.AttachmentKindPhoto)).
+			Updates(map[string]interface{}{
+				"profile_id": merge.SurvivorID,
+				"updated_at": time.Now(),
+			}).Error; err != nil {
+			return err
+		}
+
+		// and every tag, those the survivor already has go with the merged profile
+		if err := tx.Exec("INSERT INTO profile_tag (profile_id, tag_id, created_at) "+
+			"SELECT ?, tag_id, created_at FROM profile_tag WHERE profile_id = ? ON CONFLICT DO NOTHING",
+			merge.SurvivorID, merge.MergedID).Error; err != nil {
+			return err
+		}
+
+		// and every note, deleted ones included, updated_at staying the time of the last edit
+		if err := tx.Model(&models.Note{}).
+			Where("profile_id = ?", merge.MergedID).
+			UpdateColumn("profile_id", merge.SurvivorID).Error; err != nil {
+			return err
+		}
+
+		// and every relationship, the merged profile's own edges go with it
+		if err := tx.Exec(mergeRelationshipsQuery, map[string]interface{}{
+			"merged":    merge.MergedID,
+			"survivor":  merge.SurvivorID,
+			"symmetric": models.SymmetricRelationshipTypes(),
+		}).Error; err != nil {
+			return err
+		}
+
+		// skills and a photo left on the merged profile duplicate the survivor's and go with it
+		if err := tx.Delete(&models.Profile{}, merge.MergedID).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.Profile{}).Where("id = ?", survivor.ID).Updates(map[string]interface{}{
+			"external_id":       survivor.ExternalID,
+			"first_name":        survivor.FirstName,
+			"middle_name":       survivor.MiddleName,
+			"last_name":         survivor.LastName,
+			"gender":            survivor.Gender,
+			"pronouns":          survivor.Pronouns,
+			"class_id":          survivor.ClassID,
+			"class":             survivor.Class,
+			"custom_attributes": survivor.CustomAttributes,
+			"updated_at":        survivor.UpdatedAt,
+		}).Error; err != nil {
+			return err
+		}
+
+		if err := enroll(tx, survivor); err != nil {
+			return err
+		}
+
+		return tx.Create(merge).Error
+	})
+
+	return translateError(err)
+}
+
+// moveHistory moves the education or experience entries of the merged profile
+// that overlap none of the survivor's, the others go with the merged profile.
+func moveHistory(tx *gorm.DB, model interface{ TableName() string }, merge *models.ProfileMerge) error {
+	table := model.TableName()
+	return tx.Model(model).
+		Where("profile_id = ? AND NOT EXISTS (?)", merge.MergedID,
+			tx.Table(table+" AS kept").Select("1").Where("kept.profile_id = ? AND "+fmt.Sprintf(historyOverlapExpr, table), merge.SurvivorID)).
+		Updates(map[string]interface{}{
+			"profile_id": merge.SurvivorID,
+			"updated_at": time.Now(),
+		}).Error
+}
+
+// FetchProfileMergeByMergedId implements profile.ProfileRepository.
+func (p *profileRepository) FetchProfileMergeByMergedId(mergedId *uuid.UUID) (*models.ProfileMerge, error) {
+	var merge models.ProfileMerge
+	if err := p.client.First(&merge, "merged_id = ?", mergedId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &merge, nil
+}
+
+// FetchChangeRequests implements profile.ProfileRepository.
+// The change requests are listed the oldest first, in the order they were asked for.
+func (p *profileRepository) FetchChangeRequests(params profile.GetProfilesChangeRequestsParams, paginator *models.Paginator) ([]*models.ChangeRequest, error) {
+	var requests []*models.ChangeRequest
+	var totalRows int64
+	var limit = paginator.PerPage
+	var offset = (paginator.Page - 1) * paginator.PerPage
+
+	query := p.client.Model(&models.ChangeRequest{})
+	if params.Status != nil {
+		query = query.Where("status = ?", *params.Status)
+	}
+	if params.ProfileId != nil {
+		query = query.Where("profile_id = ?", params.ProfileId.String())
+	}
+	if params.RequestedBy != nil {
+		query = query.Where("requested_by = ?", *params.RequestedBy)
+	}
+
+	if err := query.Count(&totalRows).Error; err != nil {
+		return nil, err
+	}
+
+	if err := query.Order("created_at, id").
+		Limit(limit).
+		Offset(offset).
+		Find(&requests).Error; err != nil {
+		return nil, err
+	}
+
+	paginator.SetTotal(int(totalRows))
+
+	return requests, nil
+}
+
+// FetchChangeRequestById implements profile.ProfileRepository.
+func (p *profileRepository) FetchChangeRequestById(requestId *uuid.UUID) (*models.ChangeRequest, error) {
+	var request models.ChangeRequest
+	if err := p.client.First(&request, "id = ?", requestId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &request, nil
+}
+
+// CreateChangeRequest implements profile.ProfileRepository.
+// The change request still pending for the profile is superseded by the new one.
+func (p *profileRepository) CreateChangeRequest(request *models.ChangeRequest) error {
+	return p.client.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.ChangeRequest{}).
+			Where("profile_id = ? AND status = ?", request.ProfileID, models.ChangeRequestStatusPending).
+			Updates(map[string]interface{}{
+				"status":      models.ChangeRequestStatusSuperseded,
+				"reviewed_at": request.CreatedAt,
+			}).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(request).Error
+	})
+}
+
+// ReviewChangeRequest implements profile.ProfileRepository.
+// The request is only updated while still pending, so of two reviews at the
+// same time the second fails, and the event telling the requester is queued
+// with the review.
+func (p *profileRepository) ReviewChangeRequest(request *models.ChangeRequest, event *models.ProfileEvent) error {
+	return p.client.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.ChangeRequest{}).
+			Where("id = ? AND status = ?", request.ID, models.ChangeRequestStatusPending).
+			Updates(map[string]interface{}{
+				"status":        request.Status,
+				"reviewed_by":   request.ReviewedBy,
+				"review_reason": request.ReviewReason,
+				"reviewed_at":   request.ReviewedAt,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return constants.ErrChangeRequestResolved
+		}
+
+		return tx.Create(event).Error
+	})
+}
+
+// FetchIdempotencyKey implements profile.ProfileRepository.
+func (p *profileRepository) FetchIdempotencyKey(userId string, key string) (*models.IdempotencyKey, error) {
+	var idempotencyKey models.IdempotencyKey
+	if err := p.client.First(&idempotencyKey, "user_id = ? AND key = ?", userId, key).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &idempotencyKey, nil
+}
+
+// ReserveIdempotencyKey implements profile.ProfileRepository.
+// The key is inserted unless the user has a live key with the same value, an
+// expired one being replaced, and it reports whether the key was reserved. The
+// insert is atomic, so of two requests with the same key only one reserves it.
+func (p *profileRepository) ReserveIdempotencyKey(idempotencyKey *models.IdempotencyKey) (bool, error) {
+	result := p.client.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "key"}},
+		DoUpdates: clause.AssignmentColumns([]string{"request_hash", "response_status", "response_body", "created_at", "expires_at"}),
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Lt{Column: clause.Column{Table: "idempotency_key", Name: "expires_at"}, Value: time.Now()},
+		}},
+	}).Create(idempotencyKey)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected == 1, nil
+}
+
+// CompleteIdempotencyKey implements profile.ProfileRepository.
+// It stores the response of the request that reserved the key.
+func (p *profileRepository) CompleteIdempotencyKey(idempotencyKey *models.IdempotencyKey) error {
+	return p.client.Model(&models.IdempotencyKey{}).
+		Where("user_id = ? AND key = ? AND request_hash = ?", idempotencyKey.UserID, idempotencyKey.Key, idempotencyKey.RequestHash).
+		Updates(map[string]interface{}{
+			"response_status": idempotencyKey.ResponseStatus,
+			"response_body":   idempotencyKey.ResponseBody,
+			"expires_at":      idempotencyKey.ExpiresAt,
+		}).Error
+}
+
+// ReleaseIdempotencyKey implements profile.ProfileRepository.
+// The key is only deleted while its request is in progress, a stored response is kept.
+func (p *profileRepository) ReleaseIdempotencyKey(userId string, key string, requestHash string) error {
+	return p.client.Where("user_id = ? AND key = ? AND request_hash = ? AND response_status = 0", userId, key, requestHash).
+		Delete(&models.IdempotencyKey{}).Error
+}
+
+// DeleteExpiredIdempotencyKeys implements profile.ProfileRepository.
+func (p *profileRepository) DeleteExpiredIdempotencyKeys() error {
+	return p.client.Where("expires_at < ?", time.Now()).Delete(&models.IdempotencyKey{}).Error
 }
 
 func NewPsqlProfileRepository(client *gorm.DB) profile.ProfileRepository {
@@ -1,12 +1,16 @@
 package repository
 
 import (
+	"encoding/json"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jariwat/p_project/profile-service/constants"
 	"github.com/jariwat/p_project/profile-service/models"
 	_profile "github.com/jariwat/p_project/profile-service/service/profile"
 	"github.com/stretchr/testify/assert"
@@ -48,18 +52,30 @@ func TestFetchProfiles(t *testing.T) {
 
 	// Mock count query
 	likeQuery := "%" + strings.ToLower(strings.ReplaceAll(searchTerm, " ", "")) + "%"
-	countQuery := `SELECT count(*) FROM "profile" WHERE LOWER(REPLACE(CONCAT_WS('', first_name, middle_name, last_name), ' ', '')) LIKE $1`
+	searchQuery := `(LOWER(REPLACE(CONCAT_WS('', profile.first_name, profile.middle_name, profile.last_name), ' ', '')) LIKE $1 ESCAPE '\' OR EXISTS (SELECT 1 FROM "profile_name" WHERE profile_name.profile_id = profile.id AND LOWER(REPLACE(profile_name.first_name || COALESCE(profile_name.middle_name, '') || profile_name.last_name, ' ', '')) LIKE $2 ESCAPE '\'))`
+	countQuery := `SELECT count(*) FROM "profile" WHERE ` + searchQuery
 	mock.ExpectQuery(regexp.QuoteMeta(countQuery)).
-		WithArgs(likeQuery).
+		WithArgs(likeQuery, likeQuery).
 		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
 
-	selectQuery := `SELECT * FROM "profile" WHERE LOWER(REPLACE(CONCAT_WS('', first_name, middle_name, last_name), ' ', '')) LIKE $1 LIMIT $2`
+	selectQuery := `SELECT * FROM "profile" WHERE ` + searchQuery + ` LIMIT $3`
 	mock.ExpectQuery(regexp.QuoteMeta(selectQuery)).
-		WithArgs(likeQuery, limit).
+		WithArgs(likeQuery, likeQuery, limit).
 		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "middle_name", "last_name"}).
 			AddRow(profileID1, "SeiA", "F", "Phanes").
 			AddRow(profileID2, "AliZe", "", "Phanes"))
 
+	contactsQuery := `SELECT * FROM "contact" WHERE "contact"."profile_id" IN ($1,$2) ORDER BY contact.type, contact.is_primary DESC, contact.created_at, contact.id`
+	mock.ExpectQuery(regexp.QuoteMeta(contactsQuery)).
+		WithArgs(profileID1, profileID2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "profile_id", "type", "value"}).
+			AddRow(ptrUUID(), profileID1, "email", "seia@example.com"))
+
+	namesQuery := `SELECT * FROM "profile_name" WHERE "profile_name"."profile_id" IN ($1,$2)`
+	mock.ExpectQuery(regexp.QuoteMeta(namesQuery)).
+		WithArgs(profileID1, profileID2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "profile_id", "locale"}))
+
 	preloadQuery := `SELECT * FROM "skill" WHERE "skill"."profile_id" IN ($1,$2)`
 	mock.ExpectQuery(regexp.QuoteMeta(preloadQuery)).
 		WithArgs(profileID1, profileID2).
@@ -70,6 +86,7 @@ func TestFetchProfiles(t *testing.T) {
 	profiles, err := repo.FetchProfiles(params, paginator)
 	assert.NoError(t, err)
 	assert.Len(t, profiles, 2)
+	assert.Len(t, profiles[0].Contacts, 1)
 
 	assert.Equal(t, 3, paginator.TotalRows)
 
@@ -102,6 +119,20 @@ func TestFetchProfileById(t *testing.T) {
 		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name"}).
 			AddRow(profileID, "SeiA"))
 
+	// Mock preload Contacts query
+	contactsQuery := `SELECT * FROM "contact" WHERE "contact"."profile_id" = $1 ORDER BY contact.type, contact.is_primary DESC, contact.created_at, contact.id`
+	mock.ExpectQuery(regexp.QuoteMeta(contactsQuery)).
+		WithArgs(profileID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "profile_id", "type", "value", "normalized_value", "is_primary"}).
+			AddRow(ptrUUID(), profileID, "phone", "+66812345678", "+66812345678", true))
+
+	// Mock preload Names query
+	namesQuery := `SELECT * FROM "profile_name" WHERE "profile_name"."profile_id" = $1`
+	mock.ExpectQuery(regexp.QuoteMeta(namesQuery)).
+		WithArgs(profileID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "profile_id", "locale", "first_name", "last_name"}).
+			AddRow(ptrUUID(), profileID, "th", "ซีอา", "ฟาเนส"))
+
 	// Mock preload Skills query
 	skillsQuery := `SELECT * FROM "skill" WHERE "skill"."profile_id" = $1`
 	mock.ExpectQuery(regexp.QuoteMeta(skillsQuery)).
@@ -118,6 +149,8 @@ func TestFetchProfileById(t *testing.T) {
 	assert.NotNil(t, result)
 	assert.Equal(t, profileID, result.ID)
 	assert.Equal(t, "SeiA", result.FirstName)
+	assert.Len(t, result.Names, 1)
+	assert.Len(t, result.Contacts, 1)
 
 	// Expectation check
 	assert.NoError(t, mock.ExpectationsWereMet())
@@ -147,6 +180,7 @@ func TestCreateProfile(t *testing.T) {
 		LastName:   "Phanes",
 		Gender:     "FEMALE",
 		Class:      "Queen",
+		Status:     models.ProfileStatusActive,
 		Skills: []*models.Skill{
 			{
 				ID:        ptrUUID(),
@@ -166,16 +200,21 @@ func TestCreateProfile(t *testing.T) {
 
 	// Expect INSERT INTO "profile"
 	mock.ExpectExec(`INSERT INTO "profile"`).
-		WithArgs(profile.ID, profile.FirstName, profile.MiddleName, profile.LastName, profile.Gender, profile.Class, profile.CreatedAt, profile.UpdatedAt).
+		WithArgs(profile.ID, profile.ExternalID, profile.FirstName, profile.MiddleName, profile.LastName, profile.Gender, profile.Pronouns, profile.ClassID, profile.Class, profile.Status, profile.CreatedAt, profile.UpdatedAt).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	
 	// Expect INSERT INTO "skill" for each skill
 	for _, skill := range profile.Skills {
 		mock.ExpectExec(`INSERT INTO "skill"`).
-			WithArgs(skill.ID, skill.ProfileID, skill.Skill, skill.Detail, skill.CreatedAt, skill.UpdatedAt).
+			WithArgs(skill.ID, skill.ProfileID, skill.CatalogID, skill.Skill, skill.Detail, skill.Proficiency, skill.YearsExperience, skill.LastUsed, skill.CreatedAt, skill.UpdatedAt).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 	}
 
+	// Expect the current enrollment lookup, the profile has no class
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "enrollment" WHERE profile_id = $1 AND end_date IS NULL LIMIT $2 FOR UPDATE`)).
+		WithArgs(profile.ID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
 	// Expect COMMIT
 	mock.ExpectCommit()
 
@@ -211,6 +250,7 @@ func TestUpdateProfile(t *testing.T) {
 		LastName:   "Phanes",
 		Gender:     "MALE",
 		Class:      "A",
+		CustomAttributes: json.RawMessage(`{"bus_route":12}`),
 		Skills: []*models.Skill{
 			{
 				ID:        ptrUUID(),
@@ -230,14 +270,18 @@ func TestUpdateProfile(t *testing.T) {
 	mock.ExpectBegin()
 
 	// Expect update query with map of columns
-	updateQuery := `UPDATE "profile" SET "class"=$1,"first_name"=$2,"gender"=$3,"last_name"=$4,"middle_name"=$5,"updated_at"=$6 WHERE id = $7`
+	updateQuery := `UPDATE "profile" SET "class"=$1,"class_id"=$2,"custom_attributes"=$3,"external_id"=$4,"first_name"=$5,"gender"=$6,"last_name"=$7,"middle_name"=$8,"pronouns"=$9,"updated_at"=$10 WHERE id = $11`
 	mock.ExpectExec(regexp.QuoteMeta(updateQuery)).
 		WithArgs(
 			profile.Class,
+			profile.ClassID,
+			profile.CustomAttributes,
+			profile.ExternalID,
 			profile.FirstName,
 			profile.Gender,
 			profile.LastName,
 			profile.MiddleName,
+			profile.Pronouns,
 			profile.UpdatedAt,
 			profileID,
 		).
@@ -251,13 +295,23 @@ func TestUpdateProfile(t *testing.T) {
 
 	// Expect insert for each skill
 	for _, skill := range profile.Skills {
-		insertSkillQuery := `INSERT INTO "skill" ("id","profile_id","skill","detail","created_at","updated_at") VALUES ($1,$2,$3,$4,$5,$6)`
+		insertSkillQuery := `INSERT INTO "skill" ("id","profile_id","catalog_id","skill","detail","proficiency","years_experience","last_used","created_at","updated_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)`
 		mock.ExpectExec(regexp.QuoteMeta(insertSkillQuery)).
-			WithArgs(sqlmock.AnyArg(), skill.ProfileID, skill.Skill, skill.Detail, skill.CreatedAt, skill.UpdatedAt).
+			WithArgs(sqlmock.AnyArg(), skill.ProfileID, skill.CatalogID, skill.Skill, skill.Detail, skill.Proficiency, skill.YearsExperience, skill.LastUsed, skill.CreatedAt, skill.UpdatedAt).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 	}
 
 	// Commit transaction
+	// Expect the names to be replaced, the profile has none
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "profile_name" WHERE profile_id = $1`)).
+		WithArgs(profileID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// Expect the current enrollment lookup, the profile has no class
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "enrollment" WHERE profile_id = $1 AND end_date IS NULL LIMIT $2 FOR UPDATE`)).
+		WithArgs(profile.ID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
 	mock.ExpectCommit()
 
 	// Call UpdateProfile
@@ -304,4 +358,1084 @@ func TestDeleteProfile(t *testing.T) {
 
 	// Check all expectations met
 	assert.NoError(t, mock.ExpectationsWereMet())
-}
\ No newline at end of file
+}
+
+func TestDeleteProfile_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	profileID := ptrUUID()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "profile" WHERE "profile"."id" = $1`)).
+		WithArgs(profileID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	err = repo.DeleteProfile(profileID)
+	assert.Equal(t, constants.ErrProfileNotFound, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchIdempotencyKey_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	query := `SELECT * FROM "idempotency_key" WHERE user_id = $1 AND key = $2 ORDER BY "idempotency_key"."user_id" LIMIT $3`
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs("teacher-42", "retry-1", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"key"}))
+
+	result, err := repo.FetchIdempotencyKey("teacher-42", "retry-1")
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReserveIdempotencyKey_Taken(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "idempotency_key"`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	reserved, err := repo.ReserveIdempotencyKey(&models.IdempotencyKey{UserID: "teacher-42", Key: "retry-1", RequestHash: "abc"})
+	assert.NoError(t, err)
+	assert.False(t, reserved)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTranslateError(t *testing.T) {
+	assert.Equal(t, constants.ErrExternalIdConflict, translateError(&pgconn.PgError{Code: "23505", ConstraintName: "idx_profile_external_id"}))
+	assert.Equal(t, constants.ErrProfileAlreadyExists, translateError(&pgconn.PgError{Code: "23505", ConstraintName: "profile_pkey"}))
+	assert.Equal(t, constants.ErrUnknownGender, translateError(&pgconn.PgError{Code: "23503", ConstraintName: "fk_profile_gender"}))
+	assert.Equal(t, constants.ErrContactEmailConflict, translateError(&pgconn.PgError{Code: "23505", ConstraintName: "idx_contact_email"}))
+
+	otherErr := &pgconn.PgError{Code: "23503"}
+	assert.Equal(t, otherErr, translateError(otherErr))
+	assert.Nil(t, translateError(nil))
+}
+
+func TestFetchProfiles_SkillLevel(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	paginator := &models.Paginator{
+		Page:    1,
+		PerPage: 10,
+	}
+	skillLevel := []string{"Go >= 3"}
+	params := _profile.GetProfilesParams{
+		SkillLevel: &skillLevel,
+	}
+
+	skillFilter := `EXISTS (SELECT 1 FROM "skill" WHERE skill.profile_id = profile.id AND ((LOWER(REGEXP_REPLACE(TRIM(skill.skill), '\s+', ' ', 'g')) = $1 OR skill.catalog_id IN (SELECT id FROM skill_catalog WHERE normalized_name = $2 UNION SELECT catalog_id FROM skill_alias WHERE normalized_alias = $3))) AND skill.proficiency >= $4)`
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "profile" WHERE ` + skillFilter)).
+		WithArgs("go", "go", "go", models.SkillProficiencyIntermediate).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "profile" WHERE ` + skillFilter + ` LIMIT $5`)).
+		WithArgs("go", "go", "go", models.SkillProficiencyIntermediate, paginator.PerPage).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	profiles, err := repo.FetchProfiles(params, paginator)
+	assert.NoError(t, err)
+	assert.Empty(t, profiles)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchProfiles_InvalidSkillLevel(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	for _, filter := range []string{"Go >= 6", "Go >= expert", " >= 3", "Go > 3", "Go=3", "Go <= 3", "Go >= >= 3"} {
+		skillLevel := []string{filter}
+		params := _profile.GetProfilesParams{
+			SkillLevel: &skillLevel,
+		}
+
+		profiles, err := repo.FetchProfiles(params, models.NewPaginator(1, 10))
+		assert.ErrorIs(t, err, constants.ErrInvalidSkillLevelFilter, filter)
+		assert.Nil(t, profiles)
+	}
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchProfileMergeByMergedId_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	mergedID := ptrUUID()
+	query := `SELECT * FROM "profile_merge" WHERE merged_id = $1 ORDER BY "profile_merge"."id" LIMIT $2`
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(mergedID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	result, err := repo.FetchProfileMergeByMergedId(mergedID)
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchDuplicateCandidates(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	profileID := ptrUUID()
+	duplicateID := ptrUUID()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`SELECT set_config('pg_trgm.similarity_threshold', $1, true)`)).
+		WithArgs("0.5").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM profile a JOIN profile b ON a.id < b.id AND LOWER(REPLACE(COALESCE(a.first_name, '') || COALESCE(a.last_name, ''), ' ', '')) % LOWER(REPLACE(COALESCE(b.first_name, '') || COALESCE(b.last_name, ''), ' ', ''))
+WHERE a.status IN ($1,$2) AND b.status IN ($3,$4)`)).
+		WithArgs(models.ProfileStatusDraft, models.ProfileStatusActive, models.ProfileStatusDraft, models.ProfileStatusActive, 100).
+		WillReturnRows(sqlmock.NewRows([]string{"profile_id", "duplicate_id", "name_similarity"}).
+			AddRow(profileID, duplicateID, 0.8))
+	mock.ExpectCommit()
+
+	candidates, err := repo.FetchDuplicateCandidates(0.5, 100)
+	assert.NoError(t, err)
+	assert.Len(t, candidates, 1)
+	assert.Equal(t, duplicateID, candidates[0].DuplicateID)
+	assert.Equal(t, 0.8, candidates[0].NameSimilarity)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMergeProfiles_ProfileGone(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	survivorID := ptrUUID()
+	mergedID := ptrUUID()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "profile" WHERE id IN ($1,$2) FOR UPDATE`)).
+		WithArgs(survivorID, mergedID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(survivorID))
+	mock.ExpectRollback()
+
+	err = repo.MergeProfiles(&models.ProfileMerge{SurvivorID: survivorID, MergedID: mergedID}, &models.Profile{ID: survivorID})
+	assert.Equal(t, constants.ErrProfileNotFound, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMergeProfiles(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	survivorID := ptrUUID()
+	mergedID := ptrUUID()
+	survivor := &models.Profile{ID: survivorID, FirstName: "Jane"}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "profile" WHERE id IN ($1,$2) FOR UPDATE`)).
+		WithArgs(survivorID, mergedID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(survivorID).AddRow(mergedID))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "profile" WHERE id = $1`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(mergedID))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "contact" WHERE "contact"."profile_id" = $1`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "profile_name" WHERE "profile_name"."profile_id" = $1`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "skill" WHERE "skill"."profile_id" = $1`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "education" WHERE profile_id = $1`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "experience" WHERE profile_id = $1`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "skill" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "enrollment" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "profile_name" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "contact" SET "is_primary"`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "contact" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "education" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "experience" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "certification" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	// the merged profile's photo only moves when the survivor has none
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "attachment" SET "profile_id"=$1,"updated_at"=$2 WHERE profile_id = $3 AND (kind <> $4 OR NOT EXISTS (SELECT 1 FROM "attachment" WHERE profile_id = $5 AND kind = $6))`)).
+		WithArgs(survivorID, sqlmock.AnyArg(), mergedID, models.AttachmentKindPhoto, survivorID, models.AttachmentKindPhoto).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO profile_tag`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "note" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO relationship`)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "profile" WHERE "profile"."id" = $1`)).
+		WithArgs(mergedID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "profile" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "enrollment" WHERE profile_id = $1 AND end_date IS NULL`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "profile_merge"`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	merge := &models.ProfileMerge{ID: ptrUUID(), SurvivorID: survivorID, MergedID: mergedID}
+	err = repo.MergeProfiles(merge, survivor)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, merge.MovedSkills)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMatchProfiles(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	paginator := &models.Paginator{
+		Page:    1,
+		PerPage: 10,
+	}
+	requirements := []*models.SkillRequirement{
+		{Skill: "Go", Key: "go", Weight: 2, Required: true},
+		{Skill: "Docker", Key: "docker", Weight: 1},
+		{Skill: "SQL", Key: "sql", Weight: 1},
+	}
+
+	profileID1 := ptrUUID()
+	profileID2 := ptrUUID()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "profile" WHERE EXISTS`)).
+		WithArgs("go", "go", "go").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT m.id, m.matched FROM (SELECT profile.id, (CASE WHEN EXISTS`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "matched"}).
+			AddRow(profileID1, 3).
+			AddRow(profileID2, 5))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "profile" WHERE id IN ($1,$2)`)).
+		WithArgs(*profileID1, *profileID2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name"}).
+			AddRow(profileID2, "AliZe").
+			AddRow(profileID1, "SeiA"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "skill" WHERE "skill"."profile_id" IN ($1,$2)`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	matches, err := repo.MatchProfiles(_profile.GetProfilesParams{}, requirements, paginator)
+	assert.NoError(t, err)
+	assert.Len(t, matches, 2)
+
+	// ranked order is kept and the score is the matched share of the weight
+	assert.Equal(t, "SeiA", matches[0].Profile.FirstName)
+	assert.Equal(t, 0.75, matches[0].Score)
+	assert.Equal(t, []*models.SkillRequirement{requirements[0], requirements[1]}, matches[0].MatchedSkills)
+	assert.Equal(t, []*models.SkillRequirement{requirements[2]}, matches[0].MissingSkills)
+	assert.Equal(t, "AliZe", matches[1].Profile.FirstName)
+	assert.Equal(t, 0.75, matches[1].Score)
+	assert.Equal(t, []*models.SkillRequirement{requirements[1]}, matches[1].MissingSkills)
+	assert.Equal(t, 2, paginator.TotalRows)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchSimilarCandidates_SameClass(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	profileID := ptrUUID()
+	candidateID := ptrUUID()
+	sameClass := true
+	mock.ExpectQuery(`WITH skill_key AS \(.*JOIN profile p ON p.id = s.profile_id AND p.status IN \(\$3,\$4\) AND p.class_id = \(SELECT class_id FROM profile WHERE id = \$5\)\s+ORDER BY score DESC, s.profile_id LIMIT \$6`).
+		WithArgs(profileID, profileID, models.ProfileStatusDraft, models.ProfileStatusActive, profileID, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"profile_id", "shared_skills", "score"}).
+			AddRow(candidateID, 2, 0.5))
+
+	candidates, err := repo.FetchSimilarCandidates(profileID, &sameClass, 5)
+	assert.NoError(t, err)
+	assert.Len(t, candidates, 1)
+	assert.Equal(t, candidateID, candidates[0].ProfileID)
+	assert.Equal(t, 2, candidates[0].SharedSkills)
+	assert.Equal(t, 0.5, candidates[0].Score)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchProfileStats(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	externalID := "STU-000123"
+	params := _profile.GetProfilesParams{
+		ExternalId: &externalID,
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "profile" WHERE external_id = $1`)).
+		WithArgs(externalID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(gender, '') AS key, COUNT(*) AS count FROM "profile" WHERE external_id = $1 GROUP BY "key" ORDER BY count DESC, key`)).
+		WithArgs(externalID).
+		WillReturnRows(sqlmock.NewRows([]string{"key", "count"}).AddRow("MALE", 2).AddRow("FEMALE", 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(class, '') AS key, COUNT(*) AS count FROM "profile" WHERE external_id = $1 GROUP BY "key" ORDER BY count DESC, key`)).
+		WithArgs(externalID).
+		WillReturnRows(sqlmock.NewRows([]string{"key", "count"}).AddRow("Yuusha", 3))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT COALESCE(TO_CHAR(DATE_TRUNC('month', created_at), 'YYYY-MM'), '') AS key, COUNT(*) AS count FROM "profile" WHERE external_id = $1 GROUP BY "key" ORDER BY key`)).
+		WithArgs(externalID).
+		WillReturnRows(sqlmock.NewRows([]string{"key", "count"}).AddRow("2024-04", 1).AddRow("2024-05", 2))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT MIN(TRIM(skill.skill)) AS key, COUNT(DISTINCT skill.profile_id) AS count FROM "skill" WHERE skill.profile_id IN (SELECT "id" FROM "profile" WHERE external_id = $1) AND TRIM(skill.skill) <> '' GROUP BY LOWER(REGEXP_REPLACE(TRIM(skill.skill), '\s+', ' ', 'g')) ORDER BY count DESC, key LIMIT $2`)).
+		WithArgs(externalID, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"key", "count"}).AddRow("Go", 3))
+
+	stats, err := repo.FetchProfileStats(params, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), stats.Total)
+	assert.Equal(t, []*models.ProfileStatCount{{Key: "MALE", Count: 2}, {Key: "FEMALE", Count: 1}}, stats.ByGender)
+	assert.Equal(t, []*models.ProfileStatCount{{Key: "Yuusha", Count: 3}}, stats.ByClass)
+	assert.Equal(t, []*models.ProfileStatCount{{Key: "2024-04", Count: 1}, {Key: "2024-05", Count: 2}}, stats.ByMonth)
+	assert.Equal(t, []*models.ProfileStatCount{{Key: "Go", Count: 3}}, stats.BySkill)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateProfile_ClassFull(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	classID := ptrUUID()
+	profile := &models.Profile{ID: ptrUUID(), FirstName: "SeiA", LastName: "Phanes", Gender: "MALE", ClassID: classID, Class: "M1/1"}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO "profile"`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "enrollment" WHERE profile_id = $1 AND end_date IS NULL LIMIT $2 FOR UPDATE`)).
+		WithArgs(profile.ID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "class" WHERE id = $1 ORDER BY "class"."id" LIMIT $2 FOR UPDATE`)).
+		WithArgs(classID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "code", "capacity"}).AddRow(classID.String(), "M1/1", 2))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "enrollment" WHERE class_id = $1 AND end_date IS NULL`)).
+		WithArgs(classID).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectRollback()
+
+	err = repo.CreateProfile(profile)
+	assert.ErrorIs(t, err, constants.ErrClassFull)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateProfile_KeepsSeatInFullClass(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	classID := ptrUUID()
+	profile := &models.Profile{ID: ptrUUID(), FirstName: "SeiA", LastName: "Phanes", Gender: "MALE", ClassID: classID, Class: "M1/1"}
+	profile.SetUpdatedAt()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "profile" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "skill" WHERE profile_id = $1`)).
+		WithArgs(profile.ID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "profile_name" WHERE profile_id = $1`)).
+		WithArgs(profile.ID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "enrollment" WHERE profile_id = $1 AND end_date IS NULL LIMIT $2 FOR UPDATE`)).
+		WithArgs(profile.ID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "profile_id", "class_id"}).AddRow(ptrUUID().String(), profile.ID.String(), classID.String()))
+	mock.ExpectCommit()
+
+	err = repo.UpdateProfile(profile)
+	assert.NoError(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateProfile_MovesEnrollment(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	classID := ptrUUID()
+	enrollmentID := ptrUUID()
+	profile := &models.Profile{ID: ptrUUID(), FirstName: "SeiA", LastName: "Phanes", Gender: "MALE", ClassID: classID, Class: "M2/1"}
+	profile.SetUpdatedAt()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "profile" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "skill" WHERE profile_id = $1`)).
+		WithArgs(profile.ID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "profile_name" WHERE profile_id = $1`)).
+		WithArgs(profile.ID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "enrollment" WHERE profile_id = $1 AND end_date IS NULL LIMIT $2 FOR UPDATE`)).
+		WithArgs(profile.ID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "profile_id", "class_id"}).AddRow(enrollmentID.String(), profile.ID.String(), ptrUUID().String()))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "class" WHERE id = $1 ORDER BY "class"."id" LIMIT $2 FOR UPDATE`)).
+		WithArgs(classID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "code", "academic_year"}).AddRow(classID.String(), "M2/1", "2025"))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "enrollment" SET "end_date"=$1,"updated_at"=$2 WHERE id = $3`)).
+		WithArgs(models.DateOf(time.Now()), sqlmock.AnyArg(), enrollmentID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO "enrollment"`).
+		WithArgs(sqlmock.AnyArg(), profile.ID, classID, "2025", models.DateOf(time.Now()), nil, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = repo.UpdateProfile(profile)
+	assert.NoError(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateProfile_EnrollmentStartsLater(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	profile := &models.Profile{ID: ptrUUID(), FirstName: "SeiA", LastName: "Phanes", Gender: "MALE", ClassID: ptrUUID(), Class: "M2/1"}
+	profile.SetUpdatedAt()
+
+	// promoted from a date still to come
+	startDate := models.DateOf(time.Now().AddDate(0, 0, 7))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "profile" SET`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "skill" WHERE profile_id = $1`)).
+		WithArgs(profile.ID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "profile_name" WHERE profile_id = $1`)).
+		WithArgs(profile.ID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "enrollment" WHERE profile_id = $1 AND end_date IS NULL LIMIT $2 FOR UPDATE`)).
+		WithArgs(profile.ID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "profile_id", "class_id", "start_date"}).AddRow(ptrUUID().String(), profile.ID.String(), ptrUUID().String(), startDate))
+	mock.ExpectRollback()
+
+	err = repo.UpdateProfile(profile)
+	assert.Equal(t, constants.ErrPromotionBeforeEnrollment, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchProfiles_Gender(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	paginator := &models.Paginator{Page: 1, PerPage: 10}
+	gender := []string{"non_binary", " UNSPECIFIED "}
+	params := _profile.GetProfilesParams{Gender: &gender}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "profile" WHERE gender IN ($1,$2)`)).
+		WithArgs("NON_BINARY", "UNSPECIFIED").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "profile" WHERE gender IN ($1,$2) LIMIT $3`)).
+		WithArgs("NON_BINARY", "UNSPECIFIED", 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	profiles, err := repo.FetchProfiles(params, paginator)
+	assert.NoError(t, err)
+	assert.Empty(t, profiles)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchGenders(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "gender" ORDER BY sort_order, code`)).
+		WillReturnRows(sqlmock.NewRows([]string{"code", "label", "sort_order"}).
+			AddRow("MALE", "Male", 1).
+			AddRow("NON_BINARY", "Non-binary", 3))
+
+	genders, err := repo.FetchGenders()
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.GenderOption{
+		{Code: models.GenderMale, Label: "Male", SortOrder: 1},
+		{Code: models.GenderNonBinary, Label: "Non-binary", SortOrder: 3},
+	}, genders)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchProfiles_SortByThaiName(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	paginator := &models.Paginator{Page: 1, PerPage: 10}
+	sort := _profile.Name
+	acceptLanguage := "th-TH,th;q=0.9,en;q=0.8"
+	params := _profile.GetProfilesParams{Sort: &sort, AcceptLanguage: &acceptLanguage}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "profile"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT profile.* FROM "profile" LEFT JOIN profile_name AS sort_name ON sort_name.profile_id = profile.id AND sort_name.locale = $1 ORDER BY COALESCE(sort_name.last_name, profile.last_name) COLLATE name_th, COALESCE(sort_name.first_name, profile.first_name) COLLATE name_th, profile.id LIMIT $2`)).
+		WithArgs("th", 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	profiles, err := repo.FetchProfiles(params, paginator)
+	assert.NoError(t, err)
+	assert.Empty(t, profiles)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchProfiles_Email(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	paginator := &models.Paginator{Page: 1, PerPage: 10}
+	email := " SeiA@Example.com "
+	params := _profile.GetProfilesParams{Email: &email}
+
+	emailQuery := `EXISTS (SELECT 1 FROM "contact" WHERE contact.profile_id = profile.id AND contact.type = $1 AND contact.normalized_value = $2)`
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "profile" WHERE ` + emailQuery)).
+		WithArgs("email", "seia@example.com").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "profile" WHERE ` + emailQuery + ` LIMIT $3`)).
+		WithArgs("email", "seia@example.com", 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	profiles, err := repo.FetchProfiles(params, paginator)
+	assert.NoError(t, err)
+	assert.Empty(t, profiles)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateContact_Primary(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	contact := &models.Contact{ID: ptrUUID(), ProfileID: ptrUUID(), Type: models.ContactTypePhone,
+		Value: "+66812345678", NormalizedValue: "+66812345678", IsPrimary: true}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "contact" SET "is_primary"=$1,"updated_at"=$2 WHERE profile_id = $3 AND type = $4 AND is_primary AND id <> $5`)).
+		WithArgs(false, sqlmock.AnyArg(), contact.ProfileID, models.ContactTypePhone, contact.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO "contact"`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.CreateContact(contact))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateContact_EmailConflict(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	contact := &models.Contact{ID: ptrUUID(), ProfileID: ptrUUID(), Type: models.ContactTypeEmail,
+		Value: "seia@example.com", NormalizedValue: "seia@example.com"}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "contact" SET`)).
+		WillReturnError(&pgconn.PgError{Code: "23505", ConstraintName: "idx_contact_email"})
+	mock.ExpectRollback()
+
+	assert.ErrorIs(t, repo.UpdateContact(contact), constants.ErrContactEmailConflict)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteContact_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	profileId, contactId := ptrUUID(), ptrUUID()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "contact" WHERE id = $1 AND profile_id = $2`)).
+		WithArgs(contactId, profileId).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	assert.ErrorIs(t, repo.DeleteContact(profileId, contactId), constants.ErrContactNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchEducation_LatestFirst(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	profileId := ptrUUID()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "education" WHERE profile_id = $1 ORDER BY end_date DESC NULLS FIRST, start_date DESC, id`)).
+		WithArgs(profileId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "institution", "start_date"}))
+
+	education, err := repo.FetchEducation(profileId)
+	assert.NoError(t, err)
+	assert.NotNil(t, education)
+	assert.Empty(t, education)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateExperience_Overlap(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	experience := &models.Experience{ID: ptrUUID(), ProfileID: ptrUUID(), Organization: "SCB", Role: "Intern",
+		StartDate: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`INSERT INTO "experience"`).
+		WillReturnError(&pgconn.PgError{Code: "23P01", ConstraintName: "experience_no_overlap"})
+	mock.ExpectRollback()
+
+	assert.ErrorIs(t, repo.CreateExperience(experience), constants.ErrExperienceOverlap)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteEducation_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	profileId, educationId := ptrUUID(), ptrUUID()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "education" WHERE id = $1 AND profile_id = $2`)).
+		WithArgs(educationId, profileId).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	assert.ErrorIs(t, repo.DeleteEducation(profileId, educationId), constants.ErrEducationNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchProfiles_CustomAttribute(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	paginator := &models.Paginator{Page: 1, PerPage: 10}
+	attributes := []string{"bus_route=12", "locker_number"}
+	params := _profile.GetProfilesParams{Attribute: &attributes}
+
+	attributeQuery := `profile.custom_attributes ->> $1::text = $2 AND profile.custom_attributes -> $3::text IS NOT NULL`
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "profile" WHERE ` + attributeQuery)).
+		WithArgs("bus_route", "12", "locker_number").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "profile" WHERE ` + attributeQuery + ` LIMIT $4`)).
+		WithArgs("bus_route", "12", "locker_number", 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	profiles, err := repo.FetchProfiles(params, paginator)
+	assert.NoError(t, err)
+	assert.Empty(t, profiles)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchProfiles_InvalidCustomAttribute(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	for _, filter := range []string{"", "=12", "Bus Route=12"} {
+		attributes := []string{filter}
+		params := _profile.GetProfilesParams{Attribute: &attributes}
+
+		profiles, err := repo.FetchProfiles(params, models.NewPaginator(1, 10))
+		assert.ErrorIs(t, err, constants.ErrInvalidCustomAttributeFilter, filter)
+		assert.Nil(t, profiles)
+	}
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchProfiles_Tags(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	paginator := &models.Paginator{Page: 1, PerPage: 10}
+	params := _profile.GetProfilesParams{
+		TagAny:  &[]string{"scholarship", "Needs Follow Up"},
+		TagAll:  &[]string{"club:robotics"},
+		TagNone: &[]string{"graduated"},
+	}
+
+	tagQuery := `SELECT 1 FROM "profile_tag" JOIN tag ON tag.id = profile_tag.tag_id WHERE profile_tag.profile_id = profile.id AND tag.name IN `
+	filter := `EXISTS (` + tagQuery + `($1,$2)) AND EXISTS (` + tagQuery + `($3)) AND NOT EXISTS (` + tagQuery + `($4))`
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "profile" WHERE ` + filter)).
+		WithArgs("scholarship", "needs-follow-up", "club:robotics", "graduated").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "profile" WHERE ` + filter + ` LIMIT $5`)).
+		WithArgs("scholarship", "needs-follow-up", "club:robotics", "graduated", 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	profiles, err := repo.FetchProfiles(params, paginator)
+	assert.NoError(t, err)
+	assert.Empty(t, profiles)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+
+	profiles, err = repo.FetchProfiles(_profile.GetProfilesParams{TagNone: &[]string{"club robotics!"}}, paginator)
+	assert.ErrorIs(t, err, constants.ErrInvalidTag)
+	assert.Nil(t, profiles)
+}
+
+func TestFetchProfiles_Status(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	paginator := &models.Paginator{Page: 1, PerPage: 10}
+	params := _profile.GetProfilesParams{
+		Status: &[]_profile.ProfileStatus{_profile.ProfileStatusGraduated, _profile.ProfileStatusWithdrawn},
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "profile" WHERE profile.status IN ($1,$2)`)).
+		WithArgs("graduated", "withdrawn").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "profile" WHERE profile.status IN ($1,$2) LIMIT $3`)).
+		WithArgs("graduated", "withdrawn", 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	profiles, err := repo.FetchProfiles(params, paginator)
+	assert.NoError(t, err)
+	assert.Empty(t, profiles)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchChangeRequests_Filters(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	paginator := &models.Paginator{Page: 1, PerPage: 10}
+	status := _profile.GetProfilesChangeRequestsParamsStatusPending
+	requestedBy := "student-1024"
+	params := _profile.GetProfilesChangeRequestsParams{Status: &status, RequestedBy: &requestedBy}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "change_request" WHERE status = $1 AND requested_by = $2`)).
+		WithArgs("pending", requestedBy).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "change_request" WHERE status = $1 AND requested_by = $2 ORDER BY created_at, id LIMIT $3`)).
+		WithArgs("pending", requestedBy, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "status", "changes", "requested_by"}).
+			AddRow(ptrUUID().String(), "pending", []byte(`{"first_name":"Seia"}`), requestedBy))
+
+	requests, err := repo.FetchChangeRequests(params, paginator)
+	assert.NoError(t, err)
+	assert.Len(t, requests, 1)
+	assert.JSONEq(t, `{"first_name":"Seia"}`, string(requests[0].Changes))
+	assert.Equal(t, 1, paginator.TotalRows)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateChangeRequest_SupersedesPending(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	profileId := ptrUUID()
+	lastName := "Phanes-Lee"
+	request, err := models.NewChangeRequest(profileId, &models.ProfileChanges{LastName: &lastName}, "student-1024")
+	assert.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "change_request" SET "reviewed_at"=$1,"status"=$2 WHERE profile_id = $3 AND status = $4`)).
+		WithArgs(request.CreatedAt, "superseded", profileId, "pending").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "change_request"`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	assert.NoError(t, repo.CreateChangeRequest(request))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReviewChangeRequest_AlreadyReviewed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	repo := NewPsqlProfileRepository(gormDB)
+
+	firstName := "Seia"
+	request, err := models.NewChangeRequest(ptrUUID(), &models.ProfileChanges{FirstName: &firstName}, "student-1024")
+	assert.NoError(t, err)
+	request.Review(models.ChangeRequestStatusApproved, "teacher-42", nil)
+	event, err := models.NewChangeRequestEvent(request)
+	assert.NoError(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE "change_request" SET "review_reason"=$1,"reviewed_at"=$2,"reviewed_by"=$3,"status"=$4 WHERE id = $5 AND status = $6`)).
+		WithArgs(nil, request.ReviewedAt, "teacher-42", "approved", request.ID, "pending").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	assert.ErrorIs(t, repo.ReviewChangeRequest(request, event), constants.ErrChangeRequestResolved)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -12,6 +12,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
@@ -19,40 +20,348 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-// Defines values for ProfileGender.
+// Defines values for ChangeRequestStatus.
 const (
-	ProfileGenderFEMALE ProfileGender = "FEMALE"
-	ProfileGenderMALE   ProfileGender = "MALE"
+	ChangeRequestStatusApproved   ChangeRequestStatus = "approved"
+	ChangeRequestStatusPending    ChangeRequestStatus = "pending"
+	ChangeRequestStatusRejected   ChangeRequestStatus = "rejected"
+	ChangeRequestStatusSuperseded ChangeRequestStatus = "superseded"
 )
 
-// Defines values for ProfilesGender.
+// Defines values for ContactType.
 const (
-	ProfilesGenderFEMALE ProfilesGender = "FEMALE"
-	ProfilesGenderMALE   ProfilesGender = "MALE"
+	ContactTypeEmail    ContactType = "email"
+	ContactTypeGuardian ContactType = "guardian"
+	ContactTypePhone    ContactType = "phone"
 )
 
-// Defines values for UpsertProfileGender.
+// Defines values for ProfileBatchOperationOp.
 const (
-	FEMALE UpsertProfileGender = "FEMALE"
-	MALE   UpsertProfileGender = "MALE"
+	Create ProfileBatchOperationOp = "create"
+	Delete ProfileBatchOperationOp = "delete"
+	Update ProfileBatchOperationOp = "update"
 )
 
+// Defines values for ProfileMergeSource.
+const (
+	Merged   ProfileMergeSource = "merged"
+	Survivor ProfileMergeSource = "survivor"
+)
+
+// Defines values for ProfileNameLocale.
+const (
+	En ProfileNameLocale = "en"
+	Th ProfileNameLocale = "th"
+)
+
+// Defines values for ProfileStatus.
+const (
+	ProfileStatusActive    ProfileStatus = "active"
+	ProfileStatusArchived  ProfileStatus = "archived"
+	ProfileStatusDraft     ProfileStatus = "draft"
+	ProfileStatusGraduated ProfileStatus = "graduated"
+	ProfileStatusWithdrawn ProfileStatus = "withdrawn"
+)
+
+// Defines values for UpsertContactType.
+const (
+	UpsertContactTypeEmail    UpsertContactType = "email"
+	UpsertContactTypeGuardian UpsertContactType = "guardian"
+	UpsertContactTypePhone    UpsertContactType = "phone"
+)
+
+// Defines values for UpsertProfileStatus.
+const (
+	UpsertProfileStatusActive    UpsertProfileStatus = "active"
+	UpsertProfileStatusArchived  UpsertProfileStatus = "archived"
+	UpsertProfileStatusDraft     UpsertProfileStatus = "draft"
+	UpsertProfileStatusGraduated UpsertProfileStatus = "graduated"
+	UpsertProfileStatusWithdrawn UpsertProfileStatus = "withdrawn"
+)
+
+// Defines values for UserRole.
+const (
+	Admin    UserRole = "admin"
+	Guardian UserRole = "guardian"
+	Staff    UserRole = "staff"
+	Student  UserRole = "student"
+)
+
+// Defines values for GetProfileIdParamsInclude.
+const (
+	GetProfileIdParamsIncludeEducation  GetProfileIdParamsInclude = "education"
+	GetProfileIdParamsIncludeExperience GetProfileIdParamsInclude = "experience"
+)
+
+// Defines values for GetProfileIdSimilarParamsClass.
+const (
+	Any       GetProfileIdSimilarParamsClass = "any"
+	Different GetProfileIdSimilarParamsClass = "different"
+	Same      GetProfileIdSimilarParamsClass = "same"
+)
+
+// Defines values for GetProfilesParamsSort.
+const (
+	Name GetProfilesParamsSort = "name"
+)
+
+// Defines values for GetProfilesChangeRequestsParamsStatus.
+const (
+	GetProfilesChangeRequestsParamsStatusApproved   GetProfilesChangeRequestsParamsStatus = "approved"
+	GetProfilesChangeRequestsParamsStatusPending    GetProfilesChangeRequestsParamsStatus = "pending"
+	GetProfilesChangeRequestsParamsStatusRejected   GetProfilesChangeRequestsParamsStatus = "rejected"
+	GetProfilesChangeRequestsParamsStatusSuperseded GetProfilesChangeRequestsParamsStatus = "superseded"
+)
+
+// ChangeRequest defines model for ChangeRequest.
+type ChangeRequest struct {
+	// Changes The new values of the fields that need staff approval, the fields left out stay as they are
+	Changes *ProfileChanges `json:"changes,omitempty"`
+
+	// CreatedAt When the change was asked for
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Id The unique identifier of the change request
+	Id *openapi_types.UUID `json:"id,omitempty"`
+
+	// ProfileId The profile the change is for
+	ProfileId *openapi_types.UUID `json:"profile_id,omitempty"`
+
+	// RequestedBy The user who asked for the change
+	RequestedBy *string `json:"requested_by,omitempty"`
+
+	// ReviewReason Why the change was rejected
+	ReviewReason *string `json:"review_reason,omitempty"`
+
+	// ReviewedAt When the change was approved, rejected or superseded
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+
+	// ReviewedBy The staff member who approved or rejected the change
+	ReviewedBy *string `json:"reviewed_by,omitempty"`
+
+	// Status The review status, superseded once a later change of the same profile replaced it
+	Status *ChangeRequestStatus `json:"status,omitempty"`
+}
+
+// ChangeRequestStatus The review status, superseded once a later change of the same profile replaced it
+type ChangeRequestStatus string
+
+// ChangeRequestResponse defines model for ChangeRequestResponse.
+type ChangeRequestResponse struct {
+	Data *ChangeRequest `json:"data,omitempty"`
+}
+
+// ChangeRequestsPaginationResponse defines model for ChangeRequestsPaginationResponse.
+type ChangeRequestsPaginationResponse struct {
+	Data *[]ChangeRequest `json:"data,omitempty"`
+
+	// Page Current page number
+	Page *int `json:"page,omitempty"`
+
+	// PerPage Number of items per page
+	PerPage *int `json:"per_page,omitempty"`
+
+	// TotalPages Total number of pages
+	TotalPages *int `json:"total_pages,omitempty"`
+
+	// TotalRows Total rows of change requests
+	TotalRows *int `json:"total_rows,omitempty"`
+}
+
+// Contact defines model for Contact.
+type Contact struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Id The unique identifier of the contact
+	Id *openapi_types.UUID `json:"id,omitempty"`
+
+	// IsPrimary Whether it is the contact to use first among the contacts of its type
+	IsPrimary *bool `json:"is_primary,omitempty"`
+
+	// Name Who the contact reaches when it is not the profile itself, such as the name of a guardian
+	Name *string `json:"name,omitempty"`
+
+	// Type The kind of contact, a guardian is reached by phone or email
+	Type      *ContactType `json:"type,omitempty"`
+	UpdatedAt *time.Time   `json:"updated_at,omitempty"`
+
+	// Value The email address, or the phone number in E.164 format
+	Value *string `json:"value,omitempty"`
+
+	// VerifiedAt When the value was confirmed to reach the contact, empty until it is verified
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+}
+
+// ContactType The kind of contact, a guardian is reached by phone or email
+type ContactType string
+
+// ContactResponse defines model for ContactResponse.
+type ContactResponse struct {
+	Data *Contact `json:"data,omitempty"`
+}
+
+// ContactsResponse defines model for ContactsResponse.
+type ContactsResponse struct {
+	// Data Contacts of the profile by type, the primary contact of each type first
+	Data *[]Contact `json:"data,omitempty"`
+}
+
+// CustomAttributes Values of the custom attributes defined with PUT /attributes/{key}, each checked against the schema of its attribute. GET /openapi.json describes the attributes defined at the moment. Updating a profile replaces its values, they are kept when left out.
+type CustomAttributes map[string]interface{}
+
+// Education A school or university the profile studied at
+type Education struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Degree The degree or level studied for
+	Degree *string `json:"degree,omitempty"`
+
+	// EndDate The last day of study, not set while the profile still studies there
+	EndDate *openapi_types.Date `json:"end_date,omitempty"`
+
+	// Id The unique identifier of the education entry
+	Id *openapi_types.UUID `json:"id,omitempty"`
+
+	// Institution The school or university
+	Institution *string `json:"institution,omitempty"`
+
+	// StartDate The first day of study
+	StartDate *openapi_types.Date `json:"start_date,omitempty"`
+	UpdatedAt *time.Time          `json:"updated_at,omitempty"`
+}
+
+// EducationListResponse defines model for EducationListResponse.
+type EducationListResponse struct {
+	// Data Education of the profile, the latest first
+	Data *[]Education `json:"data,omitempty"`
+}
+
+// EducationResponse defines model for EducationResponse.
+type EducationResponse struct {
+	// Data A school or university the profile studied at
+	Data *Education `json:"data,omitempty"`
+}
+
+// Enrollment defines model for Enrollment.
+type Enrollment struct {
+	// AcademicYear The academic year of the enrollment
+	AcademicYear *string `json:"academic_year,omitempty"`
+
+	// ClassCode The current code of the class
+	ClassCode *string `json:"class_code,omitempty"`
+
+	// ClassId The class the profile was enrolled in
+	ClassId   *openapi_types.UUID `json:"class_id,omitempty"`
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+
+	// EndDate The first day no longer in the class, not set for the current enrollment
+	EndDate *openapi_types.Date `json:"end_date,omitempty"`
+
+	// Id The unique identifier of the enrollment
+	Id *openapi_types.UUID `json:"id,omitempty"`
+
+	// ProfileId The enrolled profile
+	ProfileId *openapi_types.UUID `json:"profile_id,omitempty"`
+
+	// StartDate The first day in the class
+	StartDate *openapi_types.Date `json:"start_date,omitempty"`
+	UpdatedAt *time.Time          `json:"updated_at,omitempty"`
+}
+
+// EnrollmentsResponse defines model for EnrollmentsResponse.
+type EnrollmentsResponse struct {
+	// Data Enrollments of the profile, the most recent first
+	Data *[]Enrollment `json:"data,omitempty"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Message Error message
 	Message string `json:"message"`
 }
 
+// Experience A position the profile held in an organization
+type Experience struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Description What the profile did in the role
+	Description *string `json:"description,omitempty"`
+
+	// EndDate The last day in the role, not set while the profile still holds it
+	EndDate *openapi_types.Date `json:"end_date,omitempty"`
+
+	// Id The unique identifier of the experience entry
+	Id *openapi_types.UUID `json:"id,omitempty"`
+
+	// Organization The company or organization
+	Organization *string `json:"organization,omitempty"`
+
+	// Role The position held
+	Role *string `json:"role,omitempty"`
+
+	// StartDate The first day in the role
+	StartDate *openapi_types.Date `json:"start_date,omitempty"`
+	UpdatedAt *time.Time          `json:"updated_at,omitempty"`
+}
+
+// ExperienceListResponse defines model for ExperienceListResponse.
+type ExperienceListResponse struct {
+	// Data Experience of the profile, the latest first
+	Data *[]Experience `json:"data,omitempty"`
+}
+
+// ExperienceResponse defines model for ExperienceResponse.
+type ExperienceResponse struct {
+	// Data A position the profile held in an organization
+	Data *Experience `json:"data,omitempty"`
+}
+
+// GenderOption defines model for GenderOption.
+type GenderOption struct {
+	// Code The value stored in the gender of a profile
+	Code *string `json:"code,omitempty"`
+
+	// Label The name to show for the value
+	Label *string `json:"label,omitempty"`
+}
+
+// GendersResponse defines model for GendersResponse.
+type GendersResponse struct {
+	// Data The allowed genders in display order
+	Data *[]GenderOption `json:"data,omitempty"`
+}
+
 // Profile defines model for Profile.
 type Profile struct {
-	// Class The class of the profile
+	// Class The code of the class of the profile
 	Class *string `json:"class,omitempty"`
 
+	// ClassId The class of the profile
+	ClassId *openapi_types.UUID `json:"class_id,omitempty"`
+
+	// Contacts Email addresses, phone numbers and guardians to reach the profile, see /profile/{id}/contacts
+	Contacts *[]Contact `json:"contacts,omitempty"`
+
+	// CustomAttributes Values of the custom attributes defined with PUT /attributes/{key}, each checked against the schema of its attribute. GET /openapi.json describes the attributes defined at the moment. Updating a profile replaces its values, they are kept when left out.
+	CustomAttributes *CustomAttributes `json:"custom_attributes,omitempty"`
+
+	// DisplayName The name in the language picked from the Accept-Language header, the first, middle and last name when the profile has no name in it
+	DisplayName *string `json:"display_name,omitempty"`
+
+	// Education Schools and universities the profile studied at, the latest first. Only returned when asked for with include=education.
+	Education *[]Education `json:"education,omitempty"`
+
+	// Experience Positions the profile held, the latest first. Only returned when asked for with include=experience.
+	Experience *[]Experience `json:"experience,omitempty"`
+
+	// ExternalId The identifier of the profile in an external system
+	ExternalId *string `json:"external_id,omitempty"`
+
 	// FirstName The first name of the profile
 	FirstName *string `json:"first_name,omitempty"`
 
-	// Gender The gender of the profile
-	Gender *ProfileGender `json:"gender,omitempty"`
+	// Gender The gender of the profile, one of the codes listed by GET /genders such as MALE, FEMALE, NON_BINARY or UNSPECIFIED
+	Gender *string `json:"gender,omitempty"`
 
 	// Id The unique identifier of the profile
 	Id *openapi_types.UUID `json:"id,omitempty"`
@@ -61,28 +370,304 @@ type Profile struct {
 	LastName *string `json:"last_name,omitempty"`
 
 	// MiddleName The middle name of the profile
-	MiddleName *string  `json:"middle_name,omitempty"`
-	Skills     *[]Skill `json:"skills,omitempty"`
+	MiddleName *string `json:"middle_name,omitempty"`
+
+	// Names The name of the profile in other languages
+	Names *[]ProfileName `json:"names,omitempty"`
+
+	// Pronouns The pronouns the profile goes by
+	Pronouns *string  `json:"pronouns,omitempty"`
+	Skills   *[]Skill `json:"skills,omitempty"`
+
+	// Status Where the profile is in its lifecycle. A draft becomes active, an active profile graduates or withdraws, and a graduated or withdrawn profile is archived.
+	Status *ProfileStatus `json:"status,omitempty"`
+}
+
+// ProfileBatchOperation defines model for ProfileBatchOperation.
+type ProfileBatchOperation struct {
+	Data *UpsertProfile `json:"data,omitempty"`
+
+	// Id The profile id, required for update and delete, optional for create
+	Id *openapi_types.UUID `json:"id,omitempty"`
+
+	// Op The operation to run
+	Op ProfileBatchOperationOp `json:"op"`
+}
+
+// ProfileBatchOperationOp The operation to run
+type ProfileBatchOperationOp string
+
+// ProfileBatchRequest defines model for ProfileBatchRequest.
+type ProfileBatchRequest struct {
+	Operations []ProfileBatchOperation `json:"operations"`
+}
+
+// ProfileBatchResponse defines model for ProfileBatchResponse.
+type ProfileBatchResponse struct {
+	// Atomic Whether the batch ran in a single transaction
+	Atomic *bool `json:"atomic,omitempty"`
+
+	// Committed Whether the successful operations were persisted
+	Committed *bool `json:"committed,omitempty"`
+
+	// Failed Number of failed operations
+	Failed  *int                  `json:"failed,omitempty"`
+	Results *[]ProfileBatchResult `json:"results,omitempty"`
+
+	// Succeeded Number of successful operations
+	Succeeded *int `json:"succeeded,omitempty"`
+}
+
+// ProfileBatchResult defines model for ProfileBatchResult.
+type ProfileBatchResult struct {
+	// ChangeRequestId The change request waiting for staff approval, for an update with a 202 status
+	ChangeRequestId *openapi_types.UUID `json:"change_request_id,omitempty"`
+
+	// Error The reason the operation failed
+	Error *string `json:"error,omitempty"`
+
+	// Id The id of the affected profile
+	Id *openapi_types.UUID `json:"id,omitempty"`
+
+	// Index The position of the operation in the request
+	Index int `json:"index"`
+
+	// Op The operation that was run
+	Op string `json:"op"`
+
+	// Status The HTTP status of the operation
+	Status int `json:"status"`
+}
+
+// ProfileChanges The new values of the fields that need staff approval, the fields left out stay as they are
+type ProfileChanges struct {
+	// Class The code of the new class, blank when the profile leaves its class
+	Class *string `json:"class,omitempty"`
+
+	// ClassId The new class, left out when the profile leaves its class
+	ClassId *openapi_types.UUID `json:"class_id,omitempty"`
+
+	// FirstName The new first name
+	FirstName *string `json:"first_name,omitempty"`
+
+	// LastName The new last name
+	LastName *string `json:"last_name,omitempty"`
+
+	// Names The new names in other languages, replacing the current ones
+	Names *[]ProfileName `json:"names,omitempty"`
+}
+
+// ProfileDuplicate defines model for ProfileDuplicate.
+type ProfileDuplicate struct {
+	Duplicate *Profile `json:"duplicate,omitempty"`
+
+	// NameSimilarity Trigram similarity of the normalized names, from 0 to 1
+	NameSimilarity *float64 `json:"name_similarity,omitempty"`
+	Profile        *Profile `json:"profile,omitempty"`
+
+	// SameClass Whether both profiles are in the same class
+	SameClass *bool `json:"same_class,omitempty"`
+
+	// Score Overall likelihood that both profiles are the same person, from 0 to 1
+	Score *float64 `json:"score,omitempty"`
+
+	// SharedSkills Skills found on both profiles
+	SharedSkills *[]string `json:"shared_skills,omitempty"`
+}
+
+// ProfileDuplicatesPaginationResponse defines model for ProfileDuplicatesPaginationResponse.
+type ProfileDuplicatesPaginationResponse struct {
+	Data *[]ProfileDuplicate `json:"data,omitempty"`
+
+	// Page Current page number
+	Page *int `json:"page,omitempty"`
+
+	// PerPage Number of items per page
+	PerPage *int `json:"per_page,omitempty"`
+
+	// TotalPages Total number of pages
+	TotalPages *int `json:"total_pages,omitempty"`
+
+	// TotalRows Total number of candidate pairs
+	TotalRows *int `json:"total_rows,omitempty"`
+}
+
+// ProfileMatch defines model for ProfileMatch.
+type ProfileMatch struct {
+	MatchedSkills *[]SkillRequirementMatch `json:"matched_skills,omitempty"`
+	MissingSkills *[]SkillRequirementMatch `json:"missing_skills,omitempty"`
+	Profile       *Profiles                `json:"profile,omitempty"`
+
+	// Score Weight of the matched skills divided by the weight of all requested skills, from 0 to 1
+	Score *float64 `json:"score,omitempty"`
+}
+
+// ProfileMatchPaginationResponse defines model for ProfileMatchPaginationResponse.
+type ProfileMatchPaginationResponse struct {
+	Data *[]ProfileMatch `json:"data,omitempty"`
+
+	// Page Current page number
+	Page *int `json:"page,omitempty"`
+
+	// PerPage Number of items per page
+	PerPage *int `json:"per_page,omitempty"`
+
+	// TotalPages Total number of pages
+	TotalPages *int `json:"total_pages,omitempty"`
+
+	// TotalRows Total number of matching profiles
+	TotalRows *int `json:"total_rows,omitempty"`
+}
+
+// ProfileMatchRequest defines model for ProfileMatchRequest.
+type ProfileMatchRequest struct {
+	// Optional Skills that raise the score of a profile having them
+	Optional *[]SkillRequirement `json:"optional,omitempty"`
+
+	// Required Skills a profile must have
+	Required *[]SkillRequirement `json:"required,omitempty"`
+}
+
+// ProfileMerge defines model for ProfileMerge.
+type ProfileMerge struct {
+	// CreatedAt When the merge happened
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Fields Which profile each field is taken from. Unset fields keep the survivor's value, falling back to the merged profile when it is empty. The pronouns are taken along with the gender.
+	Fields *ProfileMergeFields `json:"fields,omitempty"`
+
+	// Id The unique identifier of the merge record
+	Id *openapi_types.UUID `json:"id,omitempty"`
+
+	// MergedId The profile that was merged and removed
+	MergedId *openapi_types.UUID `json:"merged_id,omitempty"`
+
+	// MovedSkills Number of skills moved from the merged profile
+	MovedSkills *int     `json:"moved_skills,omitempty"`
+	Profile     *Profile `json:"profile,omitempty"`
+
+	// SurvivorId The profile that was kept
+	SurvivorId *openapi_types.UUID `json:"survivor_id,omitempty"`
+}
+
+// ProfileMergeFields Which profile each field is taken from. Unset fields keep the survivor's value, falling back to the merged profile when it is empty. The pronouns are taken along with the gender.
+type ProfileMergeFields struct {
+	// Class The profile the value is taken from
+	Class *ProfileMergeSource `json:"class,omitempty"`
+
+	// ExternalId The profile the value is taken from
+	ExternalId *ProfileMergeSource `json:"external_id,omitempty"`
+
+	// FirstName The profile the value is taken from
+	FirstName *ProfileMergeSource `json:"first_name,omitempty"`
+
+	// Gender The profile the value is taken from
+	Gender *ProfileMergeSource `json:"gender,omitempty"`
+
+	// LastName The profile the value is taken from
+	LastName *ProfileMergeSource `json:"last_name,omitempty"`
+
+	// MiddleName The profile the value is taken from
+	MiddleName *ProfileMergeSource `json:"middle_name,omitempty"`
 }
 
-// ProfileGender The gender of the profile
-type ProfileGender string
+// ProfileMergeRequest defines model for ProfileMergeRequest.
+type ProfileMergeRequest struct {
+	// Fields Which profile each field is taken from. Unset fields keep the survivor's value, falling back to the merged profile when it is empty. The pronouns are taken along with the gender.
+	Fields *ProfileMergeFields `json:"fields,omitempty"`
+
+	// MergedId The profile that is merged into the survivor and removed
+	MergedId openapi_types.UUID `json:"merged_id"`
+
+	// SurvivorId The profile that is kept
+	SurvivorId openapi_types.UUID `json:"survivor_id"`
+}
+
+// ProfileMergeResponse defines model for ProfileMergeResponse.
+type ProfileMergeResponse struct {
+	Data *ProfileMerge `json:"data,omitempty"`
+}
+
+// ProfileMergeSource The profile the value is taken from
+type ProfileMergeSource string
+
+// ProfileName The name of the profile written in one language
+type ProfileName struct {
+	// FirstName The first name in the language
+	FirstName string `json:"first_name"`
+
+	// LastName The last name in the language
+	LastName string `json:"last_name"`
+
+	// Locale The language of the name
+	Locale ProfileNameLocale `json:"locale"`
+
+	// MiddleName The middle name in the language
+	MiddleName *string `json:"middle_name,omitempty"`
+}
+
+// ProfileNameLocale The language of the name
+type ProfileNameLocale string
 
 // ProfileResponse defines model for ProfileResponse.
 type ProfileResponse struct {
 	Data *Profile `json:"data,omitempty"`
 }
 
+// ProfileStatCount defines model for ProfileStatCount.
+type ProfileStatCount struct {
+	// Count Number of profiles
+	Count int `json:"count"`
+
+	// Key The value the profiles are grouped by
+	Key string `json:"key"`
+}
+
+// ProfileStats defines model for ProfileStats.
+type ProfileStats struct {
+	ByClass  *[]ProfileStatCount `json:"by_class,omitempty"`
+	ByGender *[]ProfileStatCount `json:"by_gender,omitempty"`
+
+	// ByMonth Number of profiles created each month, keyed YYYY-MM
+	ByMonth *[]ProfileStatCount `json:"by_month,omitempty"`
+
+	// BySkill Number of profiles having each skill, the most common first
+	BySkill *[]ProfileStatCount `json:"by_skill,omitempty"`
+
+	// Total Number of profiles matching the filters
+	Total *int `json:"total,omitempty"`
+}
+
+// ProfileStatsResponse defines model for ProfileStatsResponse.
+type ProfileStatsResponse struct {
+	Data *ProfileStats `json:"data,omitempty"`
+}
+
+// ProfileStatus Where the profile is in its lifecycle. A draft becomes active, an active profile graduates or withdraws, and a graduated or withdrawn profile is archived.
+type ProfileStatus string
+
 // Profiles defines model for Profiles.
 type Profiles struct {
 	// Class The class of the profile
 	Class *string `json:"class,omitempty"`
 
+	// Contacts Email addresses, phone numbers and guardians to reach the profile, see /profile/{id}/contacts
+	Contacts *[]Contact `json:"contacts,omitempty"`
+
+	// CustomAttributes Values of the custom attributes defined with PUT /attributes/{key}, each checked against the schema of its attribute. GET /openapi.json describes the attributes defined at the moment. Updating a profile replaces its values, they are kept when left out.
+	CustomAttributes *CustomAttributes `json:"custom_attributes,omitempty"`
+
+	// DisplayName The name in the language picked from the Accept-Language header, the first, middle and last name when the profile has no name in it
+	DisplayName *string `json:"display_name,omitempty"`
+
+	// ExternalId The identifier of the profile in an external system
+	ExternalId *string `json:"external_id,omitempty"`
+
 	// FirstName The first name of the profile
 	FirstName *string `json:"first_name,omitempty"`
 
-	// Gender The gender of the profile
-	Gender *ProfilesGender `json:"gender,omitempty"`
+	// Gender The gender of the profile, one of the codes listed by GET /genders such as MALE, FEMALE, NON_BINARY or UNSPECIFIED
+	Gender *string `json:"gender,omitempty"`
 
 	// Id The unique identifier of the profile
 	Id *openapi_types.UUID `json:"id,omitempty"`
@@ -92,10 +677,16 @@ type Profiles struct {
 
 	// MiddleName The middle name of the profile
 	MiddleName *string `json:"middle_name,omitempty"`
-}
 
-// ProfilesGender The gender of the profile
-type ProfilesGender string
+	// Names The name of the profile in other languages
+	Names *[]ProfileName `json:"names,omitempty"`
+
+	// Pronouns The pronouns the profile goes by
+	Pronouns *string `json:"pronouns,omitempty"`
+
+	// Status Where the profile is in its lifecycle. A draft becomes active, an active profile graduates or withdraws, and a graduated or withdrawn profile is archived.
+	Status *ProfileStatus `json:"status,omitempty"`
+}
 
 // ProfilesPaginationResponse defines model for ProfilesPaginationResponse.
 type ProfilesPaginationResponse struct {
@@ -114,58 +705,444 @@ type ProfilesPaginationResponse struct {
 	TotalRows *int `json:"total_rows,omitempty"`
 }
 
+// RejectChangeRequest defines model for RejectChangeRequest.
+type RejectChangeRequest struct {
+	// Reason Why the change is rejected, sent to the user who asked for it
+	Reason *string `json:"reason,omitempty"`
+}
+
+// SimilarProfile defines model for SimilarProfile.
+type SimilarProfile struct {
+	Profile *Profiles `json:"profile,omitempty"`
+
+	// Score Jaccard similarity of the skills of both profiles, from 0 to 1
+	Score *float64 `json:"score,omitempty"`
+
+	// SharedSkills Skills found on both profiles
+	SharedSkills *[]string `json:"shared_skills,omitempty"`
+}
+
+// SimilarProfilesResponse defines model for SimilarProfilesResponse.
+type SimilarProfilesResponse struct {
+	Data *[]SimilarProfile `json:"data,omitempty"`
+}
+
 // Skill defines model for Skill.
 type Skill struct {
+	// CatalogId The skill catalog entry the skill was normalized to, empty for skills waiting for review
+	CatalogId *openapi_types.UUID `json:"catalog_id,omitempty"`
+
 	// Detail Additional details about the skill
 	Detail *string `json:"detail,omitempty"`
 
+	// LastUsed When the skill was last used, only the date is kept
+	LastUsed *time.Time `json:"last_used,omitempty"`
+
+	// Proficiency The proficiency level, 1 beginner, 2 elementary, 3 intermediate, 4 advanced, 5 expert
+	Proficiency *int `json:"proficiency,omitempty"`
+
 	// Skill The skill associated with the profile
 	Skill *string `json:"skill,omitempty"`
+
+	// YearsExperience The years of experience with the skill
+	YearsExperience *float32 `json:"years_experience,omitempty"`
 }
 
-// Success defines model for Success.
-type Success struct {
-	// Id The ID of the updated resource
+// SkillRequirement defines model for SkillRequirement.
+type SkillRequirement struct {
+	// MinProficiency The minimum proficiency level, any level when empty
+	MinProficiency *int `json:"min_proficiency,omitempty"`
+
+	// Skill The skill name, catalog aliases match the canonical skill
+	Skill string `json:"skill"`
+
+	// Weight How much the skill counts in the score
+	Weight *float64 `json:"weight,omitempty"`
+}
+
+// SkillRequirementMatch defines model for SkillRequirementMatch.
+type SkillRequirementMatch struct {
+	// MinProficiency The requested minimum proficiency level
+	MinProficiency *int `json:"min_proficiency,omitempty"`
+
+	// Required Whether the skill was required
+	Required *bool `json:"required,omitempty"`
+
+	// Skill The requested skill
+	Skill *string `json:"skill,omitempty"`
+
+	// Weight The weight of the skill
+	Weight *float64 `json:"weight,omitempty"`
+}
+
+// Success defines model for Success.
+type Success struct {
+	// Id The ID of the updated resource
 	Id *openapi_types.UUID `json:"id,omitempty"`
 
 	// Message success
 	Message string `json:"message"`
 }
 
+// UpsertContact defines model for UpsertContact.
+type UpsertContact struct {
+	// IsPrimary Make it the contact to use first among the contacts of its type
+	IsPrimary *bool `json:"is_primary,omitempty"`
+
+	// Name Who the contact reaches when it is not the profile itself, such as the name of a guardian
+	Name *string `json:"name,omitempty"`
+
+	// Type The kind of contact, a guardian is reached by phone or email
+	Type UpsertContactType `json:"type"`
+
+	// Value An email address, or a phone number in E.164 format. Spaces, dashes, dots and parentheses in phone numbers are ignored.
+	Value string `json:"value"`
+}
+
+// UpsertContactType The kind of contact, a guardian is reached by phone or email
+type UpsertContactType string
+
+// UpsertEducation defines model for UpsertEducation.
+type UpsertEducation struct {
+	// Degree The degree or level studied for
+	Degree *string `json:"degree,omitempty"`
+
+	// EndDate The last day of study, on or after the start date. Leave it out while the profile still studies there.
+	EndDate *openapi_types.Date `json:"end_date,omitempty"`
+
+	// Institution The school or university
+	Institution string `json:"institution"`
+
+	// StartDate The first day of study
+	StartDate openapi_types.Date `json:"start_date"`
+}
+
+// UpsertExperience defines model for UpsertExperience.
+type UpsertExperience struct {
+	// Description What the profile did in the role
+	Description *string `json:"description,omitempty"`
+
+	// EndDate The last day in the role, on or after the start date. Leave it out while the profile still holds it.
+	EndDate *openapi_types.Date `json:"end_date,omitempty"`
+
+	// Organization The company or organization
+	Organization string `json:"organization"`
+
+	// Role The position held
+	Role string `json:"role"`
+
+	// StartDate The first day in the role
+	StartDate openapi_types.Date `json:"start_date"`
+}
+
 // UpsertProfile defines model for UpsertProfile.
 type UpsertProfile struct {
-	// Class The class of the profile
-	Class string `json:"class"`
+	// Class The code of the class of the profile, matched regardless of case and spacing. Leave both class and class_id out for a profile without a class.
+	Class *string `json:"class,omitempty"`
+
+	// ClassId The class of the profile, takes precedence over class
+	ClassId *openapi_types.UUID `json:"class_id,omitempty"`
+
+	// CustomAttributes Values of the custom attributes defined with PUT /attributes/{key}, each checked against the schema of its attribute. GET /openapi.json describes the attributes defined at the moment. Updating a profile replaces its values, they are kept when left out.
+	CustomAttributes *CustomAttributes `json:"custom_attributes,omitempty"`
+
+	// ExternalId The identifier of the profile in an external system, unique across profiles
+	ExternalId *string `json:"external_id,omitempty"`
 
 	// FirstName The first name of the profile
-	FirstName string              `json:"first_name"`
-	Gender    UpsertProfileGender `json:"gender"`
+	FirstName string `json:"first_name"`
+
+	// Gender The gender of the profile, one of the codes listed by GET /genders, matched regardless of case
+	Gender string `json:"gender"`
 
 	// LastName The last name of the profile
 	LastName string `json:"last_name"`
 
 	// MiddleName The middle name of the profile
-	MiddleName *string       `json:"middle_name,omitempty"`
-	Skills     []UpsertSkill `json:"skills"`
+	MiddleName *string `json:"middle_name,omitempty"`
+
+	// Names The name of the profile in other languages, at most one per language. Replaces the names given before.
+	Names *[]ProfileName `json:"names,omitempty"`
+
+	// Pronouns The pronouns the profile goes by, leave out or blank when not given
+	Pronouns *string       `json:"pronouns,omitempty"`
+	Skills   []UpsertSkill `json:"skills"`
+
+	// Status The status a new profile starts in, draft or active, and active when left out. The status of an existing profile only changes through POST /profile/{id}/transitions, so an update must leave it out or give the current one.
+	Status *UpsertProfileStatus `json:"status,omitempty"`
 }
 
-// UpsertProfileGender defines model for UpsertProfile.Gender.
-type UpsertProfileGender string
+// UpsertProfileStatus The status a new profile starts in, draft or active, and active when left out. The status of an existing profile only changes through POST /profile/{id}/transitions, so an update must leave it out or give the current one.
+type UpsertProfileStatus string
 
 // UpsertSkill defines model for UpsertSkill.
 type UpsertSkill struct {
 	// Detail Additional details about the skill
 	Detail string `json:"detail"`
 
+	// LastUsed When the skill was last used, only the date is kept
+	LastUsed *time.Time `json:"last_used,omitempty"`
+
+	// Proficiency The proficiency level, 1 beginner, 2 elementary, 3 intermediate, 4 advanced, 5 expert
+	Proficiency *int `json:"proficiency,omitempty"`
+
 	// Skill The name of the skill
 	Skill string `json:"skill"`
+
+	// YearsExperience The years of experience with the skill
+	YearsExperience *float32 `json:"years_experience,omitempty"`
+}
+
+// UserRole The role of the user making the request, admin and staff are staff members
+type UserRole string
+
+// FilterAttribute defines model for FilterAttribute.
+type FilterAttribute = []string
+
+// FilterEmail defines model for FilterEmail.
+type FilterEmail = string
+
+// FilterExternalId defines model for FilterExternalId.
+type FilterExternalId = string
+
+// FilterGender defines model for FilterGender.
+type FilterGender = []string
+
+// FilterSearchWord defines model for FilterSearchWord.
+type FilterSearchWord = string
+
+// FilterSkillLevel defines model for FilterSkillLevel.
+type FilterSkillLevel = []string
+
+// FilterStatus defines model for FilterStatus.
+type FilterStatus = []ProfileStatus
+
+// FilterTagAll defines model for FilterTagAll.
+type FilterTagAll = []string
+
+// FilterTagAny defines model for FilterTagAny.
+type FilterTagAny = []string
+
+// FilterTagNone defines model for FilterTagNone.
+type FilterTagNone = []string
+
+// PostProfileParams defines parameters for PostProfile.
+type PostProfileParams struct {
+	// IdempotencyKey Client-generated key that makes retries of this request safe. Keys are kept per X-User-Id, which is required with a key. The first response is stored and replayed for retries with the same key unless it was a server error, a retry sent while the first request is still in progress gets a 409.
+	IdempotencyKey *string `json:"Idempotency-Key,omitempty"`
+
+	// XUserId The user making the request, set by the gateway once authenticated
+	XUserId *string `json:"X-User-Id,omitempty"`
+}
+
+// GetProfileIdParams defines parameters for GetProfileId.
+type GetProfileIdParams struct {
+	// Include The history to return with the profile, a comma separated list of education and experience
+	Include *[]GetProfileIdParamsInclude `form:"include,omitempty" json:"include,omitempty"`
+
+	// AcceptLanguage The language of display_name and of the name sort, Thai (th) or English (en)
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
+}
+
+// GetProfileIdParamsInclude defines parameters for GetProfileId.
+type GetProfileIdParamsInclude string
+
+// PutProfileIdParams defines parameters for PutProfileId.
+type PutProfileIdParams struct {
+	// XUserId The user making the request, set by the gateway once authenticated
+	XUserId string `json:"X-User-Id"`
+
+	// XUserRole The role of the user making the request, set by the gateway once authenticated
+	XUserRole UserRole `json:"X-User-Role"`
+}
+
+// GetProfileIdSimilarParams defines parameters for GetProfileIdSimilar.
+type GetProfileIdSimilarParams struct {
+	// Class Only profiles of the same class, of a different class or of any class
+	Class       *GetProfileIdSimilarParamsClass `form:"class,omitempty" json:"class,omitempty"`
+	Limit       *int                            `form:"limit,omitempty" json:"limit,omitempty"`
+	IfNoneMatch *string                         `json:"If-None-Match,omitempty"`
 }
 
+// GetProfileIdSimilarParamsClass defines parameters for GetProfileIdSimilar.
+type GetProfileIdSimilarParamsClass string
+
 // GetProfilesParams defines parameters for GetProfiles.
 type GetProfilesParams struct {
-	SearchWord *string `form:"search_word,omitempty" json:"search_word,omitempty"`
-	Page       *int    `form:"page,omitempty" json:"page,omitempty"`
-	PerPage    *int    `form:"per_page,omitempty" json:"per_page,omitempty"`
+	// SearchWord Part of the name of the profile in any language, spaces are ignored
+	SearchWord *FilterSearchWord `form:"search_word,omitempty" json:"search_word,omitempty"`
+	ExternalId *FilterExternalId `form:"external_id,omitempty" json:"external_id,omitempty"`
+
+	// SkillLevel Skills the profile must have with a minimum proficiency such as `Go>=3`, a skill without a level matches any level. Repeat to require several skills.
+	SkillLevel *FilterSkillLevel `form:"skill_level,omitempty" json:"skill_level,omitempty"`
+
+	// Gender Genders the profile must have one of. Repeat to allow several.
+	Gender *FilterGender `form:"gender,omitempty" json:"gender,omitempty"`
+
+	// Status Statuses the profile must have one of. Repeat to allow several.
+	Status *FilterStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Email Email address of the profile, compared case-insensitively
+	Email *FilterEmail `form:"email,omitempty" json:"email,omitempty"`
+
+	// Attribute Custom attributes the profile must have such as `bus_route=12`, compared as text, an attribute without a value matches any value. Repeat to require several attributes.
+	Attribute *FilterAttribute `form:"attribute,omitempty" json:"attribute,omitempty"`
+
+	// TagAny Tags the profile must have at least one of. Repeat to allow several.
+	TagAny *FilterTagAny `form:"tag_any,omitempty" json:"tag_any,omitempty"`
+
+	// TagAll Tags the profile must have every one of. Repeat to require several.
+	TagAll *FilterTagAll `form:"tag_all,omitempty" json:"tag_all,omitempty"`
+
+	// TagNone Tags the profile must have none of. Repeat to exclude several.
+	TagNone *FilterTagNone `form:"tag_none,omitempty" json:"tag_none,omitempty"`
+
+	// Sort name sorts by last then first name in the language picked from Accept-Language, English when none is given, with the collation of that language
+	Sort    *GetProfilesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+	Page    *int                   `form:"page,omitempty" json:"page,omitempty"`
+	PerPage *int                   `form:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// AcceptLanguage The language of display_name and of the name sort, Thai (th) or English (en)
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
+}
+
+// GetProfilesParamsSort defines parameters for GetProfiles.
+type GetProfilesParamsSort string
+
+// PostProfilesBatchParams defines parameters for PostProfilesBatch.
+type PostProfilesBatchParams struct {
+	// Atomic Run every operation in one transaction, rolling all of them back when one fails. Skill reviews recorded for unknown skills are not rolled back.
+	Atomic *bool `form:"atomic,omitempty" json:"atomic,omitempty"`
+
+	// XUserId The user making the request, set by the gateway once authenticated
+	XUserId string `json:"X-User-Id"`
+
+	// XUserRole The role of the user making the request, set by the gateway once authenticated
+	XUserRole UserRole `json:"X-User-Role"`
+}
+
+// GetProfilesChangeRequestsParams defines parameters for GetProfilesChangeRequests.
+type GetProfilesChangeRequestsParams struct {
+	Status *GetProfilesChangeRequestsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// ProfileId Only the change requests of the profile
+	ProfileId *openapi_types.UUID `form:"profile_id,omitempty" json:"profile_id,omitempty"`
+
+	// RequestedBy Only the change requests the user asked for
+	RequestedBy *string `form:"requested_by,omitempty" json:"requested_by,omitempty"`
+	Page        *int    `form:"page,omitempty" json:"page,omitempty"`
+	PerPage     *int    `form:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// XUserId The user making the request, set by the gateway once authenticated
+	XUserId string `json:"X-User-Id"`
+
+	// XUserRole The role of the user making the request, set by the gateway once authenticated
+	XUserRole UserRole `json:"X-User-Role"`
+}
+
+// GetProfilesChangeRequestsParamsStatus defines parameters for GetProfilesChangeRequests.
+type GetProfilesChangeRequestsParamsStatus string
+
+// PostProfilesChangeRequestsIdApproveParams defines parameters for PostProfilesChangeRequestsIdApprove.
+type PostProfilesChangeRequestsIdApproveParams struct {
+	// XUserId The user making the request, set by the gateway once authenticated
+	XUserId string `json:"X-User-Id"`
+
+	// XUserRole The role of the user making the request, set by the gateway once authenticated
+	XUserRole UserRole `json:"X-User-Role"`
+}
+
+// PostProfilesChangeRequestsIdRejectParams defines parameters for PostProfilesChangeRequestsIdReject.
+type PostProfilesChangeRequestsIdRejectParams struct {
+	// XUserId The user making the request, set by the gateway once authenticated
+	XUserId string `json:"X-User-Id"`
+
+	// XUserRole The role of the user making the request, set by the gateway once authenticated
+	XUserRole UserRole `json:"X-User-Role"`
+}
+
+// GetProfilesDuplicatesParams defines parameters for GetProfilesDuplicates.
+type GetProfilesDuplicatesParams struct {
+	// MinScore Only return pairs scoring at least this value
+	MinScore *float64 `form:"min_score,omitempty" json:"min_score,omitempty"`
+	Page     *int     `form:"page,omitempty" json:"page,omitempty"`
+	PerPage  *int     `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
+// PostProfilesMatchParams defines parameters for PostProfilesMatch.
+type PostProfilesMatchParams struct {
+	// SearchWord Part of the name of the profile in any language, spaces are ignored
+	SearchWord *FilterSearchWord `form:"search_word,omitempty" json:"search_word,omitempty"`
+	ExternalId *FilterExternalId `form:"external_id,omitempty" json:"external_id,omitempty"`
+
+	// SkillLevel Skills the profile must have with a minimum proficiency such as `Go>=3`, a skill without a level matches any level. Repeat to require several skills.
+	SkillLevel *FilterSkillLevel `form:"skill_level,omitempty" json:"skill_level,omitempty"`
+
+	// Gender Genders the profile must have one of. Repeat to allow several.
+	Gender *FilterGender `form:"gender,omitempty" json:"gender,omitempty"`
+
+	// Status Statuses the profile must have one of. Repeat to allow several.
+	Status *FilterStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Email Email address of the profile, compared case-insensitively
+	Email *FilterEmail `form:"email,omitempty" json:"email,omitempty"`
+
+	// Attribute Custom attributes the profile must have such as `bus_route=12`, compared as text, an attribute without a value matches any value. Repeat to require several attributes.
+	Attribute *FilterAttribute `form:"attribute,omitempty" json:"attribute,omitempty"`
+
+	// TagAny Tags the profile must have at least one of. Repeat to allow several.
+	TagAny *FilterTagAny `form:"tag_any,omitempty" json:"tag_any,omitempty"`
+
+	// TagAll Tags the profile must have every one of. Repeat to require several.
+	TagAll *FilterTagAll `form:"tag_all,omitempty" json:"tag_all,omitempty"`
+
+	// TagNone Tags the profile must have none of. Repeat to exclude several.
+	TagNone *FilterTagNone `form:"tag_none,omitempty" json:"tag_none,omitempty"`
+	Page    *int           `form:"page,omitempty" json:"page,omitempty"`
+	PerPage *int           `form:"per_page,omitempty" json:"per_page,omitempty"`
+}
+
+// PostProfilesMergeParams defines parameters for PostProfilesMerge.
+type PostProfilesMergeParams struct {
+	// XUserId The user making the request, set by the gateway once authenticated
+	XUserId string `json:"X-User-Id"`
+
+	// XUserRole The role of the user making the request, set by the gateway once authenticated
+	XUserRole UserRole `json:"X-User-Role"`
+}
+
+// GetProfilesStatsParams defines parameters for GetProfilesStats.
+type GetProfilesStatsParams struct {
+	// SearchWord Part of the name of the profile in any language, spaces are ignored
+	SearchWord *FilterSearchWord `form:"search_word,omitempty" json:"search_word,omitempty"`
+	ExternalId *FilterExternalId `form:"external_id,omitempty" json:"external_id,omitempty"`
+
+	// SkillLevel Skills the profile must have with a minimum proficiency such as `Go>=3`, a skill without a level matches any level. Repeat to require several skills.
+	SkillLevel *FilterSkillLevel `form:"skill_level,omitempty" json:"skill_level,omitempty"`
+
+	// Gender Genders the profile must have one of. Repeat to allow several.
+	Gender *FilterGender `form:"gender,omitempty" json:"gender,omitempty"`
+
+	// Status Statuses the profile must have one of. Repeat to allow several.
+	Status *FilterStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Email Email address of the profile, compared case-insensitively
+	Email *FilterEmail `form:"email,omitempty" json:"email,omitempty"`
+
+	// Attribute Custom attributes the profile must have such as `bus_route=12`, compared as text, an attribute without a value matches any value. Repeat to require several attributes.
+	Attribute *FilterAttribute `form:"attribute,omitempty" json:"attribute,omitempty"`
+
+	// TagAny Tags the profile must have at least one of. Repeat to allow several.
+	TagAny *FilterTagAny `form:"tag_any,omitempty" json:"tag_any,omitempty"`
+
+	// TagAll Tags the profile must have every one of. Repeat to require several.
+	TagAll *FilterTagAll `form:"tag_all,omitempty" json:"tag_all,omitempty"`
+
+	// TagNone Tags the profile must have none of. Repeat to exclude several.
+	TagNone *FilterTagNone `form:"tag_none,omitempty" json:"tag_none,omitempty"`
+
+	// SkillLimit Number of skills returned in by_skill, the most common first
+	SkillLimit *int `form:"skill_limit,omitempty" json:"skill_limit,omitempty"`
 }
 
 // PostProfileJSONRequestBody defines body for PostProfile for application/json ContentType.
@@ -174,36 +1151,1131 @@ type PostProfileJSONRequestBody = UpsertProfile
 // PutProfileIdJSONRequestBody defines body for PutProfileId for application/json ContentType.
 type PutProfileIdJSONRequestBody = UpsertProfile
 
+// PostProfileIdContactsJSONRequestBody defines body for PostProfileIdContacts for application/json ContentType.
+type PostProfileIdContactsJSONRequestBody = UpsertContact
+
+// PutProfileIdContactsContactIdJSONRequestBody defines body for PutProfileIdContactsContactId for application/json ContentType.
+type PutProfileIdContactsContactIdJSONRequestBody = UpsertContact
+
+// PostProfileIdEducationJSONRequestBody defines body for PostProfileIdEducation for application/json ContentType.
+type PostProfileIdEducationJSONRequestBody = UpsertEducation
+
+// PutProfileIdEducationEducationIdJSONRequestBody defines body for PutProfileIdEducationEducationId for application/json ContentType.
+type PutProfileIdEducationEducationIdJSONRequestBody = UpsertEducation
+
+// PostProfileIdExperienceJSONRequestBody defines body for PostProfileIdExperience for application/json ContentType.
+type PostProfileIdExperienceJSONRequestBody = UpsertExperience
+
+// PutProfileIdExperienceExperienceIdJSONRequestBody defines body for PutProfileIdExperienceExperienceId for application/json ContentType.
+type PutProfileIdExperienceExperienceIdJSONRequestBody = UpsertExperience
+
+// PostProfilesBatchJSONRequestBody defines body for PostProfilesBatch for application/json ContentType.
+type PostProfilesBatchJSONRequestBody = ProfileBatchRequest
+
+// PostProfilesChangeRequestsIdRejectJSONRequestBody defines body for PostProfilesChangeRequestsIdReject for application/json ContentType.
+type PostProfilesChangeRequestsIdRejectJSONRequestBody = RejectChangeRequest
+
+// PostProfilesMatchJSONRequestBody defines body for PostProfilesMatch for application/json ContentType.
+type PostProfilesMatchJSONRequestBody = ProfileMatchRequest
+
+// PostProfilesMergeJSONRequestBody defines body for PostProfilesMerge for application/json ContentType.
+type PostProfilesMergeJSONRequestBody = ProfileMergeRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get the allowed genders
+	// (GET /genders)
+	GetGenders(c *gin.Context)
 	// Create profile
 	// (POST /profile)
-	PostProfile(c *gin.Context)
+	PostProfile(c *gin.Context, params PostProfileParams)
 	// Delete profile
 	// (DELETE /profile/{id})
 	DeleteProfileId(c *gin.Context, id openapi_types.UUID)
 	// Get profile By ID
 	// (GET /profile/{id})
-	GetProfileId(c *gin.Context, id openapi_types.UUID)
-	// Update profile
+	GetProfileId(c *gin.Context, id openapi_types.UUID, params GetProfileIdParams)
+	// Create or update profile
 	// (PUT /profile/{id})
-	PutProfileId(c *gin.Context, id openapi_types.UUID)
+	PutProfileId(c *gin.Context, id openapi_types.UUID, params PutProfileIdParams)
+	// Get the contacts of a profile
+	// (GET /profile/{id}/contacts)
+	GetProfileIdContacts(c *gin.Context, id openapi_types.UUID)
+	// Add a contact to a profile
+	// (POST /profile/{id}/contacts)
+	PostProfileIdContacts(c *gin.Context, id openapi_types.UUID)
+	// Remove a contact from a profile
+	// (DELETE /profile/{id}/contacts/{contactId})
+	DeleteProfileIdContactsContactId(c *gin.Context, id openapi_types.UUID, contactId openapi_types.UUID)
+	// Update a contact of a profile
+	// (PUT /profile/{id}/contacts/{contactId})
+	PutProfileIdContactsContactId(c *gin.Context, id openapi_types.UUID, contactId openapi_types.UUID)
+	// Mark a contact as verified
+	// (POST /profile/{id}/contacts/{contactId}/verify)
+	PostProfileIdContactsContactIdVerify(c *gin.Context, id openapi_types.UUID, contactId openapi_types.UUID)
+	// Get the education of a profile
+	// (GET /profile/{id}/education)
+	GetProfileIdEducation(c *gin.Context, id openapi_types.UUID)
+	// Add an education entry to a profile
+	// (POST /profile/{id}/education)
+	PostProfileIdEducation(c *gin.Context, id openapi_types.UUID)
+	// Remove an education entry from a profile
+	// (DELETE /profile/{id}/education/{educationId})
+	DeleteProfileIdEducationEducationId(c *gin.Context, id openapi_types.UUID, educationId openapi_types.UUID)
+	// Update an education entry of a profile
+	// (PUT /profile/{id}/education/{educationId})
+	PutProfileIdEducationEducationId(c *gin.Context, id openapi_types.UUID, educationId openapi_types.UUID)
+	// Get the class history of a profile
+	// (GET /profile/{id}/enrollments)
+	GetProfileIdEnrollments(c *gin.Context, id openapi_types.UUID)
+	// Get the work experience of a profile
+	// (GET /profile/{id}/experience)
+	GetProfileIdExperience(c *gin.Context, id openapi_types.UUID)
+	// Add an experience entry to a profile
+	// (POST /profile/{id}/experience)
+	PostProfileIdExperience(c *gin.Context, id openapi_types.UUID)
+	// Remove an experience entry from a profile
+	// (DELETE /profile/{id}/experience/{experienceId})
+	DeleteProfileIdExperienceExperienceId(c *gin.Context, id openapi_types.UUID, experienceId openapi_types.UUID)
+	// Update an experience entry of a profile
+	// (PUT /profile/{id}/experience/{experienceId})
+	PutProfileIdExperienceExperienceId(c *gin.Context, id openapi_types.UUID, experienceId openapi_types.UUID)
+	// Get profiles with similar skills
+	// (GET /profile/{id}/similar)
+	GetProfileIdSimilar(c *gin.Context, id openapi_types.UUID, params GetProfileIdSimilarParams)
 	// Get profiles
 	// (GET /profiles)
 	GetProfiles(c *gin.Context, params GetProfilesParams)
+	// Run a batch of profile operations
+	// (POST /profiles/batch)
+	PostProfilesBatch(c *gin.Context, params PostProfilesBatchParams)
+	// Get the changes to profiles waiting for approval
+	// (GET /profiles/change-requests)
+	GetProfilesChangeRequests(c *gin.Context, params GetProfilesChangeRequestsParams)
+	// Approve a change to a profile
+	// (POST /profiles/change-requests/{id}/approve)
+	PostProfilesChangeRequestsIdApprove(c *gin.Context, id openapi_types.UUID, params PostProfilesChangeRequestsIdApproveParams)
+	// Reject a change to a profile
+	// (POST /profiles/change-requests/{id}/reject)
+	PostProfilesChangeRequestsIdReject(c *gin.Context, id openapi_types.UUID, params PostProfilesChangeRequestsIdRejectParams)
+	// Find candidate duplicate profiles
+	// (GET /profiles/duplicates)
+	GetProfilesDuplicates(c *gin.Context, params GetProfilesDuplicatesParams)
+	// Rank profiles by required and optional skills
+	// (POST /profiles/match)
+	PostProfilesMatch(c *gin.Context, params PostProfilesMatchParams)
+	// Merge a duplicate profile into another one
+	// (POST /profiles/merge)
+	PostProfilesMerge(c *gin.Context, params PostProfilesMergeParams)
+	// Get profile statistics
+	// (GET /profiles/stats)
+	GetProfilesStats(c *gin.Context, params GetProfilesStatsParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// GetGenders operation middleware
+func (siw *ServerInterfaceWrapper) GetGenders(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetGenders(c)
+}
+
+// PostProfile operation middleware
+func (siw *ServerInterfaceWrapper) PostProfile(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostProfileParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Idempotency-Key" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Idempotency-Key")]; found {
+		var IdempotencyKey string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Idempotency-Key, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Idempotency-Key", valueList[0], &IdempotencyKey, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Idempotency-Key: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IdempotencyKey = &IdempotencyKey
+
+	}
+
+	// ------------- Optional header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserId = &XUserId
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProfile(c, params)
+}
+
+// DeleteProfileId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProfileId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteProfileId(c, id)
+}
+
+// GetProfileId operation middleware
+func (siw *ServerInterfaceWrapper) GetProfileId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProfileIdParams
+
+	// ------------- Optional query parameter "include" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "include", c.Request.URL.Query(), &params.Include)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Accept-Language" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Accept-Language")]; found {
+		var AcceptLanguage string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Accept-Language, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Accept-Language", valueList[0], &AcceptLanguage, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Accept-Language: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.AcceptLanguage = &AcceptLanguage
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProfileId(c, id, params)
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandler       func(*gin.Context, error, int)
-}
+// PutProfileId operation middleware
+func (siw *ServerInterfaceWrapper) PutProfileId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutProfileIdParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserId = XUserId
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-Id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required header parameter "X-User-Role" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Role")]; found {
+		var XUserRole UserRole
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-Role, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Role", valueList[0], &XUserRole, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-Role: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserRole = XUserRole
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-Role is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutProfileId(c, id, params)
+}
+
+// GetProfileIdContacts operation middleware
+func (siw *ServerInterfaceWrapper) GetProfileIdContacts(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProfileIdContacts(c, id)
+}
+
+// PostProfileIdContacts operation middleware
+func (siw *ServerInterfaceWrapper) PostProfileIdContacts(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProfileIdContacts(c, id)
+}
+
+// DeleteProfileIdContactsContactId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProfileIdContactsContactId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "contactId" -------------
+	var contactId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contactId", c.Param("contactId"), &contactId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contactId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteProfileIdContactsContactId(c, id, contactId)
+}
+
+// PutProfileIdContactsContactId operation middleware
+func (siw *ServerInterfaceWrapper) PutProfileIdContactsContactId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "contactId" -------------
+	var contactId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contactId", c.Param("contactId"), &contactId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contactId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutProfileIdContactsContactId(c, id, contactId)
+}
+
+// PostProfileIdContactsContactIdVerify operation middleware
+func (siw *ServerInterfaceWrapper) PostProfileIdContactsContactIdVerify(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "contactId" -------------
+	var contactId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "contactId", c.Param("contactId"), &contactId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter contactId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProfileIdContactsContactIdVerify(c, id, contactId)
+}
+
+// GetProfileIdEducation operation middleware
+func (siw *ServerInterfaceWrapper) GetProfileIdEducation(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProfileIdEducation(c, id)
+}
+
+// PostProfileIdEducation operation middleware
+func (siw *ServerInterfaceWrapper) PostProfileIdEducation(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProfileIdEducation(c, id)
+}
+
+// DeleteProfileIdEducationEducationId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProfileIdEducationEducationId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "educationId" -------------
+	var educationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "educationId", c.Param("educationId"), &educationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter educationId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteProfileIdEducationEducationId(c, id, educationId)
+}
+
+// PutProfileIdEducationEducationId operation middleware
+func (siw *ServerInterfaceWrapper) PutProfileIdEducationEducationId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "educationId" -------------
+	var educationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "educationId", c.Param("educationId"), &educationId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter educationId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutProfileIdEducationEducationId(c, id, educationId)
+}
+
+// GetProfileIdEnrollments operation middleware
+func (siw *ServerInterfaceWrapper) GetProfileIdEnrollments(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProfileIdEnrollments(c, id)
+}
+
+// GetProfileIdExperience operation middleware
+func (siw *ServerInterfaceWrapper) GetProfileIdExperience(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProfileIdExperience(c, id)
+}
+
+// PostProfileIdExperience operation middleware
+func (siw *ServerInterfaceWrapper) PostProfileIdExperience(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProfileIdExperience(c, id)
+}
+
+// DeleteProfileIdExperienceExperienceId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProfileIdExperienceExperienceId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "experienceId" -------------
+	var experienceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "experienceId", c.Param("experienceId"), &experienceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter experienceId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteProfileIdExperienceExperienceId(c, id, experienceId)
+}
+
+// PutProfileIdExperienceExperienceId operation middleware
+func (siw *ServerInterfaceWrapper) PutProfileIdExperienceExperienceId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "experienceId" -------------
+	var experienceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "experienceId", c.Param("experienceId"), &experienceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter experienceId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutProfileIdExperienceExperienceId(c, id, experienceId)
+}
+
+// GetProfileIdSimilar operation middleware
+func (siw *ServerInterfaceWrapper) GetProfileIdSimilar(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProfileIdSimilarParams
+
+	// ------------- Optional query parameter "class" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "class", c.Request.URL.Query(), &params.Class)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter class: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-None-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-None-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProfileIdSimilar(c, id, params)
+}
+
+// GetProfiles operation middleware
+func (siw *ServerInterfaceWrapper) GetProfiles(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProfilesParams
+
+	// ------------- Optional query parameter "search_word" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search_word", c.Request.URL.Query(), &params.SearchWord)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search_word: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "external_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "external_id", c.Request.URL.Query(), &params.ExternalId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter external_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "skill_level" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "skill_level", c.Request.URL.Query(), &params.SkillLevel)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter skill_level: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "gender" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "gender", c.Request.URL.Query(), &params.Gender)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter gender: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "email" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "email", c.Request.URL.Query(), &params.Email)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter email: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "attribute" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "attribute", c.Request.URL.Query(), &params.Attribute)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter attribute: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag_any" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_any", c.Request.URL.Query(), &params.TagAny)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag_any: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag_all" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_all", c.Request.URL.Query(), &params.TagAll)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag_all: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag_none" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_none", c.Request.URL.Query(), &params.TagNone)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag_none: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Accept-Language" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Accept-Language")]; found {
+		var AcceptLanguage string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Accept-Language, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Accept-Language", valueList[0], &AcceptLanguage, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Accept-Language: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.AcceptLanguage = &AcceptLanguage
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProfiles(c, params)
+}
+
+// PostProfilesBatch operation middleware
+func (siw *ServerInterfaceWrapper) PostProfilesBatch(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostProfilesBatchParams
+
+	// ------------- Optional query parameter "atomic" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "atomic", c.Request.URL.Query(), &params.Atomic)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter atomic: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserId = XUserId
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-Id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required header parameter "X-User-Role" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Role")]; found {
+		var XUserRole UserRole
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-Role, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Role", valueList[0], &XUserRole, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-Role: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserRole = XUserRole
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-Role is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProfilesBatch(c, params)
+}
+
+// GetProfilesChangeRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetProfilesChangeRequests(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProfilesChangeRequestsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "profile_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "profile_id", c.Request.URL.Query(), &params.ProfileId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter profile_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "requested_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "requested_by", c.Request.URL.Query(), &params.RequestedBy)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter requested_by: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-Id: %w", err), http.StatusBadRequest)
+			return
+		}
 
-type MiddlewareFunc func(c *gin.Context)
+		params.XUserId = XUserId
 
-// PostProfile operation middleware
-func (siw *ServerInterfaceWrapper) PostProfile(c *gin.Context) {
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-Id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required header parameter "X-User-Role" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Role")]; found {
+		var XUserRole UserRole
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-Role, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Role", valueList[0], &XUserRole, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-Role: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserRole = XUserRole
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-Role is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -212,11 +2284,11 @@ func (siw *ServerInterfaceWrapper) PostProfile(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostProfile(c)
+	siw.Handler.GetProfilesChangeRequests(c, params)
 }
 
-// DeleteProfileId operation middleware
-func (siw *ServerInterfaceWrapper) DeleteProfileId(c *gin.Context) {
+// PostProfilesChangeRequestsIdApprove operation middleware
+func (siw *ServerInterfaceWrapper) PostProfilesChangeRequestsIdApprove(c *gin.Context) {
 
 	var err error
 
@@ -229,6 +2301,55 @@ func (siw *ServerInterfaceWrapper) DeleteProfileId(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostProfilesChangeRequestsIdApproveParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserId = XUserId
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-Id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required header parameter "X-User-Role" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Role")]; found {
+		var XUserRole UserRole
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-Role, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Role", valueList[0], &XUserRole, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-Role: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserRole = XUserRole
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-Role is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -236,11 +2357,11 @@ func (siw *ServerInterfaceWrapper) DeleteProfileId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteProfileId(c, id)
+	siw.Handler.PostProfilesChangeRequestsIdApprove(c, id, params)
 }
 
-// GetProfileId operation middleware
-func (siw *ServerInterfaceWrapper) GetProfileId(c *gin.Context) {
+// PostProfilesChangeRequestsIdReject operation middleware
+func (siw *ServerInterfaceWrapper) PostProfilesChangeRequestsIdReject(c *gin.Context) {
 
 	var err error
 
@@ -253,6 +2374,55 @@ func (siw *ServerInterfaceWrapper) GetProfileId(c *gin.Context) {
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostProfilesChangeRequestsIdRejectParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserId = XUserId
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-Id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required header parameter "X-User-Role" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Role")]; found {
+		var XUserRole UserRole
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-Role, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Role", valueList[0], &XUserRole, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-Role: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserRole = XUserRole
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-Role is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -260,20 +2430,38 @@ func (siw *ServerInterfaceWrapper) GetProfileId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetProfileId(c, id)
+	siw.Handler.PostProfilesChangeRequestsIdReject(c, id, params)
 }
 
-// PutProfileId operation middleware
-func (siw *ServerInterfaceWrapper) PutProfileId(c *gin.Context) {
+// GetProfilesDuplicates operation middleware
+func (siw *ServerInterfaceWrapper) GetProfilesDuplicates(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProfilesDuplicatesParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	// ------------- Optional query parameter "min_score" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_score", c.Request.URL.Query(), &params.MinScore)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter min_score: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -284,16 +2472,16 @@ func (siw *ServerInterfaceWrapper) PutProfileId(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PutProfileId(c, id)
+	siw.Handler.GetProfilesDuplicates(c, params)
 }
 
-// GetProfiles operation middleware
-func (siw *ServerInterfaceWrapper) GetProfiles(c *gin.Context) {
+// PostProfilesMatch operation middleware
+func (siw *ServerInterfaceWrapper) PostProfilesMatch(c *gin.Context) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetProfilesParams
+	var params PostProfilesMatchParams
 
 	// ------------- Optional query parameter "search_word" -------------
 
@@ -303,6 +2491,78 @@ func (siw *ServerInterfaceWrapper) GetProfiles(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "external_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "external_id", c.Request.URL.Query(), &params.ExternalId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter external_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "skill_level" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "skill_level", c.Request.URL.Query(), &params.SkillLevel)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter skill_level: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "gender" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "gender", c.Request.URL.Query(), &params.Gender)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter gender: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "email" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "email", c.Request.URL.Query(), &params.Email)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter email: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "attribute" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "attribute", c.Request.URL.Query(), &params.Attribute)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter attribute: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag_any" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_any", c.Request.URL.Query(), &params.TagAny)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag_any: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag_all" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_all", c.Request.URL.Query(), &params.TagAll)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag_all: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag_none" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_none", c.Request.URL.Query(), &params.TagNone)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag_none: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	// ------------- Optional query parameter "page" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
@@ -326,7 +2586,177 @@ func (siw *ServerInterfaceWrapper) GetProfiles(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetProfiles(c, params)
+	siw.Handler.PostProfilesMatch(c, params)
+}
+
+// PostProfilesMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostProfilesMerge(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostProfilesMergeParams
+
+	headers := c.Request.Header
+
+	// ------------- Required header parameter "X-User-Id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Id")]; found {
+		var XUserId string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-Id, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Id", valueList[0], &XUserId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-Id: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserId = XUserId
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-Id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required header parameter "X-User-Role" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-User-Role")]; found {
+		var XUserRole UserRole
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for X-User-Role, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-User-Role", valueList[0], &XUserRole, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter X-User-Role: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.XUserRole = XUserRole
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Header parameter X-User-Role is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProfilesMerge(c, params)
+}
+
+// GetProfilesStats operation middleware
+func (siw *ServerInterfaceWrapper) GetProfilesStats(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProfilesStatsParams
+
+	// ------------- Optional query parameter "search_word" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "search_word", c.Request.URL.Query(), &params.SearchWord)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search_word: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "external_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "external_id", c.Request.URL.Query(), &params.ExternalId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter external_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "skill_level" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "skill_level", c.Request.URL.Query(), &params.SkillLevel)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter skill_level: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "gender" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "gender", c.Request.URL.Query(), &params.Gender)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter gender: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "email" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "email", c.Request.URL.Query(), &params.Email)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter email: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "attribute" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "attribute", c.Request.URL.Query(), &params.Attribute)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter attribute: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag_any" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_any", c.Request.URL.Query(), &params.TagAny)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag_any: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag_all" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_all", c.Request.URL.Query(), &params.TagAll)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag_all: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tag_none" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tag_none", c.Request.URL.Query(), &params.TagNone)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tag_none: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "skill_limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "skill_limit", c.Request.URL.Query(), &params.SkillLimit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter skill_limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProfilesStats(c, params)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -356,34 +2786,187 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/genders", wrapper.GetGenders)
 	router.POST(options.BaseURL+"/profile", wrapper.PostProfile)
 	router.DELETE(options.BaseURL+"/profile/:id", wrapper.DeleteProfileId)
 	router.GET(options.BaseURL+"/profile/:id", wrapper.GetProfileId)
 	router.PUT(options.BaseURL+"/profile/:id", wrapper.PutProfileId)
+	router.GET(options.BaseURL+"/profile/:id/contacts", wrapper.GetProfileIdContacts)
+	router.POST(options.BaseURL+"/profile/:id/contacts", wrapper.PostProfileIdContacts)
+	router.DELETE(options.BaseURL+"/profile/:id/contacts/:contactId", wrapper.DeleteProfileIdContactsContactId)
+	router.PUT(options.BaseURL+"/profile/:id/contacts/:contactId", wrapper.PutProfileIdContactsContactId)
+	router.POST(options.BaseURL+"/profile/:id/contacts/:contactId/verify", wrapper.PostProfileIdContactsContactIdVerify)
+	router.GET(options.BaseURL+"/profile/:id/education", wrapper.GetProfileIdEducation)
+	router.POST(options.BaseURL+"/profile/:id/education", wrapper.PostProfileIdEducation)
+	router.DELETE(options.BaseURL+"/profile/:id/education/:educationId", wrapper.DeleteProfileIdEducationEducationId)
+	router.PUT(options.BaseURL+"/profile/:id/education/:educationId", wrapper.PutProfileIdEducationEducationId)
+	router.GET(options.BaseURL+"/profile/:id/enrollments", wrapper.GetProfileIdEnrollments)
+	router.GET(options.BaseURL+"/profile/:id/experience", wrapper.GetProfileIdExperience)
+	router.POST(options.BaseURL+"/profile/:id/experience", wrapper.PostProfileIdExperience)
+	router.DELETE(options.BaseURL+"/profile/:id/experience/:experienceId", wrapper.DeleteProfileIdExperienceExperienceId)
+	router.PUT(options.BaseURL+"/profile/:id/experience/:experienceId", wrapper.PutProfileIdExperienceExperienceId)
+	router.GET(options.BaseURL+"/profile/:id/similar", wrapper.GetProfileIdSimilar)
 	router.GET(options.BaseURL+"/profiles", wrapper.GetProfiles)
+	router.POST(options.BaseURL+"/profiles/batch", wrapper.PostProfilesBatch)
+	router.GET(options.BaseURL+"/profiles/change-requests", wrapper.GetProfilesChangeRequests)
+	router.POST(options.BaseURL+"/profiles/change-requests/:id/approve", wrapper.PostProfilesChangeRequestsIdApprove)
+	router.POST(options.BaseURL+"/profiles/change-requests/:id/reject", wrapper.PostProfilesChangeRequestsIdReject)
+	router.GET(options.BaseURL+"/profiles/duplicates", wrapper.GetProfilesDuplicates)
+	router.POST(options.BaseURL+"/profiles/match", wrapper.PostProfilesMatch)
+	router.POST(options.BaseURL+"/profiles/merge", wrapper.PostProfilesMerge)
+	router.GET(options.BaseURL+"/profiles/stats", wrapper.GetProfilesStats)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xYQW/bOBP9KwS/76jEcuKku76lTbZw0V0Ym+2pCAJGHNvsSqQyJJMagf/7giPJlivK",
-	"9qHddNucTGjIN8OZN4+kn3hmitJo0M7y8RO32QIKQcMrRINhUKIpAZ0C+lyAtWIOYSjBZqhKp4zm42o+",
-	"a8wJd8sS+Jhbh0rP+WqVcIR7rxAkH39cw9ysEj5FM1M5dH1lubC26+mvBTAyMTNjbgGsrAESDp9FUQYo",
-	"/oYmXHQDSfhMoXW3WhQQxyY7C/ZdDt6ZhY6hz0FLwDhyZYugal+ErPx+8f6KJ/y3KxrctN3Vpo47JeOu",
-	"vFb3HpiSoJ2aqajXNfjw5HR0xhM+M1gIx8fceyVj3nKxM3PBvDdxlwZi0IWSMocd4NWEvfDRktu/VZ4T",
-	"lZSDggb/R5jxMf/fYNMBg5r+g+swPayrgQSiWBKH6w/m7hNkjm/I+yfY0mgbIbEUTuzz13TALg/2pT9e",
-	"+uPb9Mcu1k3FXGkR3Oyn+EG9taZzp70SXkZPljceEbRjwcq0L+4A2zsarnGUdjAHJCTA2zjaHwQQMkTh",
-	"shKQkLcg0ximM07khBprumCsowvg1bQ25lk/JprHXshgC4DeAn4BGIkyVspKzbpVAydU3nV7IaUKQ5Gz",
-	"aopl4s54R4wiId3i09XngMmUZtOlWxjNhJbsnXgQ14TZq8ZxEpOJCWtNpoQDyR6VW/RyeYpmjqIoAu5B",
-	"rL72WQY2IqV9MjG5bHrJl5ICQrDGY9YRCBidnb86gl9+vTsansjTIzE6Oz8anZyfD0fDV6M0TQ/Rj97b",
-	"la0DbzvdfDv8rvWhtIDuR71x9Z4TP+tFpip3/3WmTZNWkdr5Wec3qdmxDuIm0mBth/8NxWmXoetrn8K0",
-	"E9isrrfZTU9YoPTMhECcco2DUHl2MZ3whD8A2iq04XF6nIbQTQlalIqP+elxenzKwynpFpTPQdnqY2Nd",
-	"+A35puN6IgO6setmr4IF614buaRmN9qBplWiLHOV0brBJxsCaN6ChzFsc4HdyolDD/ShujlQ0Cdp+tWc",
-	"N3JObrdLW6eGZQhBtkMmz76i5+p1HPE70Q4wMPka8AGQNRMTbn1RCFwGpaSY1k0fjE0pB09Kriqi5uCg",
-	"W9FL+l7neyKJDigKcOF2MP74xBXtXrgFT3ilQZzOmu2qJK197jmVVjfPW8EqE99XBasqbCoYRDLSfm/B",
-	"/TCV+vKhG8ncdF0xEvGQlpN09O0rVpfBMm0cmxmvn4EstiILRMjyFlzDFPZ6ySaX9EDxMbn2z8CXn+9M",
-	"qK/y35WifKCYomcCpWiPvtgeutx7wOWGLxYEZovbR4OBDpttdS428eX1O3mzTsJM+NxFH+G9IM3TPA4U",
-	"e9T+C7oW+6MjUsr3yjp63rf+xXjRuC2No9Zb/RMAAP//AZRnb1oYAAA=",
+	"H4sIAAAAAAAC/+x9XW8cubLYXyE6AXIvbms0kj/OroEDxPZ69+pcfyi2fDebE0OH010zw6Nucg7JlnaO",
+	"4ae8BHnOH0ge8xggwObf+KdcsMhmf7F7eqTRWF4LMOBRN5sskvXFqmLVxygR+Upw4FpFTz5GKyppDhok",
+	"/vUjyzTIp1pLNis0mEcpqESylWaCR0+i54XSIie0bKGIXgJZSTFnGZC8UJos6SUQVSRLQhX5y6xQ51IU",
+	"Gv54dPyXmJjBqYTUvNPwq44J5VVv5IrppSg0oeSSZgWQnOpkCYpQvrZPJuQtrIBqogWR8LeCSSAKLkHS",
+	"rAbUJIojZsD9WwFyHcURpzlETyLfIoojlSwhp2aGTEOOs9frlWmltGR8EX2KywdUSrqOPn2K3fq8yCnL",
+	"umuDjwlNUwlKETGvL01t5glVcMC4Aq6YZpeQrXugBRymDmkLwAqgXzVITrOTFOcT6su1OGfpqB5/Ap6C",
+	"7M7RPu/bdcGBiHl9j2iWiatyh/q2ZWEHu9GevAMqk+XPQqZdoE+p1OV+mCFbe0MYRwTLKF8UdAExUSua",
+	"GKyTQNiCCwlpD+QKRz2/MsOOWdZ3FyzLXsIlBNAH3/WtrCEMQknOOMuL3DZIGPBkXZHaT+K/FtPpA/jj",
+	"g7/EhBJl+qtRVGaGbVAUPhmiKOyil5rw7Tl2csO901QXKrAi+Bx2jG3KjhaE+N9LmEdPon93WDHJQ9tM",
+	"HZ5aAByw/bM5o4unWWB/z+iibyYG4HVgPq396JuRpotzmt1wEwzYfL0V2FSTDKjS190JhJuvbwz3a8Fh",
+	"K8B5F2D4NcmKdNRSm6+vB/On8its/XxJ+QLewt8KUNo8WEmxAqkZ4OsEX49Fy+eu9ac4SiRQDek51d1F",
+	"+XkJHBfF9k6uqCJUXUBK5kJGcQS/0nyVGZiPp8ePDqZHB9Ojs+n0Cf77L1EczYXMTcdRSjUcaJZDFHfn",
+	"zQJc+GwJpODsbwUQlgLXbM5AlqzYgSPdYtQBOTp+AA8fPf7DAXz3/ezg6Dh9cEAfPnp88PD48eOjh0d/",
+	"eDidPqoDVhQsDcHk0OC8Dzb3vg4OU51lGQHN0Rho3EwhPZ+te9ZKgSRXS1HtTw20BkxKF2ZBD46mxw/D",
+	"Y10yuDqXQJXg3cF+Xq7bKCHhr5BoSBvDGKCSjCpVtuQAqSLUgJYTxRYcUjJbE0oWBZUpo7wfmG3Qc7WS",
+	"4hLS2ENFhCSqWIFUkEIaxtrja2CtB61vS5Sm8znJIZ+VW+NgMxB56Hp2SQNNliAPHh6HxlY9MtAMa+Ei",
+	"tklcmzoRPAEj2qkGWa6ZoyhF8wqnJawymkBKGNIWL/LoyZ+jFfDUDB9H5TyiOCqnEcVRNVD0oT6T6ruu",
+	"nuOeiJnpxUysweXegloJrqDL7VKq6SZW1+hqxGjqlC4Yp2YlNw88SgdoQdBm8HG0oovgqUlK4JqYt4QX",
+	"Bn3qqHHk+2FcwwIk9gTyPNzba+zA7DPCTFYgsedGl9NQn1pommGvIUwzLwn3ndtmtT6P+7uU4qq3R/PO",
+	"9Ndk8Y2ejwJdB3dXcE2TkKxsiLzbklFu9C3FwXSMOGDqfCVZTuU6yBT1EiRh2gikGiRECyMlyJxJpQnN",
+	"BV/UXyuLIorgaDWotSzAwzATIgPKo0+ljtMdXjQGlcjHFLkyvNrCxIVuHqi0gmwe+6NJ/eDVkA7VQr4T",
+	"uZKM/ImyFILbZR+ENuyC8RQRzAIY14YwwFl4UTKtlqj4SVIerUtOWP6NDaI48iA2+F7ZqgNbsUq3Rj80",
+	"Z4QnBHVDQkyc4LfAO/pknLyYHD1+SNxgDXVA5MmSsv/onkwSkQcBAGnQe4MgRjBRDieCz5nMIbUnE5os",
+	"62gRE8hXek0Krlnm0KIcYaQAHqD4G8oN28ngCGrzEC2eXiOyOu7P1khvsXuINO1pR8yJXbj1ypFtFI+U",
+	"POUcAgeh7pzQSufNeNg5TVNmQKfZaW12lhU0Z/avZsv9vJKOxS+FOTOqHtokTt+fkcPq5eHHC1h/iu0s",
+	"kyUkRnWlC8q4sizCzqfkTP7DCfnpxRk5FCvgdMUmf1WCEwvVzJ3/AwBQ22UucuB6Qt4bKmR8QWhb71E4",
+	"GKKywo1Zo23nAlbasrEM5pqIQk/qhPQxmmVCpOdued/8UxRH3pxphFZo6V+kRULtQrYx5qmZvBCZIeiC",
+	"s0uQiul1A3mMJs9wZlG8AxGXwkJCD5Ox7wws1jBUDt0+8Dwz3DMTKAVf8AXjADKo/MUR8PTcwBMeMKNK",
+	"k5SuTUdmsHWMckOB2YPy4FUtBMtKmHD/JbT1/IcH00cHD47azGUnoh7KXSTAtVw3ht6ZyOdKM12EceVs",
+	"CUFsaUDyfFlkNBN8cSEkJ+/rjULnC6kHdsfqEPXtaa/39GD6+GA6ar23l4eDpPSSjTk5tIzxfgfbhniN",
+	"uKhB6e04sO9xHA/2zW8mu2qjBkfhUmSZ4X/d7mlCU8hZcr4GKsO7XjYhpolH/qrPBgo8evxdaLPRJHCe",
+	"iLQHsxJ3+DEtvFAx3zR6f3V0eNTfex8B49sG4zCaip0ApITxbSn3eAzlNlnxjkxmw8yzIk8uiCF5qwP6",
+	"tax4qbcTuWXv283p8WPDQI8e3w4DDY+6K965yZbnMcA1vBUT3niWWt+pgLlq7C402epO8G6Yp2ytFNc+",
+	"DfLdXChNJCQGMbdjvr7jkdxXSiG7UOegVNCmgu1J+Tq0TM4Xk5rjYtnugxnp1xVIBjyBkMa3Egq17gaP",
+	"WkKWWqcjEXJBOfu75fC7UflqAHRPdbR5UE9ZWiKnFC0yeVawzLZm3DqPSSaMtx5thTQjKVXLmaAyvYEu",
+	"WBt8szq4FFmqnAmzjvwPDqbf3Zom6Lf3FlXBBhaEBZ2JHuBrYvTwJsrUTCiM5uS5yHOQCaMZeUb5RWg0",
+	"3OngKB5bDYY2+xZzfUUl+CMAOUGk2Alf7KCe3dM9a5t+o6+lbvqvd6dv+i5Hsjzf/oYaZ23c0Dg2BOSN",
+	"ZzEtntWrB1pLktJCgmc6NvLDmgVDovr1m9fnz05eP337S2jjMzqDLDwYWhu1IGoprrxKhBA0+xf8YMY4",
+	"letxSGLnvrVYRFXbeMQhdVNWZgVSplaZOXFJG/4yCi0ayz8KMZyHOLBXqI/08JuWst5C6+Y5FBs8va76",
+	"PtDzrhhsaRHfELQFKm6YWRWhPPXGZNU0fHoCVwDk0P11+JGlnw79cDc17sWRNb+d04Ytb7Cvtu3PKAUW",
+	"0c7DBn5PL44oyzgosmJovptLkeOLp0kCK33wsny/BJqCtBwOWVtMcpamGeCyoYzHfq9KY7JXgKjxGfhB",
+	"WxL982//5/Nv/+vzb//j82//9/Nv/5t8/v//7fNv//3zb//z82//L7S50G9ye4cmFLuN3ojCWhFFldGt",
+	"y6wn5A3P1kSCLiTaPM1UKoc8mkAZx9CRP3o4JjswKZgF6VcsT52gVh298oZT8GNOdiGm4kbcYRDxuhpX",
+	"IyqPlB0QtVYa8qZScvb+YDqdHh0/CKEFTn4A5fF9KB6wMcafxDKo5Cx6AiTPGlKtwSds0JFzmqSgSMaU",
+	"to4pNH6XkqH0mr16+vJFTH58Yf+vRCERkrx//e70xfOTH09e/NA0ozx9+SKKo5z++hL4Qi+jJw+Od6EG",
+	"9zDnh6MibzI6uBEVoxgY7AcR1P0svxno3DbY2H1QeJmP1AC/7CKsQEdtyUBH838noV/TPEhEKym4KLjq",
+	"jVvCtw1YFgIUmTVPK8bvcaiXkDcR5NE0pMNj5OfouAiMXQ2BXoW1bBFaOaDEPDPhq29WIGlY/Ryj4L5f",
+	"KZDadThEDX5jMfbIHv+RZ9oTB0qVFDLQhrhX1rmG7+3B/XaOiqswrKJcE1RSCl5zbntoLNhRHFmgm85t",
+	"32rY9CFW0QffJrw9vRGNHka1bdBta9uR8PmJ7eAooAM3Ifajboa8T6+nWuQs6Y/NMLQ3M10QSTmKLqIY",
+	"X2RAtKRc0aR9Wp/TTAWDMBKR50xrSIcHU0WSgFLzIqu2XpErkEBWIBVKljExH3PKMkiHgoxsi9oo9W6D",
+	"YUYSVJHp623yW/w2yErMjCEdBja4LBuCmD5txgoDUk+A7rkLaOo/5DTinsgVZeikNnzCxhOWBrUYn1Fe",
+	"shcX8388PSY+Zn0rhvJgDEOB0lLahdwGjCK6VezFIUwdlJJPcmHcDwVPt7G8sbSUo3Q+twGUt3kSZDyF",
+	"XzdYv8S8NefSTtWNTg7i/wgebQyxGGhb8GgMFx4OEP3ns7NThyMd4BvIP50G0b/OLu0C4ST8oAN883kV",
+	"pd6Fi8OVi7oowZozMDZcnD8HSDskUGtUBmWYNmsXRoaBG11D+ThDhgHHectmGeUX3YNpBvTSRYt0nTXW",
+	"zPFsezNHbVw/p+2G3hX2bzoTGUirc1HrIMSpXoZjujO6sdeMhjrt0eqHFG+4wm5UQN2OXbwP44uGC1Tw",
+	"HaniA5Lih2KVscTZvFs6af3ViLHLFThXLGcZlUyHwtElW0iak6qNx3KDBBn7O6R2oWJrwJkSLchRg3dN",
+	"vvtD3bwuillW2w4Xp1x5W7cAXxnwe6iy1GJmQi9L7Hd37XgVud6hgT4FRiVCBvDuDV7jyUjGLiBjSyFS",
+	"y3S6o/ohVyCV4APL9f3xqOVSSyohPa+OUMF7figoieBNiOoD/jn6SURx9O4/vYw+1LB388WojTi6+8D4",
+	"9gj3sfGjYuOrHhPKU4Zq34oyea3oeLcHr4zOGnB9m8cNtBx/sn9r9YMcuLa9B3Y3Z8qceG6t/+2YkBpg",
+	"DT8DWyz9hWC3Lu6uK0nZJUutUc68vfJtDTPxl7Zc6yHWOoJVbNrE26LS/jX+HVPo0aObkihiitEtQsz6",
+	"6NF0eyodsJRYa9LALXGqiaRMOfllML3hRjUXXZ0elI/Vfdq0iGRNf3V2luNpF2Gqg0MPnLR7+/a2oBla",
+	"bJAL2HRrqOcCRG6+JUu6WgHvu2x4nXg/e8gZS7EGiB/tF9cx39tJSEiEbE5hVyGROEA64m6tO/fa9mhB",
+	"lZC7q4e7D9HDnns1sZrZCFsQbF75Ox2QAXNEUNxfQ00u5CW7FHL8upkrC7dwMtxEOz96ZG1TCUu88mov",
+	"fSBe40U1egEcF3NC3nMMTcVeyAXAytkw7fT/g7ubEZM5zTLDtWY0uTBCtbsL9WtneONoQhpOENTocWSM",
+	"jLdGtCrUZNJvOxhLhu9EIa2zs+Xc3L6D5nF8++8rJ+T23zbO7Nt/3nK8bdvBJoTrlYw3YZvbMCnmeRTj",
+	"WjTQ9da51nZsgd0mV6hbButg1Rfzw8a9vEk4Wr2nzXjj8GtjggcbjNbgUjWPWTlTP82mq6z2urN3dfvR",
+	"aM/xlWRaA9qZBa8CcDq8aouQhlYsz1CATdMdfPzo0U1c+IPjNgN5Ng8qEpr1jmgHqOd1qu2gXuIfzX3D",
+	"hzcLIehOb8MsWjTkptRg/fXVHaClnZDRIAUZ//tzUYTuEiXl4z4NKnQmehhUky5gPRQgWqMLK80XUhQr",
+	"PJGHIl2Gl9uMFTvgPwxPXHUnPVtXNsxtU0TZdQycsWfr80ps77LXXHC9HLNFLjwhtfoafhaTC1hDSn75",
+	"5ZdfDl69iuKdQoba9SjI3KEVAcOvatdGjGPc+B+3iaEeAyCaAEZB5w0A1kmVaZA3sgEg2u2ErLGnjWMV",
+	"YWu8hGYYk7IxmYpkbA7JOslgQp6SVNK5JjNIRG4IM9HsEmz2RvzpP19ImhZUgyIuuDCV9ErFqDRR/zat",
+	"v+X1sU1CPXYJ6aTGzXHsKI7sUFEc+W6iOPK9mAbu4ybf95/1SWu1XWz0TeKh7wORf8eByPfBrvfBrvfB",
+	"rl9fsOuOg1Rvzb2q7p022+UcK9R1VbS3mAZvQ4rOkRkVWZVQ0QhprkvTZiDFY0sEXSvnYg3fj6bT6Shr",
+	"7zsbR9J7V2x37tc/0SShMg1ErjgrvJg3YyIGvKyPvtKAjOZqqx1xidYejoOkPJu11F+qaSYWvZoMriVx",
+	"rezN6GoL0VNRC0PSoswOhkGvdrnrcbA2weWtRLyloIPJ2p/6dFjENlGEzkShq1k0wMFbTtpIvdO1XgqO",
+	"iuWf6CV9h332KgKF6gnk5q3lMq0NP0iN6pTZxcRwkJB5t8x+dC3HYy13+ICd1DawuaFickRmsGCcg4zJ",
+	"MYEMHbJUrmPywGYHyCFlVENMHhKaXlKemIk8srfnG7A/QPZkkphHTx5hGL/9HRRIPYaDCgGpUiJheJ70",
+	"jp6QxnMqhYmWy3sSV62BSnU+dOHODImtDHeqGlajdlDmeFKf3nRU8EfH5d2N4mH8fOMGhrLEu430ad/t",
+	"WQip8va2xyiOsecSNGNUlWYUKx8pF5wlZab5xpYhq+3slI3HsWPOKYblH7Xz1/2zuCJ5kdT2haAFUPkA",
+	"QxRMTXcu5v9W7BJelVO2AYdd8TK8pw2HCU7qw4iN7ovZGrPbVVBS775vdF33x3E07px4buXbj7nRMoAm",
+	"rYCq1iEouQC5CQnaPV41grs6vR5dMyzrnb1X0t2iPgF58kMJg0tPQSQo65y6DTHXm9jGXYiJmv6r8tn4",
+	"NDf20lxvAt6h/LWv6AUQpr+VzLUb3Vp3IJNt+XZsVtqnPJCUlg6mpJ2Qd1hFJcY8Qfi/0NaOuaISuF6C",
+	"slH8LUNnVXSlkY0z+qfHj8l3R+T4wUPy6PEfvmsbfqbImf25ZxNuO8yy8w2xaIvwjXSeLdX8VpNrbsSi",
+	"rZNtCo6bNtclN9dUatQxJ+Ql0EukUXs1ZUQ2zslN0nHeZubL9sINIsVe82J2LlpVq9CAYwAbGxpqGx2/",
+	"SOKvhm1tOt1RJrAb42qZKmxyg1xht5qVa0s03XHOrlsjkl1k9Grf326urOt6FL3cRuaj2N8YkLCgMs1c",
+	"sbeEKutuMsXDGF+UiIrWJNuReVveFETsnVspWkYB+VJd2GhyezmWYgx7UmQlIYHUZi27BHmLFw934j3c",
+	"tX8tLl1ANJFCqaDNr+l32yiVv1Y/3BBWj/C5beAf36xPLCZU2+gVsw+r2iusPWZz05dnDEUW7BI4mcFc",
+	"yPEJmG7NnxbbO8nIqYSsX5k2pyaEdV/5ZSw7H5FlJlizSReKULw5XCkJVKI9KHZxLYYR+5CW1P1u1QYg",
+	"te7E3PITpnTtRpA13brCbUQvpSgWS3L65t1ZKy4Dk4LYDF4xUaKW9AGvyWR1FUdIXOv2jeYdxseUnw/L",
+	"4Z6IxbhWvtNuar887nE23Nvof582+jqP7G7XF7PJl1P7/vvvJ99vb8/1PqUgniuQb3t1dSkyvyDo+83p",
+	"RRlP6EyhMaFpzizuutwYsll4TtUIH9tabXg+j+KyEmDL7tOmazyCz4WBUTNdbgYysKenJxGWBlIW6qPJ",
+	"dDK1OU2wMkv0JHowmU6MLrSieonkW+oQ5vcC9EBwr4kbTGClBxPATsjrKm2ImTxNU1swuvTq1z7UdFbT",
+	"nJHzlgv69PRkgnlMXCIUUxw5+gm0y+Eamb21bleE/Hg6jTDQmWvneaEre5mdCX74V+fnr4p+bs7RWrl1",
+	"cclbvK2ZDtYs8aMdQmDznwfGPSmP9AqkUfnBNYwjVeTWfmsWiehuylpsdVgLBFgJGxTRXOJTofzBK27U",
+	"F/9zJ0glY8D1wQK46QBSE4VsL7XkeD6RoCUrk8cwVdIIUXQOE/IvsFZVHZ8VSPKfDwz9HZyksTEMJEvC",
+	"KldFmU7pAtyVMauKlzhgmrrcxPZ6j4lTdDEZJRgVYzGMzcBacFSUmb2cRxuLGhOKX65tyEdlqSgHtnPB",
+	"cY2EYRgGu8Di4QvQpruH0+99IVob41hVoj1JIV8JbSTAwb9As4juxjsJvfVGQwxJgS6vwy+ohitjC8O6",
+	"k4VeAtcscYpGEEy/JYMADltuP/hyqc9Eut4ZjbTyADa5vZYFfLpFFlE6lAIkWiqTLkzfsIaH+2AN7/kF",
+	"F1e8tBhIR/cx0Veiyrnj8b8ql94tEYY0nAo8KHh3L5Ou+lfc0sZNK9TIDeohHXWUcoGJ0Vo47/l+hWS4",
+	"Vt/f/lp5SwLDu640k0DTNSqHGI7F7UGwkQTeritTZF6UFxvcaSJl8zlIVQU41/R8O/cqfVyDB7UXJMRM",
+	"cE2Oj/cgWipgkDle0dbCOA5sZ2umV05qZsh67xLwnWXWLwIS8DmSXrl9DcGHBzir42SgoSv/fsDnjq2c",
+	"pF0ZiHzSaE8Vl0SjWZP51NnlpnuaH74so7Ir4Yjv4e3vYDff4F3CHbv/Fe7EpVLc0UT3iiNBmb9kRudZ",
+	"2/skupC8E0ZleLW5eUWJghW1WlrGlC1l6WusGZWpdlTD5OWZSKuokFAtfZd0PFxK3/uzyzGiRkb0D/Gm",
+	"sMs4UnqNZxuzMlF4/vVbpPW7KTih2s1SooTUMTlbUkb+QS//0fDkF3yRMbUk/wD8H/u0n9ZFlcZU90nE",
+	"7SukAdQ+9cSMlhazgMd7JGbVpOYH06O9DV1PSIKX/TvSW4HV3F8Kh+9un+/aoa2c0LM1OfnBQLcq+lLb",
+	"UKvvCxfcZdy7zfL2lRWz7rWIK2N93JuH0VCHdzsFzKRW97EfuZwgVFaBUob29FIowBjhQKJcqyM28+p2",
+	"j/qnxR1gsLd/qOoHeLtDVnxtg9UupvLWenX7JzN4kCuNbt/sUdGRjmXaR1/okHo83d0ho3HxZkhstVYg",
+	"rt276U8zbBlLgIvcn7XL86ZL52ipvhJ3v8fDdbhaaukjdEFIIqV38ohaVaPoPawe1i+dbzyLlHXtv/Zz",
+	"a6euf2Cde2r4f0sH2RFugHpQNq2vUWn/bxc9tfwGo8Frsde1aIf2y1q0d0CPE+oLYudt6RI+08IYXeJo",
+	"11Qxgiis221vAvGEX9KMpc0wc8Pd6tHhX5Yw9yP7cP4DUi+pUnTcGU7xNE0JLSEjWtTZRK80Ovzofp1s",
+	"ZU8tOcDz8uM9ne0CnSY1EL464+1zz5htIsN9kVaJJXdT5r3F1aghM2qNTakXsqk8r0ce2ERmSYaxKfgA",
+	"JJuzqjhkv6Hi94ndd0KITr+EEK0dzL9hMSokCZL9vUTt40PvXYXBuoq8rVA9RLazrkfqNAF5i/nAnQWh",
+	"YlzGHJ4IPmcyx+QNtVRj/mKiMcq6sDp3Qqb25kSZ3sSFxJRVZ0eq9Z7t/asF/V6074IJWfFzL+Mdbb2i",
+	"8qJGWVTVFqhDWI3i0huNJ9V10a/ceuIn8pIN2199w3sbypANBerLNMKIcuZCyxXJ6RrnZi5oZXTlpVbV",
+	"o82+01z+Dfx2/3h6WypgNZM9W1L8wOOoY7/WFIM+YKoTu9sJ9kpR6yrtN2BNqchoO/K5e9YV3oF4g5nF",
+	"Nz/86H9uZ2nxyPui+v7LaWTQAOKrM7dUnGDfBpc23txtw0sXz0daYG5BYhb6W6CFOyKZp19KMu/bRHM3",
+	"ZbPo0se9uL6R6YaHgB6U2D72YJyn/kWt/dd+3KymMki0VbP74+agyx6jYcro7k1417hjuxntquZfO9b5",
+	"mWy0cviW93g3hHdXQl7Ur2LvzNhRdXkda8f+MfbWlKpqKvu2d/iRRxLKvcXjjlk8hmnoTpo82iBvsnn4",
+	"9ocfq99bWj38dy9qPXzBs14Tiq/P8FHt4d4tH230ufOmjzbAu7N9bC1AC/2NkMRdEdXTLyeq700gpQlk",
+	"kGPcC/DrGEECUA/JcFcWpjeZ0Gm9Nqik/MIG85iF6K0sw2S9HIlNUxa7/0nGsA8tqus2zbomWDygTAaO",
+	"7/HLCXnDs7W7PlNLGtcoXqqKxQIT3E9ISYuKJFRKE35EXpzRBX6aUE5mZlyTgjGYtcgzY1fk5YvcaMQJ",
+	"+/mJebUiaHCI7d5W+R387SbznK99ftHQbfDyXQWer/AQUb6uJ73Cv8ywURz5waIP/eKjNVTGcqbDQx1N",
+	"awnCHk1r2cGOQnWjPvbk5pkfvBYcDmxZhy916buvyFCApF3TWsklTFrpCMnXXLXzRECfG1Q9MBFGUmRN",
+	"mDq7EBk8H27zCe98P+xJ4diCDrNXG87sLu8pZriLwUUkKMZJcwP2JUhO77yNxq8gpnko19UywgYvHmOB",
+	"DlidQ8BXTQ5/ZJkG+Q5MGsqfhUwxK8Oob164K4EnW3yD2SZfYv2T0d/YzG1bjOGqAY6eB5ZnGN3c5z8e",
+	"/8kZXTzl6+3aZ9lW7Q1pBRJq+EQZJnmszROgl8AHqtI3Cru2cmXEPsGGSznLUVXEtLNxlaYkEVlWiz+j",
+	"ul6bPcT8DYANllyKlVb99eGL8XcpcUiPlFu1v6tXTOoVZe1OypKQvdKy09Me8piECmwGuOJLl6dmVStK",
+	"+AVzmuz1AgDydF/1q2QjBv00Xbji5XdWPDVF0eHMV8cKXzj1yZOxEOaYvCZVEfcbJDYhzdwDKpDVhKc2",
+	"l7OqJTQpix5uzmkS461YL37LC+0zMF0cT48H3R7qmVM+BzNwvi04gUswxzI/jJk3B4I5qs2ZRvCYGL+r",
+	"GZVmmWNvOZnRxOUDN83nlGVqQlDouoKOiki8YeDSaBYu54E7d1FpNSXTtTnD0eRi0sOzqRY5S8I8qKfs",
+	"2H06lt9xOhaH4ojhZXHePdvYmiAMnKx8vni8ROOpbO8CgfFVoYdzexw92IN5SwiSY82cciV8ppNZeVi7",
+	"Mzk2DHekFq6aHlEDvSWoLPs+cCjfnxD7XT2dN1EAjge32b8VJmabVJlx3kgRvYR1VTJ6yFykGnl0+sKE",
+	"2hqyPdOETTEr4KnN2F7qzdUTK8vAskFb79r0UqxAKkht1YFxNqZa8exyMbvFO4L6qn17ztLo5pauEBSe",
+	"9fr174HEfQHp+Wz9Nanv93LzrsjN27o42GAJ252kWtTgEvllKSjtjIR3MhquTAcmagawmiZe6uDD3Ny6",
+	"J2xb6D+NnDXq/uPMK++C69ulOHTIeLUUFTep6pLijcQJacoKIwWG1f7m9p6kTx3A9xkQ71nH3rLyNfkE",
+	"8YrBvpTeTvE+BxAXxFQNRWcqU9rmRp8+2A9AiD6WtltGif1dzG7uy/69212VqpEm3toNICVCkkpvDGb4",
+	"+2oT9zmGXFl7eoPaekSQ1a2HJZDrwSwX1i+jygmWAcmjG5JrdxLorYX3XgDd23xuQHMWixq4tfe8P9cU",
+	"f/4wfC9tvgJpc7csUIg6o2RFWlgIod/uNCJgyUyASiNzfQ3JmPgSkvYzV0Sy+aEvkzlkjvqhgnGDYwJB",
+	"dTUpVpRJRVQiJLogsD4mOpeZsimLekwwOePn5qse88d08rhej1EUM2SAPvznaENtwG/O7Vrt3jirwXPK",
+	"U4aOOY+bdi9jsmSLpSFH3J47aDr4kdmovC78YRdlPuyibAbOLemlQWRr8y3Fl/PYGlIqk2bZWrRo+G2T",
+	"qis8R0ufYBkZ4YrU4qoya628ArZY6lKFKMs8Oydcyi5ZWkVQVm1plpU807ceVvtehf2N9yFB31RI0J1j",
+	"ibfmfXz15b2PCMI4XoxNazVYVExmoMqKAZ4B798lGX99oSpvTSF0z4pnNR6O/HrlalaHwioPsc7QBkHR",
+	"qPZrKxPVtq0MRC/kJbsUEq/8aHoB/QEtjbAVFAK22NGY27PqFQK8QVu7P2f/3mIrcNu/MHezIGwsWVYW",
+	"77qLIRX7OO2f3Y3T/hveTo6g8LR9N6s0InIR2tXsmyXgUK1pMHCjcPefsJ+bu0Kqsw55KX3NUyyfaMWa",
+	"IgspipVVwMsSQO4ujTsPmHOIBFwfkguul4MH7HcI3b0Sfh+XX+Hka7RYGJx0h77yfEkYJ7P1OT61Pha8",
+	"9WPKfQruL/0EQ4TMJ+cD96iO6/eojqabLlLtwXiBlDFCmuCJminNEnUftL1N0cn6un369OnfBgBCzXQ8",
+	"afwAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -7,8 +7,42 @@ import (
 
 type ProfileUsecase interface {
 	FetchProfiles(params GetProfilesParams, paginator *models.Paginator) ([]*models.Profile, error)
+	FetchProfileStats(params GetProfilesStatsParams) (*models.ProfileStats, error)
 	FetchProfileById(profileId *uuid.UUID) (*models.Profile, error)
+	IncludeProfileHistory(profile *models.Profile, include []GetProfileIdParamsInclude) error
 	CreateProfile(profile *models.Profile, newProfile UpsertProfile) error
 	UpdateProfile(profileId *uuid.UUID, updateProfile UpsertProfile) error
+	UpsertProfile(profileId *uuid.UUID, upsertProfile UpsertProfile) (bool, error)
 	DeleteProfile(profileId *uuid.UUID) error
+	ExecuteBatch(operations []ProfileBatchOperation, atomic bool, viewer *models.Viewer) (*models.BatchResult, error)
+	FetchEnrollments(profileId *uuid.UUID) ([]*models.Enrollment, error)
+	FetchContacts(profileId *uuid.UUID) ([]*models.Contact, error)
+	CreateContact(profileId *uuid.UUID, newContact UpsertContact) (*models.Contact, error)
+	UpdateContact(profileId *uuid.UUID, contactId *uuid.UUID, updateContact UpsertContact) (*models.Contact, error)
+	VerifyContact(profileId *uuid.UUID, contactId *uuid.UUID) (*models.Contact, error)
+	DeleteContact(profileId *uuid.UUID, contactId *uuid.UUID) error
+	FetchEducation(profileId *uuid.UUID) ([]*models.Education, error)
+	CreateEducation(profileId *uuid.UUID, newEducation UpsertEducation) (*models.Education, error)
+	UpdateEducation(profileId *uuid.UUID, educationId *uuid.UUID, updateEducation UpsertEducation) (*models.Education, error)
+	DeleteEducation(profileId *uuid.UUID, educationId *uuid.UUID) error
+	FetchExperience(profileId *uuid.UUID) ([]*models.Experience, error)
+	CreateExperience(profileId *uuid.UUID, newExperience UpsertExperience) (*models.Experience, error)
+	UpdateExperience(profileId *uuid.UUID, experienceId *uuid.UUID, updateExperience UpsertExperience) (*models.Experience, error)
+	DeleteExperience(profileId *uuid.UUID, experienceId *uuid.UUID) error
+	FetchGenders() ([]*models.GenderOption, error)
+	FetchSimilarProfiles(profileId *uuid.UUID, params GetProfileIdSimilarParams) ([]*models.SimilarProfile, error)
+	FetchDuplicates(minScore float64, paginator *models.Paginator) ([]*models.ProfileDuplicate, error)
+	MergeProfiles(request ProfileMergeRequest, viewer *models.Viewer) (*models.ProfileMerge, error)
+	FetchProfileMerge(mergedId *uuid.UUID) (*models.ProfileMerge, error)
+	MatchProfiles(params PostProfilesMatchParams, request ProfileMatchRequest, paginator *models.Paginator) ([]*models.ProfileMatch, error)
+
+	UpdateProfileWithApproval(profileId *uuid.UUID, updateProfile UpsertProfile, viewer *models.Viewer) (*models.ChangeRequest, error)
+	FetchChangeRequests(viewer *models.Viewer, params GetProfilesChangeRequestsParams, paginator *models.Paginator) ([]*models.ChangeRequest, error)
+	ApproveChangeRequest(requestId *uuid.UUID, viewer *models.Viewer) (*models.ChangeRequest, error)
+	RejectChangeRequest(requestId *uuid.UUID, viewer *models.Viewer, rejection RejectChangeRequest) (*models.ChangeRequest, error)
+
+	ReserveIdempotencyKey(userId string, key string, requestHash string) (*models.IdempotencyKey, error)
+	SaveIdempotencyKey(userId string, key string, requestHash string, responseStatus int, responseBody []byte) error
+	ReleaseIdempotencyKey(userId string, key string, requestHash string) error
+	PurgeExpiredIdempotencyKeys() error
 }
@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/profile"
+)
+
+// ExecuteBatch implements profile.ProfileUsecase.
+// In atomic mode every operation runs in one transaction and the first failure
+// rolls back the whole batch, otherwise each operation is committed on its own.
+// Only the profile repository is bound to the transaction: the skill reviews
+// NormalizeSkills records for unknown skills are kept when the batch rolls
+// back, and classes are resolved outside it. Updates go through
+// UpdateProfileWithApproval, so those of a user other than a staff member
+// changing a protected field leave a change request.
+func (p *profileUsecase) ExecuteBatch(operations []profile.ProfileBatchOperation, atomic bool, viewer *models.Viewer) (*models.BatchResult, error) {
+	if len(operations) > p.batchMaxOperations {
+		return nil, constants.ErrBatchTooLarge
+	}
+
+	result := &models.BatchResult{Atomic: atomic}
+
+	if !atomic {
+		for i, operation := range operations {
+			result.Add(p.executeBatchOperation(i, operation, viewer))
+		}
+		result.Committed = true
+
+		return result, nil
+	}
+
+	var results []*models.BatchOperationResult
+	err := p.profileRepo.WithTransaction(func(txRepo profile.ProfileRepository) error {
+		txUsecase := p.withRepository(txRepo)
+
+		for i, operation := range operations {
+			opResult := txUsecase.executeBatchOperation(i, operation, viewer)
+			results = append(results, opResult)
+			if opResult.Failed() {
+				return constants.ErrBatchRolledBack
+			}
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, constants.ErrBatchRolledBack) {
+		return nil, err
+	}
+
+	result.Committed = err == nil
+	for _, opResult := range results {
+		if !result.Committed && !opResult.Failed() {
+			opResult.Status = http.StatusFailedDependency
+			opResult.Error = constants.ErrBatchRolledBack.Error()
+		}
+		result.Add(opResult)
+	}
+
+	for i := len(results); i < len(operations); i++ {
+		result.Add(&models.BatchOperationResult{
+			Index:  i,
+			Op:     string(operations[i].Op),
+			Status: http.StatusFailedDependency,
+			Error:  constants.ErrBatchNotExecuted.Error(),
+		})
+	}
+
+	return result, nil
+}
+
+func (p *profileUsecase) executeBatchOperation(index int, operation profile.ProfileBatchOperation, viewer *models.Viewer) *models.BatchOperationResult {
+	result := &models.BatchOperationResult{
+		Index: index,
+		Op:    string(operation.Op),
+	}
+
+	var profileId *uuid.UUID
+	if operation.Id != nil {
+		id := uuid.FromStringOrNil(operation.Id.String())
+		profileId = &id
+	}
+	result.ID = profileId
+
+	var err error
+	switch operation.Op {
+	case profile.Create:
+		if operation.Data == nil {
+			err = constants.ErrInvalidBatchOperation
+			break
+		}
+
+		newProfile := &models.Profile{ID: profileId}
+		if newProfile.ID == nil {
+			newProfile.GenUUID()
+		}
+		result.ID = newProfile.ID
+		result.Status = http.StatusCreated
+		err = p.CreateProfile(newProfile, *operation.Data)
+	case profile.Update:
+		if profileId == nil || operation.Data == nil {
+			err = constants.ErrInvalidBatchOperation
+			break
+		}
+
+		var request *models.ChangeRequest
+		request, err = p.UpdateProfileWithApproval(profileId, *operation.Data, viewer)
+		result.Status = http.StatusOK
+		if request != nil {
+			result.Status = http.StatusAccepted
+			result.ChangeRequestID = request.ID
+		}
+	case profile.Delete:
+		if profileId == nil {
+			err = constants.ErrInvalidBatchOperation
+			break
+		}
+
+		result.Status = http.StatusOK
+		err = p.DeleteProfile(profileId)
+	default:
+		err = constants.ErrInvalidBatchOperation
+	}
+
+	if err != nil {
+		result.Status = batchErrorStatus(err)
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+func batchErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, constants.ErrInvalidBatchOperation), errors.Is(err, constants.ErrUnknownClass),
+		errors.Is(err, constants.ErrUnknownGender), errors.Is(err, constants.ErrDuplicateNameLocale),
+		errors.Is(err, constants.ErrInvalidCustomAttributes), errors.Is(err, constants.ErrInvalidInitialStatus):
+		return http.StatusBadRequest
+	case errors.Is(err, constants.ErrProfileNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, constants.ErrExternalIdConflict), errors.Is(err, constants.ErrProfileAlreadyExists),
+		errors.Is(err, constants.ErrClassFull), errors.Is(err, constants.ErrInvalidStatusTransition),
+		errors.Is(err, constants.ErrPromotionBeforeEnrollment):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
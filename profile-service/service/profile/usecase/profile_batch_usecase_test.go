@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_profile "github.com/jariwat/p_project/profile-service/service/profile"
+	"github.com/jariwat/p_project/profile-service/service/profile/mocks"
+	skillMocks "github.com/jariwat/p_project/profile-service/service/skill/mocks"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExecuteBatch_TooLarge(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 1)
+
+	operations := []_profile.ProfileBatchOperation{
+		{Op: _profile.Delete, Id: (*types.UUID)(ptrUUID())},
+		{Op: _profile.Delete, Id: (*types.UUID)(ptrUUID())},
+	}
+
+	result, err := usecase.ExecuteBatch(operations, false, staffViewer)
+
+	require.Nil(t, result)
+	require.Equal(t, constants.ErrBatchTooLarge, err)
+}
+
+func TestExecuteBatch_BestEffort(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	missingID := ptrUUID()
+	deleteID := ptrUUID()
+	operations := []_profile.ProfileBatchOperation{
+		{Op: _profile.Create, Data: &_profile.UpsertProfile{FirstName: "SeiA", LastName: "Phanes", Gender: "MALE", Class: strPtr("Yuusha")}},
+		{Op: _profile.Update, Id: (*types.UUID)(missingID), Data: &_profile.UpsertProfile{FirstName: "AliZe", Gender: "MALE"}},
+		{Op: _profile.Delete, Id: (*types.UUID)(deleteID)},
+		{Op: _profile.Update, Data: &_profile.UpsertProfile{FirstName: "AliZe", Gender: "MALE"}},
+	}
+
+	mockRepo.On("CreateProfile", mock.AnythingOfType("*models.Profile")).Return(nil)
+	mockRepo.On("FetchProfileById", missingID).Return(nil, nil)
+	mockRepo.On("DeleteProfile", deleteID).Return(nil)
+
+	result, err := usecase.ExecuteBatch(operations, false, staffViewer)
+
+	require.NoError(t, err)
+	require.True(t, result.Committed)
+	require.Equal(t, 2, result.Succeeded)
+	require.Equal(t, 2, result.Failed)
+	require.Equal(t, http.StatusCreated, result.Results[0].Status)
+	require.NotNil(t, result.Results[0].ID)
+	require.Equal(t, http.StatusNotFound, result.Results[1].Status)
+	require.Equal(t, http.StatusOK, result.Results[2].Status)
+	require.Equal(t, http.StatusBadRequest, result.Results[3].Status)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestExecuteBatch_AtomicCommitted(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileID := ptrUUID()
+	operations := []_profile.ProfileBatchOperation{
+		{Op: _profile.Create, Id: (*types.UUID)(profileID), Data: &_profile.UpsertProfile{FirstName: "SeiA", Gender: "MALE"}},
+		{Op: _profile.Delete, Id: (*types.UUID)(profileID)},
+	}
+
+	mockRepo.On("WithTransaction", mock.Anything).
+		Run(func(args mock.Arguments) {
+			fn := args.Get(0).(func(_profile.ProfileRepository) error)
+			_ = fn(mockRepo)
+		}).
+		Return(nil)
+	mockRepo.On("CreateProfile", mock.MatchedBy(func(p *models.Profile) bool {
+		return *p.ID == *profileID
+	})).Return(nil)
+	mockRepo.On("DeleteProfile", profileID).Return(nil)
+
+	result, err := usecase.ExecuteBatch(operations, true, staffViewer)
+
+	require.NoError(t, err)
+	require.True(t, result.Atomic)
+	require.True(t, result.Committed)
+	require.Equal(t, 2, result.Succeeded)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestExecuteBatch_AtomicRolledBack(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	operations := []_profile.ProfileBatchOperation{
+		{Op: _profile.Create, Data: &_profile.UpsertProfile{FirstName: "SeiA", Gender: "MALE"}},
+		{Op: _profile.Create, Data: &_profile.UpsertProfile{FirstName: "AliZe", Gender: "MALE"}},
+		{Op: _profile.Delete, Id: (*types.UUID)(ptrUUID())},
+	}
+
+	mockRepo.On("WithTransaction", mock.Anything).
+		Return(func(fn func(_profile.ProfileRepository) error) error {
+			return fn(mockRepo)
+		})
+	mockRepo.On("CreateProfile", mock.MatchedBy(func(p *models.Profile) bool {
+		return p.FirstName == "SeiA"
+	})).Return(nil)
+	mockRepo.On("CreateProfile", mock.MatchedBy(func(p *models.Profile) bool {
+		return p.FirstName == "AliZe"
+	})).Return(constants.ErrExternalIdConflict)
+
+	result, err := usecase.ExecuteBatch(operations, true, staffViewer)
+
+	require.NoError(t, err)
+	require.False(t, result.Committed)
+	require.Equal(t, 0, result.Succeeded)
+	require.Equal(t, 3, result.Failed)
+	require.Equal(t, http.StatusFailedDependency, result.Results[0].Status)
+	require.Equal(t, http.StatusConflict, result.Results[1].Status)
+	require.Equal(t, http.StatusFailedDependency, result.Results[2].Status)
+	mockRepo.AssertNotCalled(t, "DeleteProfile", mock.Anything)
+}
+
+func TestExecuteBatch_AtomicCommitError(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	mockRepo.On("WithTransaction", mock.Anything).Return(errors.New("commit failed"))
+
+	result, err := usecase.ExecuteBatch([]_profile.ProfileBatchOperation{{Op: _profile.Delete, Id: (*types.UUID)(ptrUUID())}}, true, staffViewer)
+
+	require.Nil(t, result)
+	require.EqualError(t, err, "commit failed")
+}
+
+func TestExecuteBatch_DeleteMissing(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	missingID := ptrUUID()
+	mockRepo.On("DeleteProfile", missingID).Return(constants.ErrProfileNotFound)
+
+	result, err := usecase.ExecuteBatch([]_profile.ProfileBatchOperation{{Op: _profile.Delete, Id: (*types.UUID)(missingID)}}, false, staffViewer)
+
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Failed)
+	require.Equal(t, http.StatusNotFound, result.Results[0].Status)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestExecuteBatch_UpdateNeedsApproval(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassesUs(), newAttributeUs(), time.Hour, 100)
+
+	profileID := ptrUUID()
+	mockRepo.On("FetchProfileById", profileID).Return(&models.Profile{ID: profileID, FirstName: "SeiA", LastName: "Phanes"}, nil)
+	runInTransaction(mockRepo)
+	mockRepo.On("UpdateProfile", mock.MatchedBy(func(p *models.Profile) bool {
+		return p.FirstName == "SeiA"
+	})).Return(nil)
+	mockRepo.On("CreateChangeRequest", mock.AnythingOfType("*models.ChangeRequest")).Return(nil)
+
+	result, err := usecase.ExecuteBatch([]_profile.ProfileBatchOperation{
+		{Op: _profile.Update, Id: (*types.UUID)(profileID), Data: &_profile.UpsertProfile{FirstName: "Seia", LastName: "Phanes", Gender: "FEMALE"}},
+	}, false, studentViewer)
+
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Succeeded)
+	require.Equal(t, http.StatusAccepted, result.Results[0].Status)
+	require.NotNil(t, result.Results[0].ChangeRequestID)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,280 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/profile"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+// UpdateProfileWithApproval implements profile.ProfileUsecase.
+// Changes a user other than a staff member makes to the first name, last name,
+// names in other languages or class wait for staff approval as a change request, which supersedes the
+// one still pending for the profile, and the other fields are updated right
+// away. It returns nil when no change needs approval.
+func (p *profileUsecase) UpdateProfileWithApproval(profileId *uuid.UUID, updateProfile profile.UpsertProfile, viewer *models.Viewer) (*models.ChangeRequest, error) {
+	existing, err := p.profileRepo.FetchProfileById(profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		return nil, constants.ErrProfileNotFound
+	}
+
+	if viewer.IsStaff() {
+		return nil, p.UpdateProfile(profileId, updateProfile)
+	}
+
+	changes, err := p.protectedChanges(existing, updateProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	if changes.IsEmpty() {
+		return nil, p.UpdateProfile(profileId, updateProfile)
+	}
+
+	request, err := models.NewChangeRequest(profileId, changes, viewer.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	keepProtectedFields(existing, &updateProfile)
+	err = p.profileRepo.WithTransaction(func(txRepo profile.ProfileRepository) error {
+		if err := p.withRepository(txRepo).UpdateProfile(profileId, updateProfile); err != nil {
+			return err
+		}
+
+		return txRepo.CreateChangeRequest(request)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+// protectedChanges lists the fields only staff members change freely that the
+// update gives another value, the class compared once resolved.
+func (p *profileUsecase) protectedChanges(existing *models.Profile, updateProfile profile.UpsertProfile) (*models.ProfileChanges, error) {
+	changes := &models.ProfileChanges{}
+	if updateProfile.FirstName != existing.FirstName {
+		changes.FirstName = &updateProfile.FirstName
+	}
+	if updateProfile.LastName != existing.LastName {
+		changes.LastName = &updateProfile.LastName
+	}
+
+	updated := &models.Profile{}
+	if err := setNames(updated, updateProfile); err != nil {
+		return nil, err
+	}
+	if !sameNames(updated.Names, existing.Names) {
+		changes.Names = &updated.Names
+	}
+
+	if err := p.setClass(updated, updateProfile); err != nil {
+		return nil, err
+	}
+	if !sameClass(updated.ClassID, existing.ClassID) {
+		changes.ClassID = updated.ClassID
+		changes.Class = &updated.Class
+	}
+
+	return changes, nil
+}
+
+func sameClass(classId *uuid.UUID, otherClassId *uuid.UUID) bool {
+	if classId == nil || otherClassId == nil {
+		return classId == otherClassId
+	}
+
+	return *classId == *otherClassId
+}
+
+// sameNames reports whether the names in other languages have the same first
+// and last names in the same languages, the middle names being free to change.
+func sameNames(names []*models.ProfileName, otherNames []*models.ProfileName) bool {
+	if len(names) != len(otherNames) {
+		return false
+	}
+
+	byLocale := make(map[string]*models.ProfileName, len(otherNames))
+	for _, name := range otherNames {
+		byLocale[name.Locale] = name
+	}
+	for _, name := range names {
+		other, ok := byLocale[name.Locale]
+		if !ok || other.FirstName != name.FirstName || other.LastName != name.LastName {
+			return false
+		}
+	}
+
+	return true
+}
+
+// upsertNames returns names as the names of an update.
+func upsertNames(names []*models.ProfileName) *[]profile.ProfileName {
+	upserted := make([]profile.ProfileName, 0, len(names))
+	for _, name := range names {
+		upserted = append(upserted, profile.ProfileName{
+			Locale:     profile.ProfileNameLocale(name.Locale),
+			FirstName:  name.FirstName,
+			MiddleName: name.MiddleName,
+			LastName:   name.LastName,
+		})
+	}
+
+	return &upserted
+}
+
+// keepProtectedFields gives the update the current first name, last name,
+// names in other languages and class of the profile.
+func keepProtectedFields(existing *models.Profile, updateProfile *profile.UpsertProfile) {
+	updateProfile.FirstName = existing.FirstName
+	updateProfile.LastName = existing.LastName
+	updateProfile.Names = upsertNames(existing.Names)
+	updateProfile.ClassId = nil
+	updateProfile.Class = nil
+	if existing.ClassID != nil {
+		updateProfile.ClassId = (*types.UUID)(existing.ClassID)
+	}
+}
+
+// applyChanges returns the update leaving the profile as it is but for the
+// changes, its skills and custom attributes being kept when none are given.
+func applyChanges(existing *models.Profile, changes *models.ProfileChanges) profile.UpsertProfile {
+	updateProfile := profile.UpsertProfile{
+		ExternalId: existing.ExternalID,
+		MiddleName: existing.MiddleName,
+		Gender:     string(existing.Gender),
+		Pronouns:   existing.Pronouns,
+	}
+	keepProtectedFields(existing, &updateProfile)
+
+	if changes.FirstName != nil {
+		updateProfile.FirstName = *changes.FirstName
+	}
+	if changes.LastName != nil {
+		updateProfile.LastName = *changes.LastName
+	}
+	if changes.Names != nil {
+		updateProfile.Names = upsertNames(*changes.Names)
+	}
+	if changes.Class != nil {
+		updateProfile.ClassId = (*types.UUID)(changes.ClassID)
+	}
+
+	return updateProfile
+}
+
+// FetchChangeRequests implements profile.ProfileUsecase.
+// Users other than staff members only see the change requests they asked for.
+func (p *profileUsecase) FetchChangeRequests(viewer *models.Viewer, params profile.GetProfilesChangeRequestsParams, paginator *models.Paginator) ([]*models.ChangeRequest, error) {
+	if params.Status == nil {
+		status := profile.GetProfilesChangeRequestsParamsStatusPending
+		params.Status = &status
+	}
+
+	if !viewer.IsStaff() {
+		params.RequestedBy = &viewer.UserID
+	}
+
+	return p.profileRepo.FetchChangeRequests(params, paginator)
+}
+
+// fetchPendingChangeRequest returns the change request a staff member reviews.
+func (p *profileUsecase) fetchPendingChangeRequest(requestId *uuid.UUID, viewer *models.Viewer) (*models.ChangeRequest, error) {
+	if !viewer.IsStaff() {
+		return nil, constants.ErrChangeRequestForbidden
+	}
+
+	request, err := p.profileRepo.FetchChangeRequestById(requestId)
+	if err != nil {
+		return nil, err
+	}
+
+	if request == nil {
+		return nil, constants.ErrChangeRequestNotFound
+	}
+
+	if !request.IsPending() {
+		return nil, constants.ErrChangeRequestResolved
+	}
+
+	return request, nil
+}
+
+// ApproveChangeRequest implements profile.ProfileUsecase.
+// The changes are applied through UpdateProfile in the transaction marking the
+// request approved, and the event telling the requester is queued with them.
+func (p *profileUsecase) ApproveChangeRequest(requestId *uuid.UUID, viewer *models.Viewer) (*models.ChangeRequest, error) {
+	request, err := p.fetchPendingChangeRequest(requestId, viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := request.ProfileChanges()
+	if err != nil {
+		return nil, err
+	}
+
+	request.Review(models.ChangeRequestStatusApproved, viewer.UserID, nil)
+	event, err := models.NewChangeRequestEvent(request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.profileRepo.WithTransaction(func(txRepo profile.ProfileRepository) error {
+		// reviewed first so a concurrent review of the request fails before the profile changes
+		if err := txRepo.ReviewChangeRequest(request, event); err != nil {
+			return err
+		}
+
+		existing, err := txRepo.FetchProfileById(request.ProfileID)
+		if err != nil {
+			return err
+		}
+
+		if existing == nil {
+			return constants.ErrProfileNotFound
+		}
+
+		return p.withRepository(txRepo).UpdateProfile(request.ProfileID, applyChanges(existing, changes))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+// RejectChangeRequest implements profile.ProfileUsecase.
+func (p *profileUsecase) RejectChangeRequest(requestId *uuid.UUID, viewer *models.Viewer, rejection profile.RejectChangeRequest) (*models.ChangeRequest, error) {
+	request, err := p.fetchPendingChangeRequest(requestId, viewer)
+	if err != nil {
+		return nil, err
+	}
+
+	var reason *string
+	if rejection.Reason != nil && strings.TrimSpace(*rejection.Reason) != "" {
+		trimmed := strings.TrimSpace(*rejection.Reason)
+		reason = &trimmed
+	}
+
+	request.Review(models.ChangeRequestStatusRejected, viewer.UserID, reason)
+	event, err := models.NewChangeRequestEvent(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.profileRepo.ReviewChangeRequest(request, event); err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
@@ -0,0 +1,350 @@
+package usecase
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	classMocks "github.com/jariwat/p_project/profile-service/service/class/mocks"
+	_profile "github.com/jariwat/p_project/profile-service/service/profile"
+	"github.com/jariwat/p_project/profile-service/service/profile/mocks"
+	skillMocks "github.com/jariwat/p_project/profile-service/service/skill/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	staffViewer   = &models.Viewer{UserID: "teacher-42", Role: models.UserRoleStaff}
+	studentViewer = &models.Viewer{UserID: "student-1024", Role: models.UserRoleStudent}
+)
+
+// newClassesUs resolves the classes given by id or by code.
+func newClassesUs(classes ...*models.Class) *classMocks.ClassUsecase {
+	classUs := new(classMocks.ClassUsecase)
+	classUs.
+		On("ResolveClass", mock.Anything, mock.Anything).
+		Return(func(classId *uuid.UUID, code string) *models.Class {
+			for _, class := range classes {
+				if (classId != nil && *classId == *class.ID) || (classId == nil && code == class.Code) {
+					return class
+				}
+			}
+			return nil
+		}, nil)
+	return classUs
+}
+
+// runInTransaction runs the transactions of the usecase on mockRepo itself.
+func runInTransaction(mockRepo *mocks.ProfileRepository) {
+	mockRepo.On("WithTransaction", mock.Anything).
+		Return(func(fn func(_profile.ProfileRepository) error) error {
+			return fn(mockRepo)
+		})
+}
+
+func pendingChangeRequest(t *testing.T, profileId *uuid.UUID, changes *models.ProfileChanges) *models.ChangeRequest {
+	request, err := models.NewChangeRequest(profileId, changes, studentViewer.UserID)
+	require.NoError(t, err)
+	return request
+}
+
+func TestUpdateProfileWithApproval_NothingToApprove(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassesUs(), newAttributeUs(), time.Hour, 100)
+
+	profileId := ptrUUID()
+	mockRepo.On("FetchProfileById", profileId).Return(&models.Profile{ID: profileId, FirstName: "SeiA", LastName: "Phanes"}, nil)
+	mockRepo.On("UpdateProfile", mock.MatchedBy(func(p *models.Profile) bool {
+		return p.Pronouns != nil && *p.Pronouns == "she/her"
+	})).Return(nil)
+
+	request, err := usecase.UpdateProfileWithApproval(profileId, _profile.UpsertProfile{
+		FirstName: "SeiA",
+		LastName:  "Phanes",
+		Gender:    "FEMALE",
+		Pronouns:  strPtr("she/her"),
+	}, studentViewer)
+
+	require.NoError(t, err)
+	require.Nil(t, request)
+	mockRepo.AssertNotCalled(t, "WithTransaction", mock.Anything)
+	mockRepo.AssertNotCalled(t, "CreateChangeRequest", mock.Anything)
+}
+
+func TestUpdateProfileWithApproval_ProtectedChanges(t *testing.T) {
+	classA := &models.Class{ID: ptrUUID(), Code: "Class A"}
+	classB := &models.Class{ID: ptrUUID(), Code: "Class B"}
+
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassesUs(classA, classB), newAttributeUs(), time.Hour, 100)
+
+	profileId := ptrUUID()
+	mockRepo.On("FetchProfileById", profileId).
+		Return(&models.Profile{ID: profileId, FirstName: "SeiA", LastName: "Phanes", ClassID: classA.ID, Class: classA.Code}, nil)
+	runInTransaction(mockRepo)
+	mockRepo.On("UpdateProfile", mock.MatchedBy(func(p *models.Profile) bool {
+		return p.FirstName == "SeiA" && *p.ClassID == *classA.ID && *p.Pronouns == "she/her"
+	})).Return(nil)
+	mockRepo.On("CreateChangeRequest", mock.AnythingOfType("*models.ChangeRequest")).Return(nil)
+
+	request, err := usecase.UpdateProfileWithApproval(profileId, _profile.UpsertProfile{
+		FirstName: "Seia",
+		LastName:  "Phanes",
+		Class:     strPtr("Class B"),
+		Gender:    "FEMALE",
+		Pronouns:  strPtr("she/her"),
+	}, studentViewer)
+
+	require.NoError(t, err)
+	require.Equal(t, models.ChangeRequestStatusPending, request.Status)
+	require.Equal(t, studentViewer.UserID, request.RequestedBy)
+
+	changes, err := request.ProfileChanges()
+	require.NoError(t, err)
+	require.Equal(t, "Seia", *changes.FirstName)
+	require.Nil(t, changes.LastName)
+	require.Equal(t, *classB.ID, *changes.ClassID)
+	require.Equal(t, "Class B", *changes.Class)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateProfileWithApproval_LocalizedNames(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassesUs(), newAttributeUs(), time.Hour, 100)
+
+	profileId := ptrUUID()
+	mockRepo.On("FetchProfileById", profileId).Return(&models.Profile{
+		ID:        profileId,
+		FirstName: "SeiA",
+		LastName:  "Phanes",
+		Names:     []*models.ProfileName{{Locale: "th", FirstName: "เซอา", LastName: "ฟาเนส"}},
+	}, nil)
+	runInTransaction(mockRepo)
+	mockRepo.On("UpdateProfile", mock.MatchedBy(func(p *models.Profile) bool {
+		return len(p.Names) == 1 && p.Names[0].FirstName == "เซอา"
+	})).Return(nil)
+	mockRepo.On("CreateChangeRequest", mock.AnythingOfType("*models.ChangeRequest")).Return(nil)
+
+	request, err := usecase.UpdateProfileWithApproval(profileId, _profile.UpsertProfile{
+		FirstName: "SeiA",
+		LastName:  "Phanes",
+		Names:     &[]_profile.ProfileName{{Locale: _profile.Th, FirstName: "ไซอา", LastName: "ฟาเนส"}},
+		Gender:    "FEMALE",
+	}, studentViewer)
+
+	require.NoError(t, err)
+
+	changes, err := request.ProfileChanges()
+	require.NoError(t, err)
+	require.Nil(t, changes.FirstName)
+	require.Len(t, *changes.Names, 1)
+	require.Equal(t, "ไซอา", (*changes.Names)[0].FirstName)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateProfileWithApproval_Staff(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassesUs(), newAttributeUs(), time.Hour, 100)
+
+	profileId := ptrUUID()
+	mockRepo.On("FetchProfileById", profileId).Return(&models.Profile{ID: profileId, FirstName: "SeiA", LastName: "Phanes"}, nil)
+	mockRepo.On("UpdateProfile", mock.MatchedBy(func(p *models.Profile) bool {
+		return p.FirstName == "Seia"
+	})).Return(nil)
+
+	request, err := usecase.UpdateProfileWithApproval(profileId, _profile.UpsertProfile{
+		FirstName: "Seia",
+		LastName:  "Phanes",
+		Gender:    "FEMALE",
+	}, staffViewer)
+
+	require.NoError(t, err)
+	require.Nil(t, request)
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "CreateChangeRequest", mock.Anything)
+}
+
+func TestUpdateProfileWithApproval_NotFound(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassesUs(), newAttributeUs(), time.Hour, 100)
+
+	profileId := ptrUUID()
+	mockRepo.On("FetchProfileById", profileId).Return(nil, nil)
+
+	request, err := usecase.UpdateProfileWithApproval(profileId, _profile.UpsertProfile{FirstName: "SeiA", Gender: "FEMALE"}, studentViewer)
+
+	require.Nil(t, request)
+	require.ErrorIs(t, err, constants.ErrProfileNotFound)
+}
+
+func TestFetchChangeRequests(t *testing.T) {
+	tests := []struct {
+		name        string
+		viewer      *models.Viewer
+		requestedBy *string
+	}{
+		{"staff see every request", staffViewer, nil},
+		{"students see their own", studentViewer, &studentViewer.UserID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.ProfileRepository)
+			usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassesUs(), newAttributeUs(), time.Hour, 100)
+
+			mockRepo.On("FetchChangeRequests", mock.MatchedBy(func(params _profile.GetProfilesChangeRequestsParams) bool {
+				return *params.Status == _profile.GetProfilesChangeRequestsParamsStatusPending &&
+					(params.RequestedBy == nil) == (tt.requestedBy == nil) &&
+					(tt.requestedBy == nil || *params.RequestedBy == *tt.requestedBy)
+			}), mock.Anything).Return([]*models.ChangeRequest{}, nil)
+
+			_, err := usecase.FetchChangeRequests(tt.viewer, _profile.GetProfilesChangeRequestsParams{}, models.NewPaginator(1, 10))
+
+			require.NoError(t, err)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestApproveChangeRequest(t *testing.T) {
+	classB := &models.Class{ID: ptrUUID(), Code: "Class B"}
+
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassesUs(classB), newAttributeUs(), time.Hour, 100)
+
+	profileId := ptrUUID()
+	request := pendingChangeRequest(t, profileId, &models.ProfileChanges{FirstName: strPtr("Seia"), ClassID: classB.ID, Class: strPtr(classB.Code)})
+	existing := &models.Profile{
+		ID:        profileId,
+		FirstName: "SeiA",
+		LastName:  "Phanes",
+		Gender:    models.GenderFemale,
+		Names:     []*models.ProfileName{{Locale: "th", FirstName: "เซอา", LastName: "ฟาเนส"}},
+	}
+
+	mockRepo.On("FetchChangeRequestById", request.ID).Return(request, nil)
+	runInTransaction(mockRepo)
+	mockRepo.On("ReviewChangeRequest", request, mock.MatchedBy(func(event *models.ProfileEvent) bool {
+		return event.Type == models.ProfileEventChangeApproved && *event.ProfileID == *profileId
+	})).Return(nil)
+	mockRepo.On("FetchProfileById", profileId).Return(existing, nil)
+	mockRepo.On("UpdateProfile", mock.MatchedBy(func(p *models.Profile) bool {
+		return p.FirstName == "Seia" && p.LastName == "Phanes" && *p.ClassID == *classB.ID &&
+			len(p.Names) == 1 && p.Names[0].FirstName == "เซอา"
+	})).Return(nil)
+
+	approved, err := usecase.ApproveChangeRequest(request.ID, staffViewer)
+
+	require.NoError(t, err)
+	require.Equal(t, models.ChangeRequestStatusApproved, approved.Status)
+	require.Equal(t, staffViewer.UserID, *approved.ReviewedBy)
+	require.NotNil(t, approved.ReviewedAt)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestApproveChangeRequest_LocalizedNames(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassesUs(), newAttributeUs(), time.Hour, 100)
+
+	profileId := ptrUUID()
+	names := []*models.ProfileName{{Locale: "en", FirstName: "Seia", LastName: "Phanes"}}
+	request := pendingChangeRequest(t, profileId, &models.ProfileChanges{Names: &names})
+
+	mockRepo.On("FetchChangeRequestById", request.ID).Return(request, nil)
+	runInTransaction(mockRepo)
+	mockRepo.On("ReviewChangeRequest", request, mock.Anything).Return(nil)
+	mockRepo.On("FetchProfileById", profileId).Return(&models.Profile{
+		ID:        profileId,
+		FirstName: "SeiA",
+		LastName:  "Phanes",
+		Gender:    models.GenderFemale,
+		Names:     []*models.ProfileName{{Locale: "th", FirstName: "เซอา", LastName: "ฟาเนส"}},
+	}, nil)
+	mockRepo.On("UpdateProfile", mock.MatchedBy(func(p *models.Profile) bool {
+		return p.FirstName == "SeiA" && len(p.Names) == 1 && p.Names[0].Locale == "en" && p.Names[0].FirstName == "Seia"
+	})).Return(nil)
+
+	_, err := usecase.ApproveChangeRequest(request.ID, staffViewer)
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestApproveChangeRequest_ClassFull(t *testing.T) {
+	classB := &models.Class{ID: ptrUUID(), Code: "Class B"}
+
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassesUs(classB), newAttributeUs(), time.Hour, 100)
+
+	profileId := ptrUUID()
+	request := pendingChangeRequest(t, profileId, &models.ProfileChanges{ClassID: classB.ID, Class: strPtr(classB.Code)})
+
+	mockRepo.On("FetchChangeRequestById", request.ID).Return(request, nil)
+	runInTransaction(mockRepo)
+	mockRepo.On("ReviewChangeRequest", request, mock.Anything).Return(nil)
+	mockRepo.On("FetchProfileById", profileId).Return(&models.Profile{ID: profileId, FirstName: "SeiA", Gender: models.GenderFemale}, nil)
+	mockRepo.On("UpdateProfile", mock.Anything).Return(constants.ErrClassFull)
+
+	approved, err := usecase.ApproveChangeRequest(request.ID, staffViewer)
+
+	require.Nil(t, approved)
+	require.ErrorIs(t, err, constants.ErrClassFull)
+}
+
+func TestApproveChangeRequest_Refused(t *testing.T) {
+	reviewed := pendingChangeRequest(t, ptrUUID(), &models.ProfileChanges{FirstName: strPtr("Seia")})
+	reviewed.Review(models.ChangeRequestStatusRejected, "teacher-7", nil)
+
+	tests := []struct {
+		name    string
+		viewer  *models.Viewer
+		request *models.ChangeRequest
+		err     error
+	}{
+		{"not staff", studentViewer, nil, constants.ErrChangeRequestForbidden},
+		{"not found", staffViewer, nil, constants.ErrChangeRequestNotFound},
+		{"already reviewed", staffViewer, reviewed, constants.ErrChangeRequestResolved},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.ProfileRepository)
+			usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassesUs(), newAttributeUs(), time.Hour, 100)
+
+			requestId := ptrUUID()
+			mockRepo.On("FetchChangeRequestById", requestId).Return(tt.request, nil)
+
+			approved, err := usecase.ApproveChangeRequest(requestId, tt.viewer)
+
+			require.Nil(t, approved)
+			require.ErrorIs(t, err, tt.err)
+			mockRepo.AssertNotCalled(t, "WithTransaction", mock.Anything)
+		})
+	}
+}
+
+func TestRejectChangeRequest(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassesUs(), newAttributeUs(), time.Hour, 100)
+
+	profileId := ptrUUID()
+	request := pendingChangeRequest(t, profileId, &models.ProfileChanges{LastName: strPtr("Phanes-Lee")})
+
+	mockRepo.On("FetchChangeRequestById", request.ID).Return(request, nil)
+	mockRepo.On("ReviewChangeRequest", request, mock.MatchedBy(func(event *models.ProfileEvent) bool {
+		var payload map[string]interface{}
+		_ = json.Unmarshal(event.Payload, &payload)
+		return event.Type == models.ProfileEventChangeRejected && payload["requested_by"] == studentViewer.UserID
+	})).Return(nil)
+
+	rejected, err := usecase.RejectChangeRequest(request.ID, staffViewer, _profile.RejectChangeRequest{Reason: strPtr("  Needs a form signed by a guardian ")})
+
+	require.NoError(t, err)
+	require.Equal(t, models.ChangeRequestStatusRejected, rejected.Status)
+	require.Equal(t, "Needs a form signed by a guardian", *rejected.ReviewReason)
+	mockRepo.AssertNotCalled(t, "UpdateProfile", mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
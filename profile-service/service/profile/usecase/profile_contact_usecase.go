@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/profile"
+)
+
+// FetchContacts implements profile.ProfileUsecase.
+func (p *profileUsecase) FetchContacts(profileId *uuid.UUID) ([]*models.Contact, error) {
+	if err := p.checkProfileExists(profileId); err != nil {
+		return nil, err
+	}
+
+	return p.profileRepo.FetchContacts(profileId)
+}
+
+// CreateContact implements profile.ProfileUsecase.
+func (p *profileUsecase) CreateContact(profileId *uuid.UUID, newContact profile.UpsertContact) (*models.Contact, error) {
+	if err := p.checkProfileExists(profileId); err != nil {
+		return nil, err
+	}
+
+	contact := &models.Contact{ProfileID: profileId}
+	if err := setContact(contact, newContact); err != nil {
+		return nil, err
+	}
+	contact.GenUUID()
+	contact.SetCreatedAt()
+	contact.SetUpdatedAt()
+
+	if err := p.profileRepo.CreateContact(contact); err != nil {
+		return nil, err
+	}
+
+	return contact, nil
+}
+
+// UpdateContact implements profile.ProfileUsecase.
+// A contact whose value changes is no longer verified.
+func (p *profileUsecase) UpdateContact(profileId *uuid.UUID, contactId *uuid.UUID, updateContact profile.UpsertContact) (*models.Contact, error) {
+	contact, err := p.fetchContact(profileId, contactId)
+	if err != nil {
+		return nil, err
+	}
+
+	previousType, previousValue := contact.Type, contact.NormalizedValue
+	if err := setContact(contact, updateContact); err != nil {
+		return nil, err
+	}
+	if contact.Type != previousType || contact.NormalizedValue != previousValue {
+		contact.VerifiedAt = nil
+	}
+	contact.SetUpdatedAt()
+
+	if err := p.profileRepo.UpdateContact(contact); err != nil {
+		return nil, err
+	}
+
+	return contact, nil
+}
+
+// VerifyContact implements profile.ProfileUsecase.
+func (p *profileUsecase) VerifyContact(profileId *uuid.UUID, contactId *uuid.UUID) (*models.Contact, error) {
+	contact, err := p.fetchContact(profileId, contactId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	contact.VerifiedAt = &now
+	contact.UpdatedAt = &now
+
+	if err := p.profileRepo.UpdateContact(contact); err != nil {
+		return nil, err
+	}
+
+	return contact, nil
+}
+
+// DeleteContact implements profile.ProfileUsecase.
+func (p *profileUsecase) DeleteContact(profileId *uuid.UUID, contactId *uuid.UUID) error {
+	return p.profileRepo.DeleteContact(profileId, contactId)
+}
+
+// checkProfileExists returns constants.ErrProfileNotFound when there is no profile with profileId.
+func (p *profileUsecase) checkProfileExists(profileId *uuid.UUID) error {
+	profile, err := p.profileRepo.FetchProfileById(profileId)
+	if err != nil {
+		return err
+	}
+
+	if profile == nil {
+		return constants.ErrProfileNotFound
+	}
+
+	return nil
+}
+
+// fetchContact returns the contact of the profile, constants.ErrContactNotFound when it has none with contactId.
+func (p *profileUsecase) fetchContact(profileId *uuid.UUID, contactId *uuid.UUID) (*models.Contact, error) {
+	contact, err := p.profileRepo.FetchContactById(profileId, contactId)
+	if err != nil {
+		return nil, err
+	}
+
+	if contact == nil {
+		return nil, constants.ErrContactNotFound
+	}
+
+	return contact, nil
+}
+
+// setContact copies upsertContact into contact once its value is checked
+// against the format of its type. Phone numbers are kept in E.164 format.
+func setContact(contact *models.Contact, upsertContact profile.UpsertContact) error {
+	value, normalized, err := normalizeContactValue(models.ContactType(upsertContact.Type), strings.TrimSpace(upsertContact.Value))
+	if err != nil {
+		return err
+	}
+
+	contact.Type = models.ContactType(upsertContact.Type)
+	contact.Value = value
+	contact.NormalizedValue = normalized
+	contact.Name = nil
+	if upsertContact.Name != nil && strings.TrimSpace(*upsertContact.Name) != "" {
+		name := strings.TrimSpace(*upsertContact.Name)
+		contact.Name = &name
+	}
+	contact.IsPrimary = upsertContact.IsPrimary != nil && *upsertContact.IsPrimary
+
+	return nil
+}
+
+// normalizeContactValue checks value is an email address or a phone number as
+// contactType requires, a guardian may be reached by either. It returns the
+// value to store and the normalized value contacts are compared by.
+func normalizeContactValue(contactType models.ContactType, value string) (string, string, error) {
+	email, isEmail := models.NormalizeEmail(value)
+	phone, isPhone := models.NormalizePhone(value)
+
+	switch contactType {
+	case models.ContactTypeEmail:
+		if !isEmail {
+			return "", "", constants.ErrInvalidEmail
+		}
+	case models.ContactTypePhone:
+		if !isPhone {
+			return "", "", constants.ErrInvalidPhone
+		}
+	case models.ContactTypeGuardian:
+		if !isEmail && !isPhone {
+			return "", "", constants.ErrInvalidGuardianContact
+		}
+	default:
+		return "", "", constants.ErrInvalidContactType
+	}
+
+	if isEmail {
+		return value, email, nil
+	}
+
+	return phone, phone, nil
+}
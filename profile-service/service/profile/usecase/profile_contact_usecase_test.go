@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_profile "github.com/jariwat/p_project/profile-service/service/profile"
+	"github.com/jariwat/p_project/profile-service/service/profile/mocks"
+	skillMocks "github.com/jariwat/p_project/profile-service/service/skill/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateContact_NormalizesPhone(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileId := ptrUUID()
+	isPrimary := true
+	mockRepo.On("FetchProfileById", profileId).Return(&models.Profile{ID: profileId}, nil)
+	mockRepo.On("CreateContact", mock.AnythingOfType("*models.Contact")).Return(nil)
+
+	contact, err := usecase.CreateContact(profileId, _profile.UpsertContact{
+		Type:      _profile.UpsertContactTypePhone,
+		Value:     " +66 (81) 234-5678 ",
+		IsPrimary: &isPrimary,
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, contact.ID)
+	require.Equal(t, profileId, contact.ProfileID)
+	require.Equal(t, "+66812345678", contact.Value)
+	require.Equal(t, "+66812345678", contact.NormalizedValue)
+	require.True(t, contact.IsPrimary)
+	require.Nil(t, contact.VerifiedAt)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateContact_InvalidValue(t *testing.T) {
+	cases := []struct {
+		name        string
+		contactType _profile.UpsertContactType
+		value       string
+		err         error
+	}{
+		{"email with display name", _profile.UpsertContactTypeEmail, "SeiA <seia@example.com>", constants.ErrInvalidEmail},
+		{"email without domain", _profile.UpsertContactTypeEmail, "seia@", constants.ErrInvalidEmail},
+		{"local phone", _profile.UpsertContactTypePhone, "081-234-5678", constants.ErrInvalidPhone},
+		{"phone too long", _profile.UpsertContactTypePhone, "+6681234567890123", constants.ErrInvalidPhone},
+		{"guardian name only", _profile.UpsertContactTypeGuardian, "Somsri", constants.ErrInvalidGuardianContact},
+		{"unknown type", "fax", "+66812345678", constants.ErrInvalidContactType},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockRepo := new(mocks.ProfileRepository)
+			usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+			profileId := ptrUUID()
+			mockRepo.On("FetchProfileById", profileId).Return(&models.Profile{ID: profileId}, nil)
+
+			_, err := usecase.CreateContact(profileId, _profile.UpsertContact{Type: tc.contactType, Value: tc.value})
+
+			require.ErrorIs(t, err, tc.err)
+			mockRepo.AssertNotCalled(t, "CreateContact", mock.Anything)
+		})
+	}
+}
+
+func TestCreateContact_GuardianEmail(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileId := ptrUUID()
+	name := " Somsri Jaidee "
+	mockRepo.On("FetchProfileById", profileId).Return(&models.Profile{ID: profileId}, nil)
+	mockRepo.On("CreateContact", mock.AnythingOfType("*models.Contact")).Return(nil)
+
+	contact, err := usecase.CreateContact(profileId, _profile.UpsertContact{
+		Type:  _profile.UpsertContactTypeGuardian,
+		Value: "Somsri@Example.com",
+		Name:  &name,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "Somsri@Example.com", contact.Value)
+	require.Equal(t, "somsri@example.com", contact.NormalizedValue)
+	require.Equal(t, "Somsri Jaidee", *contact.Name)
+	require.False(t, contact.IsPrimary)
+}
+
+func TestCreateContact_ProfileNotFound(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileId := ptrUUID()
+	mockRepo.On("FetchProfileById", profileId).Return(nil, nil)
+
+	_, err := usecase.CreateContact(profileId, _profile.UpsertContact{Type: _profile.UpsertContactTypeEmail, Value: "seia@example.com"})
+
+	require.ErrorIs(t, err, constants.ErrProfileNotFound)
+}
+
+func TestUpdateContact_ChangedValueIsNoLongerVerified(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileId, contactId := ptrUUID(), ptrUUID()
+	verifiedAt := time.Now().Add(-time.Hour)
+	mockRepo.On("FetchContactById", profileId, contactId).Return(&models.Contact{
+		ID: contactId, ProfileID: profileId, Type: models.ContactTypeEmail,
+		Value: "seia@example.com", NormalizedValue: "seia@example.com", VerifiedAt: &verifiedAt,
+	}, nil)
+	mockRepo.On("UpdateContact", mock.AnythingOfType("*models.Contact")).Return(nil)
+
+	contact, err := usecase.UpdateContact(profileId, contactId, _profile.UpsertContact{
+		Type: _profile.UpsertContactTypeEmail, Value: "seia@school.ac.th",
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "seia@school.ac.th", contact.Value)
+	require.Nil(t, contact.VerifiedAt)
+}
+
+func TestUpdateContact_SameValueStaysVerified(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileId, contactId := ptrUUID(), ptrUUID()
+	verifiedAt := time.Now().Add(-time.Hour)
+	isPrimary := true
+	mockRepo.On("FetchContactById", profileId, contactId).Return(&models.Contact{
+		ID: contactId, ProfileID: profileId, Type: models.ContactTypeEmail,
+		Value: "seia@example.com", NormalizedValue: "seia@example.com", VerifiedAt: &verifiedAt,
+	}, nil)
+	mockRepo.On("UpdateContact", mock.AnythingOfType("*models.Contact")).Return(nil)
+
+	contact, err := usecase.UpdateContact(profileId, contactId, _profile.UpsertContact{
+		Type: _profile.UpsertContactTypeEmail, Value: "SeiA@Example.com", IsPrimary: &isPrimary,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, &verifiedAt, contact.VerifiedAt)
+	require.True(t, contact.IsPrimary)
+}
+
+func TestUpdateContact_NotFound(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileId, contactId := ptrUUID(), ptrUUID()
+	mockRepo.On("FetchContactById", profileId, contactId).Return(nil, nil)
+
+	_, err := usecase.UpdateContact(profileId, contactId, _profile.UpsertContact{
+		Type: _profile.UpsertContactTypeEmail, Value: "seia@example.com",
+	})
+
+	require.ErrorIs(t, err, constants.ErrContactNotFound)
+	mockRepo.AssertNotCalled(t, "UpdateContact", mock.Anything)
+}
+
+func TestVerifyContact(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileId, contactId := ptrUUID(), ptrUUID()
+	mockRepo.On("FetchContactById", profileId, contactId).Return(&models.Contact{
+		ID: contactId, ProfileID: profileId, Type: models.ContactTypePhone, Value: "+66812345678",
+	}, nil)
+	mockRepo.On("UpdateContact", mock.MatchedBy(func(contact *models.Contact) bool {
+		return contact.VerifiedAt != nil
+	})).Return(nil)
+
+	contact, err := usecase.VerifyContact(profileId, contactId)
+
+	require.NoError(t, err)
+	require.NotNil(t, contact.VerifiedAt)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,209 @@
+package usecase
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/profile"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+// IncludeProfileHistory implements profile.ProfileUsecase.
+func (p *profileUsecase) IncludeProfileHistory(fetchedProfile *models.Profile, include []profile.GetProfileIdParamsInclude) error {
+	for _, history := range include {
+		switch history {
+		case profile.GetProfileIdParamsIncludeEducation:
+			education, err := p.profileRepo.FetchEducation(fetchedProfile.ID)
+			if err != nil {
+				return err
+			}
+			fetchedProfile.Education = &education
+		case profile.GetProfileIdParamsIncludeExperience:
+			experience, err := p.profileRepo.FetchExperience(fetchedProfile.ID)
+			if err != nil {
+				return err
+			}
+			fetchedProfile.Experience = &experience
+		}
+	}
+
+	return nil
+}
+
+// FetchEducation implements profile.ProfileUsecase.
+func (p *profileUsecase) FetchEducation(profileId *uuid.UUID) ([]*models.Education, error) {
+	if err := p.checkProfileExists(profileId); err != nil {
+		return nil, err
+	}
+
+	return p.profileRepo.FetchEducation(profileId)
+}
+
+// CreateEducation implements profile.ProfileUsecase.
+func (p *profileUsecase) CreateEducation(profileId *uuid.UUID, newEducation profile.UpsertEducation) (*models.Education, error) {
+	if err := p.checkProfileExists(profileId); err != nil {
+		return nil, err
+	}
+
+	education := &models.Education{ProfileID: profileId}
+	if err := setEducation(education, newEducation); err != nil {
+		return nil, err
+	}
+	education.GenUUID()
+	education.SetCreatedAt()
+	education.SetUpdatedAt()
+
+	if err := p.profileRepo.CreateEducation(education); err != nil {
+		return nil, err
+	}
+
+	return education, nil
+}
+
+// UpdateEducation implements profile.ProfileUsecase.
+func (p *profileUsecase) UpdateEducation(profileId *uuid.UUID, educationId *uuid.UUID, updateEducation profile.UpsertEducation) (*models.Education, error) {
+	education, err := p.profileRepo.FetchEducationById(profileId, educationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if education == nil {
+		return nil, constants.ErrEducationNotFound
+	}
+
+	if err := setEducation(education, updateEducation); err != nil {
+		return nil, err
+	}
+	education.SetUpdatedAt()
+
+	if err := p.profileRepo.UpdateEducation(education); err != nil {
+		return nil, err
+	}
+
+	return education, nil
+}
+
+// DeleteEducation implements profile.ProfileUsecase.
+func (p *profileUsecase) DeleteEducation(profileId *uuid.UUID, educationId *uuid.UUID) error {
+	return p.profileRepo.DeleteEducation(profileId, educationId)
+}
+
+// FetchExperience implements profile.ProfileUsecase.
+func (p *profileUsecase) FetchExperience(profileId *uuid.UUID) ([]*models.Experience, error) {
+	if err := p.checkProfileExists(profileId); err != nil {
+		return nil, err
+	}
+
+	return p.profileRepo.FetchExperience(profileId)
+}
+
+// CreateExperience implements profile.ProfileUsecase.
+func (p *profileUsecase) CreateExperience(profileId *uuid.UUID, newExperience profile.UpsertExperience) (*models.Experience, error) {
+	if err := p.checkProfileExists(profileId); err != nil {
+		return nil, err
+	}
+
+	experience := &models.Experience{ProfileID: profileId}
+	if err := setExperience(experience, newExperience); err != nil {
+		return nil, err
+	}
+	experience.GenUUID()
+	experience.SetCreatedAt()
+	experience.SetUpdatedAt()
+
+	if err := p.profileRepo.CreateExperience(experience); err != nil {
+		return nil, err
+	}
+
+	return experience, nil
+}
+
+// UpdateExperience implements profile.ProfileUsecase.
+func (p *profileUsecase) UpdateExperience(profileId *uuid.UUID, experienceId *uuid.UUID, updateExperience profile.UpsertExperience) (*models.Experience, error) {
+	experience, err := p.profileRepo.FetchExperienceById(profileId, experienceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if experience == nil {
+		return nil, constants.ErrExperienceNotFound
+	}
+
+	if err := setExperience(experience, updateExperience); err != nil {
+		return nil, err
+	}
+	experience.SetUpdatedAt()
+
+	if err := p.profileRepo.UpdateExperience(experience); err != nil {
+		return nil, err
+	}
+
+	return experience, nil
+}
+
+// DeleteExperience implements profile.ProfileUsecase.
+func (p *profileUsecase) DeleteExperience(profileId *uuid.UUID, experienceId *uuid.UUID) error {
+	return p.profileRepo.DeleteExperience(profileId, experienceId)
+}
+
+// setEducation copies upsertEducation into education once its dates are checked.
+// Overlapping entries are rejected by the repository.
+func setEducation(education *models.Education, upsertEducation profile.UpsertEducation) error {
+	startDate, endDate, err := dateRange(upsertEducation.StartDate, upsertEducation.EndDate)
+	if err != nil {
+		return err
+	}
+
+	education.Institution = strings.TrimSpace(upsertEducation.Institution)
+	education.Degree = trimmedOrNil(upsertEducation.Degree)
+	education.StartDate = startDate
+	education.EndDate = endDate
+
+	return nil
+}
+
+// setExperience copies upsertExperience into experience once its dates are checked.
+// Overlapping entries are rejected by the repository.
+func setExperience(experience *models.Experience, upsertExperience profile.UpsertExperience) error {
+	startDate, endDate, err := dateRange(upsertExperience.StartDate, upsertExperience.EndDate)
+	if err != nil {
+		return err
+	}
+
+	experience.Organization = strings.TrimSpace(upsertExperience.Organization)
+	experience.Role = strings.TrimSpace(upsertExperience.Role)
+	experience.Description = trimmedOrNil(upsertExperience.Description)
+	experience.StartDate = startDate
+	experience.EndDate = endDate
+
+	return nil
+}
+
+// dateRange returns the start and the optional end as calendar dates,
+// constants.ErrInvalidDateRange when the end comes before the start.
+func dateRange(start types.Date, end *types.Date) (time.Time, *time.Time, error) {
+	startDate := models.DateOf(start.Time)
+	if end == nil {
+		return startDate, nil, nil
+	}
+
+	endDate := models.DateOf(end.Time)
+	if endDate.Before(startDate) {
+		return time.Time{}, nil, constants.ErrInvalidDateRange
+	}
+
+	return startDate, &endDate, nil
+}
+
+// trimmedOrNil returns value without surrounding spaces, nil when nothing is left.
+func trimmedOrNil(value *string) *string {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return nil
+	}
+
+	trimmed := strings.TrimSpace(*value)
+	return &trimmed
+}
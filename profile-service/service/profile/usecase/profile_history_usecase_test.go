@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_profile "github.com/jariwat/p_project/profile-service/service/profile"
+	"github.com/jariwat/p_project/profile-service/service/profile/mocks"
+	skillMocks "github.com/jariwat/p_project/profile-service/service/skill/mocks"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func date(year int, month time.Month, day int) types.Date {
+	return types.Date{Time: time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
+func TestCreateEducation_Success(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileId := ptrUUID()
+	degree := " "
+	endDate := date(2024, time.May, 31)
+	mockRepo.On("FetchProfileById", profileId).Return(&models.Profile{ID: profileId}, nil)
+	mockRepo.On("CreateEducation", mock.AnythingOfType("*models.Education")).Return(nil)
+
+	education, err := usecase.CreateEducation(profileId, _profile.UpsertEducation{
+		Institution: " Chulalongkorn University ",
+		Degree:      &degree,
+		StartDate:   date(2020, time.June, 1),
+		EndDate:     &endDate,
+	})
+
+	require.NoError(t, err)
+	require.NotNil(t, education.ID)
+	require.Equal(t, profileId, education.ProfileID)
+	require.Equal(t, "Chulalongkorn University", education.Institution)
+	require.Nil(t, education.Degree)
+	require.Equal(t, "2024-05-31", education.EndDate.Format(models.DateFormat))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateExperience_EndBeforeStart(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileId := ptrUUID()
+	endDate := date(2023, time.May, 31)
+	mockRepo.On("FetchProfileById", profileId).Return(&models.Profile{ID: profileId}, nil)
+
+	_, err := usecase.CreateExperience(profileId, _profile.UpsertExperience{
+		Organization: "SCB",
+		Role:         "Intern",
+		StartDate:    date(2023, time.June, 1),
+		EndDate:      &endDate,
+	})
+
+	require.ErrorIs(t, err, constants.ErrInvalidDateRange)
+	mockRepo.AssertNotCalled(t, "CreateExperience", mock.Anything)
+}
+
+func TestCreateExperience_Overlap(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileId := ptrUUID()
+	endDate := date(2023, time.June, 1)
+	mockRepo.On("FetchProfileById", profileId).Return(&models.Profile{ID: profileId}, nil)
+	mockRepo.On("CreateExperience", mock.AnythingOfType("*models.Experience")).Return(constants.ErrExperienceOverlap)
+
+	_, err := usecase.CreateExperience(profileId, _profile.UpsertExperience{
+		Organization: "SCB",
+		Role:         "Workshop speaker",
+		StartDate:    date(2023, time.June, 1),
+		EndDate:      &endDate,
+	})
+
+	require.ErrorIs(t, err, constants.ErrExperienceOverlap)
+}
+
+func TestUpdateEducation_NotFound(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileId, educationId := ptrUUID(), ptrUUID()
+	mockRepo.On("FetchEducationById", profileId, educationId).Return(nil, nil)
+
+	_, err := usecase.UpdateEducation(profileId, educationId, _profile.UpsertEducation{
+		Institution: "Chulalongkorn University",
+		StartDate:   date(2020, time.June, 1),
+	})
+
+	require.ErrorIs(t, err, constants.ErrEducationNotFound)
+	mockRepo.AssertNotCalled(t, "UpdateEducation", mock.Anything)
+}
+
+func TestFetchExperience_ProfileNotFound(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileId := ptrUUID()
+	mockRepo.On("FetchProfileById", profileId).Return(nil, nil)
+
+	_, err := usecase.FetchExperience(profileId)
+
+	require.ErrorIs(t, err, constants.ErrProfileNotFound)
+}
+
+func TestIncludeProfileHistory(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileId := ptrUUID()
+	mockRepo.On("FetchExperience", profileId).Return([]*models.Experience{{ID: ptrUUID(), Organization: "SCB"}}, nil)
+
+	profile := &models.Profile{ID: profileId}
+	err := usecase.IncludeProfileHistory(profile, []_profile.GetProfileIdParamsInclude{_profile.GetProfileIdParamsIncludeExperience})
+
+	require.NoError(t, err)
+	require.Nil(t, profile.Education)
+	require.Len(t, *profile.Experience, 1)
+	mockRepo.AssertNotCalled(t, "FetchEducation", mock.Anything)
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/profile"
+)
+
+const (
+	defaultSkillWeight = 1
+
+	// matchMaxRequirements keeps the matched skills within the repository's 64 bit mask
+	matchMaxRequirements = 40
+)
+
+// MatchProfiles implements profile.ProfileUsecase.
+// A skill asked for more than once only counts once, as required when one of
+// the requests is. Only active profiles are matched unless a status is given.
+func (p *profileUsecase) MatchProfiles(params profile.PostProfilesMatchParams, request profile.ProfileMatchRequest, paginator *models.Paginator) ([]*models.ProfileMatch, error) {
+	requirements := make([]*models.SkillRequirement, 0)
+	requirementByKey := make(map[string]*models.SkillRequirement)
+	addRequirements := func(skills *[]profile.SkillRequirement, required bool) {
+		if skills == nil {
+			return
+		}
+
+		for _, skill := range *skills {
+			key := models.NormalizeSkillName(skill.Skill)
+			if key == "" {
+				continue
+			}
+
+			if existing, ok := requirementByKey[key]; ok {
+				existing.Required = existing.Required || required
+				continue
+			}
+
+			requirement := &models.SkillRequirement{
+				Skill:    skill.Skill,
+				Key:      key,
+				Weight:   defaultSkillWeight,
+				Required: required,
+			}
+			if skill.MinProficiency != nil {
+				proficiency := models.SkillProficiency(*skill.MinProficiency)
+				requirement.MinProficiency = &proficiency
+			}
+			if skill.Weight != nil && *skill.Weight > 0 {
+				requirement.Weight = *skill.Weight
+			}
+
+			requirementByKey[key] = requirement
+			requirements = append(requirements, requirement)
+		}
+	}
+	addRequirements(request.Required, true)
+	addRequirements(request.Optional, false)
+
+	if len(requirements) == 0 || len(requirements) > matchMaxRequirements {
+		return nil, constants.ErrInvalidMatchRequest
+	}
+
+	filters, err := listFilters(params)
+	if err != nil {
+		return nil, err
+	}
+
+	if filters.Status == nil || len(*filters.Status) == 0 {
+		filters.Status = &[]profile.ProfileStatus{profile.ProfileStatusActive}
+	}
+
+	return p.profileRepo.MatchProfiles(filters, requirements, paginator)
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_profile "github.com/jariwat/p_project/profile-service/service/profile"
+	"github.com/jariwat/p_project/profile-service/service/profile/mocks"
+	skillMocks "github.com/jariwat/p_project/profile-service/service/skill/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchProfiles_BuildsRequirements(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	minProficiency := 3
+	weight := 2.5
+	searchWord := "phanes"
+	attribute := []string{"bus_route=12"}
+	tagAll := []string{"robotics", "chess"}
+	request := _profile.ProfileMatchRequest{
+		Required: &[]_profile.SkillRequirement{
+			{Skill: "Go", MinProficiency: &minProficiency, Weight: &weight},
+			{Skill: " SQL "},
+		},
+		Optional: &[]_profile.SkillRequirement{
+			{Skill: "Docker"},
+			{Skill: "go"},
+			{Skill: "  "},
+		},
+	}
+
+	paginator := models.NewPaginator(1, 10)
+	mockRepo.On("MatchProfiles",
+		_profile.GetProfilesParams{SearchWord: &searchWord, Status: &[]_profile.ProfileStatus{_profile.ProfileStatusActive}, Attribute: &attribute, TagAll: &tagAll},
+		mock.MatchedBy(func(requirements []*models.SkillRequirement) bool {
+			return len(requirements) == 3 &&
+				requirements[0].Key == "go" && requirements[0].Required && requirements[0].Weight == 2.5 &&
+				*requirements[0].MinProficiency == models.SkillProficiencyIntermediate &&
+				requirements[1].Key == "sql" && requirements[1].Required && requirements[1].Weight == 1 &&
+				requirements[2].Key == "docker" && !requirements[2].Required
+		}),
+		paginator).
+		Return([]*models.ProfileMatch{}, nil)
+
+	matches, err := usecase.MatchProfiles(_profile.PostProfilesMatchParams{SearchWord: &searchWord, Attribute: &attribute, TagAll: &tagAll}, request, paginator)
+
+	require.NoError(t, err)
+	require.Empty(t, matches)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestMatchProfiles_NoSkills(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	matches, err := usecase.MatchProfiles(_profile.PostProfilesMatchParams{}, _profile.ProfileMatchRequest{
+		Optional: &[]_profile.SkillRequirement{{Skill: " "}},
+	}, models.NewPaginator(1, 10))
+
+	require.ErrorIs(t, err, constants.ErrInvalidMatchRequest)
+	require.Nil(t, matches)
+	mockRepo.AssertNotCalled(t, "MatchProfiles", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestMatchProfiles_Status(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	status := []_profile.ProfileStatus{_profile.ProfileStatusGraduated}
+	request := _profile.ProfileMatchRequest{Required: &[]_profile.SkillRequirement{{Skill: "Go"}}}
+	paginator := models.NewPaginator(1, 10)
+	mockRepo.On("MatchProfiles", _profile.GetProfilesParams{Status: &status}, mock.Anything, paginator).
+		Return([]*models.ProfileMatch{}, nil)
+
+	_, err := usecase.MatchProfiles(_profile.PostProfilesMatchParams{Status: &status}, request, paginator)
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
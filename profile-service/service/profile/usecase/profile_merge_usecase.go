@@ -0,0 +1,241 @@
+package usecase
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/profile"
+)
+
+const (
+	// weights of the duplicate score, they add up to 1
+	duplicateNameWeight  = 0.6
+	duplicateClassWeight = 0.2
+	duplicateSkillWeight = 0.2
+
+	// duplicateMinNameSimilarity is the lowest name similarity still worth scoring
+	duplicateMinNameSimilarity = 0.3
+	// duplicateCandidateLimit caps the pairs scored per request
+	duplicateCandidateLimit = 1000
+)
+
+// FetchDuplicates implements profile.ProfileUsecase.
+// Candidate pairs come from name similarity and are scored together with the
+// class and the overlap of their skills.
+func (p *profileUsecase) FetchDuplicates(minScore float64, paginator *models.Paginator) ([]*models.ProfileDuplicate, error) {
+	// a pair can reach minScore only when its name similarity covers what
+	// matching class and skills cannot make up for
+	minNameSimilarity := (minScore - duplicateClassWeight - duplicateSkillWeight) / duplicateNameWeight
+	if minNameSimilarity < duplicateMinNameSimilarity {
+		minNameSimilarity = duplicateMinNameSimilarity
+	}
+
+	candidates, err := p.profileRepo.FetchDuplicateCandidates(minNameSimilarity, duplicateCandidateLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	profileIds := make([]uuid.UUID, 0, len(candidates)*2)
+	for _, candidate := range candidates {
+		profileIds = append(profileIds, *candidate.ProfileID, *candidate.DuplicateID)
+	}
+
+	profiles, err := p.profileRepo.FetchProfilesByIds(profileIds)
+	if err != nil {
+		return nil, err
+	}
+
+	profilesById := make(map[uuid.UUID]*models.Profile, len(profiles))
+	for _, profile := range profiles {
+		profilesById[*profile.ID] = profile
+	}
+
+	duplicates := make([]*models.ProfileDuplicate, 0)
+	for _, candidate := range candidates {
+		a, b := profilesById[*candidate.ProfileID], profilesById[*candidate.DuplicateID]
+		if a == nil || b == nil {
+			continue
+		}
+
+		duplicate := scoreDuplicate(a, b, candidate.NameSimilarity)
+		if duplicate.Score >= minScore {
+			duplicates = append(duplicates, duplicate)
+		}
+	}
+
+	sort.SliceStable(duplicates, func(i, j int) bool {
+		return duplicates[i].Score > duplicates[j].Score
+	})
+
+	paginator.SetTotal(len(duplicates))
+
+	start := (paginator.Page - 1) * paginator.PerPage
+	if start >= len(duplicates) {
+		return []*models.ProfileDuplicate{}, nil
+	}
+	end := start + paginator.PerPage
+	if end > len(duplicates) {
+		end = len(duplicates)
+	}
+
+	return duplicates[start:end], nil
+}
+
+func scoreDuplicate(a, b *models.Profile, nameSimilarity float64) *models.ProfileDuplicate {
+	sameClass := normalizeValue(a.Class) != "" && normalizeValue(a.Class) == normalizeValue(b.Class)
+
+	skillsA := make(map[string]string)
+	for _, skill := range a.Skills {
+		skillsA[normalizeValue(skill.Skill)] = skill.Skill
+	}
+	skillsB := make(map[string]bool)
+	for _, skill := range b.Skills {
+		skillsB[normalizeValue(skill.Skill)] = true
+	}
+
+	sharedSkills := make([]string, 0)
+	for key, skill := range skillsA {
+		if skillsB[key] {
+			sharedSkills = append(sharedSkills, skill)
+		}
+	}
+	sort.Strings(sharedSkills)
+
+	var skillSimilarity float64
+	if union := len(skillsA) + len(skillsB) - len(sharedSkills); union > 0 {
+		skillSimilarity = float64(len(sharedSkills)) / float64(union)
+	}
+
+	score := duplicateNameWeight*nameSimilarity + duplicateSkillWeight*skillSimilarity
+	if sameClass {
+		score += duplicateClassWeight
+	}
+
+	return &models.ProfileDuplicate{
+		Profile:        a,
+		Duplicate:      b,
+		Score:          score,
+		NameSimilarity: nameSimilarity,
+		SameClass:      sameClass,
+		SharedSkills:   sharedSkills,
+	}
+}
+
+func normalizeValue(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+// MergeProfiles implements profile.ProfileUsecase.
+// The merged profile is folded into the survivor and deleted, skills are unioned.
+// Only staff members merge profiles, as the survivor may take the first name,
+// last name or class of the merged profile without a change request.
+func (p *profileUsecase) MergeProfiles(request profile.ProfileMergeRequest, viewer *models.Viewer) (*models.ProfileMerge, error) {
+	if !viewer.IsStaff() {
+		return nil, constants.ErrMergeForbidden
+	}
+
+	survivorId := uuid.FromStringOrNil(request.SurvivorId.String())
+	mergedId := uuid.FromStringOrNil(request.MergedId.String())
+	if survivorId == mergedId {
+		return nil, constants.ErrMergeSameProfile
+	}
+
+	survivor, err := p.profileRepo.FetchProfileById(&survivorId)
+	if err != nil {
+		return nil, err
+	}
+	merged, err := p.profileRepo.FetchProfileById(&mergedId)
+	if err != nil {
+		return nil, err
+	}
+	if survivor == nil || merged == nil {
+		return nil, constants.ErrProfileNotFound
+	}
+
+	var requested profile.ProfileMergeFields
+	if request.Fields != nil {
+		requested = *request.Fields
+	}
+
+	fields := map[string]models.ProfileMergeSource{
+		"external_id": mergeSource(requested.ExternalId, survivor.ExternalID == nil || *survivor.ExternalID == ""),
+		"first_name":  mergeSource(requested.FirstName, survivor.FirstName == ""),
+		"middle_name": mergeSource(requested.MiddleName, survivor.MiddleName == nil || *survivor.MiddleName == ""),
+		"last_name":   mergeSource(requested.LastName, survivor.LastName == ""),
+		"gender":      mergeSource(requested.Gender, survivor.Gender == ""),
+		"class":       mergeSource(requested.Class, survivor.Class == ""),
+	}
+
+	if fields["external_id"] == models.ProfileMergeSourceMerged {
+		survivor.ExternalID = merged.ExternalID
+	}
+	if fields["first_name"] == models.ProfileMergeSourceMerged {
+		survivor.FirstName = merged.FirstName
+	}
+	if fields["middle_name"] == models.ProfileMergeSourceMerged {
+		survivor.MiddleName = merged.MiddleName
+	}
+	if fields["last_name"] == models.ProfileMergeSourceMerged {
+		survivor.LastName = merged.LastName
+	}
+	if fields["gender"] == models.ProfileMergeSourceMerged {
+		survivor.Gender = merged.Gender
+		survivor.Pronouns = merged.Pronouns
+	}
+	if fields["class"] == models.ProfileMergeSourceMerged {
+		survivor.ClassID = merged.ClassID
+		survivor.Class = merged.Class
+	}
+	// custom attributes the survivor has no value for take the merged profile's
+	if survivor.CustomAttributes, err = models.MergeCustomAttributes(survivor.CustomAttributes, merged.CustomAttributes); err != nil {
+		return nil, err
+	}
+	survivor.SetUpdatedAt()
+
+	bu, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	merge := &models.ProfileMerge{
+		SurvivorID: &survivorId,
+		MergedID:   &mergedId,
+		Fields:     bu,
+	}
+	merge.GenUUID()
+	merge.SetCreatedAt()
+
+	if err := p.profileRepo.MergeProfiles(merge, survivor); err != nil {
+		return nil, err
+	}
+
+	merge.Profile, err = p.profileRepo.FetchProfileById(&survivorId)
+	if err != nil {
+		return nil, err
+	}
+
+	return merge, nil
+}
+
+// mergeSource keeps the survivor's value unless another source was requested
+// or the survivor has no value for the field.
+func mergeSource(requested *profile.ProfileMergeSource, survivorEmpty bool) models.ProfileMergeSource {
+	if requested != nil {
+		return models.ProfileMergeSource(*requested)
+	}
+	if survivorEmpty {
+		return models.ProfileMergeSourceMerged
+	}
+
+	return models.ProfileMergeSourceSurvivor
+}
+
+// FetchProfileMerge implements profile.ProfileUsecase.
+// It returns nil when the profile was never merged into another one.
+func (p *profileUsecase) FetchProfileMerge(mergedId *uuid.UUID) (*models.ProfileMerge, error) {
+	return p.profileRepo.FetchProfileMergeByMergedId(mergedId)
+}
@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_profile "github.com/jariwat/p_project/profile-service/service/profile"
+	"github.com/jariwat/p_project/profile-service/service/profile/mocks"
+	skillMocks "github.com/jariwat/p_project/profile-service/service/skill/mocks"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchDuplicates_ScoresAndPaginates(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	seia := &models.Profile{ID: ptrUUID(), FirstName: "SeiA", LastName: "Phanes", Class: "Yuusha",
+		Skills: []*models.Skill{{Skill: "Go"}, {Skill: "SQL"}}}
+	seia2 := &models.Profile{ID: ptrUUID(), FirstName: "Seia", LastName: "Phanes", Class: "yuusha ",
+		Skills: []*models.Skill{{Skill: "go"}}}
+	alize := &models.Profile{ID: ptrUUID(), FirstName: "AliZe", LastName: "Phanes", Class: "Mage"}
+
+	mockRepo.On("FetchDuplicateCandidates", 0.3, duplicateCandidateLimit).Return([]*models.DuplicateCandidate{
+		{ProfileID: seia.ID, DuplicateID: alize.ID, NameSimilarity: 0.5},
+		{ProfileID: seia.ID, DuplicateID: seia2.ID, NameSimilarity: 1},
+	}, nil)
+	mockRepo.On("FetchProfilesByIds", mock.Anything).Return([]*models.Profile{seia, seia2, alize}, nil)
+
+	paginator := models.NewPaginator(1, 10)
+	duplicates, err := usecase.FetchDuplicates(0.3, paginator)
+
+	require.NoError(t, err)
+	require.Len(t, duplicates, 2)
+	require.Equal(t, seia2, duplicates[0].Duplicate)
+	require.True(t, duplicates[0].SameClass)
+	require.Equal(t, []string{"Go"}, duplicates[0].SharedSkills)
+	require.InDelta(t, 0.6+0.2+0.2*0.5, duplicates[0].Score, 1e-9)
+	require.False(t, duplicates[1].SameClass)
+	require.InDelta(t, 0.3, duplicates[1].Score, 1e-9)
+	require.Equal(t, 2, paginator.TotalRows)
+}
+
+func TestFetchDuplicates_MinScoreRaisesNameThreshold(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	mockRepo.On("FetchDuplicateCandidates", mock.MatchedBy(func(minNameSimilarity float64) bool {
+		return minNameSimilarity > 0.83 && minNameSimilarity < 0.84
+	}), duplicateCandidateLimit).Return([]*models.DuplicateCandidate{}, nil)
+	mockRepo.On("FetchProfilesByIds", []uuid.UUID{}).Return([]*models.Profile{}, nil)
+
+	duplicates, err := usecase.FetchDuplicates(0.9, models.NewPaginator(1, 10))
+
+	require.NoError(t, err)
+	require.Empty(t, duplicates)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestMergeProfiles_SameProfile(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileID := types.UUID(*ptrUUID())
+	merge, err := usecase.MergeProfiles(_profile.ProfileMergeRequest{SurvivorId: profileID, MergedId: profileID}, staffViewer)
+
+	require.Nil(t, merge)
+	require.Equal(t, constants.ErrMergeSameProfile, err)
+}
+
+func TestMergeProfiles_NotStaff(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	merge, err := usecase.MergeProfiles(_profile.ProfileMergeRequest{
+		SurvivorId: types.UUID(*ptrUUID()),
+		MergedId:   types.UUID(*ptrUUID()),
+	}, studentViewer)
+
+	require.Nil(t, merge)
+	require.Equal(t, constants.ErrMergeForbidden, err)
+	mockRepo.AssertNotCalled(t, "FetchProfileById", mock.Anything)
+}
+
+func TestMergeProfiles_NotFound(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	survivorID := ptrUUID()
+	mergedID := ptrUUID()
+	mockRepo.On("FetchProfileById", survivorID).Return(&models.Profile{ID: survivorID}, nil)
+	mockRepo.On("FetchProfileById", mergedID).Return(nil, nil)
+
+	merge, err := usecase.MergeProfiles(_profile.ProfileMergeRequest{
+		SurvivorId: types.UUID(*survivorID),
+		MergedId:   types.UUID(*mergedID),
+	}, staffViewer)
+
+	require.Nil(t, merge)
+	require.Equal(t, constants.ErrProfileNotFound, err)
+	mockRepo.AssertNotCalled(t, "MergeProfiles", mock.Anything, mock.Anything)
+}
+
+func TestMergeProfiles_ResolvesFields(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	survivorID := ptrUUID()
+	mergedID := ptrUUID()
+	middleName := "Der"
+	externalID := "STU-1"
+	survivor := &models.Profile{ID: survivorID, FirstName: "Seia", LastName: "Phanes", Gender: models.GenderMale, Class: "Yuusha"}
+	merged := &models.Profile{ID: mergedID, ExternalID: &externalID, FirstName: "SeiA", MiddleName: &middleName, LastName: "Phanes", Gender: models.GenderMale, Class: "Mage"}
+	updated := &models.Profile{ID: survivorID, FirstName: "SeiA"}
+
+	mockRepo.On("FetchProfileById", survivorID).Return(survivor, nil).Once()
+	mockRepo.On("FetchProfileById", mergedID).Return(merged, nil)
+	mockRepo.On("MergeProfiles", mock.AnythingOfType("*models.ProfileMerge"), survivor).Return(nil)
+	mockRepo.On("FetchProfileById", survivorID).Return(updated, nil).Once()
+
+	source := _profile.Merged
+	merge, err := usecase.MergeProfiles(_profile.ProfileMergeRequest{
+		SurvivorId: types.UUID(*survivorID),
+		MergedId:   types.UUID(*mergedID),
+		Fields:     &_profile.ProfileMergeFields{FirstName: &source},
+	}, staffViewer)
+
+	require.NoError(t, err)
+	require.Equal(t, updated, merge.Profile)
+	require.Equal(t, "SeiA", survivor.FirstName)
+	require.Equal(t, &middleName, survivor.MiddleName)
+	require.Equal(t, &externalID, survivor.ExternalID)
+	require.Equal(t, "Yuusha", survivor.Class)
+
+	var fields map[string]models.ProfileMergeSource
+	require.NoError(t, json.Unmarshal(merge.Fields, &fields))
+	require.Equal(t, models.ProfileMergeSourceMerged, fields["first_name"])
+	require.Equal(t, models.ProfileMergeSourceMerged, fields["middle_name"])
+	require.Equal(t, models.ProfileMergeSourceSurvivor, fields["class"])
+}
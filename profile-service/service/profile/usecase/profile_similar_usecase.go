@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/profile"
+)
+
+const defaultSimilarLimit = 10
+
+// FetchSimilarProfiles implements profile.ProfileUsecase.
+// Each result lists the skills it shares with the profile, named as entered on
+// the similar profile.
+func (p *profileUsecase) FetchSimilarProfiles(profileId *uuid.UUID, params profile.GetProfileIdSimilarParams) ([]*models.SimilarProfile, error) {
+	target, err := p.profileRepo.FetchProfileById(profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	if target == nil {
+		return nil, constants.ErrProfileNotFound
+	}
+
+	similar := make([]*models.SimilarProfile, 0)
+	if len(target.Skills) == 0 {
+		return similar, nil
+	}
+
+	var sameClass *bool
+	if params.Class != nil && *params.Class != profile.Any {
+		same := *params.Class == profile.Same
+		sameClass = &same
+	}
+
+	limit := defaultSimilarLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	candidates, err := p.profileRepo.FetchSimilarCandidates(profileId, sameClass, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	profileIds := make([]uuid.UUID, 0, len(candidates))
+	for _, candidate := range candidates {
+		profileIds = append(profileIds, *candidate.ProfileID)
+	}
+
+	profiles, err := p.profileRepo.FetchProfilesByIds(profileIds)
+	if err != nil {
+		return nil, err
+	}
+
+	profileById := make(map[uuid.UUID]*models.Profile)
+	for _, profile := range profiles {
+		profileById[*profile.ID] = profile
+	}
+
+	targetKeys := make(map[string]bool)
+	for _, skill := range target.Skills {
+		targetKeys[models.SkillKey(skill)] = true
+	}
+
+	for _, candidate := range candidates {
+		// skip profiles deleted in between
+		candidateProfile, ok := profileById[*candidate.ProfileID]
+		if !ok {
+			continue
+		}
+
+		shared := make([]string, 0, candidate.SharedSkills)
+		seen := make(map[string]bool)
+		for _, skill := range candidateProfile.Skills {
+			key := models.SkillKey(skill)
+			if targetKeys[key] && !seen[key] {
+				seen[key] = true
+				shared = append(shared, skill.Skill)
+			}
+		}
+
+		similar = append(similar, &models.SimilarProfile{
+			Profile:      candidateProfile,
+			Score:        candidate.Score,
+			SharedSkills: shared,
+		})
+	}
+
+	return similar, nil
+}
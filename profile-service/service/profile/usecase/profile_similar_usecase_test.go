@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_profile "github.com/jariwat/p_project/profile-service/service/profile"
+	"github.com/jariwat/p_project/profile-service/service/profile/mocks"
+	skillMocks "github.com/jariwat/p_project/profile-service/service/skill/mocks"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchSimilarProfiles_ExplainsSharedSkills(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	goCatalogID := ptrUUID()
+	seia := &models.Profile{ID: ptrUUID(), FirstName: "SeiA", Class: "Yuusha",
+		Skills: []*models.Skill{{Skill: "Go", CatalogID: goCatalogID}, {Skill: "SQL"}, {Skill: "Rust"}}}
+	alize := &models.Profile{ID: ptrUUID(), FirstName: "AliZe", Class: "Yuusha",
+		Skills: []*models.Skill{{Skill: "Go", CatalogID: goCatalogID}, {Skill: "sql "}, {Skill: "Docker"}}}
+	gone := ptrUUID()
+
+	class := _profile.Same
+	sameClass := true
+	mockRepo.On("FetchProfileById", seia.ID).Return(seia, nil)
+	mockRepo.On("FetchSimilarCandidates", seia.ID, &sameClass, defaultSimilarLimit).Return([]*models.SimilarCandidate{
+		{ProfileID: alize.ID, SharedSkills: 2, Score: 0.5},
+		{ProfileID: gone, SharedSkills: 1, Score: 0.2},
+	}, nil)
+	mockRepo.On("FetchProfilesByIds", mock.Anything).Return([]*models.Profile{alize}, nil)
+
+	similar, err := usecase.FetchSimilarProfiles(seia.ID, _profile.GetProfileIdSimilarParams{Class: &class})
+
+	require.NoError(t, err)
+	require.Len(t, similar, 1)
+	require.Equal(t, alize, similar[0].Profile)
+	require.Equal(t, 0.5, similar[0].Score)
+	require.Equal(t, []string{"Go", "sql "}, similar[0].SharedSkills)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestFetchSimilarProfiles_NoSkills(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profile := &models.Profile{ID: ptrUUID()}
+	mockRepo.On("FetchProfileById", profile.ID).Return(profile, nil)
+
+	similar, err := usecase.FetchSimilarProfiles(profile.ID, _profile.GetProfileIdSimilarParams{})
+
+	require.NoError(t, err)
+	require.Empty(t, similar)
+	mockRepo.AssertNotCalled(t, "FetchSimilarCandidates", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestFetchSimilarProfiles_NotFound(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileID := ptrUUID()
+	mockRepo.On("FetchProfileById", profileID).Return(nil, nil)
+
+	similar, err := usecase.FetchSimilarProfiles(profileID, _profile.GetProfileIdSimilarParams{})
+
+	require.ErrorIs(t, err, constants.ErrProfileNotFound)
+	require.Nil(t, similar)
+}
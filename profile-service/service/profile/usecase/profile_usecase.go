@@ -1,16 +1,38 @@
 package usecase
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
+	"math"
+	"strings"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/jariwat/p_project/profile-service/constants"
 	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/attribute"
+	"github.com/jariwat/p_project/profile-service/service/class"
 	"github.com/jariwat/p_project/profile-service/service/profile"
+	"github.com/jariwat/p_project/profile-service/service/skill"
 )
 
+// defaultStatsSkillLimit mirrors the skill_limit default of GET /profiles/stats.
+const defaultStatsSkillLimit = 20
+
+// idempotencyKeyLease is how long a key stays reserved for a request in
+// progress, after which a retry takes over the key of a request that never
+// completed.
+const idempotencyKeyLease = time.Minute
+
 type profileUsecase struct {
-	profileRepo profile.ProfileRepository
+	profileRepo        profile.ProfileRepository
+	skillUs            skill.SkillUsecase
+	classUs            class.ClassUsecase
+	attributeUs        attribute.AttributeUsecase
+	idempotencyKeyTTL  time.Duration
+	batchMaxOperations int
 }
 
 // FetchProfiles implements profile.ProfileUsecase.
@@ -18,36 +40,139 @@ func (p *profileUsecase) FetchProfiles(params profile.GetProfilesParams, paginat
 	return p.profileRepo.FetchProfiles(params, paginator)
 }
 
+// FetchProfileStats implements profile.ProfileUsecase.
+func (p *profileUsecase) FetchProfileStats(params profile.GetProfilesStatsParams) (*models.ProfileStats, error) {
+	skillLimit := defaultStatsSkillLimit
+	if params.SkillLimit != nil {
+		skillLimit = *params.SkillLimit
+	}
+
+	filters, err := listFilters(params)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := p.profileRepo.FetchProfileStats(filters, skillLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	genders, err := p.profileRepo.FetchGenders()
+	if err != nil {
+		return nil, err
+	}
+	addMissingGenders(stats, genders)
+
+	return stats, nil
+}
+
+// listFilters returns the list filters of the parameters of an endpoint sharing
+// them with GET /profiles. The filters are matched by their query parameter
+// name, so a filter shared with one more endpoint needs no change here.
+func listFilters(params interface{}) (profile.GetProfilesParams, error) {
+	var filters profile.GetProfilesParams
+	bu, err := json.Marshal(params)
+	if err != nil {
+		return filters, err
+	}
+
+	if err := json.Unmarshal(bu, &filters); err != nil {
+		return filters, err
+	}
+
+	// the paginator carries the page of the endpoint
+	filters.Page = nil
+	filters.PerPage = nil
+
+	return filters, nil
+}
+
+// addMissingGenders lists the allowed genders no profile has with a zero count,
+// after the counted ones and in display order.
+func addMissingGenders(stats *models.ProfileStats, genders []*models.GenderOption) {
+	counted := make(map[string]bool, len(stats.ByGender))
+	for _, count := range stats.ByGender {
+		counted[count.Key] = true
+	}
+
+	for _, gender := range genders {
+		if !counted[string(gender.Code)] {
+			stats.ByGender = append(stats.ByGender, &models.ProfileStatCount{Key: string(gender.Code), Count: 0})
+		}
+	}
+}
+
+// FetchGenders implements profile.ProfileUsecase.
+func (p *profileUsecase) FetchGenders() ([]*models.GenderOption, error) {
+	return p.profileRepo.FetchGenders()
+}
+
 // FetchProfileById implements profile.ProfileUsecase.
 func (p *profileUsecase) FetchProfileById(profileId *uuid.UUID) (*models.Profile, error) {
 	return p.profileRepo.FetchProfileById(profileId)
 }
 
+// FetchEnrollments implements profile.ProfileUsecase.
+func (p *profileUsecase) FetchEnrollments(profileId *uuid.UUID) ([]*models.Enrollment, error) {
+	profile, err := p.profileRepo.FetchProfileById(profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	if profile == nil {
+		return nil, constants.ErrProfileNotFound
+	}
+
+	return p.profileRepo.FetchEnrollments(profileId)
+}
+
 // CreateProfile implements profile.ProfileUsecase.
 func (p *profileUsecase) CreateProfile(profile *models.Profile, newProfile profile.UpsertProfile) error {
+	if newProfile.ExternalId != nil && *newProfile.ExternalId != "" {
+		profile.ExternalID = newProfile.ExternalId
+	}
 	profile.FirstName = newProfile.FirstName
 	if newProfile.MiddleName != nil && *newProfile.MiddleName != "" {
 		profile.MiddleName = newProfile.MiddleName
 	}
 	profile.LastName = newProfile.LastName
-	profile.Gender = models.Gender(newProfile.Gender)
-	profile.Class = newProfile.Class
+	if err := setNames(profile, newProfile); err != nil {
+		return err
+	}
+	if err := setGender(profile, newProfile); err != nil {
+		return err
+	}
+	if err := setInitialStatus(profile, newProfile); err != nil {
+		return err
+	}
+	if err := p.setClass(profile, newProfile); err != nil {
+		return err
+	}
+	if err := p.setCustomAttributes(profile, newProfile); err != nil {
+		return err
+	}
 	profile.SetCreatedAt()
 	profile.SetUpdatedAt()
 	if newProfile.Skills != nil && len(newProfile.Skills) > 0 {
 		skills := make([]*models.Skill, 0)
-		for _, skill := range newProfile.Skills {
+		for _, newSkill := range newProfile.Skills {
 			skill := &models.Skill{
 				ProfileID: profile.ID,
-				Skill:     skill.Skill,
-				Detail:    skill.Detail,
+				Skill:     newSkill.Skill,
+				Detail:    newSkill.Detail,
 			}
+			setSkillExperience(skill, newSkill)
 			skill.GenUUID()
 			skill.SetCreatedAt()
 			skill.SetUpdatedAt()
 
 			skills = append(skills, skill)
 		}
+
+		skills, err := p.skillUs.NormalizeSkills(skills)
+		if err != nil {
+			return err
+		}
 		profile.Skills = skills
 	}
 
@@ -58,6 +183,148 @@ func (p *profileUsecase) CreateProfile(profile *models.Profile, newProfile profi
 	return p.profileRepo.CreateProfile(profile)
 }
 
+// setNames replaces the names of the profile in other languages.
+func setNames(profile *models.Profile, upsertProfile profile.UpsertProfile) error {
+	profile.Names = nil
+	if upsertProfile.Names == nil {
+		return nil
+	}
+
+	locales := make(map[string]bool, len(*upsertProfile.Names))
+	for _, upsertName := range *upsertProfile.Names {
+		locale := string(upsertName.Locale)
+		if locales[locale] {
+			return constants.ErrDuplicateNameLocale
+		}
+		locales[locale] = true
+
+		name := &models.ProfileName{
+			ProfileID: profile.ID,
+			Locale:    locale,
+			FirstName: strings.TrimSpace(upsertName.FirstName),
+			LastName:  strings.TrimSpace(upsertName.LastName),
+		}
+		if upsertName.MiddleName != nil && strings.TrimSpace(*upsertName.MiddleName) != "" {
+			middleName := strings.TrimSpace(*upsertName.MiddleName)
+			name.MiddleName = &middleName
+		}
+		name.GenUUID()
+		name.SetCreatedAt()
+		name.SetUpdatedAt()
+
+		profile.Names = append(profile.Names, name)
+	}
+
+	return nil
+}
+
+// setGender copies the gender and pronouns, the gender must be in the gender
+// table which is checked when the profile is saved.
+func setGender(profile *models.Profile, upsertProfile profile.UpsertProfile) error {
+	profile.Gender = models.NormalizeGender(upsertProfile.Gender)
+	if profile.Gender == "" {
+		return constants.ErrUnknownGender
+	}
+
+	profile.Pronouns = nil
+	if upsertProfile.Pronouns != nil && strings.TrimSpace(*upsertProfile.Pronouns) != "" {
+		pronouns := strings.TrimSpace(*upsertProfile.Pronouns)
+		profile.Pronouns = &pronouns
+	}
+
+	return nil
+}
+
+// setInitialStatus sets the status a new profile starts in, active unless
+// given as a draft.
+func setInitialStatus(profile *models.Profile, upsertProfile profile.UpsertProfile) error {
+	profile.Status = models.ProfileStatusActive
+	if upsertProfile.Status != nil {
+		profile.Status = models.ProfileStatus(*upsertProfile.Status)
+	}
+
+	if !profile.Status.IsInitial() {
+		return constants.ErrInvalidInitialStatus
+	}
+
+	return nil
+}
+
+// checkStatusUnchanged rejects an update giving another status than the
+// current one, the status only changes through a transition.
+func checkStatusUnchanged(profile *models.Profile, upsertProfile profile.UpsertProfile) error {
+	if upsertProfile.Status != nil && models.ProfileStatus(*upsertProfile.Status) != profile.Status {
+		return fmt.Errorf("%w: the status of a profile changes through POST /profile/{id}/transitions", constants.ErrInvalidStatusTransition)
+	}
+
+	return nil
+}
+
+// setClass assigns the class given by id or code, the capacity is checked when the profile is saved.
+func (p *profileUsecase) setClass(profile *models.Profile, upsertProfile profile.UpsertProfile) error {
+	var classId *uuid.UUID
+	if upsertProfile.ClassId != nil {
+		id := uuid.FromStringOrNil(upsertProfile.ClassId.String())
+		classId = &id
+	}
+	var code string
+	if upsertProfile.Class != nil {
+		code = *upsertProfile.Class
+	}
+
+	class, err := p.classUs.ResolveClass(classId, code)
+	if err != nil {
+		return err
+	}
+
+	profile.ClassID = nil
+	profile.Class = ""
+	if class != nil {
+		profile.ClassID = class.ID
+		profile.Class = class.Code
+	}
+
+	return nil
+}
+
+// setCustomAttributes replaces the custom attributes of the profile once they
+// match the attributes defined, those of an existing profile are kept when
+// none are given.
+func (p *profileUsecase) setCustomAttributes(profile *models.Profile, upsertProfile profile.UpsertProfile) error {
+	values := map[string]interface{}{}
+	if upsertProfile.CustomAttributes != nil {
+		values = *upsertProfile.CustomAttributes
+	} else if profile.CustomAttributes != nil {
+		return nil
+	}
+
+	if err := p.attributeUs.ValidateCustomAttributes(values); err != nil {
+		return err
+	}
+
+	customAttributes, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+	profile.CustomAttributes = customAttributes
+
+	return nil
+}
+
+// setSkillExperience copies the optional proficiency, years of experience and last used date.
+func setSkillExperience(skill *models.Skill, newSkill profile.UpsertSkill) {
+	if newSkill.Proficiency != nil {
+		proficiency := models.SkillProficiency(*newSkill.Proficiency)
+		skill.Proficiency = &proficiency
+	}
+	if newSkill.YearsExperience != nil {
+		// stored with one decimal, round away the float32 noise
+		yearsExperience := math.Round(float64(*newSkill.YearsExperience)*10) / 10
+		skill.YearsExperience = &yearsExperience
+	}
+	skill.SetLastUsed(newSkill.LastUsed)
+}
+
 // UpdateProfile implements profile.ProfileUsecase.
 func (p *profileUsecase) UpdateProfile(profileId *uuid.UUID, updateProfile profile.UpsertProfile) error {
 	profile, err := p.profileRepo.FetchProfileById(profileId)
@@ -69,41 +336,161 @@ func (p *profileUsecase) UpdateProfile(profileId *uuid.UUID, updateProfile profi
 		return constants.ErrProfileNotFound
 	}
 
+	if updateProfile.ExternalId != nil && *updateProfile.ExternalId != "" {
+		profile.ExternalID = updateProfile.ExternalId
+	}
 	profile.FirstName = updateProfile.FirstName
 	if updateProfile.MiddleName != nil && *updateProfile.MiddleName != "" {
 		profile.MiddleName = updateProfile.MiddleName
 	}
 	profile.LastName = updateProfile.LastName
-	profile.Gender = models.Gender(updateProfile.Gender)
-	profile.Class = updateProfile.Class
+	if err := setNames(profile, updateProfile); err != nil {
+		return err
+	}
+	if err := setGender(profile, updateProfile); err != nil {
+		return err
+	}
+	if err := checkStatusUnchanged(profile, updateProfile); err != nil {
+		return err
+	}
+	if err := p.setClass(profile, updateProfile); err != nil {
+		return err
+	}
+	if err := p.setCustomAttributes(profile, updateProfile); err != nil {
+		return err
+	}
 	profile.SetUpdatedAt()
 	if updateProfile.Skills != nil && len(updateProfile.Skills) > 0 {
 		skills := make([]*models.Skill, 0)
-		for _, skill := range updateProfile.Skills {
+		for _, newSkill := range updateProfile.Skills {
 			skill := &models.Skill{
 				ProfileID: profile.ID,
-				Skill:     skill.Skill,
-				Detail:    skill.Detail,
+				Skill:     newSkill.Skill,
+				Detail:    newSkill.Detail,
 			}
+			setSkillExperience(skill, newSkill)
 			skill.GenUUID()
 			skill.SetCreatedAt()
 			skill.SetUpdatedAt()
 
 			skills = append(skills, skill)
 		}
+
+		skills, err := p.skillUs.NormalizeSkills(skills)
+		if err != nil {
+			return err
+		}
 		profile.Skills = skills
 	}
 
 	return p.profileRepo.UpdateProfile(profile)
 }
 
+// UpsertProfile implements profile.ProfileUsecase.
+// The profile is created with the given id when it does not exist yet.
+func (p *profileUsecase) UpsertProfile(profileId *uuid.UUID, upsertProfile profile.UpsertProfile) (bool, error) {
+	existing, err := p.profileRepo.FetchProfileById(profileId)
+	if err != nil {
+		return false, err
+	}
+
+	if existing == nil {
+		err := p.CreateProfile(&models.Profile{ID: profileId}, upsertProfile)
+		if !errors.Is(err, constants.ErrProfileAlreadyExists) {
+			return err == nil, err
+		}
+		// created by a concurrent request in the meantime, update it instead
+	}
+
+	return false, p.UpdateProfile(profileId, upsertProfile)
+}
+
 // DeleteProfile implements profile.ProfileUsecase.
 func (p *profileUsecase) DeleteProfile(profileId *uuid.UUID) error {
 	return p.profileRepo.DeleteProfile(profileId)
 }
 
-func NewProfileUsecase(profileRepo profile.ProfileRepository) profile.ProfileUsecase {
+// ReserveIdempotencyKey implements profile.ProfileUsecase.
+// It returns nil once the key is reserved for the request, and the stored
+// response when a previous request of the user with the key completed.
+func (p *profileUsecase) ReserveIdempotencyKey(userId string, key string, requestHash string) (*models.IdempotencyKey, error) {
+	reservation := &models.IdempotencyKey{UserID: userId, Key: key, RequestHash: requestHash}
+	reservation.SetCreatedAt()
+	reservation.SetExpiresAt(idempotencyKeyLease)
+
+	reserved, err := p.profileRepo.ReserveIdempotencyKey(reservation)
+	if err != nil || reserved {
+		return nil, err
+	}
+
+	idempotencyKey, err := p.profileRepo.FetchIdempotencyKey(userId, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if idempotencyKey == nil || idempotencyKey.IsExpired() {
+		// released or lapsed since, a retry can reserve it
+		return nil, constants.ErrIdempotencyKeyInProgress
+	}
+
+	if idempotencyKey.RequestHash != requestHash {
+		return nil, constants.ErrIdempotencyKeyMismatch
+	}
+
+	if idempotencyKey.IsPending() {
+		return nil, constants.ErrIdempotencyKeyInProgress
+	}
+
+	return idempotencyKey, nil
+}
+
+// SaveIdempotencyKey implements profile.ProfileUsecase.
+// It stores the response of the request that reserved the key, replayed to
+// its retries until the key expires.
+func (p *profileUsecase) SaveIdempotencyKey(userId string, key string, requestHash string, responseStatus int, responseBody []byte) error {
+	idempotencyKey := &models.IdempotencyKey{
+		UserID:         userId,
+		Key:            key,
+		RequestHash:    requestHash,
+		ResponseStatus: responseStatus,
+		ResponseBody:   responseBody,
+	}
+	idempotencyKey.SetExpiresAt(p.idempotencyKeyTTL)
+
+	return p.profileRepo.CompleteIdempotencyKey(idempotencyKey)
+}
+
+// ReleaseIdempotencyKey implements profile.ProfileUsecase.
+// A request that failed with a server error gives its key back, so a retry runs it again.
+func (p *profileUsecase) ReleaseIdempotencyKey(userId string, key string, requestHash string) error {
+	return p.profileRepo.ReleaseIdempotencyKey(userId, key, requestHash)
+}
+
+// PurgeExpiredIdempotencyKeys implements profile.ProfileUsecase.
+func (p *profileUsecase) PurgeExpiredIdempotencyKeys() error {
+	return p.profileRepo.DeleteExpiredIdempotencyKeys()
+}
+
+// withRepository returns a copy of the usecase working with profileRepo, such
+// as a repository bound to a transaction.
+func (p *profileUsecase) withRepository(profileRepo profile.ProfileRepository) *profileUsecase {
+	return &profileUsecase{
+		profileRepo:        profileRepo,
+		skillUs:            p.skillUs,
+		classUs:            p.classUs,
+		attributeUs:        p.attributeUs,
+		idempotencyKeyTTL:  p.idempotencyKeyTTL,
+		batchMaxOperations: p.batchMaxOperations,
+	}
+}
+
+func NewProfileUsecase(profileRepo profile.ProfileRepository, skillUs skill.SkillUsecase, classUs class.ClassUsecase, attributeUs attribute.AttributeUsecase, idempotencyKeyTTL time.Duration, batchMaxOperations int) profile.ProfileUsecase {
 	return &profileUsecase{
-		profileRepo: profileRepo,
+		profileRepo:        profileRepo,
+		skillUs:            skillUs,
+		classUs:            classUs,
+		attributeUs:        attributeUs,
+		idempotencyKeyTTL:  idempotencyKeyTTL,
+		batchMaxOperations: batchMaxOperations,
 	}
 }
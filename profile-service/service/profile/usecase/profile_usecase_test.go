@@ -1,14 +1,21 @@
 package usecase
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/jariwat/p_project/profile-service/constants"
 	"github.com/jariwat/p_project/profile-service/models"
+	attributeMocks "github.com/jariwat/p_project/profile-service/service/attribute/mocks"
+	classMocks "github.com/jariwat/p_project/profile-service/service/class/mocks"
 	_profile "github.com/jariwat/p_project/profile-service/service/profile"
 	"github.com/jariwat/p_project/profile-service/service/profile/mocks"
+	skillMocks "github.com/jariwat/p_project/profile-service/service/skill/mocks"
+	"github.com/oapi-codegen/runtime/types"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -18,10 +25,35 @@ func ptrUUID() *uuid.UUID {
 	return &id
 }
 
+func strPtr(s string) *string {
+	return &s
+}
+
+// newClassUs resolves every class code to a class with that code.
+func newClassUs() *classMocks.ClassUsecase {
+	classUs := new(classMocks.ClassUsecase)
+	classUs.
+		On("ResolveClass", mock.Anything, mock.Anything).
+		Return(func(classId *uuid.UUID, code string) *models.Class {
+			if code == "" {
+				return nil
+			}
+			return &models.Class{ID: ptrUUID(), Code: code}
+		}, nil)
+	return classUs
+}
+
+// newAttributeUs accepts any custom attributes.
+func newAttributeUs() *attributeMocks.AttributeUsecase {
+	attributeUs := new(attributeMocks.AttributeUsecase)
+	attributeUs.On("ValidateCustomAttributes", mock.Anything).Return(nil)
+	return attributeUs
+}
+
 func TestFetchProfiles_Success(t *testing.T) {
 	// Arrange
 	mockRepo := new(mocks.ProfileRepository)
-	usecase := NewProfileUsecase(mockRepo)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
 
 	var page = 1
 	var perPage = 10
@@ -54,7 +86,7 @@ func TestFetchProfiles_Success(t *testing.T) {
 
 func TestFetchProfiles_Error(t *testing.T) {
 	mockRepo := new(mocks.ProfileRepository)
-	usecase := NewProfileUsecase(mockRepo)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
 
 	params := _profile.GetProfilesParams{}
 	paginator := &models.Paginator{Page: 1, PerPage: 10}
@@ -74,9 +106,56 @@ func TestFetchProfiles_Error(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestFetchProfileStats_Filters(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	searchWord := "phanes"
+	skillLevel := []string{"Go>=3"}
+	attribute := []string{"bus_route=12"}
+	tagAny := []string{"robotics"}
+	tagNone := []string{"alumni"}
+	stats := &models.ProfileStats{Total: 2}
+	mockRepo.
+		On("FetchProfileStats", _profile.GetProfilesParams{SearchWord: &searchWord, SkillLevel: &skillLevel, Attribute: &attribute, TagAny: &tagAny, TagNone: &tagNone}, defaultStatsSkillLimit).
+		Return(stats, nil)
+	mockRepo.On("FetchGenders").Return([]*models.GenderOption{}, nil)
+
+	result, err := usecase.FetchProfileStats(_profile.GetProfilesStatsParams{SearchWord: &searchWord, SkillLevel: &skillLevel, Attribute: &attribute, TagAny: &tagAny, TagNone: &tagNone})
+
+	require.NoError(t, err)
+	require.Equal(t, stats, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestFetchProfileStats_ListsEveryGender(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	gender := []string{"non_binary", "FEMALE"}
+	stats := &models.ProfileStats{Total: 3, ByGender: []*models.ProfileStatCount{{Key: "NON_BINARY", Count: 3}}}
+	mockRepo.
+		On("FetchProfileStats", _profile.GetProfilesParams{Gender: &gender}, defaultStatsSkillLimit).
+		Return(stats, nil)
+	mockRepo.On("FetchGenders").Return([]*models.GenderOption{
+		{Code: models.GenderMale, Label: "Male"},
+		{Code: models.GenderFemale, Label: "Female"},
+		{Code: models.GenderNonBinary, Label: "Non-binary"},
+	}, nil)
+
+	result, err := usecase.FetchProfileStats(_profile.GetProfilesStatsParams{Gender: &gender})
+
+	require.NoError(t, err)
+	require.Equal(t, []*models.ProfileStatCount{
+		{Key: "NON_BINARY", Count: 3},
+		{Key: "MALE", Count: 0},
+		{Key: "FEMALE", Count: 0},
+	}, result.ByGender)
+}
+
 func TestFetchProfileById_Success(t *testing.T) {
 	mockRepo := new(mocks.ProfileRepository)
-	usecase := NewProfileUsecase(mockRepo)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
 
 	profileID := ptrUUID()
 	expected := &models.Profile{
@@ -99,7 +178,7 @@ func TestFetchProfileById_Success(t *testing.T) {
 
 func TestFetchProfileById_Error(t *testing.T) {
 	mockRepo := new(mocks.ProfileRepository)
-	usecase := NewProfileUsecase(mockRepo)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
 
 	profileID := ptrUUID()
 	expectedErr := errors.New("not found")
@@ -120,7 +199,12 @@ func TestFetchProfileById_Error(t *testing.T) {
 func TestCreateProfile_Success(t *testing.T) {
 	// Mock repository
 	mockRepo := new(mocks.ProfileRepository)
-	usecase := NewProfileUsecase(mockRepo)
+	mockSkillUs := new(skillMocks.SkillUsecase)
+	usecase := NewProfileUsecase(mockRepo, mockSkillUs, newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	mockSkillUs.
+		On("NormalizeSkills", mock.AnythingOfType("[]*models.Skill")).
+		Return(func(skills []*models.Skill) []*models.Skill { return skills }, nil)
 
 	// Prepare input
 	profile := &models.Profile{}
@@ -131,7 +215,7 @@ func TestCreateProfile_Success(t *testing.T) {
 		MiddleName: &middle,
 		LastName:   "Phanes",
 		Gender:     "MALE",
-		Class:      "King",
+		Class:      strPtr("King"),
 		Skills: []_profile.UpsertSkill{
 			{
 				Skill:  "Swordsmanship",
@@ -148,6 +232,7 @@ func TestCreateProfile_Success(t *testing.T) {
 				p.LastName == "Phanes" &&
 				p.Gender == models.Gender("MALE") &&
 				p.Class == "King" &&
+				p.ClassID != nil &&
 				len(p.Skills) == 1 &&
 				p.Skills[0].Skill == "Swordsmanship" &&
 				p.Skills[0].Detail == "Expert in sword fighting techniques"
@@ -162,16 +247,201 @@ func TestCreateProfile_Success(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestCreateProfile_NormalizesSkills(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	mockSkillUs := new(skillMocks.SkillUsecase)
+	usecase := NewProfileUsecase(mockRepo, mockSkillUs, newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	catalogID := ptrUUID()
+	newProfile := _profile.UpsertProfile{
+		FirstName: "SeiA",
+		LastName:  "Phanes",
+		Gender:    "MALE",
+		Class:     strPtr("King"),
+		Skills: []_profile.UpsertSkill{
+			{Skill: "golang", Detail: "Backend"},
+			{Skill: "GoLang", Detail: "CLI tools"},
+		},
+	}
+
+	mockSkillUs.
+		On("NormalizeSkills", mock.MatchedBy(func(skills []*models.Skill) bool {
+			return len(skills) == 2 && skills[0].Skill == "golang"
+		})).
+		Return(func(skills []*models.Skill) []*models.Skill {
+			skills[0].Skill = "Go"
+			skills[0].CatalogID = catalogID
+			return skills[:1]
+		}, nil)
+	mockRepo.
+		On("CreateProfile", mock.MatchedBy(func(p *models.Profile) bool {
+			return len(p.Skills) == 1 && p.Skills[0].Skill == "Go" && p.Skills[0].CatalogID == catalogID
+		})).
+		Return(nil)
+
+	err := usecase.CreateProfile(&models.Profile{ID: ptrUUID()}, newProfile)
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateProfile_SkillExperience(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	mockSkillUs := new(skillMocks.SkillUsecase)
+	usecase := NewProfileUsecase(mockRepo, mockSkillUs, newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	proficiency := 4
+	var yearsExperience float32 = 2.3
+	lastUsed := time.Date(2024, 5, 1, 15, 30, 0, 0, time.UTC)
+	newProfile := _profile.UpsertProfile{
+		FirstName: "SeiA",
+		LastName:  "Phanes",
+		Gender:    "MALE",
+		Class:     strPtr("King"),
+		Skills: []_profile.UpsertSkill{
+			{Skill: "Go", Detail: "Backend", Proficiency: &proficiency, YearsExperience: &yearsExperience, LastUsed: &lastUsed},
+			{Skill: "Rust", Detail: "Learning"},
+		},
+	}
+
+	mockSkillUs.
+		On("NormalizeSkills", mock.Anything).
+		Return(func(skills []*models.Skill) []*models.Skill { return skills }, nil)
+	mockRepo.
+		On("CreateProfile", mock.MatchedBy(func(p *models.Profile) bool {
+			goSkill, rustSkill := p.Skills[0], p.Skills[1]
+			return *goSkill.Proficiency == models.SkillProficiencyAdvanced &&
+				*goSkill.YearsExperience == 2.3 &&
+				goSkill.LastUsed.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)) &&
+				rustSkill.Proficiency == nil && rustSkill.YearsExperience == nil && rustSkill.LastUsed == nil
+		})).
+		Return(nil)
+
+	err := usecase.CreateProfile(&models.Profile{ID: ptrUUID()}, newProfile)
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateProfile_NormalizeSkillsError(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	mockSkillUs := new(skillMocks.SkillUsecase)
+	usecase := NewProfileUsecase(mockRepo, mockSkillUs, newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	mockSkillUs.
+		On("NormalizeSkills", mock.Anything).
+		Return(nil, errors.New("db error"))
+
+	err := usecase.CreateProfile(&models.Profile{ID: ptrUUID()}, _profile.UpsertProfile{
+		FirstName: "SeiA",
+		Gender:    "MALE",
+		Skills:    []_profile.UpsertSkill{{Skill: "Go"}},
+	})
+
+	require.EqualError(t, err, "db error")
+	mockRepo.AssertNotCalled(t, "CreateProfile", mock.Anything)
+}
+
+func TestCreateProfile_UnknownClass(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	mockClassUs := new(classMocks.ClassUsecase)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), mockClassUs, newAttributeUs(), time.Hour, 100)
+
+	classID := ptrUUID()
+	mockClassUs.On("ResolveClass", classID, "King").Return(nil, constants.ErrUnknownClass)
+
+	err := usecase.CreateProfile(&models.Profile{ID: ptrUUID()}, _profile.UpsertProfile{
+		FirstName: "SeiA",
+		LastName:  "Phanes",
+		Gender:    "MALE",
+		ClassId:   (*types.UUID)(classID),
+		Class:     strPtr("King"),
+	})
+
+	require.ErrorIs(t, err, constants.ErrUnknownClass)
+	mockRepo.AssertNotCalled(t, "CreateProfile", mock.Anything)
+}
+
+func TestUpdateProfile_LeavesClass(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileID := ptrUUID()
+	mockRepo.On("FetchProfileById", profileID).Return(&models.Profile{ID: profileID, ClassID: ptrUUID(), Class: "King"}, nil)
+	mockRepo.
+		On("UpdateProfile", mock.MatchedBy(func(p *models.Profile) bool {
+			return p.ClassID == nil && p.Class == ""
+		})).
+		Return(nil)
+
+	err := usecase.UpdateProfile(profileID, _profile.UpsertProfile{FirstName: "SeiA", LastName: "Phanes", Gender: "MALE"})
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateProfile_InvalidCustomAttributes(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	mockAttributeUs := new(attributeMocks.AttributeUsecase)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), mockAttributeUs, time.Hour, 100)
+
+	invalid := fmt.Errorf("%w: property \"blood_type\" is missing", constants.ErrInvalidCustomAttributes)
+	mockAttributeUs.On("ValidateCustomAttributes", map[string]interface{}{}).Return(invalid)
+
+	err := usecase.CreateProfile(&models.Profile{ID: ptrUUID()}, _profile.UpsertProfile{FirstName: "SeiA", LastName: "Phanes", Gender: "MALE"})
+
+	require.ErrorIs(t, err, constants.ErrInvalidCustomAttributes)
+	mockRepo.AssertNotCalled(t, "CreateProfile", mock.Anything)
+}
+
+func TestUpdateProfile_CustomAttributes(t *testing.T) {
+	profileID := ptrUUID()
+	existing := json.RawMessage(`{"blood_type":"O+"}`)
+
+	t.Run("kept when left out", func(t *testing.T) {
+		mockRepo := new(mocks.ProfileRepository)
+		mockAttributeUs := new(attributeMocks.AttributeUsecase)
+		usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), mockAttributeUs, time.Hour, 100)
+
+		mockRepo.On("FetchProfileById", profileID).Return(&models.Profile{ID: profileID, CustomAttributes: existing}, nil)
+		mockRepo.On("UpdateProfile", mock.MatchedBy(func(p *models.Profile) bool {
+			return string(p.CustomAttributes) == string(existing)
+		})).Return(nil)
+
+		err := usecase.UpdateProfile(profileID, _profile.UpsertProfile{FirstName: "SeiA", LastName: "Phanes", Gender: "MALE"})
+
+		require.NoError(t, err)
+		mockAttributeUs.AssertNotCalled(t, "ValidateCustomAttributes", mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("replaced", func(t *testing.T) {
+		mockRepo := new(mocks.ProfileRepository)
+		usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+		mockRepo.On("FetchProfileById", profileID).Return(&models.Profile{ID: profileID, CustomAttributes: existing}, nil)
+		mockRepo.On("UpdateProfile", mock.MatchedBy(func(p *models.Profile) bool {
+			return string(p.CustomAttributes) == `{"bus_route":12}`
+		})).Return(nil)
+
+		err := usecase.UpdateProfile(profileID, _profile.UpsertProfile{FirstName: "SeiA", LastName: "Phanes", Gender: "MALE",
+			CustomAttributes: &_profile.CustomAttributes{"bus_route": float64(12)}})
+
+		require.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
 func TestCreateProfile_RepoError(t *testing.T) {
 	mockRepo := new(mocks.ProfileRepository)
-	usecase := NewProfileUsecase(mockRepo)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
 
 	profile := &models.Profile{}
 	newProfile := _profile.UpsertProfile{
 		FirstName: "SeiA",
 		LastName:  "Phanes",
 		Gender:    "MALE",
-		Class:     "King",
+		Class:     strPtr("King"),
 	}
 
 	mockRepo.
@@ -187,7 +457,12 @@ func TestCreateProfile_RepoError(t *testing.T) {
 
 func TestUpdateProfile_Success(t *testing.T) {
 	mockRepo := new(mocks.ProfileRepository)
-	usecase := NewProfileUsecase(mockRepo)
+	mockSkillUs := new(skillMocks.SkillUsecase)
+	usecase := NewProfileUsecase(mockRepo, mockSkillUs, newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	mockSkillUs.
+		On("NormalizeSkills", mock.AnythingOfType("[]*models.Skill")).
+		Return(func(skills []*models.Skill) []*models.Skill { return skills }, nil)
 
 	profileID := ptrUUID()
 	middle := "F"
@@ -196,7 +471,7 @@ func TestUpdateProfile_Success(t *testing.T) {
 		MiddleName: &middle,
 		LastName:   "Phanes",
 		Gender:     "MALE",
-		Class:      "Yuusha",
+		Class:      strPtr("Yuusha"),
 		Skills: []_profile.UpsertSkill{
 			{Skill: "Swordsmanship", Detail: "Strong in sword fighting techniques"},
 		},
@@ -219,7 +494,7 @@ func TestUpdateProfile_Success(t *testing.T) {
 
 func TestUpdateProfile_ProfileNotFound(t *testing.T) {
 	mockRepo := new(mocks.ProfileRepository)
-	usecase := NewProfileUsecase(mockRepo)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
 
 	profileID := ptrUUID()
 	mockRepo.On("FetchProfileById", profileID).Return(nil, nil)
@@ -232,7 +507,7 @@ func TestUpdateProfile_ProfileNotFound(t *testing.T) {
 
 func TestUpdateProfile_FetchError(t *testing.T) {
 	mockRepo := new(mocks.ProfileRepository)
-	usecase := NewProfileUsecase(mockRepo)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
 
 	profileID := ptrUUID()
 	mockRepo.On("FetchProfileById", profileID).Return(nil, errors.New("db error"))
@@ -244,21 +519,21 @@ func TestUpdateProfile_FetchError(t *testing.T) {
 
 func TestUpdateProfile_UpdateError(t *testing.T) {
 	mockRepo := new(mocks.ProfileRepository)
-	usecase := NewProfileUsecase(mockRepo)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
 
 	profileID := ptrUUID()
 	existingProfile := &models.Profile{ID: profileID}
 	mockRepo.On("FetchProfileById", profileID).Return(existingProfile, nil)
 	mockRepo.On("UpdateProfile", mock.Anything).Return(errors.New("update failed"))
 
-	err := usecase.UpdateProfile(profileID, _profile.UpsertProfile{FirstName: "Test"})
+	err := usecase.UpdateProfile(profileID, _profile.UpsertProfile{FirstName: "Test", Gender: "MALE"})
 
 	require.EqualError(t, err, "update failed")
 }
 
 func TestDeleteProfile_Success(t *testing.T) {
 	mockRepo := new(mocks.ProfileRepository)
-	usecase := NewProfileUsecase(mockRepo)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
 
 	profileID := ptrUUID()
 
@@ -275,7 +550,7 @@ func TestDeleteProfile_Success(t *testing.T) {
 
 func TestDeleteProfile_Error(t *testing.T) {
 	mockRepo := new(mocks.ProfileRepository)
-	usecase := NewProfileUsecase(mockRepo)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
 
 	profileID := ptrUUID()
 	mockRepo.On("DeleteProfile", profileID).Return(errors.New("delete failed"))
@@ -284,4 +559,307 @@ func TestDeleteProfile_Error(t *testing.T) {
 
 	require.EqualError(t, err, "delete failed")
 	mockRepo.AssertExpectations(t)
-}
\ No newline at end of file
+}
+
+func TestReserveIdempotencyKey_Reserved(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	mockRepo.On("ReserveIdempotencyKey", mock.MatchedBy(func(k *models.IdempotencyKey) bool {
+		return k.UserID == "teacher-42" && k.Key == "retry-1" && k.RequestHash == "abc" && k.IsPending() &&
+			k.ExpiresAt != nil && k.ExpiresAt.Before(time.Now().Add(2*time.Minute))
+	})).Return(true, nil)
+
+	result, err := usecase.ReserveIdempotencyKey("teacher-42", "retry-1", "abc")
+
+	require.NoError(t, err)
+	require.Nil(t, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestReserveIdempotencyKey_Replay(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	stored := &models.IdempotencyKey{UserID: "teacher-42", Key: "retry-1", RequestHash: "abc", ResponseStatus: 200}
+	stored.SetExpiresAt(time.Hour)
+	mockRepo.On("ReserveIdempotencyKey", mock.Anything).Return(false, nil)
+	mockRepo.On("FetchIdempotencyKey", "teacher-42", "retry-1").Return(stored, nil)
+
+	result, err := usecase.ReserveIdempotencyKey("teacher-42", "retry-1", "abc")
+
+	require.NoError(t, err)
+	require.Equal(t, stored, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestReserveIdempotencyKey_InProgress(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	stored := &models.IdempotencyKey{UserID: "teacher-42", Key: "retry-1", RequestHash: "abc"}
+	stored.SetExpiresAt(time.Minute)
+	mockRepo.On("ReserveIdempotencyKey", mock.Anything).Return(false, nil)
+	mockRepo.On("FetchIdempotencyKey", "teacher-42", "retry-1").Return(stored, nil)
+
+	result, err := usecase.ReserveIdempotencyKey("teacher-42", "retry-1", "abc")
+
+	require.Nil(t, result)
+	require.Equal(t, constants.ErrIdempotencyKeyInProgress, err)
+}
+
+func TestReserveIdempotencyKey_Mismatch(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	stored := &models.IdempotencyKey{UserID: "teacher-42", Key: "retry-1", RequestHash: "abc"}
+	stored.SetExpiresAt(time.Hour)
+	mockRepo.On("ReserveIdempotencyKey", mock.Anything).Return(false, nil)
+	mockRepo.On("FetchIdempotencyKey", "teacher-42", "retry-1").Return(stored, nil)
+
+	result, err := usecase.ReserveIdempotencyKey("teacher-42", "retry-1", "def")
+
+	require.Nil(t, result)
+	require.Equal(t, constants.ErrIdempotencyKeyMismatch, err)
+}
+
+func TestSaveIdempotencyKey_Success(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	mockRepo.On("CompleteIdempotencyKey", mock.MatchedBy(func(k *models.IdempotencyKey) bool {
+		return k.UserID == "teacher-42" && k.Key == "retry-1" && k.RequestHash == "abc" && k.ResponseStatus == 200 &&
+			k.ExpiresAt != nil && k.ExpiresAt.After(time.Now().Add(59*time.Minute))
+	})).Return(nil)
+
+	err := usecase.SaveIdempotencyKey("teacher-42", "retry-1", "abc", 200, []byte(`{}`))
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpsertProfile_Create(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileID := ptrUUID()
+	externalID := "STU-000123"
+	upsert := _profile.UpsertProfile{
+		ExternalId: &externalID,
+		FirstName:  "SeiA",
+		LastName:   "Phanes",
+		Gender:     "MALE",
+		Class:      strPtr("Yuusha"),
+	}
+
+	mockRepo.On("FetchProfileById", profileID).Return(nil, nil)
+	mockRepo.On("CreateProfile", mock.MatchedBy(func(p *models.Profile) bool {
+		return p.ID == profileID && *p.ExternalID == externalID && p.FirstName == "SeiA"
+	})).Return(nil)
+
+	created, err := usecase.UpsertProfile(profileID, upsert)
+
+	require.NoError(t, err)
+	require.True(t, created)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpsertProfile_Update(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileID := ptrUUID()
+	existingProfile := &models.Profile{ID: profileID, FirstName: "Old"}
+
+	mockRepo.On("FetchProfileById", profileID).Return(existingProfile, nil)
+	mockRepo.On("UpdateProfile", mock.MatchedBy(func(p *models.Profile) bool {
+		return p.FirstName == "SeiA"
+	})).Return(nil)
+
+	created, err := usecase.UpsertProfile(profileID, _profile.UpsertProfile{FirstName: "SeiA", Gender: "MALE"})
+
+	require.NoError(t, err)
+	require.False(t, created)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpsertProfile_CreatedConcurrently(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileID := ptrUUID()
+	existingProfile := &models.Profile{ID: profileID}
+
+	mockRepo.On("FetchProfileById", profileID).Return(nil, nil).Once()
+	mockRepo.On("CreateProfile", mock.Anything).Return(constants.ErrProfileAlreadyExists)
+	mockRepo.On("FetchProfileById", profileID).Return(existingProfile, nil).Once()
+	mockRepo.On("UpdateProfile", mock.Anything).Return(nil)
+
+	created, err := usecase.UpsertProfile(profileID, _profile.UpsertProfile{FirstName: "SeiA", Gender: "MALE"})
+
+	require.NoError(t, err)
+	require.False(t, created)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestFetchEnrollments_ProfileNotFound(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileID := ptrUUID()
+	mockRepo.On("FetchProfileById", profileID).Return(nil, nil)
+
+	enrollments, err := usecase.FetchEnrollments(profileID)
+
+	require.ErrorIs(t, err, constants.ErrProfileNotFound)
+	require.Nil(t, enrollments)
+	mockRepo.AssertNotCalled(t, "FetchEnrollments", mock.Anything)
+}
+
+func TestFetchEnrollments_Success(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileID := ptrUUID()
+	expected := []*models.Enrollment{{ID: ptrUUID(), ProfileID: profileID, ClassID: ptrUUID(), ClassCode: "M1/1"}}
+	mockRepo.On("FetchProfileById", profileID).Return(&models.Profile{ID: profileID}, nil)
+	mockRepo.On("FetchEnrollments", profileID).Return(expected, nil)
+
+	enrollments, err := usecase.FetchEnrollments(profileID)
+
+	require.NoError(t, err)
+	require.Equal(t, expected, enrollments)
+}
+
+func TestCreateProfile_NormalizesGenderAndPronouns(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	mockRepo.On("CreateProfile", mock.MatchedBy(func(p *models.Profile) bool {
+		return p.Gender == models.GenderNonBinary && p.Pronouns != nil && *p.Pronouns == "they/them"
+	})).Return(nil)
+
+	err := usecase.CreateProfile(&models.Profile{ID: ptrUUID()}, _profile.UpsertProfile{
+		FirstName: "SeiA",
+		LastName:  "Phanes",
+		Gender:    " non_binary ",
+		Pronouns:  strPtr(" they/them "),
+	})
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateProfile_BlankGender(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	err := usecase.CreateProfile(&models.Profile{ID: ptrUUID()}, _profile.UpsertProfile{FirstName: "SeiA", LastName: "Phanes", Gender: " "})
+
+	require.ErrorIs(t, err, constants.ErrUnknownGender)
+	mockRepo.AssertNotCalled(t, "CreateProfile", mock.Anything)
+}
+
+func TestCreateProfile_Names(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileID := ptrUUID()
+	mockRepo.On("CreateProfile", mock.MatchedBy(func(p *models.Profile) bool {
+		return len(p.Names) == 1 && *p.Names[0].ProfileID == *profileID && p.Names[0].Locale == models.NameLocaleThai &&
+			p.Names[0].FirstName == "สมชาย" && p.Names[0].LastName == "ใจดี" && p.Names[0].MiddleName == nil && p.Names[0].ID != nil
+	})).Return(nil)
+
+	err := usecase.CreateProfile(&models.Profile{ID: profileID}, _profile.UpsertProfile{
+		FirstName: "Somchai",
+		LastName:  "Jaidee",
+		Gender:    "MALE",
+		Names:     &[]_profile.ProfileName{{Locale: _profile.Th, FirstName: " สมชาย ", MiddleName: strPtr(""), LastName: "ใจดี"}},
+	})
+
+	require.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateProfile_DuplicateNameLocale(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileID := ptrUUID()
+	mockRepo.On("FetchProfileById", profileID).Return(&models.Profile{ID: profileID}, nil)
+
+	err := usecase.UpdateProfile(profileID, _profile.UpsertProfile{
+		FirstName: "Somchai",
+		LastName:  "Jaidee",
+		Gender:    "MALE",
+		Names: &[]_profile.ProfileName{
+			{Locale: _profile.En, FirstName: "Somchai", LastName: "Jaidee"},
+			{Locale: _profile.En, FirstName: "Som", LastName: "Jaidee"},
+		},
+	})
+
+	require.ErrorIs(t, err, constants.ErrDuplicateNameLocale)
+	mockRepo.AssertNotCalled(t, "UpdateProfile", mock.Anything)
+}
+
+func TestCreateProfile_Status(t *testing.T) {
+	tests := []struct {
+		name   string
+		status *_profile.UpsertProfileStatus
+		want   models.ProfileStatus
+	}{
+		{"left out", nil, models.ProfileStatusActive},
+		{"draft", statusPtr(_profile.UpsertProfileStatusDraft), models.ProfileStatusDraft},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.ProfileRepository)
+			usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+			mockRepo.On("CreateProfile", mock.MatchedBy(func(p *models.Profile) bool {
+				return p.Status == tt.want
+			})).Return(nil)
+
+			err := usecase.CreateProfile(&models.Profile{ID: ptrUUID()}, _profile.UpsertProfile{FirstName: "SeiA", LastName: "Phanes", Gender: "MALE", Status: tt.status})
+
+			require.NoError(t, err)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateProfile_GraduatedStatus(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	err := usecase.CreateProfile(&models.Profile{ID: ptrUUID()}, _profile.UpsertProfile{
+		FirstName: "SeiA",
+		LastName:  "Phanes",
+		Gender:    "MALE",
+		Status:    statusPtr(_profile.UpsertProfileStatusGraduated),
+	})
+
+	require.ErrorIs(t, err, constants.ErrInvalidInitialStatus)
+	mockRepo.AssertNotCalled(t, "CreateProfile", mock.Anything)
+}
+
+func TestUpdateProfile_Status(t *testing.T) {
+	mockRepo := new(mocks.ProfileRepository)
+	usecase := NewProfileUsecase(mockRepo, new(skillMocks.SkillUsecase), newClassUs(), newAttributeUs(), time.Hour, 100)
+
+	profileID := ptrUUID()
+	mockRepo.On("FetchProfileById", profileID).Return(&models.Profile{ID: profileID, Status: models.ProfileStatusGraduated}, nil)
+	mockRepo.On("UpdateProfile", mock.Anything).Return(nil)
+
+	update := _profile.UpsertProfile{FirstName: "SeiA", LastName: "Phanes", Gender: "MALE", Status: statusPtr(_profile.UpsertProfileStatusGraduated)}
+	require.NoError(t, usecase.UpdateProfile(profileID, update))
+
+	update.Status = statusPtr(_profile.UpsertProfileStatusActive)
+	require.ErrorIs(t, usecase.UpdateProfile(profileID, update), constants.ErrInvalidStatusTransition)
+	mockRepo.AssertNumberOfCalls(t, "UpdateProfile", 1)
+}
+
+func statusPtr(status _profile.UpsertProfileStatus) *_profile.UpsertProfileStatus {
+	return &status
+}
@@ -0,0 +1,2 @@
+package relationship 
+//go:generate oapi-codegen --config=./server.cfg.yaml ../../../api-spec/relationship/openapi_bundle.yml
@@ -0,0 +1,105 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	_relationship "github.com/jariwat/p_project/profile-service/service/relationship"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type relationshipHandler struct {
+	relationshipUs _relationship.RelationshipUsecase
+}
+
+// respondError maps domain errors to their HTTP status.
+func respondError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, constants.ErrProfileNotFound),
+		errors.Is(err, constants.ErrRelationshipNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrInvalidRelationshipType),
+		errors.Is(err, constants.ErrSelfRelationship):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrRelationshipAlreadyExists):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// GetProfileIdRelationships implements relationship.ServerInterface.
+func (r *relationshipHandler) GetProfileIdRelationships(c *gin.Context, id types.UUID, params _relationship.GetProfileIdRelationshipsParams) {
+	var profileId = uuid.FromStringOrNil(id.String())
+
+	relationships, err := r.relationshipUs.FetchProfileRelationships(&profileId, params)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var data []_relationship.ProfileRelationship
+	bu, err := json.Marshal(relationships)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal relationships"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal relationships"})
+		return
+	}
+
+	c.JSON(http.StatusOK, _relationship.ProfileRelationshipsResponse{Data: &data})
+}
+
+// PostRelationships implements relationship.ServerInterface.
+func (r *relationshipHandler) PostRelationships(c *gin.Context) {
+	var request _relationship.CreateRelationship
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	created, err := r.relationshipUs.CreateRelationship(request)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var data _relationship.Relationship
+	bu, err := json.Marshal(created)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal relationship"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal relationship"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, _relationship.RelationshipResponse{Data: &data})
+}
+
+// DeleteRelationshipsRelationshipId implements relationship.ServerInterface.
+func (r *relationshipHandler) DeleteRelationshipsRelationshipId(c *gin.Context, relationshipId types.UUID) {
+	var id = uuid.FromStringOrNil(relationshipId.String())
+
+	if err := r.relationshipUs.DeleteRelationship(&id); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, _relationship.Success{Message: "Relationship deleted successfully"})
+}
+
+func NewRelationshipHandler(relationshipUs _relationship.RelationshipUsecase) _relationship.ServerInterface {
+	return &relationshipHandler{
+		relationshipUs: relationshipUs,
+	}
+}
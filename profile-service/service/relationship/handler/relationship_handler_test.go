@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_relationship "github.com/jariwat/p_project/profile-service/service/relationship"
+	"github.com/jariwat/p_project/profile-service/service/relationship/mocks"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func postRequest(body string) *http.Request {
+	req, _ := http.NewRequest(http.MethodPost, "/relationships", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+	return req
+}
+
+func TestGetProfileIdRelationships(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	profileId, mentorId := ptrUUID(), ptrUUID()
+	req, _ := http.NewRequest(http.MethodGet, "/profile/"+profileId.String()+"/relationships", nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	now := time.Now()
+	mockUsecase := new(mocks.RelationshipUsecase)
+	mockUsecase.On("FetchProfileRelationships", profileId, mock.Anything).Return([]*models.ProfileRelationship{
+		{ID: ptrUUID(), Type: models.RelationshipTypeMentor, Role: "mentor", CreatedAt: &now,
+			RelatedProfile: &models.RelatedProfile{ID: mentorId, FirstName: "Somchai", LastName: "Jaidee"}},
+	}, nil)
+
+	handler := NewRelationshipHandler(mockUsecase)
+	handler.GetProfileIdRelationships(c, types.UUID(*profileId), _relationship.GetProfileIdRelationshipsParams{})
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp _relationship.ProfileRelationshipsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, *resp.Data, 1)
+	assert.Equal(t, "mentor", *(*resp.Data)[0].Role)
+	assert.Equal(t, mentorId.String(), (*resp.Data)[0].RelatedProfile.Id.String())
+}
+
+func TestPostRelationships(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mentorId, menteeId := ptrUUID(), ptrUUID()
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = postRequest(`{"type":"mentor","from_profile_id":"` + mentorId.String() + `","to_profile_id":"` + menteeId.String() + `"}`)
+
+	mockUsecase := new(mocks.RelationshipUsecase)
+	mockUsecase.On("CreateRelationship", mock.AnythingOfType("relationship.CreateRelationship")).
+		Return(&models.Relationship{ID: ptrUUID(), Type: models.RelationshipTypeMentor, FromProfileID: mentorId, ToProfileID: menteeId}, nil)
+
+	handler := NewRelationshipHandler(mockUsecase)
+	handler.PostRelationships(c)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var resp _relationship.RelationshipResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, _relationship.Mentor, *resp.Data.Type)
+	assert.Equal(t, mentorId.String(), resp.Data.FromProfileId.String())
+}
+
+func TestPostRelationships_Errors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"self link", constants.ErrSelfRelationship, http.StatusBadRequest},
+		{"profile not found", constants.ErrProfileNotFound, http.StatusNotFound},
+		{"duplicate", constants.ErrRelationshipAlreadyExists, http.StatusConflict},
+		{"database", errors.New("connection refused"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = postRequest(`{"type":"sibling","from_profile_id":"` + ptrUUID().String() + `","to_profile_id":"` + ptrUUID().String() + `"}`)
+
+			mockUsecase := new(mocks.RelationshipUsecase)
+			mockUsecase.On("CreateRelationship", mock.Anything).Return(nil, tt.err)
+
+			handler := NewRelationshipHandler(mockUsecase)
+			handler.PostRelationships(c)
+
+			assert.Equal(t, tt.status, w.Code)
+			assert.Contains(t, w.Body.String(), tt.err.Error())
+		})
+	}
+}
+
+func TestDeleteRelationshipsRelationshipId_NotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	relationshipId := ptrUUID()
+	req, _ := http.NewRequest(http.MethodDelete, "/relationships/"+relationshipId.String(), nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.RelationshipUsecase)
+	mockUsecase.On("DeleteRelationship", relationshipId).Return(constants.ErrRelationshipNotFound)
+
+	handler := NewRelationshipHandler(mockUsecase)
+	handler.DeleteRelationshipsRelationshipId(c, types.UUID(*relationshipId))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
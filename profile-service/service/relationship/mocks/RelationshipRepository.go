@@ -0,0 +1,95 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jariwat/p_project/profile-service/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/gofrs/uuid"
+)
+
+// RelationshipRepository is an autogenerated mock type for the RelationshipRepository type
+type RelationshipRepository struct {
+	mock.Mock
+}
+
+// CreateRelationship provides a mock function with given fields: _a0
+func (_m *RelationshipRepository) CreateRelationship(_a0 *models.Relationship) error {
+	ret := _m.Called(_a0)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRelationship")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Relationship) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteRelationship provides a mock function with given fields: relationshipId
+func (_m *RelationshipRepository) DeleteRelationship(relationshipId *uuid.UUID) error {
+	ret := _m.Called(relationshipId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRelationship")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) error); ok {
+		r0 = rf(relationshipId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FetchRelationships provides a mock function with given fields: profileId, relationshipType
+func (_m *RelationshipRepository) FetchRelationships(profileId *uuid.UUID, relationshipType *models.RelationshipType) ([]*models.Relationship, error) {
+	ret := _m.Called(profileId, relationshipType)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchRelationships")
+	}
+
+	var r0 []*models.Relationship
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *models.RelationshipType) ([]*models.Relationship, error)); ok {
+		return rf(profileId, relationshipType)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, *models.RelationshipType) []*models.Relationship); ok {
+		r0 = rf(profileId, relationshipType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Relationship)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, *models.RelationshipType) error); ok {
+		r1 = rf(profileId, relationshipType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewRelationshipRepository creates a new instance of RelationshipRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRelationshipRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RelationshipRepository {
+	mock := &RelationshipRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,108 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jariwat/p_project/profile-service/models"
+	relationship "github.com/jariwat/p_project/profile-service/service/relationship"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/gofrs/uuid"
+)
+
+// RelationshipUsecase is an autogenerated mock type for the RelationshipUsecase type
+type RelationshipUsecase struct {
+	mock.Mock
+}
+
+// CreateRelationship provides a mock function with given fields: newRelationship
+func (_m *RelationshipUsecase) CreateRelationship(newRelationship relationship.CreateRelationship) (*models.Relationship, error) {
+	ret := _m.Called(newRelationship)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRelationship")
+	}
+
+	var r0 *models.Relationship
+	var r1 error
+	if rf, ok := ret.Get(0).(func(relationship.CreateRelationship) (*models.Relationship, error)); ok {
+		return rf(newRelationship)
+	}
+	if rf, ok := ret.Get(0).(func(relationship.CreateRelationship) *models.Relationship); ok {
+		r0 = rf(newRelationship)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Relationship)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(relationship.CreateRelationship) error); ok {
+		r1 = rf(newRelationship)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRelationship provides a mock function with given fields: relationshipId
+func (_m *RelationshipUsecase) DeleteRelationship(relationshipId *uuid.UUID) error {
+	ret := _m.Called(relationshipId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRelationship")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) error); ok {
+		r0 = rf(relationshipId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FetchProfileRelationships provides a mock function with given fields: profileId, params
+func (_m *RelationshipUsecase) FetchProfileRelationships(profileId *uuid.UUID, params relationship.GetProfileIdRelationshipsParams) ([]*models.ProfileRelationship, error) {
+	ret := _m.Called(profileId, params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchProfileRelationships")
+	}
+
+	var r0 []*models.ProfileRelationship
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, relationship.GetProfileIdRelationshipsParams) ([]*models.ProfileRelationship, error)); ok {
+		return rf(profileId, params)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, relationship.GetProfileIdRelationshipsParams) []*models.ProfileRelationship); ok {
+		r0 = rf(profileId, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.ProfileRelationship)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, relationship.GetProfileIdRelationshipsParams) error); ok {
+		r1 = rf(profileId, params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewRelationshipUsecase creates a new instance of RelationshipUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRelationshipUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RelationshipUsecase {
+	mock := &RelationshipUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
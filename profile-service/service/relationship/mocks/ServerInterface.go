@@ -0,0 +1,46 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+
+	relationship "github.com/jariwat/p_project/profile-service/service/relationship"
+
+	uuid "github.com/google/uuid"
+)
+
+// ServerInterface is an autogenerated mock type for the ServerInterface type
+type ServerInterface struct {
+	mock.Mock
+}
+
+// DeleteRelationshipsRelationshipId provides a mock function with given fields: c, relationshipId
+func (_m *ServerInterface) DeleteRelationshipsRelationshipId(c *gin.Context, relationshipId uuid.UUID) {
+	_m.Called(c, relationshipId)
+}
+
+// GetProfileIdRelationships provides a mock function with given fields: c, id, params
+func (_m *ServerInterface) GetProfileIdRelationships(c *gin.Context, id uuid.UUID, params relationship.GetProfileIdRelationshipsParams) {
+	_m.Called(c, id, params)
+}
+
+// PostRelationships provides a mock function with given fields: c
+func (_m *ServerInterface) PostRelationships(c *gin.Context) {
+	_m.Called(c)
+}
+
+// NewServerInterface creates a new instance of ServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServerInterface {
+	mock := &ServerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
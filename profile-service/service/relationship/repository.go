@@ -0,0 +1,12 @@
+package relationship
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/models"
+)
+
+type RelationshipRepository interface {
+	FetchRelationships(profileId *uuid.UUID, relationshipType *models.RelationshipType) ([]*models.Relationship, error)
+	CreateRelationship(relationship *models.Relationship) error
+	DeleteRelationship(relationshipId *uuid.UUID) error
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/relationship"
+	"gorm.io/gorm"
+)
+
+const (
+	uniqueViolationCode     = "23505"
+	foreignKeyViolationCode = "23503"
+	checkViolationCode      = "23514"
+	relationshipEdgeIndex   = "idx_relationship_edge"
+	selfLinkConstraint      = "relationship_no_self_link"
+)
+
+type relationshipRepository struct {
+	client *gorm.DB
+}
+
+// translateError maps an edge created twice, a profile removed while it was
+// related or a profile related to itself to domain errors.
+func translateError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch {
+	case pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == relationshipEdgeIndex:
+		return constants.ErrRelationshipAlreadyExists
+	case pgErr.Code == foreignKeyViolationCode:
+		return constants.ErrProfileNotFound
+	case pgErr.Code == checkViolationCode && pgErr.ConstraintName == selfLinkConstraint:
+		return constants.ErrSelfRelationship
+	}
+
+	return err
+}
+
+// relatedProfileColumns loads only what a relationship shows of its profiles.
+func relatedProfileColumns(db *gorm.DB) *gorm.DB {
+	return db.Select("id", "first_name", "last_name")
+}
+
+// FetchRelationships implements relationship.RelationshipRepository.
+// It lists the relationships starting or ending at the profile, the oldest first.
+func (r *relationshipRepository) FetchRelationships(profileId *uuid.UUID, relationshipType *models.RelationshipType) ([]*models.Relationship, error) {
+	query := r.client.Preload("FromProfile", relatedProfileColumns).Preload("ToProfile", relatedProfileColumns).
+		Where("from_profile_id = ? OR to_profile_id = ?", profileId, profileId)
+
+	if relationshipType != nil {
+		query = query.Where("type = ?", *relationshipType)
+	}
+
+	var relationships []*models.Relationship
+	if err := query.Order("created_at, id").Find(&relationships).Error; err != nil {
+		return nil, err
+	}
+
+	return relationships, nil
+}
+
+// CreateRelationship implements relationship.RelationshipRepository.
+func (r *relationshipRepository) CreateRelationship(relationship *models.Relationship) error {
+	return translateError(r.client.Omit("FromProfile", "ToProfile").Create(relationship).Error)
+}
+
+// DeleteRelationship implements relationship.RelationshipRepository.
+func (r *relationshipRepository) DeleteRelationship(relationshipId *uuid.UUID) error {
+	result := r.client.Where("id = ?", relationshipId).Delete(&models.Relationship{})
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return constants.ErrRelationshipNotFound
+	}
+
+	return nil
+}
+
+func NewPsqlRelationshipRepository(client *gorm.DB) relationship.RelationshipRepository {
+	return &relationshipRepository{
+		client: client,
+	}
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	return gormDB, mock
+}
+
+func TestFetchRelationships(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlRelationshipRepository(gormDB)
+
+	mentorId, menteeId, relationshipId := ptrUUID(), ptrUUID(), ptrUUID()
+	relationshipType := models.RelationshipTypeMentor
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "relationship" WHERE (from_profile_id = $1 OR to_profile_id = $2) AND type = $3 ORDER BY created_at, id`)).
+		WithArgs(menteeId, menteeId, relationshipType).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "type", "from_profile_id", "to_profile_id", "created_at"}).
+			AddRow(relationshipId.String(), "mentor", mentorId.String(), menteeId.String(), time.Now()))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id","first_name","last_name" FROM "profile" WHERE "profile"."id" = $1`)).
+		WithArgs(mentorId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "last_name"}).AddRow(mentorId.String(), "Somchai", "Jaidee"))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id","first_name","last_name" FROM "profile" WHERE "profile"."id" = $1`)).
+		WithArgs(menteeId).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "first_name", "last_name"}).AddRow(menteeId.String(), "Suda", "Rakdee"))
+
+	relationships, err := repo.FetchRelationships(menteeId, &relationshipType)
+	require.NoError(t, err)
+	require.Len(t, relationships, 1)
+	assert.Equal(t, "Somchai", relationships[0].FromProfile.FirstName)
+	assert.Equal(t, "Suda", relationships[0].ToProfile.FirstName)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateRelationship_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		pgErr *pgconn.PgError
+		err   error
+	}{
+		{"duplicate edge", &pgconn.PgError{Code: "23505", ConstraintName: "idx_relationship_edge"}, constants.ErrRelationshipAlreadyExists},
+		{"unknown profile", &pgconn.PgError{Code: "23503", ConstraintName: "relationship_to_profile_id_fkey"}, constants.ErrProfileNotFound},
+		{"self link", &pgconn.PgError{Code: "23514", ConstraintName: "relationship_no_self_link"}, constants.ErrSelfRelationship},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gormDB, mock := newMockDB(t)
+			repo := NewPsqlRelationshipRepository(gormDB)
+
+			relationship := &models.Relationship{ID: ptrUUID(), Type: models.RelationshipTypeSibling, FromProfileID: ptrUUID(), ToProfileID: ptrUUID()}
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "relationship" ("id","type","from_profile_id","to_profile_id","created_at") VALUES ($1,$2,$3,$4,$5)`)).
+				WillReturnError(tt.pgErr)
+			mock.ExpectRollback()
+
+			assert.ErrorIs(t, repo.CreateRelationship(relationship), tt.err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDeleteRelationship_NotFound(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlRelationshipRepository(gormDB)
+
+	relationshipId := ptrUUID()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "relationship" WHERE id = $1`)).
+		WithArgs(relationshipId).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	assert.ErrorIs(t, repo.DeleteRelationship(relationshipId), constants.ErrRelationshipNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
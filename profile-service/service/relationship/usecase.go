@@ -0,0 +1,12 @@
+package relationship
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/models"
+)
+
+type RelationshipUsecase interface {
+	FetchProfileRelationships(profileId *uuid.UUID, params GetProfileIdRelationshipsParams) ([]*models.ProfileRelationship, error)
+	CreateRelationship(newRelationship CreateRelationship) (*models.Relationship, error)
+	DeleteRelationship(relationshipId *uuid.UUID) error
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/profile"
+	"github.com/jariwat/p_project/profile-service/service/relationship"
+)
+
+type relationshipUsecase struct {
+	relationshipRepo relationship.RelationshipRepository
+	profileUs        profile.ProfileUsecase
+}
+
+// FetchProfileRelationships implements relationship.RelationshipUsecase.
+// Each relationship is seen from the profile, with the role of the other one.
+func (r *relationshipUsecase) FetchProfileRelationships(profileId *uuid.UUID, params relationship.GetProfileIdRelationshipsParams) ([]*models.ProfileRelationship, error) {
+	found, err := r.profileUs.FetchProfileById(profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		return nil, constants.ErrProfileNotFound
+	}
+
+	var relationshipType *models.RelationshipType
+	if params.Type != nil {
+		t := models.RelationshipType(*params.Type)
+		if !t.IsValid() {
+			return nil, constants.ErrInvalidRelationshipType
+		}
+		relationshipType = &t
+	}
+
+	relationships, err := r.relationshipRepo.FetchRelationships(profileId, relationshipType)
+	if err != nil {
+		return nil, err
+	}
+
+	viewed := make([]*models.ProfileRelationship, 0, len(relationships))
+	for _, related := range relationships {
+		viewed = append(viewed, related.ForProfile(profileId))
+	}
+
+	return viewed, nil
+}
+
+// CreateRelationship implements relationship.RelationshipUsecase.
+func (r *relationshipUsecase) CreateRelationship(newRelationship relationship.CreateRelationship) (*models.Relationship, error) {
+	relationshipType := models.RelationshipType(newRelationship.Type)
+	if !relationshipType.IsValid() {
+		return nil, constants.ErrInvalidRelationshipType
+	}
+
+	fromProfileId := uuid.FromStringOrNil(newRelationship.FromProfileId.String())
+	toProfileId := uuid.FromStringOrNil(newRelationship.ToProfileId.String())
+	if fromProfileId == toProfileId {
+		return nil, constants.ErrSelfRelationship
+	}
+
+	created := &models.Relationship{
+		Type:          relationshipType,
+		FromProfileID: &fromProfileId,
+		ToProfileID:   &toProfileId,
+	}
+	created.Normalize()
+	created.GenUUID()
+	created.SetCreatedAt()
+
+	if err := r.relationshipRepo.CreateRelationship(created); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// DeleteRelationship implements relationship.RelationshipUsecase.
+func (r *relationshipUsecase) DeleteRelationship(relationshipId *uuid.UUID) error {
+	return r.relationshipRepo.DeleteRelationship(relationshipId)
+}
+
+func NewRelationshipUsecase(relationshipRepo relationship.RelationshipRepository, profileUs profile.ProfileUsecase) relationship.RelationshipUsecase {
+	return &relationshipUsecase{
+		relationshipRepo: relationshipRepo,
+		profileUs:        profileUs,
+	}
+}
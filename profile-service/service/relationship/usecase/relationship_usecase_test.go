@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	profileMocks "github.com/jariwat/p_project/profile-service/service/profile/mocks"
+	_relationship "github.com/jariwat/p_project/profile-service/service/relationship"
+	"github.com/jariwat/p_project/profile-service/service/relationship/mocks"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func TestFetchProfileRelationships(t *testing.T) {
+	profileId, mentorId, menteeId, siblingId := ptrUUID(), ptrUUID(), ptrUUID(), ptrUUID()
+	profile := &models.Profile{ID: profileId, FirstName: "Suda"}
+
+	mockRepo := new(mocks.RelationshipRepository)
+	mockRepo.On("FetchRelationships", profileId, (*models.RelationshipType)(nil)).Return([]*models.Relationship{
+		{ID: ptrUUID(), Type: models.RelationshipTypeMentor, FromProfileID: mentorId, ToProfileID: profileId,
+			FromProfile: &models.Profile{ID: mentorId, FirstName: "Somchai"}, ToProfile: profile},
+		{ID: ptrUUID(), Type: models.RelationshipTypeMentor, FromProfileID: profileId, ToProfileID: menteeId,
+			FromProfile: profile, ToProfile: &models.Profile{ID: menteeId, FirstName: "Anan"}},
+		{ID: ptrUUID(), Type: models.RelationshipTypeSibling, FromProfileID: siblingId, ToProfileID: profileId,
+			FromProfile: &models.Profile{ID: siblingId, FirstName: "Malee"}, ToProfile: profile},
+	}, nil)
+	mockProfileUs := new(profileMocks.ProfileUsecase)
+	mockProfileUs.On("FetchProfileById", profileId).Return(profile, nil)
+
+	usecase := NewRelationshipUsecase(mockRepo, mockProfileUs)
+	relationships, err := usecase.FetchProfileRelationships(profileId, _relationship.GetProfileIdRelationshipsParams{})
+	require.NoError(t, err)
+	require.Len(t, relationships, 3)
+
+	assert.Equal(t, "mentor", relationships[0].Role)
+	assert.Equal(t, "Somchai", relationships[0].RelatedProfile.FirstName)
+	assert.Equal(t, "mentee", relationships[1].Role)
+	assert.Equal(t, "Anan", relationships[1].RelatedProfile.FirstName)
+	assert.Equal(t, "sibling", relationships[2].Role)
+	assert.Equal(t, "Malee", relationships[2].RelatedProfile.FirstName)
+}
+
+func TestFetchProfileRelationships_ProfileNotFound(t *testing.T) {
+	profileId := ptrUUID()
+	mockRepo := new(mocks.RelationshipRepository)
+	mockProfileUs := new(profileMocks.ProfileUsecase)
+	mockProfileUs.On("FetchProfileById", profileId).Return(nil, nil)
+
+	usecase := NewRelationshipUsecase(mockRepo, mockProfileUs)
+	_, err := usecase.FetchProfileRelationships(profileId, _relationship.GetProfileIdRelationshipsParams{})
+
+	assert.ErrorIs(t, err, constants.ErrProfileNotFound)
+	mockRepo.AssertNotCalled(t, "FetchRelationships", mock.Anything, mock.Anything)
+}
+
+func TestCreateRelationship_SiblingOrder(t *testing.T) {
+	first, second := ptrUUID(), ptrUUID()
+	if bytes.Compare(first.Bytes(), second.Bytes()) > 0 {
+		first, second = second, first
+	}
+
+	mockRepo := new(mocks.RelationshipRepository)
+	mockRepo.On("CreateRelationship", mock.AnythingOfType("*models.Relationship")).Return(nil)
+
+	usecase := NewRelationshipUsecase(mockRepo, new(profileMocks.ProfileUsecase))
+	created, err := usecase.CreateRelationship(_relationship.CreateRelationship{
+		Type:          _relationship.Sibling,
+		FromProfileId: types.UUID(*second),
+		ToProfileId:   types.UUID(*first),
+	})
+
+	require.NoError(t, err)
+	assert.NotNil(t, created.ID)
+	assert.Equal(t, first, created.FromProfileID)
+	assert.Equal(t, second, created.ToProfileID)
+}
+
+func TestCreateRelationship_MentorKeepsDirection(t *testing.T) {
+	mentorId, menteeId := ptrUUID(), ptrUUID()
+
+	mockRepo := new(mocks.RelationshipRepository)
+	mockRepo.On("CreateRelationship", mock.AnythingOfType("*models.Relationship")).Return(nil)
+
+	usecase := NewRelationshipUsecase(mockRepo, new(profileMocks.ProfileUsecase))
+	created, err := usecase.CreateRelationship(_relationship.CreateRelationship{
+		Type:          _relationship.Mentor,
+		FromProfileId: types.UUID(*mentorId),
+		ToProfileId:   types.UUID(*menteeId),
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, mentorId, created.FromProfileID)
+	assert.Equal(t, menteeId, created.ToProfileID)
+}
+
+func TestCreateRelationship_Invalid(t *testing.T) {
+	profileId := ptrUUID()
+
+	tests := []struct {
+		name    string
+		request _relationship.CreateRelationship
+		err     error
+	}{
+		{"self link", _relationship.CreateRelationship{Type: _relationship.Guardian, FromProfileId: types.UUID(*profileId), ToProfileId: types.UUID(*profileId)}, constants.ErrSelfRelationship},
+		{"unknown type", _relationship.CreateRelationship{Type: "cousin", FromProfileId: types.UUID(*profileId), ToProfileId: types.UUID(*ptrUUID())}, constants.ErrInvalidRelationshipType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.RelationshipRepository)
+
+			usecase := NewRelationshipUsecase(mockRepo, new(profileMocks.ProfileUsecase))
+			_, err := usecase.CreateRelationship(tt.request)
+
+			assert.ErrorIs(t, err, tt.err)
+			mockRepo.AssertNotCalled(t, "CreateRelationship", mock.Anything)
+		})
+	}
+}
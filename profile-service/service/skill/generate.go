@@ -0,0 +1,2 @@
+package skill 
+//go:generate oapi-codegen --config=./server.cfg.yaml ../../../api-spec/skill/openapi_bundle.yml
@@ -0,0 +1,348 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_skill "github.com/jariwat/p_project/profile-service/service/skill"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type skillHandler struct {
+	skillUs _skill.SkillUsecase
+}
+
+// respondError maps domain errors to their HTTP status.
+func respondError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, constants.ErrSkillCatalogNotFound),
+		errors.Is(err, constants.ErrSkillCategoryNotFound),
+		errors.Is(err, constants.ErrSkillReviewNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrUnknownSkillCategory),
+		errors.Is(err, constants.ErrSkillCategoryCycle),
+		errors.Is(err, constants.ErrInvalidSkillReviewDecision):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrSkillNameConflict),
+		errors.Is(err, constants.ErrSkillReviewResolved):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// convert copies a model into its generated response type.
+func convert(c *gin.Context, from interface{}, to interface{}) bool {
+	bu, err := json.Marshal(from)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal response"})
+		return false
+	}
+
+	if err := json.Unmarshal(bu, to); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal response"})
+		return false
+	}
+
+	return true
+}
+
+// GetSkillsCatalog implements skill.ServerInterface.
+func (s *skillHandler) GetSkillsCatalog(c *gin.Context, params _skill.GetSkillsCatalogParams) {
+	var page, perPage int
+	if params.Page != nil && params.PerPage != nil {
+		page = *params.Page
+		perPage = *params.PerPage
+	}
+	var paginator = models.NewPaginator(page, perPage)
+
+	catalog, err := s.skillUs.FetchCatalog(params, paginator)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var data []_skill.SkillCatalog
+	if !convert(c, catalog, &data) {
+		return
+	}
+
+	response := _skill.SkillCatalogPaginationResponse{
+		Data:       &data,
+		Page:       &paginator.Page,
+		PerPage:    &paginator.PerPage,
+		TotalPages: &paginator.TotalPages,
+		TotalRows:  &paginator.TotalRows,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// PostSkillsCatalog implements skill.ServerInterface.
+func (s *skillHandler) PostSkillsCatalog(c *gin.Context) {
+	var newCatalog _skill.UpsertSkillCatalog
+	if err := c.ShouldBindJSON(&newCatalog); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
+		return
+	}
+
+	var catalog = new(models.SkillCatalog)
+	catalog.GenUUID()
+	if err := s.skillUs.CreateCatalog(catalog, newCatalog); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var data _skill.SkillCatalog
+	if !convert(c, catalog, &data) {
+		return
+	}
+
+	c.JSON(http.StatusCreated, _skill.SkillCatalogResponse{Data: &data})
+}
+
+// GetSkillsCatalogId implements skill.ServerInterface.
+func (s *skillHandler) GetSkillsCatalogId(c *gin.Context, id types.UUID) {
+	var catalogId = uuid.FromStringOrNil(id.String())
+
+	catalog, err := s.skillUs.FetchCatalogById(&catalogId)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if catalog == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Skill catalog entry not found"})
+		return
+	}
+
+	var data _skill.SkillCatalog
+	if !convert(c, catalog, &data) {
+		return
+	}
+
+	c.JSON(http.StatusOK, _skill.SkillCatalogResponse{Data: &data})
+}
+
+// PutSkillsCatalogId implements skill.ServerInterface.
+func (s *skillHandler) PutSkillsCatalogId(c *gin.Context, id types.UUID) {
+	var catalogId = uuid.FromStringOrNil(id.String())
+
+	var updateCatalog _skill.UpsertSkillCatalog
+	if err := c.ShouldBindJSON(&updateCatalog); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
+		return
+	}
+
+	catalog, err := s.skillUs.UpdateCatalog(&catalogId, updateCatalog)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var data _skill.SkillCatalog
+	if !convert(c, catalog, &data) {
+		return
+	}
+
+	c.JSON(http.StatusOK, _skill.SkillCatalogResponse{Data: &data})
+}
+
+// DeleteSkillsCatalogId implements skill.ServerInterface.
+func (s *skillHandler) DeleteSkillsCatalogId(c *gin.Context, id types.UUID) {
+	var catalogId = uuid.FromStringOrNil(id.String())
+
+	if err := s.skillUs.DeleteCatalog(&catalogId); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := _skill.Success{
+		Message: "Skill catalog entry deleted successfully",
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetSkillsCategories implements skill.ServerInterface.
+func (s *skillHandler) GetSkillsCategories(c *gin.Context) {
+	categories, err := s.skillUs.FetchCategories()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var data []_skill.SkillCategory
+	if !convert(c, categories, &data) {
+		return
+	}
+
+	c.JSON(http.StatusOK, _skill.SkillCategoriesResponse{Data: &data})
+}
+
+// PostSkillsCategories implements skill.ServerInterface.
+func (s *skillHandler) PostSkillsCategories(c *gin.Context) {
+	var newCategory _skill.UpsertSkillCategory
+	if err := c.ShouldBindJSON(&newCategory); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
+		return
+	}
+
+	var category = new(models.SkillCategory)
+	category.GenUUID()
+	if err := s.skillUs.CreateCategory(category, newCategory); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var data _skill.SkillCategory
+	if !convert(c, category, &data) {
+		return
+	}
+
+	c.JSON(http.StatusCreated, _skill.SkillCategoryResponse{Data: &data})
+}
+
+// PutSkillsCategoriesId implements skill.ServerInterface.
+func (s *skillHandler) PutSkillsCategoriesId(c *gin.Context, id types.UUID) {
+	var categoryId = uuid.FromStringOrNil(id.String())
+
+	var updateCategory _skill.UpsertSkillCategory
+	if err := c.ShouldBindJSON(&updateCategory); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
+		return
+	}
+
+	category, err := s.skillUs.UpdateCategory(&categoryId, updateCategory)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var data _skill.SkillCategory
+	if !convert(c, category, &data) {
+		return
+	}
+
+	c.JSON(http.StatusOK, _skill.SkillCategoryResponse{Data: &data})
+}
+
+// DeleteSkillsCategoriesId implements skill.ServerInterface.
+func (s *skillHandler) DeleteSkillsCategoriesId(c *gin.Context, id types.UUID) {
+	var categoryId = uuid.FromStringOrNil(id.String())
+
+	if err := s.skillUs.DeleteCategory(&categoryId); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := _skill.Success{
+		Message: "Skill category deleted successfully",
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetSkillsReviews implements skill.ServerInterface.
+func (s *skillHandler) GetSkillsReviews(c *gin.Context, params _skill.GetSkillsReviewsParams) {
+	var page, perPage int
+	if params.Page != nil && params.PerPage != nil {
+		page = *params.Page
+		perPage = *params.PerPage
+	}
+	var paginator = models.NewPaginator(page, perPage)
+
+	var status = models.SkillReviewStatusPending
+	if params.Status != nil {
+		status = models.SkillReviewStatus(*params.Status)
+	}
+
+	reviews, err := s.skillUs.FetchReviews(status, paginator)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var data []_skill.SkillReview
+	if !convert(c, reviews, &data) {
+		return
+	}
+
+	response := _skill.SkillReviewPaginationResponse{
+		Data:       &data,
+		Page:       &paginator.Page,
+		PerPage:    &paginator.PerPage,
+		TotalPages: &paginator.TotalPages,
+		TotalRows:  &paginator.TotalRows,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// PostSkillsReviewsIdResolve implements skill.ServerInterface.
+func (s *skillHandler) PostSkillsReviewsIdResolve(c *gin.Context, id types.UUID) {
+	var reviewId = uuid.FromStringOrNil(id.String())
+
+	var resolution _skill.ResolveSkillReview
+	if err := c.ShouldBindJSON(&resolution); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid input"})
+		return
+	}
+
+	review, err := s.skillUs.ResolveReview(&reviewId, resolution)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var data _skill.SkillReview
+	if !convert(c, review, &data) {
+		return
+	}
+
+	c.JSON(http.StatusOK, _skill.SkillReviewResponse{Data: &data})
+}
+
+// PostSkillsRemap implements skill.ServerInterface.
+func (s *skillHandler) PostSkillsRemap(c *gin.Context) {
+	remapped, err := s.skillUs.RemapSkills()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var count = int(remapped)
+	response := _skill.SkillRemapResponse{
+		Message:  "Skills remapped successfully",
+		Remapped: &count,
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// GetSkillsSuggest implements skill.ServerInterface.
+func (s *skillHandler) GetSkillsSuggest(c *gin.Context, params _skill.GetSkillsSuggestParams) {
+	suggestions, err := s.skillUs.SuggestSkills(params)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	var data []_skill.SkillSuggestion
+	if !convert(c, suggestions, &data) {
+		return
+	}
+
+	c.JSON(http.StatusOK, _skill.SkillSuggestionsResponse{Data: &data})
+}
+
+func NewSkillHandler(skillUs _skill.SkillUsecase) _skill.ServerInterface {
+	return &skillHandler{
+		skillUs: skillUs,
+	}
+}
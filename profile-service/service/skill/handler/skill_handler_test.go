@@ -0,0 +1,242 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_skill "github.com/jariwat/p_project/profile-service/service/skill"
+	"github.com/jariwat/p_project/profile-service/service/skill/mocks"
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func TestPostSkillsCatalog_Success(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	aliases := []string{"golang"}
+	newCatalog := _skill.UpsertSkillCatalog{Name: "Go", Aliases: &aliases}
+	body, _ := json.Marshal(newCatalog)
+
+	req, _ := http.NewRequest(http.MethodPost, "/skills/catalog", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.SkillUsecase)
+	mockUsecase.
+		On("CreateCatalog", mock.AnythingOfType("*models.SkillCatalog"), newCatalog).
+		Run(func(args mock.Arguments) {
+			catalog := args.Get(0).(*models.SkillCatalog)
+			catalog.SetName("Go")
+			catalog.SetAliases(aliases)
+		}).
+		Return(nil)
+
+	handler := NewSkillHandler(mockUsecase)
+	handler.PostSkillsCatalog(c)
+
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	var resp _skill.SkillCatalogResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "Go", *resp.Data.Name)
+	assert.Equal(t, []string{"golang"}, *resp.Data.Aliases)
+}
+
+func TestPostSkillsCatalog_Conflict(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	body, _ := json.Marshal(_skill.UpsertSkillCatalog{Name: "Go"})
+	req, _ := http.NewRequest(http.MethodPost, "/skills/catalog", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.SkillUsecase)
+	mockUsecase.
+		On("CreateCatalog", mock.Anything, mock.Anything).
+		Return(constants.ErrSkillNameConflict)
+
+	handler := NewSkillHandler(mockUsecase)
+	handler.PostSkillsCatalog(c)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestGetSkillsCatalogId_NotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	catalogID := ptrUUID()
+	req, _ := http.NewRequest(http.MethodGet, "/skills/catalog/"+catalogID.String(), nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.SkillUsecase)
+	mockUsecase.On("FetchCatalogById", catalogID).Return(nil, nil)
+
+	handler := NewSkillHandler(mockUsecase)
+	handler.GetSkillsCatalogId(c, types.UUID(*catalogID))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestPutSkillsCategoriesId_NotFound(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	categoryID := ptrUUID()
+	body, _ := json.Marshal(_skill.UpsertSkillCategory{Name: "Languages"})
+	req, _ := http.NewRequest(http.MethodPut, "/skills/categories/"+categoryID.String(), bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.SkillUsecase)
+	mockUsecase.
+		On("UpdateCategory", categoryID, mock.Anything).
+		Return(nil, constants.ErrSkillCategoryNotFound)
+
+	handler := NewSkillHandler(mockUsecase)
+	handler.PutSkillsCategoriesId(c, types.UUID(*categoryID))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestGetSkillsReviews_DefaultsToPending(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, _ := http.NewRequest(http.MethodGet, "/skills/reviews", nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.SkillUsecase)
+	mockUsecase.
+		On("FetchReviews", models.SkillReviewStatusPending, mock.AnythingOfType("*models.Paginator")).
+		Return([]*models.SkillReview{{ID: ptrUUID(), Name: "Golang", Occurrences: 3, Status: models.SkillReviewStatusPending}}, nil)
+
+	handler := NewSkillHandler(mockUsecase)
+	handler.GetSkillsReviews(c, _skill.GetSkillsReviewsParams{})
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp _skill.SkillReviewPaginationResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, *resp.Data, 1)
+	assert.Equal(t, 3, *(*resp.Data)[0].Occurrences)
+}
+
+func TestPostSkillsReviewsIdResolve_AlreadyResolved(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	reviewID := ptrUUID()
+	body, _ := json.Marshal(_skill.ResolveSkillReview{Action: _skill.Reject})
+	req, _ := http.NewRequest(http.MethodPost, "/skills/reviews/"+reviewID.String()+"/resolve", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.SkillUsecase)
+	mockUsecase.
+		On("ResolveReview", reviewID, mock.Anything).
+		Return(nil, constants.ErrSkillReviewResolved)
+
+	handler := NewSkillHandler(mockUsecase)
+	handler.PostSkillsReviewsIdResolve(c, types.UUID(*reviewID))
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestPostSkillsRemap(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, _ := http.NewRequest(http.MethodPost, "/skills/remap", nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.SkillUsecase)
+	mockUsecase.On("RemapSkills").Return(int64(42), nil)
+
+	handler := NewSkillHandler(mockUsecase)
+	handler.PostSkillsRemap(c)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"remapped":42`)
+}
+
+func TestPostSkillsRemap_Error(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, _ := http.NewRequest(http.MethodPost, "/skills/remap", nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.SkillUsecase)
+	mockUsecase.On("RemapSkills").Return(int64(0), errors.New("db error"))
+
+	handler := NewSkillHandler(mockUsecase)
+	handler.PostSkillsRemap(c)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestGetSkillsSuggest(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, _ := http.NewRequest(http.MethodGet, "/skills/suggest?q=go", nil)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	params := _skill.GetSkillsSuggestParams{Q: "go"}
+	catalogID := ptrUUID()
+	mockUsecase := new(mocks.SkillUsecase)
+	mockUsecase.On("SuggestSkills", params).Return([]*models.SkillSuggestion{
+		{CatalogID: catalogID, Name: "Go", Usage: 30, Keys: []string{"go", "golang"}},
+		{Name: "Google Cloud", Usage: 5, Keys: []string{"google cloud"}},
+	}, nil)
+
+	handler := NewSkillHandler(mockUsecase)
+	handler.GetSkillsSuggest(c, params)
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp _skill.SkillSuggestionsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, *resp.Data, 2)
+	assert.Equal(t, "Go", (*resp.Data)[0].Name)
+	assert.Equal(t, catalogID.String(), (*resp.Data)[0].CatalogId.String())
+	assert.Equal(t, 30, (*resp.Data)[0].Usage)
+	assert.Nil(t, (*resp.Data)[1].CatalogId)
+	assert.NotContains(t, w.Body.String(), "golang")
+}
@@ -0,0 +1,96 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+
+	skill "github.com/jariwat/p_project/profile-service/service/skill"
+
+	uuid "github.com/google/uuid"
+)
+
+// ServerInterface is an autogenerated mock type for the ServerInterface type
+type ServerInterface struct {
+	mock.Mock
+}
+
+// DeleteSkillsCatalogId provides a mock function with given fields: c, id
+func (_m *ServerInterface) DeleteSkillsCatalogId(c *gin.Context, id uuid.UUID) {
+	_m.Called(c, id)
+}
+
+// DeleteSkillsCategoriesId provides a mock function with given fields: c, id
+func (_m *ServerInterface) DeleteSkillsCategoriesId(c *gin.Context, id uuid.UUID) {
+	_m.Called(c, id)
+}
+
+// GetSkillsCatalog provides a mock function with given fields: c, params
+func (_m *ServerInterface) GetSkillsCatalog(c *gin.Context, params skill.GetSkillsCatalogParams) {
+	_m.Called(c, params)
+}
+
+// GetSkillsCatalogId provides a mock function with given fields: c, id
+func (_m *ServerInterface) GetSkillsCatalogId(c *gin.Context, id uuid.UUID) {
+	_m.Called(c, id)
+}
+
+// GetSkillsCategories provides a mock function with given fields: c
+func (_m *ServerInterface) GetSkillsCategories(c *gin.Context) {
+	_m.Called(c)
+}
+
+// GetSkillsReviews provides a mock function with given fields: c, params
+func (_m *ServerInterface) GetSkillsReviews(c *gin.Context, params skill.GetSkillsReviewsParams) {
+	_m.Called(c, params)
+}
+
+// GetSkillsSuggest provides a mock function with given fields: c, params
+func (_m *ServerInterface) GetSkillsSuggest(c *gin.Context, params skill.GetSkillsSuggestParams) {
+	_m.Called(c, params)
+}
+
+// PostSkillsCatalog provides a mock function with given fields: c
+func (_m *ServerInterface) PostSkillsCatalog(c *gin.Context) {
+	_m.Called(c)
+}
+
+// PostSkillsCategories provides a mock function with given fields: c
+func (_m *ServerInterface) PostSkillsCategories(c *gin.Context) {
+	_m.Called(c)
+}
+
+// PostSkillsRemap provides a mock function with given fields: c
+func (_m *ServerInterface) PostSkillsRemap(c *gin.Context) {
+	_m.Called(c)
+}
+
+// PostSkillsReviewsIdResolve provides a mock function with given fields: c, id
+func (_m *ServerInterface) PostSkillsReviewsIdResolve(c *gin.Context, id uuid.UUID) {
+	_m.Called(c, id)
+}
+
+// PutSkillsCatalogId provides a mock function with given fields: c, id
+func (_m *ServerInterface) PutSkillsCatalogId(c *gin.Context, id uuid.UUID) {
+	_m.Called(c, id)
+}
+
+// PutSkillsCategoriesId provides a mock function with given fields: c, id
+func (_m *ServerInterface) PutSkillsCategoriesId(c *gin.Context, id uuid.UUID) {
+	_m.Called(c, id)
+}
+
+// NewServerInterface creates a new instance of ServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServerInterface {
+	mock := &ServerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
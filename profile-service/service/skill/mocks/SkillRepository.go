@@ -0,0 +1,460 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jariwat/p_project/profile-service/models"
+	skill "github.com/jariwat/p_project/profile-service/service/skill"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/gofrs/uuid"
+)
+
+// SkillRepository is an autogenerated mock type for the SkillRepository type
+type SkillRepository struct {
+	mock.Mock
+}
+
+// CreateCatalog provides a mock function with given fields: catalog
+func (_m *SkillRepository) CreateCatalog(catalog *models.SkillCatalog) error {
+	ret := _m.Called(catalog)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCatalog")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.SkillCatalog) error); ok {
+		r0 = rf(catalog)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateCategory provides a mock function with given fields: category
+func (_m *SkillRepository) CreateCategory(category *models.SkillCategory) error {
+	ret := _m.Called(category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.SkillCategory) error); ok {
+		r0 = rf(category)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCatalog provides a mock function with given fields: catalogId
+func (_m *SkillRepository) DeleteCatalog(catalogId *uuid.UUID) error {
+	ret := _m.Called(catalogId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCatalog")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) error); ok {
+		r0 = rf(catalogId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCategory provides a mock function with given fields: categoryId
+func (_m *SkillRepository) DeleteCategory(categoryId *uuid.UUID) error {
+	ret := _m.Called(categoryId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) error); ok {
+		r0 = rf(categoryId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FetchCatalog provides a mock function with given fields: params, paginator
+func (_m *SkillRepository) FetchCatalog(params skill.GetSkillsCatalogParams, paginator *models.Paginator) ([]*models.SkillCatalog, error) {
+	ret := _m.Called(params, paginator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchCatalog")
+	}
+
+	var r0 []*models.SkillCatalog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(skill.GetSkillsCatalogParams, *models.Paginator) ([]*models.SkillCatalog, error)); ok {
+		return rf(params, paginator)
+	}
+	if rf, ok := ret.Get(0).(func(skill.GetSkillsCatalogParams, *models.Paginator) []*models.SkillCatalog); ok {
+		r0 = rf(params, paginator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SkillCatalog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(skill.GetSkillsCatalogParams, *models.Paginator) error); ok {
+		r1 = rf(params, paginator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchCatalogById provides a mock function with given fields: catalogId
+func (_m *SkillRepository) FetchCatalogById(catalogId *uuid.UUID) (*models.SkillCatalog, error) {
+	ret := _m.Called(catalogId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchCatalogById")
+	}
+
+	var r0 *models.SkillCatalog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) (*models.SkillCatalog, error)); ok {
+		return rf(catalogId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) *models.SkillCatalog); ok {
+		r0 = rf(catalogId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SkillCatalog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(catalogId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchCatalogByKeys provides a mock function with given fields: keys
+func (_m *SkillRepository) FetchCatalogByKeys(keys []string) ([]*models.SkillCatalog, error) {
+	ret := _m.Called(keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchCatalogByKeys")
+	}
+
+	var r0 []*models.SkillCatalog
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) ([]*models.SkillCatalog, error)); ok {
+		return rf(keys)
+	}
+	if rf, ok := ret.Get(0).(func([]string) []*models.SkillCatalog); ok {
+		r0 = rf(keys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SkillCatalog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(keys)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchCategories provides a mock function with no fields
+func (_m *SkillRepository) FetchCategories() ([]*models.SkillCategory, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchCategories")
+	}
+
+	var r0 []*models.SkillCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*models.SkillCategory, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*models.SkillCategory); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SkillCategory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchCategoryById provides a mock function with given fields: categoryId
+func (_m *SkillRepository) FetchCategoryById(categoryId *uuid.UUID) (*models.SkillCategory, error) {
+	ret := _m.Called(categoryId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchCategoryById")
+	}
+
+	var r0 *models.SkillCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) (*models.SkillCategory, error)); ok {
+		return rf(categoryId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) *models.SkillCategory); ok {
+		r0 = rf(categoryId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SkillCategory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(categoryId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchReviewById provides a mock function with given fields: reviewId
+func (_m *SkillRepository) FetchReviewById(reviewId *uuid.UUID) (*models.SkillReview, error) {
+	ret := _m.Called(reviewId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchReviewById")
+	}
+
+	var r0 *models.SkillReview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) (*models.SkillReview, error)); ok {
+		return rf(reviewId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) *models.SkillReview); ok {
+		r0 = rf(reviewId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SkillReview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(reviewId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchReviews provides a mock function with given fields: status, paginator
+func (_m *SkillRepository) FetchReviews(status models.SkillReviewStatus, paginator *models.Paginator) ([]*models.SkillReview, error) {
+	ret := _m.Called(status, paginator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchReviews")
+	}
+
+	var r0 []*models.SkillReview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.SkillReviewStatus, *models.Paginator) ([]*models.SkillReview, error)); ok {
+		return rf(status, paginator)
+	}
+	if rf, ok := ret.Get(0).(func(models.SkillReviewStatus, *models.Paginator) []*models.SkillReview); ok {
+		r0 = rf(status, paginator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SkillReview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.SkillReviewStatus, *models.Paginator) error); ok {
+		r1 = rf(status, paginator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchSkillSuggestions provides a mock function with no fields
+func (_m *SkillRepository) FetchSkillSuggestions() ([]*models.SkillSuggestion, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchSkillSuggestions")
+	}
+
+	var r0 []*models.SkillSuggestion
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*models.SkillSuggestion, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*models.SkillSuggestion); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SkillSuggestion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// QueueReviews provides a mock function with given fields: reviews
+func (_m *SkillRepository) QueueReviews(reviews []*models.SkillReview) error {
+	ret := _m.Called(reviews)
+
+	if len(ret) == 0 {
+		panic("no return value specified for QueueReviews")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*models.SkillReview) error); ok {
+		r0 = rf(reviews)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemapSkills provides a mock function with given fields: keys
+func (_m *SkillRepository) RemapSkills(keys []string) (int64, error) {
+	ret := _m.Called(keys)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemapSkills")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) (int64, error)); ok {
+		return rf(keys)
+	}
+	if rf, ok := ret.Get(0).(func([]string) int64); ok {
+		r0 = rf(keys)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(keys)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCatalog provides a mock function with given fields: catalog
+func (_m *SkillRepository) UpdateCatalog(catalog *models.SkillCatalog) error {
+	ret := _m.Called(catalog)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCatalog")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.SkillCatalog) error); ok {
+		r0 = rf(catalog)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateCategory provides a mock function with given fields: category
+func (_m *SkillRepository) UpdateCategory(category *models.SkillCategory) error {
+	ret := _m.Called(category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.SkillCategory) error); ok {
+		r0 = rf(category)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateReview provides a mock function with given fields: review
+func (_m *SkillRepository) UpdateReview(review *models.SkillReview) error {
+	ret := _m.Called(review)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateReview")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.SkillReview) error); ok {
+		r0 = rf(review)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WithTransaction provides a mock function with given fields: fn
+func (_m *SkillRepository) WithTransaction(fn func(skill.SkillRepository) error) error {
+	ret := _m.Called(fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WithTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(func(skill.SkillRepository) error) error); ok {
+		r0 = rf(fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewSkillRepository creates a new instance of SkillRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSkillRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SkillRepository {
+	mock := &SkillRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,400 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jariwat/p_project/profile-service/models"
+	skill "github.com/jariwat/p_project/profile-service/service/skill"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/gofrs/uuid"
+)
+
+// SkillUsecase is an autogenerated mock type for the SkillUsecase type
+type SkillUsecase struct {
+	mock.Mock
+}
+
+// CreateCatalog provides a mock function with given fields: catalog, newCatalog
+func (_m *SkillUsecase) CreateCatalog(catalog *models.SkillCatalog, newCatalog skill.UpsertSkillCatalog) error {
+	ret := _m.Called(catalog, newCatalog)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCatalog")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.SkillCatalog, skill.UpsertSkillCatalog) error); ok {
+		r0 = rf(catalog, newCatalog)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateCategory provides a mock function with given fields: category, newCategory
+func (_m *SkillUsecase) CreateCategory(category *models.SkillCategory, newCategory skill.UpsertSkillCategory) error {
+	ret := _m.Called(category, newCategory)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.SkillCategory, skill.UpsertSkillCategory) error); ok {
+		r0 = rf(category, newCategory)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCatalog provides a mock function with given fields: catalogId
+func (_m *SkillUsecase) DeleteCatalog(catalogId *uuid.UUID) error {
+	ret := _m.Called(catalogId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCatalog")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) error); ok {
+		r0 = rf(catalogId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteCategory provides a mock function with given fields: categoryId
+func (_m *SkillUsecase) DeleteCategory(categoryId *uuid.UUID) error {
+	ret := _m.Called(categoryId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) error); ok {
+		r0 = rf(categoryId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FetchCatalog provides a mock function with given fields: params, paginator
+func (_m *SkillUsecase) FetchCatalog(params skill.GetSkillsCatalogParams, paginator *models.Paginator) ([]*models.SkillCatalog, error) {
+	ret := _m.Called(params, paginator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchCatalog")
+	}
+
+	var r0 []*models.SkillCatalog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(skill.GetSkillsCatalogParams, *models.Paginator) ([]*models.SkillCatalog, error)); ok {
+		return rf(params, paginator)
+	}
+	if rf, ok := ret.Get(0).(func(skill.GetSkillsCatalogParams, *models.Paginator) []*models.SkillCatalog); ok {
+		r0 = rf(params, paginator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SkillCatalog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(skill.GetSkillsCatalogParams, *models.Paginator) error); ok {
+		r1 = rf(params, paginator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchCatalogById provides a mock function with given fields: catalogId
+func (_m *SkillUsecase) FetchCatalogById(catalogId *uuid.UUID) (*models.SkillCatalog, error) {
+	ret := _m.Called(catalogId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchCatalogById")
+	}
+
+	var r0 *models.SkillCatalog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) (*models.SkillCatalog, error)); ok {
+		return rf(catalogId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) *models.SkillCatalog); ok {
+		r0 = rf(catalogId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SkillCatalog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(catalogId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchCategories provides a mock function with no fields
+func (_m *SkillUsecase) FetchCategories() ([]*models.SkillCategory, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchCategories")
+	}
+
+	var r0 []*models.SkillCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*models.SkillCategory, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*models.SkillCategory); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SkillCategory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchReviews provides a mock function with given fields: status, paginator
+func (_m *SkillUsecase) FetchReviews(status models.SkillReviewStatus, paginator *models.Paginator) ([]*models.SkillReview, error) {
+	ret := _m.Called(status, paginator)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchReviews")
+	}
+
+	var r0 []*models.SkillReview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.SkillReviewStatus, *models.Paginator) ([]*models.SkillReview, error)); ok {
+		return rf(status, paginator)
+	}
+	if rf, ok := ret.Get(0).(func(models.SkillReviewStatus, *models.Paginator) []*models.SkillReview); ok {
+		r0 = rf(status, paginator)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SkillReview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.SkillReviewStatus, *models.Paginator) error); ok {
+		r1 = rf(status, paginator)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NormalizeSkills provides a mock function with given fields: skills
+func (_m *SkillUsecase) NormalizeSkills(skills []*models.Skill) ([]*models.Skill, error) {
+	ret := _m.Called(skills)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NormalizeSkills")
+	}
+
+	var r0 []*models.Skill
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]*models.Skill) ([]*models.Skill, error)); ok {
+		return rf(skills)
+	}
+	if rf, ok := ret.Get(0).(func([]*models.Skill) []*models.Skill); ok {
+		r0 = rf(skills)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Skill)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]*models.Skill) error); ok {
+		r1 = rf(skills)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemapSkills provides a mock function with no fields
+func (_m *SkillUsecase) RemapSkills() (int64, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemapSkills")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (int64, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() int64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResolveReview provides a mock function with given fields: reviewId, resolution
+func (_m *SkillUsecase) ResolveReview(reviewId *uuid.UUID, resolution skill.ResolveSkillReview) (*models.SkillReview, error) {
+	ret := _m.Called(reviewId, resolution)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveReview")
+	}
+
+	var r0 *models.SkillReview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, skill.ResolveSkillReview) (*models.SkillReview, error)); ok {
+		return rf(reviewId, resolution)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, skill.ResolveSkillReview) *models.SkillReview); ok {
+		r0 = rf(reviewId, resolution)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SkillReview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, skill.ResolveSkillReview) error); ok {
+		r1 = rf(reviewId, resolution)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SuggestSkills provides a mock function with given fields: params
+func (_m *SkillUsecase) SuggestSkills(params skill.GetSkillsSuggestParams) ([]*models.SkillSuggestion, error) {
+	ret := _m.Called(params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SuggestSkills")
+	}
+
+	var r0 []*models.SkillSuggestion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(skill.GetSkillsSuggestParams) ([]*models.SkillSuggestion, error)); ok {
+		return rf(params)
+	}
+	if rf, ok := ret.Get(0).(func(skill.GetSkillsSuggestParams) []*models.SkillSuggestion); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.SkillSuggestion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(skill.GetSkillsSuggestParams) error); ok {
+		r1 = rf(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCatalog provides a mock function with given fields: catalogId, updateCatalog
+func (_m *SkillUsecase) UpdateCatalog(catalogId *uuid.UUID, updateCatalog skill.UpsertSkillCatalog) (*models.SkillCatalog, error) {
+	ret := _m.Called(catalogId, updateCatalog)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCatalog")
+	}
+
+	var r0 *models.SkillCatalog
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, skill.UpsertSkillCatalog) (*models.SkillCatalog, error)); ok {
+		return rf(catalogId, updateCatalog)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, skill.UpsertSkillCatalog) *models.SkillCatalog); ok {
+		r0 = rf(catalogId, updateCatalog)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SkillCatalog)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, skill.UpsertSkillCatalog) error); ok {
+		r1 = rf(catalogId, updateCatalog)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCategory provides a mock function with given fields: categoryId, updateCategory
+func (_m *SkillUsecase) UpdateCategory(categoryId *uuid.UUID, updateCategory skill.UpsertSkillCategory) (*models.SkillCategory, error) {
+	ret := _m.Called(categoryId, updateCategory)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCategory")
+	}
+
+	var r0 *models.SkillCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, skill.UpsertSkillCategory) (*models.SkillCategory, error)); ok {
+		return rf(categoryId, updateCategory)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, skill.UpsertSkillCategory) *models.SkillCategory); ok {
+		r0 = rf(categoryId, updateCategory)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.SkillCategory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, skill.UpsertSkillCategory) error); ok {
+		r1 = rf(categoryId, updateCategory)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewSkillUsecase creates a new instance of SkillUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSkillUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SkillUsecase {
+	mock := &SkillUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
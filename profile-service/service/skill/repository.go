@@ -0,0 +1,30 @@
+package skill
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/models"
+)
+
+type SkillRepository interface {
+	FetchCatalog(params GetSkillsCatalogParams, paginator *models.Paginator) ([]*models.SkillCatalog, error)
+	FetchCatalogById(catalogId *uuid.UUID) (*models.SkillCatalog, error)
+	FetchCatalogByKeys(keys []string) ([]*models.SkillCatalog, error)
+	CreateCatalog(catalog *models.SkillCatalog) error
+	UpdateCatalog(catalog *models.SkillCatalog) error
+	DeleteCatalog(catalogId *uuid.UUID) error
+
+	FetchCategories() ([]*models.SkillCategory, error)
+	FetchCategoryById(categoryId *uuid.UUID) (*models.SkillCategory, error)
+	CreateCategory(category *models.SkillCategory) error
+	UpdateCategory(category *models.SkillCategory) error
+	DeleteCategory(categoryId *uuid.UUID) error
+
+	FetchReviews(status models.SkillReviewStatus, paginator *models.Paginator) ([]*models.SkillReview, error)
+	FetchReviewById(reviewId *uuid.UUID) (*models.SkillReview, error)
+	QueueReviews(reviews []*models.SkillReview) error
+	UpdateReview(review *models.SkillReview) error
+
+	RemapSkills(keys []string) (int64, error)
+	FetchSkillSuggestions() ([]*models.SkillSuggestion, error)
+	WithTransaction(fn func(txRepo SkillRepository) error) error
+}
@@ -0,0 +1,395 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/helper"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/skill"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	uniqueViolationCode = "23505"
+
+	// normalizedSkillExpr is models.NormalizeSkillName written in SQL
+	normalizedSkillExpr = `LOWER(REGEXP_REPLACE(TRIM(skill.skill), '\s+', ' ', 'g'))`
+
+	// catalogKeysQuery lists every key a skill can be matched on together with its catalog entry
+	catalogKeysQuery = `SELECT id AS catalog_id, name, normalized_name AS key FROM skill_catalog
+UNION ALL
+SELECT a.catalog_id, c.name, a.normalized_alias AS key FROM skill_alias a JOIN skill_catalog c ON c.id = a.catalog_id`
+
+	// subcategoriesQuery lists a category and all categories below it
+	subcategoriesQuery = `WITH RECURSIVE subcategory AS (
+SELECT id FROM skill_category WHERE id = ?
+UNION
+SELECT c.id FROM skill_category c JOIN subcategory s ON c.parent_id = s.id
+) SELECT id FROM subcategory`
+)
+
+type skillRepository struct {
+	client *gorm.DB
+}
+
+// translateError maps unique violations on the catalog keys to a domain error.
+func translateError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+		return constants.ErrSkillNameConflict
+	}
+
+	return err
+}
+
+// FetchCatalog implements skill.SkillRepository.
+func (s *skillRepository) FetchCatalog(params skill.GetSkillsCatalogParams, paginator *models.Paginator) ([]*models.SkillCatalog, error) {
+	var catalog []*models.SkillCatalog
+	var totalRows int64
+	var limit = paginator.PerPage
+	var offset = (paginator.Page - 1) * paginator.PerPage
+
+	query := s.client.Model(&models.SkillCatalog{})
+
+	if params.Q != nil && *params.Q != "" {
+		likeQuery := helper.ContainsPattern(models.NormalizeSkillName(*params.Q))
+		query = query.Where(`normalized_name LIKE ? ESCAPE '\' OR id IN (?)`, likeQuery,
+			s.client.Model(&models.SkillAlias{}).Select("catalog_id").Where(`normalized_alias LIKE ? ESCAPE '\'`, likeQuery))
+	}
+
+	if params.CategoryId != nil {
+		query = query.Where("category_id IN (?)", gorm.Expr(subcategoriesQuery, *params.CategoryId))
+	}
+
+	if err := query.Count(&totalRows).Error; err != nil {
+		return nil, err
+	}
+
+	if err := query.Preload("Aliases").
+		Order("normalized_name").
+		Limit(limit).
+		Offset(offset).
+		Find(&catalog).Error; err != nil {
+		return nil, err
+	}
+
+	paginator.SetTotal(int(totalRows))
+
+	return catalog, nil
+}
+
+// FetchCatalogById implements skill.SkillRepository.
+func (s *skillRepository) FetchCatalogById(catalogId *uuid.UUID) (*models.SkillCatalog, error) {
+	var catalog models.SkillCatalog
+	if err := s.client.Preload("Aliases").First(&catalog, "id = ?", catalogId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &catalog, nil
+}
+
+// FetchCatalogByKeys implements skill.SkillRepository.
+// It returns the entries whose normalized name or one of whose aliases is in keys.
+func (s *skillRepository) FetchCatalogByKeys(keys []string) ([]*models.SkillCatalog, error) {
+	var catalog []*models.SkillCatalog
+	if len(keys) == 0 {
+		return catalog, nil
+	}
+
+	if err := s.client.Preload("Aliases").
+		Where("normalized_name IN ? OR id IN (?)", keys,
+			s.client.Model(&models.SkillAlias{}).Select("catalog_id").Where("normalized_alias IN ?", keys)).
+		Find(&catalog).Error; err != nil {
+		return nil, err
+	}
+
+	return catalog, nil
+}
+
+// CreateCatalog implements skill.SkillRepository.
+func (s *skillRepository) CreateCatalog(catalog *models.SkillCatalog) error {
+	err := s.client.Transaction(func(tx *gorm.DB) error {
+		return tx.Create(catalog).Error
+	})
+
+	return translateError(err)
+}
+
+// UpdateCatalog implements skill.SkillRepository.
+// The aliases are replaced by catalog.Aliases.
+func (s *skillRepository) UpdateCatalog(catalog *models.SkillCatalog) error {
+	err := s.client.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.SkillCatalog{}).Where("id = ?", catalog.ID).Updates(map[string]interface{}{
+			"name":            catalog.Name,
+			"normalized_name": catalog.NormalizedName,
+			"category_id":     catalog.CategoryID,
+			"updated_at":      catalog.UpdatedAt,
+		}).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Where("catalog_id = ?", catalog.ID).Delete(&models.SkillAlias{}).Error; err != nil {
+			return err
+		}
+
+		for _, alias := range catalog.Aliases {
+			if err := tx.Create(alias).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return translateError(err)
+}
+
+// DeleteCatalog implements skill.SkillRepository.
+func (s *skillRepository) DeleteCatalog(catalogId *uuid.UUID) error {
+	return s.client.Delete(&models.SkillCatalog{}, catalogId).Error
+}
+
+// FetchCategories implements skill.SkillRepository.
+func (s *skillRepository) FetchCategories() ([]*models.SkillCategory, error) {
+	var categories []*models.SkillCategory
+	if err := s.client.Order("name").Find(&categories).Error; err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+// FetchCategoryById implements skill.SkillRepository.
+func (s *skillRepository) FetchCategoryById(categoryId *uuid.UUID) (*models.SkillCategory, error) {
+	var category models.SkillCategory
+	if err := s.client.First(&category, "id = ?", categoryId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &category, nil
+}
+
+// CreateCategory implements skill.SkillRepository.
+func (s *skillRepository) CreateCategory(category *models.SkillCategory) error {
+	return s.client.Create(category).Error
+}
+
+// UpdateCategory implements skill.SkillRepository.
+func (s *skillRepository) UpdateCategory(category *models.SkillCategory) error {
+	return s.client.Model(&models.SkillCategory{}).Where("id = ?", category.ID).Updates(map[string]interface{}{
+		"name":       category.Name,
+		"parent_id":  category.ParentID,
+		"updated_at": category.UpdatedAt,
+	}).Error
+}
+
+// DeleteCategory implements skill.SkillRepository.
+func (s *skillRepository) DeleteCategory(categoryId *uuid.UUID) error {
+	return s.client.Delete(&models.SkillCategory{}, categoryId).Error
+}
+
+// FetchReviews implements skill.SkillRepository.
+// The most frequently entered skills come first.
+func (s *skillRepository) FetchReviews(status models.SkillReviewStatus, paginator *models.Paginator) ([]*models.SkillReview, error) {
+	var reviews []*models.SkillReview
+	var totalRows int64
+	var limit = paginator.PerPage
+	var offset = (paginator.Page - 1) * paginator.PerPage
+
+	query := s.client.Model(&models.SkillReview{}).Where("status = ?", status)
+
+	if err := query.Count(&totalRows).Error; err != nil {
+		return nil, err
+	}
+
+	if err := query.Order("occurrences DESC, created_at").
+		Limit(limit).
+		Offset(offset).
+		Find(&reviews).Error; err != nil {
+		return nil, err
+	}
+
+	paginator.SetTotal(int(totalRows))
+
+	return reviews, nil
+}
+
+// FetchReviewById implements skill.SkillRepository.
+func (s *skillRepository) FetchReviewById(reviewId *uuid.UUID) (*models.SkillReview, error) {
+	var review models.SkillReview
+	if err := s.client.First(&review, "id = ?", reviewId).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &review, nil
+}
+
+// QueueReviews implements skill.SkillRepository.
+// A skill that is already queued has its occurrences incremented instead.
+func (s *skillRepository) QueueReviews(reviews []*models.SkillReview) error {
+	if len(reviews) == 0 {
+		return nil
+	}
+
+	return s.client.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "normalized_name"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"occurrences": gorm.Expr("skill_review.occurrences + 1"),
+			"updated_at":  time.Now(),
+		}),
+	}).Create(&reviews).Error
+}
+
+// UpdateReview implements skill.SkillRepository.
+func (s *skillRepository) UpdateReview(review *models.SkillReview) error {
+	return s.client.Model(&models.SkillReview{}).Where("id = ?", review.ID).Updates(map[string]interface{}{
+		"status":     review.Status,
+		"catalog_id": review.CatalogID,
+		"updated_at": review.UpdatedAt,
+	}).Error
+}
+
+// RemapSkills implements skill.SkillRepository.
+// Profile skills matching a catalog key are renamed to the canonical name and
+// linked to the entry, skills that become duplicates on the same profile are
+// removed and pending reviews for those keys are approved. Only the given keys
+// are remapped, all of them when keys is empty. It returns the number of
+// profile skills that changed.
+func (s *skillRepository) RemapSkills(keys []string) (int64, error) {
+	var remapped int64
+	err := s.client.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		skillFilter, reviewFilter := "", ""
+		skillArgs := []interface{}{now}
+		reviewArgs := []interface{}{models.SkillReviewStatusApproved, now, models.SkillReviewStatusPending}
+		if len(keys) > 0 {
+			skillFilter, reviewFilter = " AND m.key IN ?", " AND r.normalized_name IN ?"
+			skillArgs = append(skillArgs, keys)
+			reviewArgs = append(reviewArgs, keys)
+		}
+
+		result := tx.Exec(`UPDATE skill SET catalog_id = m.catalog_id, skill = m.name, updated_at = ?
+FROM (`+catalogKeysQuery+`) m
+WHERE `+normalizedSkillExpr+` = m.key
+AND (skill.catalog_id IS DISTINCT FROM m.catalog_id OR skill.skill <> m.name)`+skillFilter, skillArgs...)
+		if result.Error != nil {
+			return result.Error
+		}
+		remapped = result.RowsAffected
+
+		// linked skills follow renamed entries
+		result = tx.Exec(`UPDATE skill SET skill = c.name, updated_at = ?
+FROM skill_catalog c
+WHERE skill.catalog_id = c.id AND skill.skill <> c.name`, now)
+		if result.Error != nil {
+			return result.Error
+		}
+		remapped += result.RowsAffected
+
+		// keep the oldest skill when a profile now has the same entry twice
+		if err := tx.Exec(`DELETE FROM skill a USING skill b
+WHERE a.profile_id = b.profile_id AND a.catalog_id = b.catalog_id
+AND (a.created_at > b.created_at OR (a.created_at = b.created_at AND a.id > b.id))`).Error; err != nil {
+			return err
+		}
+
+		return tx.Exec(`UPDATE skill_review r SET status = ?, catalog_id = m.catalog_id, updated_at = ?
+FROM (`+catalogKeysQuery+`) m
+WHERE r.normalized_name = m.key AND r.status = ?`+reviewFilter, reviewArgs...).Error
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return remapped, nil
+}
+
+// FetchSkillSuggestions implements skill.SkillRepository.
+// It returns every catalog entry with its aliases and every distinct skill
+// outside the catalog, each with the number of profile skills using it.
+func (s *skillRepository) FetchSkillSuggestions() ([]*models.SkillSuggestion, error) {
+	var catalogRows []struct {
+		CatalogID      *uuid.UUID
+		Name           string
+		NormalizedName string
+		Usage          int64
+	}
+	if err := s.client.Raw(`SELECT c.id AS catalog_id, c.name, c.normalized_name, COUNT(skill.id) AS usage
+FROM skill_catalog c LEFT JOIN skill ON skill.catalog_id = c.id
+GROUP BY c.id, c.name, c.normalized_name`).Scan(&catalogRows).Error; err != nil {
+		return nil, err
+	}
+
+	var aliases []*models.SkillAlias
+	if err := s.client.Find(&aliases).Error; err != nil {
+		return nil, err
+	}
+
+	var skillRows []struct {
+		Name  string
+		Key   string
+		Usage int64
+	}
+	if err := s.client.Raw(`SELECT MIN(TRIM(skill.skill)) AS name, ` + normalizedSkillExpr + ` AS key, COUNT(*) AS usage
+FROM skill WHERE skill.catalog_id IS NULL AND TRIM(skill.skill) <> ''
+GROUP BY key`).Scan(&skillRows).Error; err != nil {
+		return nil, err
+	}
+
+	suggestions := make([]*models.SkillSuggestion, 0, len(catalogRows)+len(skillRows))
+	catalogById := make(map[uuid.UUID]*models.SkillSuggestion)
+	for _, row := range catalogRows {
+		suggestion := &models.SkillSuggestion{
+			CatalogID: row.CatalogID,
+			Name:      row.Name,
+			Usage:     row.Usage,
+			Keys:      []string{row.NormalizedName},
+		}
+		catalogById[*row.CatalogID] = suggestion
+		suggestions = append(suggestions, suggestion)
+	}
+
+	for _, alias := range aliases {
+		if suggestion, ok := catalogById[*alias.CatalogID]; ok {
+			suggestion.Keys = append(suggestion.Keys, alias.NormalizedAlias)
+		}
+	}
+
+	for _, row := range skillRows {
+		suggestions = append(suggestions, &models.SkillSuggestion{
+			Name:  row.Name,
+			Usage: row.Usage,
+			Keys:  []string{row.Key},
+		})
+	}
+
+	return suggestions, nil
+}
+
+// WithTransaction implements skill.SkillRepository.
+// fn receives a repository bound to the transaction, which is committed when fn returns nil.
+func (s *skillRepository) WithTransaction(fn func(txRepo skill.SkillRepository) error) error {
+	return s.client.Transaction(func(tx *gorm.DB) error {
+		return fn(&skillRepository{client: tx})
+	})
+}
+
+func NewPsqlSkillRepository(client *gorm.DB) skill.SkillRepository {
+	return &skillRepository{
+		client: client,
+	}
+}
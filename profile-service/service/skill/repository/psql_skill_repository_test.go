@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	return gormDB, mock
+}
+
+func TestFetchCatalogById_NotFound(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlSkillRepository(gormDB)
+
+	catalogID := ptrUUID()
+	query := `SELECT * FROM "skill_catalog" WHERE id = $1 ORDER BY "skill_catalog"."id" LIMIT $2`
+	mock.ExpectQuery(regexp.QuoteMeta(query)).
+		WithArgs(catalogID, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	catalog, err := repo.FetchCatalogById(catalogID)
+
+	assert.NoError(t, err)
+	assert.Nil(t, catalog)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchCatalogByKeys_Empty(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlSkillRepository(gormDB)
+
+	catalog, err := repo.FetchCatalogByKeys(nil)
+
+	assert.NoError(t, err)
+	assert.Empty(t, catalog)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateCatalog_NameConflict(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlSkillRepository(gormDB)
+
+	catalog := &models.SkillCatalog{}
+	catalog.GenUUID()
+	catalog.SetName("Go")
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "skill_catalog"`)).
+		WillReturnError(&pgconn.PgError{Code: uniqueViolationCode})
+	mock.ExpectRollback()
+
+	err := repo.CreateCatalog(catalog)
+
+	assert.ErrorIs(t, err, constants.ErrSkillNameConflict)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestQueueReviews_IncrementsOnConflict(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlSkillRepository(gormDB)
+
+	review := &models.SkillReview{Name: "Gooo", NormalizedName: "gooo", Occurrences: 1, Status: models.SkillReviewStatusPending}
+	review.GenUUID()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "skill_review" ("id","name","normalized_name","occurrences","status","catalog_id","created_at","updated_at") VALUES ($1,$2,$3,$4,$5,$6,$7,$8) ON CONFLICT ("normalized_name") DO UPDATE SET "occurrences"=skill_review.occurrences + 1`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.QueueReviews([]*models.SkillReview{review})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRemapSkills_Success(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlSkillRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE skill SET catalog_id = m.catalog_id`)).
+		WithArgs(sqlmock.AnyArg(), "golang").
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE skill SET skill = c.name`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM skill a USING skill b`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE skill_review r SET status = $1`)).
+		WithArgs(models.SkillReviewStatusApproved, sqlmock.AnyArg(), models.SkillReviewStatusPending, "golang").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	remapped, err := repo.RemapSkills([]string{"golang"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), remapped)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRemapSkills_Error(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlSkillRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`UPDATE skill SET catalog_id = m.catalog_id`)).
+		WillReturnError(errors.New("db error"))
+	mock.ExpectRollback()
+
+	remapped, err := repo.RemapSkills(nil)
+
+	assert.Error(t, err)
+	assert.Equal(t, int64(0), remapped)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchSkillSuggestions(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlSkillRepository(gormDB)
+
+	catalogID := ptrUUID()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT c.id AS catalog_id, c.name, c.normalized_name, COUNT(skill.id) AS usage`)).
+		WillReturnRows(sqlmock.NewRows([]string{"catalog_id", "name", "normalized_name", "usage"}).
+			AddRow(catalogID, "Go", "go", 30))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM "skill_alias"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "catalog_id", "alias", "normalized_alias"}).
+			AddRow(ptrUUID(), catalogID, "GoLang", "golang"))
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM skill WHERE skill.catalog_id IS NULL AND TRIM(skill.skill) <> '' GROUP BY key`)).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "key", "usage"}).
+			AddRow("Google Cloud", "google cloud", 5))
+
+	suggestions, err := repo.FetchSkillSuggestions()
+
+	assert.NoError(t, err)
+	assert.Len(t, suggestions, 2)
+	assert.Equal(t, "Go", suggestions[0].Name)
+	assert.Equal(t, []string{"go", "golang"}, suggestions[0].Keys)
+	assert.Equal(t, int64(30), suggestions[0].Usage)
+	assert.Nil(t, suggestions[1].CatalogID)
+	assert.Equal(t, []string{"google cloud"}, suggestions[1].Keys)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,27 @@
+package skill
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/models"
+)
+
+type SkillUsecase interface {
+	NormalizeSkills(skills []*models.Skill) ([]*models.Skill, error)
+
+	FetchCatalog(params GetSkillsCatalogParams, paginator *models.Paginator) ([]*models.SkillCatalog, error)
+	FetchCatalogById(catalogId *uuid.UUID) (*models.SkillCatalog, error)
+	CreateCatalog(catalog *models.SkillCatalog, newCatalog UpsertSkillCatalog) error
+	UpdateCatalog(catalogId *uuid.UUID, updateCatalog UpsertSkillCatalog) (*models.SkillCatalog, error)
+	DeleteCatalog(catalogId *uuid.UUID) error
+
+	FetchCategories() ([]*models.SkillCategory, error)
+	CreateCategory(category *models.SkillCategory, newCategory UpsertSkillCategory) error
+	UpdateCategory(categoryId *uuid.UUID, updateCategory UpsertSkillCategory) (*models.SkillCategory, error)
+	DeleteCategory(categoryId *uuid.UUID) error
+
+	FetchReviews(status models.SkillReviewStatus, paginator *models.Paginator) ([]*models.SkillReview, error)
+	ResolveReview(reviewId *uuid.UUID, resolution ResolveSkillReview) (*models.SkillReview, error)
+
+	RemapSkills() (int64, error)
+	SuggestSkills(params GetSkillsSuggestParams) ([]*models.SkillSuggestion, error)
+}
@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/skill"
+)
+
+const (
+	defaultSuggestLimit = 10
+
+	// suggestMinSimilarity is the pg_trgm default similarity threshold
+	suggestMinSimilarity = 0.3
+
+	// fuzzy matches are only looked for once the query has a few letters,
+	// shorter queries share trigrams with almost everything
+	suggestMinFuzzyLength = 3
+)
+
+// suggestionCache keeps every suggestion in memory so autocomplete calls do
+// not hit the database on each keystroke. It is reloaded once older than ttl
+// or after the catalog changed.
+type suggestionCache struct {
+	mu          sync.Mutex
+	ttl         time.Duration
+	loadedAt    time.Time
+	suggestions []*cachedSuggestion
+}
+
+type cachedSuggestion struct {
+	*models.SkillSuggestion
+	trigrams []map[string]bool
+}
+
+func newSuggestionCache(ttl time.Duration) *suggestionCache {
+	return &suggestionCache{ttl: ttl}
+}
+
+func (c *suggestionCache) get(load func() ([]*models.SkillSuggestion, error)) ([]*cachedSuggestion, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.suggestions != nil && time.Since(c.loadedAt) < c.ttl {
+		return c.suggestions, nil
+	}
+
+	suggestions, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	cached := make([]*cachedSuggestion, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		trigrams := make([]map[string]bool, 0, len(suggestion.Keys))
+		for _, key := range suggestion.Keys {
+			trigrams = append(trigrams, models.SkillTrigrams(key))
+		}
+		cached = append(cached, &cachedSuggestion{SkillSuggestion: suggestion, trigrams: trigrams})
+	}
+
+	c.suggestions = cached
+	c.loadedAt = time.Now()
+
+	return cached, nil
+}
+
+func (c *suggestionCache) invalidate() {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.suggestions = nil
+}
+
+// SuggestSkills implements skill.SkillUsecase.
+// Skills with a name or alias starting with the query, or with a word of it
+// starting with the query, come first. Fuzzy matches follow. Within each group
+// the most used skills come first.
+func (s *skillUsecase) SuggestSkills(params skill.GetSkillsSuggestParams) ([]*models.SkillSuggestion, error) {
+	query := models.NormalizeSkillName(params.Q)
+	limit := defaultSuggestLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	suggestions := make([]*models.SkillSuggestion, 0, limit)
+	if query == "" {
+		return suggestions, nil
+	}
+
+	cached, err := s.suggestCache.get(s.skillRepo.FetchSkillSuggestions)
+	if err != nil {
+		return nil, err
+	}
+
+	type match struct {
+		suggestion *models.SkillSuggestion
+		prefix     bool
+		similarity float64
+	}
+
+	var queryTrigrams map[string]bool
+	if len([]rune(query)) >= suggestMinFuzzyLength {
+		queryTrigrams = models.SkillTrigrams(query)
+	}
+
+	matches := make([]match, 0)
+	for _, candidate := range cached {
+		m := match{suggestion: candidate.SkillSuggestion}
+		for i, key := range candidate.Keys {
+			if hasWordPrefix(key, query) {
+				m.prefix = true
+			}
+			if queryTrigrams != nil {
+				if similarity := models.TrigramSimilarity(queryTrigrams, candidate.trigrams[i]); similarity > m.similarity {
+					m.similarity = similarity
+				}
+			}
+		}
+
+		if m.prefix || m.similarity >= suggestMinSimilarity {
+			matches = append(matches, m)
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		a, b := matches[i], matches[j]
+		if a.prefix != b.prefix {
+			return a.prefix
+		}
+		if a.suggestion.Usage != b.suggestion.Usage {
+			return a.suggestion.Usage > b.suggestion.Usage
+		}
+		if a.similarity != b.similarity {
+			return a.similarity > b.similarity
+		}
+		return a.suggestion.Name < b.suggestion.Name
+	})
+
+	for _, m := range matches {
+		if len(suggestions) == limit {
+			break
+		}
+		suggestions = append(suggestions, m.suggestion)
+	}
+
+	return suggestions, nil
+}
+
+// hasWordPrefix reports whether key or one of its words starts with prefix.
+func hasWordPrefix(key, prefix string) bool {
+	if strings.HasPrefix(key, prefix) {
+		return true
+	}
+
+	for _, word := range strings.Fields(key) {
+		if strings.HasPrefix(word, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
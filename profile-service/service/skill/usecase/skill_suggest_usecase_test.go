@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jariwat/p_project/profile-service/models"
+	_skill "github.com/jariwat/p_project/profile-service/service/skill"
+	"github.com/jariwat/p_project/profile-service/service/skill/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func suggestionNames(suggestions []*models.SkillSuggestion) []string {
+	names := make([]string, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		names = append(names, suggestion.Name)
+	}
+	return names
+}
+
+func testSuggestions() []*models.SkillSuggestion {
+	return []*models.SkillSuggestion{
+		{CatalogID: ptrUUID(), Name: "JavaScript", Usage: 40, Keys: []string{"javascript", "js"}},
+		{CatalogID: ptrUUID(), Name: "Java", Usage: 25, Keys: []string{"java"}},
+		{CatalogID: ptrUUID(), Name: "Go", Usage: 30, Keys: []string{"go", "golang"}},
+		{Name: "Google Cloud", Usage: 5, Keys: []string{"google cloud"}},
+		{Name: "Spring Boot", Usage: 8, Keys: []string{"spring boot"}},
+	}
+}
+
+func TestSuggestSkills_PrefixRankedByUsage(t *testing.T) {
+	mockRepo := new(mocks.SkillRepository)
+	usecase := NewSkillUsecase(mockRepo, time.Minute)
+
+	mockRepo.On("FetchSkillSuggestions").Return(testSuggestions(), nil).Once()
+
+	suggestions, err := usecase.SuggestSkills(_skill.GetSkillsSuggestParams{Q: "Ja"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"JavaScript", "Java"}, suggestionNames(suggestions))
+
+	// word prefixes and aliases match too, served from the cache
+	suggestions, err = usecase.SuggestSkills(_skill.GetSkillsSuggestParams{Q: "go"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Go", "Google Cloud"}, suggestionNames(suggestions))
+
+	suggestions, err = usecase.SuggestSkills(_skill.GetSkillsSuggestParams{Q: "boot"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Spring Boot"}, suggestionNames(suggestions))
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSuggestSkills_Fuzzy(t *testing.T) {
+	mockRepo := new(mocks.SkillRepository)
+	usecase := NewSkillUsecase(mockRepo, time.Minute)
+
+	mockRepo.On("FetchSkillSuggestions").Return(testSuggestions(), nil)
+
+	suggestions, err := usecase.SuggestSkills(_skill.GetSkillsSuggestParams{Q: "javascrpt"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"JavaScript", "Java"}, suggestionNames(suggestions))
+
+	suggestions, err = usecase.SuggestSkills(_skill.GetSkillsSuggestParams{Q: "sprng boot"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"Spring Boot"}, suggestionNames(suggestions))
+}
+
+func TestSuggestSkills_Limit(t *testing.T) {
+	mockRepo := new(mocks.SkillRepository)
+	usecase := NewSkillUsecase(mockRepo, time.Minute)
+
+	mockRepo.On("FetchSkillSuggestions").Return(testSuggestions(), nil)
+
+	limit := 1
+	suggestions, err := usecase.SuggestSkills(_skill.GetSkillsSuggestParams{Q: "j", Limit: &limit})
+	require.NoError(t, err)
+	require.Equal(t, []string{"JavaScript"}, suggestionNames(suggestions))
+}
+
+func TestSuggestSkills_ReloadsAfterCatalogChange(t *testing.T) {
+	mockRepo := new(mocks.SkillRepository)
+	usecase := NewSkillUsecase(mockRepo, time.Minute)
+
+	mockRepo.On("FetchSkillSuggestions").Return(testSuggestions(), nil).Twice()
+	mockRepo.On("RemapSkills", []string(nil)).Return(int64(0), nil)
+
+	_, err := usecase.SuggestSkills(_skill.GetSkillsSuggestParams{Q: "go"})
+	require.NoError(t, err)
+
+	_, err = usecase.RemapSkills()
+	require.NoError(t, err)
+
+	_, err = usecase.SuggestSkills(_skill.GetSkillsSuggestParams{Q: "go"})
+	require.NoError(t, err)
+
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSuggestSkills_Error(t *testing.T) {
+	mockRepo := new(mocks.SkillRepository)
+	usecase := NewSkillUsecase(mockRepo, time.Minute)
+
+	mockRepo.On("FetchSkillSuggestions").Return(nil, errors.New("db error"))
+
+	suggestions, err := usecase.SuggestSkills(_skill.GetSkillsSuggestParams{Q: "go"})
+	require.Error(t, err)
+	require.Nil(t, suggestions)
+}
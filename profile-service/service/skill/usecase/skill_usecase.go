@@ -0,0 +1,371 @@
+package usecase
+
+import (
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/skill"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type skillUsecase struct {
+	skillRepo    skill.SkillRepository
+	suggestCache *suggestionCache
+}
+
+// NormalizeSkills implements skill.SkillUsecase.
+// Skills found in the catalog are renamed to their canonical name and linked
+// to it, the others are kept as entered and queued for review. Skills that
+// normalize to the same entry are only kept once.
+func (s *skillUsecase) NormalizeSkills(skills []*models.Skill) ([]*models.Skill, error) {
+	keys := make([]string, 0, len(skills))
+	for _, sk := range skills {
+		keys = append(keys, models.NormalizeSkillName(sk.Skill))
+	}
+
+	catalog, err := s.skillRepo.FetchCatalogByKeys(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	catalogByKey := make(map[string]*models.SkillCatalog)
+	for _, entry := range catalog {
+		for _, key := range entry.Keys() {
+			catalogByKey[key] = entry
+		}
+	}
+
+	normalized := make([]*models.Skill, 0, len(skills))
+	reviews := make([]*models.SkillReview, 0)
+	seen := make(map[string]bool)
+	for i, sk := range skills {
+		key := keys[i]
+		if entry, ok := catalogByKey[key]; ok {
+			key = entry.NormalizedName
+			sk.Skill = entry.Name
+			sk.CatalogID = entry.ID
+		} else {
+			sk.Skill = strings.TrimSpace(sk.Skill)
+			sk.CatalogID = nil
+		}
+
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		normalized = append(normalized, sk)
+
+		if sk.CatalogID == nil && key != "" {
+			review := &models.SkillReview{
+				Name:           sk.Skill,
+				NormalizedName: key,
+				Occurrences:    1,
+				Status:         models.SkillReviewStatusPending,
+			}
+			review.GenUUID()
+			review.SetCreatedAt()
+			review.SetUpdatedAt()
+			reviews = append(reviews, review)
+		}
+	}
+
+	// the review queue only helps curating the catalog, it must not block saving profiles
+	if err := s.skillRepo.QueueReviews(reviews); err != nil {
+		log.Printf("Failed to queue %d skills for review: %v", len(reviews), err)
+	}
+
+	return normalized, nil
+}
+
+// FetchCatalog implements skill.SkillUsecase.
+func (s *skillUsecase) FetchCatalog(params skill.GetSkillsCatalogParams, paginator *models.Paginator) ([]*models.SkillCatalog, error) {
+	return s.skillRepo.FetchCatalog(params, paginator)
+}
+
+// FetchCatalogById implements skill.SkillUsecase.
+func (s *skillUsecase) FetchCatalogById(catalogId *uuid.UUID) (*models.SkillCatalog, error) {
+	return s.skillRepo.FetchCatalogById(catalogId)
+}
+
+// CreateCatalog implements skill.SkillUsecase.
+func (s *skillUsecase) CreateCatalog(catalog *models.SkillCatalog, newCatalog skill.UpsertSkillCatalog) error {
+	if err := s.setCatalog(catalog, newCatalog); err != nil {
+		return err
+	}
+	catalog.SetCreatedAt()
+	catalog.SetUpdatedAt()
+
+	if err := s.skillRepo.CreateCatalog(catalog); err != nil {
+		return err
+	}
+	s.suggestCache.invalidate()
+
+	return nil
+}
+
+// UpdateCatalog implements skill.SkillUsecase.
+func (s *skillUsecase) UpdateCatalog(catalogId *uuid.UUID, updateCatalog skill.UpsertSkillCatalog) (*models.SkillCatalog, error) {
+	catalog, err := s.skillRepo.FetchCatalogById(catalogId)
+	if err != nil {
+		return nil, err
+	}
+
+	if catalog == nil {
+		return nil, constants.ErrSkillCatalogNotFound
+	}
+
+	if err := s.setCatalog(catalog, updateCatalog); err != nil {
+		return nil, err
+	}
+	catalog.SetUpdatedAt()
+
+	if err := s.skillRepo.UpdateCatalog(catalog); err != nil {
+		return nil, err
+	}
+	s.suggestCache.invalidate()
+
+	return catalog, nil
+}
+
+// setCatalog copies the input onto catalog and checks that none of its keys
+// belongs to another entry, the unique indexes only cover names and aliases
+// separately.
+func (s *skillUsecase) setCatalog(catalog *models.SkillCatalog, upsertCatalog skill.UpsertSkillCatalog) error {
+	catalog.SetName(upsertCatalog.Name)
+	var aliases []string
+	if upsertCatalog.Aliases != nil {
+		aliases = *upsertCatalog.Aliases
+	}
+	catalog.SetAliases(aliases)
+
+	catalog.CategoryID = nil
+	if upsertCatalog.CategoryId != nil {
+		categoryId := uuid.FromStringOrNil(upsertCatalog.CategoryId.String())
+		category, err := s.skillRepo.FetchCategoryById(&categoryId)
+		if err != nil {
+			return err
+		}
+		if category == nil {
+			return constants.ErrUnknownSkillCategory
+		}
+		catalog.CategoryID = category.ID
+	}
+
+	existing, err := s.skillRepo.FetchCatalogByKeys(catalog.Keys())
+	if err != nil {
+		return err
+	}
+	for _, entry := range existing {
+		if catalog.ID == nil || *entry.ID != *catalog.ID {
+			return constants.ErrSkillNameConflict
+		}
+	}
+
+	return nil
+}
+
+// DeleteCatalog implements skill.SkillUsecase.
+// Profile skills linked to the entry keep their name and lose the link.
+func (s *skillUsecase) DeleteCatalog(catalogId *uuid.UUID) error {
+	if err := s.skillRepo.DeleteCatalog(catalogId); err != nil {
+		return err
+	}
+	s.suggestCache.invalidate()
+
+	return nil
+}
+
+// FetchCategories implements skill.SkillUsecase.
+func (s *skillUsecase) FetchCategories() ([]*models.SkillCategory, error) {
+	return s.skillRepo.FetchCategories()
+}
+
+// CreateCategory implements skill.SkillUsecase.
+func (s *skillUsecase) CreateCategory(category *models.SkillCategory, newCategory skill.UpsertSkillCategory) error {
+	category.Name = strings.TrimSpace(newCategory.Name)
+	if err := s.setParentCategory(category, newCategory.ParentId); err != nil {
+		return err
+	}
+	category.SetCreatedAt()
+	category.SetUpdatedAt()
+
+	return s.skillRepo.CreateCategory(category)
+}
+
+// UpdateCategory implements skill.SkillUsecase.
+func (s *skillUsecase) UpdateCategory(categoryId *uuid.UUID, updateCategory skill.UpsertSkillCategory) (*models.SkillCategory, error) {
+	category, err := s.skillRepo.FetchCategoryById(categoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	if category == nil {
+		return nil, constants.ErrSkillCategoryNotFound
+	}
+
+	category.Name = strings.TrimSpace(updateCategory.Name)
+	if err := s.setParentCategory(category, updateCategory.ParentId); err != nil {
+		return nil, err
+	}
+	category.SetUpdatedAt()
+
+	if err := s.skillRepo.UpdateCategory(category); err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// setParentCategory walks up from the new parent to make sure the hierarchy stays a tree.
+func (s *skillUsecase) setParentCategory(category *models.SkillCategory, parentId *types.UUID) error {
+	category.ParentID = nil
+	if parentId == nil {
+		return nil
+	}
+
+	id := uuid.FromStringOrNil(parentId.String())
+	for next := &id; next != nil; {
+		if category.ID != nil && *next == *category.ID {
+			return constants.ErrSkillCategoryCycle
+		}
+
+		parent, err := s.skillRepo.FetchCategoryById(next)
+		if err != nil {
+			return err
+		}
+		if parent == nil {
+			return constants.ErrUnknownSkillCategory
+		}
+		if category.ParentID == nil {
+			category.ParentID = parent.ID
+		}
+		next = parent.ParentID
+	}
+
+	return nil
+}
+
+// DeleteCategory implements skill.SkillUsecase.
+// Subcategories and skills of the category are left without a category.
+func (s *skillUsecase) DeleteCategory(categoryId *uuid.UUID) error {
+	return s.skillRepo.DeleteCategory(categoryId)
+}
+
+// FetchReviews implements skill.SkillUsecase.
+func (s *skillUsecase) FetchReviews(status models.SkillReviewStatus, paginator *models.Paginator) ([]*models.SkillReview, error) {
+	return s.skillRepo.FetchReviews(status, paginator)
+}
+
+// ResolveReview implements skill.SkillUsecase.
+// Approving adds the skill to the catalog, either as a new entry or as an alias
+// of an existing one, and remaps the profile skills entered that way.
+func (s *skillUsecase) ResolveReview(reviewId *uuid.UUID, resolution skill.ResolveSkillReview) (*models.SkillReview, error) {
+	var review *models.SkillReview
+	err := s.skillRepo.WithTransaction(func(txRepo skill.SkillRepository) error {
+		txUsecase := &skillUsecase{skillRepo: txRepo}
+
+		var err error
+		review, err = txRepo.FetchReviewById(reviewId)
+		if err != nil {
+			return err
+		}
+		if review == nil {
+			return constants.ErrSkillReviewNotFound
+		}
+		if review.Status != models.SkillReviewStatusPending {
+			return constants.ErrSkillReviewResolved
+		}
+
+		var catalog *models.SkillCatalog
+		switch resolution.Action {
+		case skill.Create:
+			name := review.Name
+			if resolution.Name != nil && strings.TrimSpace(*resolution.Name) != "" {
+				name = *resolution.Name
+			}
+
+			catalog = new(models.SkillCatalog)
+			catalog.GenUUID()
+			if err := txUsecase.CreateCatalog(catalog, skill.UpsertSkillCatalog{
+				Name:       name,
+				Aliases:    &[]string{review.Name},
+				CategoryId: resolution.CategoryId,
+			}); err != nil {
+				return err
+			}
+
+		case skill.Alias:
+			if resolution.CatalogId == nil {
+				return constants.ErrInvalidSkillReviewDecision
+			}
+
+			catalogId := uuid.FromStringOrNil(resolution.CatalogId.String())
+			existing, err := txRepo.FetchCatalogById(&catalogId)
+			if err != nil {
+				return err
+			}
+			if existing == nil {
+				return constants.ErrSkillCatalogNotFound
+			}
+
+			aliases := []string{review.Name}
+			for _, alias := range existing.Aliases {
+				aliases = append(aliases, alias.Alias)
+			}
+			catalog, err = txUsecase.UpdateCatalog(&catalogId, skill.UpsertSkillCatalog{
+				Name:       existing.Name,
+				Aliases:    &aliases,
+				CategoryId: (*types.UUID)(existing.CategoryID),
+			})
+			if err != nil {
+				return err
+			}
+
+		case skill.Reject:
+			review.Status = models.SkillReviewStatusRejected
+			review.SetUpdatedAt()
+			return txRepo.UpdateReview(review)
+
+		default:
+			return constants.ErrInvalidSkillReviewDecision
+		}
+
+		review.Status = models.SkillReviewStatusApproved
+		review.CatalogID = catalog.ID
+		review.SetUpdatedAt()
+		if err := txRepo.UpdateReview(review); err != nil {
+			return err
+		}
+
+		_, err = txRepo.RemapSkills(catalog.Keys())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.suggestCache.invalidate()
+
+	return review, nil
+}
+
+// RemapSkills implements skill.SkillUsecase.
+func (s *skillUsecase) RemapSkills() (int64, error) {
+	remapped, err := s.skillRepo.RemapSkills(nil)
+	if err != nil {
+		return 0, err
+	}
+	s.suggestCache.invalidate()
+
+	return remapped, nil
+}
+
+func NewSkillUsecase(skillRepo skill.SkillRepository, suggestCacheTTL time.Duration) skill.SkillUsecase {
+	return &skillUsecase{
+		skillRepo:    skillRepo,
+		suggestCache: newSuggestionCache(suggestCacheTTL),
+	}
+}
@@ -0,0 +1,262 @@
+package usecase
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_skill "github.com/jariwat/p_project/profile-service/service/skill"
+	"github.com/jariwat/p_project/profile-service/service/skill/mocks"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func newCatalog(name string, aliases ...string) *models.SkillCatalog {
+	catalog := &models.SkillCatalog{}
+	catalog.GenUUID()
+	catalog.SetName(name)
+	catalog.SetAliases(aliases)
+	return catalog
+}
+
+// withTransaction makes the mocked repository run fn against itself.
+func withTransaction(mockRepo *mocks.SkillRepository) {
+	mockRepo.On("WithTransaction", mock.Anything).
+		Return(func(fn func(txRepo _skill.SkillRepository) error) error {
+			return fn(mockRepo)
+		})
+}
+
+func TestNormalizeSkills(t *testing.T) {
+	mockRepo := new(mocks.SkillRepository)
+	usecase := NewSkillUsecase(mockRepo, time.Minute)
+
+	golang := newCatalog("Go", "golang")
+	skills := []*models.Skill{
+		{Skill: " GoLang ", Detail: "Backend"},
+		{Skill: "go", Detail: "CLI tools"},
+		{Skill: "Rust  lang", Detail: "Systems"},
+	}
+
+	mockRepo.On("FetchCatalogByKeys", []string{"golang", "go", "rust lang"}).Return([]*models.SkillCatalog{golang}, nil)
+	mockRepo.On("QueueReviews", mock.MatchedBy(func(reviews []*models.SkillReview) bool {
+		return len(reviews) == 1 && reviews[0].NormalizedName == "rust lang" && reviews[0].Name == "Rust  lang"
+	})).Return(nil)
+
+	normalized, err := usecase.NormalizeSkills(skills)
+
+	require.NoError(t, err)
+	require.Len(t, normalized, 2)
+	require.Equal(t, "Go", normalized[0].Skill)
+	require.Equal(t, golang.ID, normalized[0].CatalogID)
+	require.Equal(t, "Backend", normalized[0].Detail)
+	require.Equal(t, "Rust  lang", normalized[1].Skill)
+	require.Nil(t, normalized[1].CatalogID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestNormalizeSkills_QueueErrorIsIgnored(t *testing.T) {
+	mockRepo := new(mocks.SkillRepository)
+	usecase := NewSkillUsecase(mockRepo, time.Minute)
+
+	mockRepo.On("FetchCatalogByKeys", []string{"cobol"}).Return([]*models.SkillCatalog{}, nil)
+	mockRepo.On("QueueReviews", mock.Anything).Return(errors.New("db error"))
+
+	normalized, err := usecase.NormalizeSkills([]*models.Skill{{Skill: "COBOL"}})
+
+	require.NoError(t, err)
+	require.Len(t, normalized, 1)
+}
+
+func TestCreateCatalog_NameConflict(t *testing.T) {
+	mockRepo := new(mocks.SkillRepository)
+	usecase := NewSkillUsecase(mockRepo, time.Minute)
+
+	catalog := &models.SkillCatalog{}
+	catalog.GenUUID()
+	aliases := []string{"golang", "Go"}
+
+	mockRepo.On("FetchCatalogByKeys", []string{"go lang", "golang", "go"}).Return([]*models.SkillCatalog{newCatalog("Go", "golang")}, nil)
+
+	err := usecase.CreateCatalog(catalog, _skill.UpsertSkillCatalog{Name: "Go Lang", Aliases: &aliases})
+
+	require.Equal(t, constants.ErrSkillNameConflict, err)
+	mockRepo.AssertNotCalled(t, "CreateCatalog", mock.Anything)
+}
+
+func TestCreateCatalog_UnknownCategory(t *testing.T) {
+	mockRepo := new(mocks.SkillRepository)
+	usecase := NewSkillUsecase(mockRepo, time.Minute)
+
+	categoryID := ptrUUID()
+	mockRepo.On("FetchCategoryById", categoryID).Return(nil, nil)
+
+	err := usecase.CreateCatalog(&models.SkillCatalog{ID: ptrUUID()}, _skill.UpsertSkillCatalog{
+		Name:       "Go",
+		CategoryId: (*types.UUID)(categoryID),
+	})
+
+	require.Equal(t, constants.ErrUnknownSkillCategory, err)
+}
+
+func TestUpdateCatalog_KeepsOwnKeys(t *testing.T) {
+	mockRepo := new(mocks.SkillRepository)
+	usecase := NewSkillUsecase(mockRepo, time.Minute)
+
+	existing := newCatalog("Go", "golang")
+	aliases := []string{"golang", "go-lang"}
+
+	mockRepo.On("FetchCatalogById", existing.ID).Return(existing, nil)
+	mockRepo.On("FetchCatalogByKeys", []string{"go", "golang", "go-lang"}).Return([]*models.SkillCatalog{existing}, nil)
+	mockRepo.On("UpdateCatalog", existing).Return(nil)
+
+	catalog, err := usecase.UpdateCatalog(existing.ID, _skill.UpsertSkillCatalog{Name: "Go", Aliases: &aliases})
+
+	require.NoError(t, err)
+	require.Len(t, catalog.Aliases, 2)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateCategory_Cycle(t *testing.T) {
+	mockRepo := new(mocks.SkillRepository)
+	usecase := NewSkillUsecase(mockRepo, time.Minute)
+
+	root := &models.SkillCategory{ID: ptrUUID(), Name: "Programming"}
+	child := &models.SkillCategory{ID: ptrUUID(), Name: "Languages", ParentID: root.ID}
+
+	mockRepo.On("FetchCategoryById", root.ID).Return(root, nil)
+	mockRepo.On("FetchCategoryById", child.ID).Return(child, nil)
+
+	category, err := usecase.UpdateCategory(root.ID, _skill.UpsertSkillCategory{
+		Name:     "Programming",
+		ParentId: (*types.UUID)(child.ID),
+	})
+
+	require.Nil(t, category)
+	require.Equal(t, constants.ErrSkillCategoryCycle, err)
+	mockRepo.AssertNotCalled(t, "UpdateCategory", mock.Anything)
+}
+
+func TestCreateCategory_WithParent(t *testing.T) {
+	mockRepo := new(mocks.SkillRepository)
+	usecase := NewSkillUsecase(mockRepo, time.Minute)
+
+	root := &models.SkillCategory{ID: ptrUUID(), Name: "Programming"}
+	category := &models.SkillCategory{}
+	category.GenUUID()
+
+	mockRepo.On("FetchCategoryById", root.ID).Return(root, nil)
+	mockRepo.On("CreateCategory", category).Return(nil)
+
+	err := usecase.CreateCategory(category, _skill.UpsertSkillCategory{
+		Name:     " Languages ",
+		ParentId: (*types.UUID)(root.ID),
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "Languages", category.Name)
+	require.Equal(t, root.ID, category.ParentID)
+}
+
+func TestResolveReview_Alias(t *testing.T) {
+	mockRepo := new(mocks.SkillRepository)
+	usecase := NewSkillUsecase(mockRepo, time.Minute)
+	withTransaction(mockRepo)
+
+	review := &models.SkillReview{ID: ptrUUID(), Name: "Golang", NormalizedName: "golang", Status: models.SkillReviewStatusPending}
+	golang := newCatalog("Go")
+
+	mockRepo.On("FetchReviewById", review.ID).Return(review, nil)
+	mockRepo.On("FetchCatalogById", golang.ID).Return(golang, nil)
+	mockRepo.On("FetchCatalogByKeys", []string{"go", "golang"}).Return([]*models.SkillCatalog{golang}, nil)
+	mockRepo.On("UpdateCatalog", golang).Return(nil)
+	mockRepo.On("UpdateReview", review).Return(nil)
+	mockRepo.On("RemapSkills", []string{"go", "golang"}).Return(int64(3), nil)
+
+	resolved, err := usecase.ResolveReview(review.ID, _skill.ResolveSkillReview{
+		Action:    _skill.Alias,
+		CatalogId: (*types.UUID)(golang.ID),
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, models.SkillReviewStatusApproved, resolved.Status)
+	require.Equal(t, golang.ID, resolved.CatalogID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestResolveReview_Create(t *testing.T) {
+	mockRepo := new(mocks.SkillRepository)
+	usecase := NewSkillUsecase(mockRepo, time.Minute)
+	withTransaction(mockRepo)
+
+	review := &models.SkillReview{ID: ptrUUID(), Name: "k8s", NormalizedName: "k8s", Status: models.SkillReviewStatusPending}
+	name := "Kubernetes"
+
+	mockRepo.On("FetchReviewById", review.ID).Return(review, nil)
+	mockRepo.On("FetchCatalogByKeys", []string{"kubernetes", "k8s"}).Return([]*models.SkillCatalog{}, nil)
+	mockRepo.On("CreateCatalog", mock.MatchedBy(func(c *models.SkillCatalog) bool {
+		return c.Name == "Kubernetes" && len(c.Aliases) == 1 && c.Aliases[0].Alias == "k8s"
+	})).Return(nil)
+	mockRepo.On("UpdateReview", review).Return(nil)
+	mockRepo.On("RemapSkills", []string{"kubernetes", "k8s"}).Return(int64(1), nil)
+
+	resolved, err := usecase.ResolveReview(review.ID, _skill.ResolveSkillReview{Action: _skill.Create, Name: &name})
+
+	require.NoError(t, err)
+	require.Equal(t, models.SkillReviewStatusApproved, resolved.Status)
+	require.NotNil(t, resolved.CatalogID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestResolveReview_Reject(t *testing.T) {
+	mockRepo := new(mocks.SkillRepository)
+	usecase := NewSkillUsecase(mockRepo, time.Minute)
+	withTransaction(mockRepo)
+
+	review := &models.SkillReview{ID: ptrUUID(), Name: "asdf", NormalizedName: "asdf", Status: models.SkillReviewStatusPending}
+
+	mockRepo.On("FetchReviewById", review.ID).Return(review, nil)
+	mockRepo.On("UpdateReview", review).Return(nil)
+
+	resolved, err := usecase.ResolveReview(review.ID, _skill.ResolveSkillReview{Action: _skill.Reject})
+
+	require.NoError(t, err)
+	require.Equal(t, models.SkillReviewStatusRejected, resolved.Status)
+	mockRepo.AssertNotCalled(t, "RemapSkills", mock.Anything)
+}
+
+func TestResolveReview_AlreadyResolved(t *testing.T) {
+	mockRepo := new(mocks.SkillRepository)
+	usecase := NewSkillUsecase(mockRepo, time.Minute)
+	withTransaction(mockRepo)
+
+	review := &models.SkillReview{ID: ptrUUID(), Name: "Golang", Status: models.SkillReviewStatusApproved}
+	mockRepo.On("FetchReviewById", review.ID).Return(review, nil)
+
+	resolved, err := usecase.ResolveReview(review.ID, _skill.ResolveSkillReview{Action: _skill.Reject})
+
+	require.Nil(t, resolved)
+	require.Equal(t, constants.ErrSkillReviewResolved, err)
+}
+
+func TestResolveReview_AliasWithoutCatalog(t *testing.T) {
+	mockRepo := new(mocks.SkillRepository)
+	usecase := NewSkillUsecase(mockRepo, time.Minute)
+	withTransaction(mockRepo)
+
+	review := &models.SkillReview{ID: ptrUUID(), Name: "Golang", Status: models.SkillReviewStatusPending}
+	mockRepo.On("FetchReviewById", review.ID).Return(review, nil)
+
+	_, err := usecase.ResolveReview(review.ID, _skill.ResolveSkillReview{Action: _skill.Alias})
+
+	require.Equal(t, constants.ErrInvalidSkillReviewDecision, err)
+}
@@ -0,0 +1,2 @@
+package tag 
+//go:generate oapi-codegen --config=./server.cfg.yaml ../../../api-spec/tag/openapi_bundle.yml
@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	_tag "github.com/jariwat/p_project/profile-service/service/tag"
+	"github.com/oapi-codegen/runtime/types"
+)
+
+type tagHandler struct {
+	tagUs _tag.TagUsecase
+}
+
+// respondError maps domain errors to their HTTP status.
+func respondError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, constants.ErrProfileNotFound),
+		errors.Is(err, constants.ErrProfileTagNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+	case errors.Is(err, constants.ErrInvalidTag),
+		errors.Is(err, constants.ErrConflictingTags):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// GetProfileIdTags implements tag.ServerInterface.
+func (t *tagHandler) GetProfileIdTags(c *gin.Context, id types.UUID) {
+	var profileId = uuid.FromStringOrNil(id.String())
+
+	tags, err := t.tagUs.FetchProfileTags(&profileId)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, _tag.ProfileTagsResponse{Data: &tags})
+}
+
+// PutProfileIdTagsTag implements tag.ServerInterface.
+func (t *tagHandler) PutProfileIdTagsTag(c *gin.Context, id types.UUID, tag string) {
+	var profileId = uuid.FromStringOrNil(id.String())
+
+	tags, err := t.tagUs.AddProfileTag(&profileId, tag)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, _tag.ProfileTagsResponse{Data: &tags})
+}
+
+// DeleteProfileIdTagsTag implements tag.ServerInterface.
+func (t *tagHandler) DeleteProfileIdTagsTag(c *gin.Context, id types.UUID, tag string) {
+	var profileId = uuid.FromStringOrNil(id.String())
+
+	if err := t.tagUs.RemoveProfileTag(&profileId, tag); err != nil {
+		respondError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, _tag.Success{Message: "Tag removed successfully"})
+}
+
+// GetTags implements tag.ServerInterface.
+func (t *tagHandler) GetTags(c *gin.Context, params _tag.GetTagsParams) {
+	counts, err := t.tagUs.FetchTagCounts(params)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var data []_tag.TagCount
+	bu, err := json.Marshal(counts)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal tags"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &data); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal tags"})
+		return
+	}
+
+	c.JSON(http.StatusOK, _tag.TagCountsResponse{Data: &data})
+}
+
+// PostTagsBulk implements tag.ServerInterface.
+func (t *tagHandler) PostTagsBulk(c *gin.Context) {
+	var request _tag.TagBulkRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid request body"})
+		return
+	}
+
+	result, err := t.tagUs.UpdateProfileTags(request)
+	if err != nil {
+		respondError(c, err)
+		return
+	}
+
+	var response _tag.TagBulkResponse
+	bu, err := json.Marshal(map[string]interface{}{"data": result})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to marshal tag changes"})
+		return
+	}
+
+	if err := json.Unmarshal(bu, &response); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to unmarshal tag changes"})
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+func NewTagHandler(tagUs _tag.TagUsecase) _tag.ServerInterface {
+	return &tagHandler{
+		tagUs: tagUs,
+	}
+}
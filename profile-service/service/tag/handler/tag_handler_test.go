@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	_tag "github.com/jariwat/p_project/profile-service/service/tag"
+	"github.com/jariwat/p_project/profile-service/service/tag/mocks"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPutProfileIdTagsTag(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	id, _ := uuid.NewV4()
+	req, _ := http.NewRequest(http.MethodPut, "/profile/"+id.String()+"/tags/scholarship", nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.TagUsecase)
+	mockUsecase.On("AddProfileTag", &id, "scholarship").Return([]string{"club:robotics", "scholarship"}, nil)
+
+	handler := NewTagHandler(mockUsecase)
+	handler.PutProfileIdTagsTag(c, types.UUID(id), "scholarship")
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp _tag.ProfileTagsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, []string{"club:robotics", "scholarship"}, *resp.Data)
+}
+
+func TestDeleteProfileIdTagsTag_Errors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		err    error
+		status int
+	}{
+		{"profile not found", constants.ErrProfileNotFound, http.StatusNotFound},
+		{"tag not found", constants.ErrProfileTagNotFound, http.StatusNotFound},
+		{"invalid tag", constants.ErrInvalidTag, http.StatusBadRequest},
+		{"database", errors.New("connection refused"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, _ := uuid.NewV4()
+			req, _ := http.NewRequest(http.MethodDelete, "/profile/"+id.String()+"/tags/scholarship", nil)
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+
+			mockUsecase := new(mocks.TagUsecase)
+			mockUsecase.On("RemoveProfileTag", &id, "scholarship").Return(tt.err)
+
+			handler := NewTagHandler(mockUsecase)
+			handler.DeleteProfileIdTagsTag(c, types.UUID(id), "scholarship")
+
+			assert.Equal(t, tt.status, w.Code)
+			assert.Contains(t, w.Body.String(), tt.err.Error())
+		})
+	}
+}
+
+func TestGetTags(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	req, _ := http.NewRequest(http.MethodGet, "/tags", nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.TagUsecase)
+	mockUsecase.On("FetchTagCounts", mock.Anything).
+		Return([]*models.TagCount{{Name: "scholarship", Count: 42}, {Name: "club:robotics", Count: 7}}, nil)
+
+	handler := NewTagHandler(mockUsecase)
+	handler.GetTags(c, _tag.GetTagsParams{})
+
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var resp _tag.TagCountsResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	require.Len(t, *resp.Data, 2)
+	assert.Equal(t, "scholarship", *(*resp.Data)[0].Name)
+	assert.Equal(t, 42, *(*resp.Data)[0].Count)
+}
+
+func TestPostTagsBulk(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	id, _ := uuid.NewV4()
+	body := `{"profile_ids":["` + id.String() + `"],"add":["needs-follow-up"],"remove":["scholarship"]}`
+	req, _ := http.NewRequest(http.MethodPost, "/tags/bulk", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.TagUsecase)
+	mockUsecase.On("UpdateProfileTags", mock.AnythingOfType("tag.TagBulkRequest")).
+		Return(&models.TagBulkResult{Added: 1, Removed: 1}, nil)
+
+	handler := NewTagHandler(mockUsecase)
+	handler.PostTagsBulk(c)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"data":{"added":1,"removed":1}}`, w.Body.String())
+}
+
+func TestPostTagsBulk_Conflicting(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	id, _ := uuid.NewV4()
+	body := `{"profile_ids":["` + id.String() + `"],"add":["scholarship"],"remove":["scholarship"]}`
+	req, _ := http.NewRequest(http.MethodPost, "/tags/bulk", bytes.NewBufferString(body))
+	req.Header.Set("Content-Type", "application/json")
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = req
+
+	mockUsecase := new(mocks.TagUsecase)
+	mockUsecase.On("UpdateProfileTags", mock.Anything).Return(nil, constants.ErrConflictingTags)
+
+	handler := NewTagHandler(mockUsecase)
+	handler.PostTagsBulk(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -0,0 +1,56 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+
+	tag "github.com/jariwat/p_project/profile-service/service/tag"
+
+	uuid "github.com/google/uuid"
+)
+
+// ServerInterface is an autogenerated mock type for the ServerInterface type
+type ServerInterface struct {
+	mock.Mock
+}
+
+// DeleteProfileIdTagsTag provides a mock function with given fields: c, id, _a2
+func (_m *ServerInterface) DeleteProfileIdTagsTag(c *gin.Context, id uuid.UUID, _a2 string) {
+	_m.Called(c, id, _a2)
+}
+
+// GetProfileIdTags provides a mock function with given fields: c, id
+func (_m *ServerInterface) GetProfileIdTags(c *gin.Context, id uuid.UUID) {
+	_m.Called(c, id)
+}
+
+// GetTags provides a mock function with given fields: c, params
+func (_m *ServerInterface) GetTags(c *gin.Context, params tag.GetTagsParams) {
+	_m.Called(c, params)
+}
+
+// PostTagsBulk provides a mock function with given fields: c
+func (_m *ServerInterface) PostTagsBulk(c *gin.Context) {
+	_m.Called(c)
+}
+
+// PutProfileIdTagsTag provides a mock function with given fields: c, id, _a2
+func (_m *ServerInterface) PutProfileIdTagsTag(c *gin.Context, id uuid.UUID, _a2 string) {
+	_m.Called(c, id, _a2)
+}
+
+// NewServerInterface creates a new instance of ServerInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewServerInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ServerInterface {
+	mock := &ServerInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
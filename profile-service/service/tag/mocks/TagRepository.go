@@ -0,0 +1,119 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jariwat/p_project/profile-service/models"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/gofrs/uuid"
+)
+
+// TagRepository is an autogenerated mock type for the TagRepository type
+type TagRepository struct {
+	mock.Mock
+}
+
+// FetchProfileTags provides a mock function with given fields: profileId
+func (_m *TagRepository) FetchProfileTags(profileId *uuid.UUID) ([]string, error) {
+	ret := _m.Called(profileId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchProfileTags")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) ([]string, error)); ok {
+		return rf(profileId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) []string); ok {
+		r0 = rf(profileId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(profileId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchTagCounts provides a mock function with given fields: prefix, limit
+func (_m *TagRepository) FetchTagCounts(prefix string, limit int) ([]*models.TagCount, error) {
+	ret := _m.Called(prefix, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchTagCounts")
+	}
+
+	var r0 []*models.TagCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) ([]*models.TagCount, error)); ok {
+		return rf(prefix, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) []*models.TagCount); ok {
+		r0 = rf(prefix, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.TagCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(prefix, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateProfileTags provides a mock function with given fields: profileIds, add, remove
+func (_m *TagRepository) UpdateProfileTags(profileIds []*uuid.UUID, add []string, remove []string) (*models.TagBulkResult, error) {
+	ret := _m.Called(profileIds, add, remove)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfileTags")
+	}
+
+	var r0 *models.TagBulkResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]*uuid.UUID, []string, []string) (*models.TagBulkResult, error)); ok {
+		return rf(profileIds, add, remove)
+	}
+	if rf, ok := ret.Get(0).(func([]*uuid.UUID, []string, []string) *models.TagBulkResult); ok {
+		r0 = rf(profileIds, add, remove)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TagBulkResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]*uuid.UUID, []string, []string) error); ok {
+		r1 = rf(profileIds, add, remove)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewTagRepository creates a new instance of TagRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagRepository {
+	mock := &TagRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,168 @@
+// Code generated by mockery v2.53.4. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/jariwat/p_project/profile-service/models"
+	tag "github.com/jariwat/p_project/profile-service/service/tag"
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/gofrs/uuid"
+)
+
+// TagUsecase is an autogenerated mock type for the TagUsecase type
+type TagUsecase struct {
+	mock.Mock
+}
+
+// AddProfileTag provides a mock function with given fields: profileId, name
+func (_m *TagUsecase) AddProfileTag(profileId *uuid.UUID, name string) ([]string, error) {
+	ret := _m.Called(profileId, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddProfileTag")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, string) ([]string, error)); ok {
+		return rf(profileId, name)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, string) []string); ok {
+		r0 = rf(profileId, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID, string) error); ok {
+		r1 = rf(profileId, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchProfileTags provides a mock function with given fields: profileId
+func (_m *TagUsecase) FetchProfileTags(profileId *uuid.UUID) ([]string, error) {
+	ret := _m.Called(profileId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchProfileTags")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) ([]string, error)); ok {
+		return rf(profileId)
+	}
+	if rf, ok := ret.Get(0).(func(*uuid.UUID) []string); ok {
+		r0 = rf(profileId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*uuid.UUID) error); ok {
+		r1 = rf(profileId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FetchTagCounts provides a mock function with given fields: params
+func (_m *TagUsecase) FetchTagCounts(params tag.GetTagsParams) ([]*models.TagCount, error) {
+	ret := _m.Called(params)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FetchTagCounts")
+	}
+
+	var r0 []*models.TagCount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(tag.GetTagsParams) ([]*models.TagCount, error)); ok {
+		return rf(params)
+	}
+	if rf, ok := ret.Get(0).(func(tag.GetTagsParams) []*models.TagCount); ok {
+		r0 = rf(params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.TagCount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(tag.GetTagsParams) error); ok {
+		r1 = rf(params)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveProfileTag provides a mock function with given fields: profileId, name
+func (_m *TagUsecase) RemoveProfileTag(profileId *uuid.UUID, name string) error {
+	ret := _m.Called(profileId, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveProfileTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*uuid.UUID, string) error); ok {
+		r0 = rf(profileId, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateProfileTags provides a mock function with given fields: request
+func (_m *TagUsecase) UpdateProfileTags(request tag.TagBulkRequest) (*models.TagBulkResult, error) {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfileTags")
+	}
+
+	var r0 *models.TagBulkResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(tag.TagBulkRequest) (*models.TagBulkResult, error)); ok {
+		return rf(request)
+	}
+	if rf, ok := ret.Get(0).(func(tag.TagBulkRequest) *models.TagBulkResult); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.TagBulkResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(tag.TagBulkRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewTagUsecase creates a new instance of TagUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTagUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TagUsecase {
+	mock := &TagUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
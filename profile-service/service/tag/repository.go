@@ -0,0 +1,12 @@
+package tag
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/models"
+)
+
+type TagRepository interface {
+	FetchProfileTags(profileId *uuid.UUID) ([]string, error)
+	FetchTagCounts(prefix string, limit int) ([]*models.TagCount, error)
+	UpdateProfileTags(profileIds []*uuid.UUID, add []string, remove []string) (*models.TagBulkResult, error)
+}
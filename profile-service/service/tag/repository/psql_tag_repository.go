@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/tag"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	foreignKeyViolationCode = "23503"
+	profileForeignKey       = "profile_tag_profile_id_fkey"
+
+	// profileTagBatchSize keeps the inserts of a bulk change under the parameter limit of postgres
+	profileTagBatchSize = 1000
+)
+
+type tagRepository struct {
+	client *gorm.DB
+}
+
+// translateError maps a profile removed while it was tagged to domain errors.
+func translateError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode && pgErr.ConstraintName == profileForeignKey {
+		return constants.ErrProfileNotFound
+	}
+
+	return err
+}
+
+// FetchProfileTags implements tag.TagRepository.
+func (t *tagRepository) FetchProfileTags(profileId *uuid.UUID) ([]string, error) {
+	tags := make([]string, 0)
+	if err := t.client.Model(&models.Tag{}).
+		Joins("JOIN profile_tag ON profile_tag.tag_id = tag.id").
+		Where("profile_tag.profile_id = ?", profileId).
+		Order("tag.name").
+		Pluck("tag.name", &tags).Error; err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// FetchTagCounts implements tag.TagRepository.
+// Only the tags at least one profile has are counted, the most used first.
+func (t *tagRepository) FetchTagCounts(prefix string, limit int) ([]*models.TagCount, error) {
+	query := t.client.Model(&models.Tag{}).
+		Select("tag.name, COUNT(*) AS count").
+		Joins("JOIN profile_tag ON profile_tag.tag_id = tag.id")
+
+	if prefix != "" {
+		query = query.Where("starts_with(tag.name, ?)", prefix)
+	}
+
+	counts := make([]*models.TagCount, 0)
+	if err := query.Group("tag.name").Order("count DESC, tag.name").Limit(limit).Scan(&counts).Error; err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+// UpdateProfileTags implements tag.TagRepository.
+// The tags are added to and removed from every profile in one transaction,
+// none of them is changed when a profile is not found.
+func (t *tagRepository) UpdateProfileTags(profileIds []*uuid.UUID, add []string, remove []string) (*models.TagBulkResult, error) {
+	result := &models.TagBulkResult{}
+
+	err := t.client.Transaction(func(tx *gorm.DB) error {
+		var found int64
+		if err := tx.Model(&models.Profile{}).Where("id IN ?", profileIds).Count(&found).Error; err != nil {
+			return err
+		}
+		if found != int64(len(profileIds)) {
+			return constants.ErrProfileNotFound
+		}
+
+		if len(add) > 0 {
+			added, err := addProfileTags(tx, profileIds, add)
+			if err != nil {
+				return err
+			}
+			result.Added = added
+		}
+
+		if len(remove) > 0 {
+			removed := tx.Where("profile_id IN ? AND tag_id IN (?)", profileIds,
+				tx.Model(&models.Tag{}).Select("id").Where("name IN ?", remove)).
+				Delete(&models.ProfileTag{})
+			if removed.Error != nil {
+				return removed.Error
+			}
+			result.Removed = removed.RowsAffected
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, translateError(err)
+	}
+
+	return result, nil
+}
+
+// addProfileTags creates the tags not used yet and links them to the profiles
+// that do not have them, returning how many links were created.
+func addProfileTags(tx *gorm.DB, profileIds []*uuid.UUID, names []string) (int64, error) {
+	now := time.Now()
+
+	tags := make([]*models.Tag, 0, len(names))
+	for _, name := range names {
+		tag := &models.Tag{Name: name, CreatedAt: &now}
+		tag.GenUUID()
+		tags = append(tags, tag)
+	}
+	if err := tx.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "name"}}, DoNothing: true}).
+		Create(&tags).Error; err != nil {
+		return 0, err
+	}
+
+	// the tags created by another request are kept, so their ids are read back
+	var tagIds []*uuid.UUID
+	if err := tx.Model(&models.Tag{}).Where("name IN ?", names).Pluck("id", &tagIds).Error; err != nil {
+		return 0, err
+	}
+
+	links := make([]*models.ProfileTag, 0, len(profileIds)*len(tagIds))
+	for _, profileId := range profileIds {
+		for _, tagId := range tagIds {
+			links = append(links, &models.ProfileTag{ProfileID: profileId, TagID: tagId, CreatedAt: &now})
+		}
+	}
+
+	created := tx.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(links, profileTagBatchSize)
+	return created.RowsAffected, created.Error
+}
+
+func NewPsqlTagRepository(client *gorm.DB) tag.TagRepository {
+	return &tagRepository{
+		client: client,
+	}
+}
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/gofrs/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func newMockDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	gormDB, err := gorm.Open(postgres.New(postgres.Config{
+		Conn: db,
+	}), &gorm.Config{})
+	assert.NoError(t, err)
+
+	return gormDB, mock
+}
+
+func TestFetchProfileTags(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlTagRepository(gormDB)
+
+	profileId := ptrUUID()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "tag"."name" FROM "tag" JOIN profile_tag ON profile_tag.tag_id = tag.id WHERE profile_tag.profile_id = $1 ORDER BY tag.name`)).
+		WithArgs(profileId).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("club:robotics").AddRow("scholarship"))
+
+	tags, err := repo.FetchProfileTags(profileId)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"club:robotics", "scholarship"}, tags)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFetchTagCounts(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlTagRepository(gormDB)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT tag.name, COUNT(*) AS count FROM "tag" JOIN profile_tag ON profile_tag.tag_id = tag.id WHERE starts_with(tag.name, $1) GROUP BY "tag"."name" ORDER BY count DESC, tag.name LIMIT $2`)).
+		WithArgs("club:", 20).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "count"}).AddRow("club:robotics", 12).AddRow("club:chess", 4))
+
+	counts, err := repo.FetchTagCounts("club:", 20)
+	assert.NoError(t, err)
+	assert.Equal(t, []*models.TagCount{{Name: "club:robotics", Count: 12}, {Name: "club:chess", Count: 4}}, counts)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateProfileTags(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlTagRepository(gormDB)
+
+	firstId, secondId, tagId := ptrUUID(), ptrUUID(), ptrUUID()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "profile" WHERE id IN ($1,$2)`)).
+		WithArgs(firstId, secondId).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "tag" ("id","name","created_at") VALUES ($1,$2,$3) ON CONFLICT ("name") DO NOTHING`)).
+		WithArgs(sqlmock.AnyArg(), "needs-follow-up", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "tag" WHERE name IN ($1)`)).
+		WithArgs("needs-follow-up").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(tagId.String()))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "profile_tag" ("profile_id","tag_id","created_at") VALUES ($1,$2,$3),($4,$5,$6) ON CONFLICT DO NOTHING`)).
+		WithArgs(firstId, tagId, sqlmock.AnyArg(), secondId, tagId, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM "profile_tag" WHERE profile_id IN ($1,$2) AND tag_id IN (SELECT "id" FROM "tag" WHERE name IN ($3))`)).
+		WithArgs(firstId, secondId, "scholarship").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	result, err := repo.UpdateProfileTags([]*uuid.UUID{firstId, secondId}, []string{"needs-follow-up"}, []string{"scholarship"})
+	require.NoError(t, err)
+	assert.Equal(t, &models.TagBulkResult{Added: 1, Removed: 2}, result)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateProfileTags_ProfileNotFound(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlTagRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "profile" WHERE id IN ($1,$2)`)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectRollback()
+
+	_, err := repo.UpdateProfileTags([]*uuid.UUID{ptrUUID(), ptrUUID()}, []string{"scholarship"}, nil)
+	assert.ErrorIs(t, err, constants.ErrProfileNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateProfileTags_ProfileDeleted(t *testing.T) {
+	gormDB, mock := newMockDB(t)
+	repo := NewPsqlTagRepository(gormDB)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT count(*) FROM "profile" WHERE id IN ($1)`)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "tag"`)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT "id" FROM "tag"`)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(ptrUUID().String()))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO "profile_tag"`)).
+		WillReturnError(&pgconn.PgError{Code: "23503", ConstraintName: "profile_tag_profile_id_fkey"})
+	mock.ExpectRollback()
+
+	_, err := repo.UpdateProfileTags([]*uuid.UUID{ptrUUID()}, []string{"scholarship"}, nil)
+	assert.ErrorIs(t, err, constants.ErrProfileNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
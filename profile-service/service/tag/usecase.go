@@ -0,0 +1,14 @@
+package tag
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/models"
+)
+
+type TagUsecase interface {
+	FetchProfileTags(profileId *uuid.UUID) ([]string, error)
+	AddProfileTag(profileId *uuid.UUID, name string) ([]string, error)
+	RemoveProfileTag(profileId *uuid.UUID, name string) error
+	FetchTagCounts(params GetTagsParams) ([]*models.TagCount, error)
+	UpdateProfileTags(request TagBulkRequest) (*models.TagBulkResult, error)
+}
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	"github.com/jariwat/p_project/profile-service/service/profile"
+	"github.com/jariwat/p_project/profile-service/service/tag"
+)
+
+// defaultTagLimit is how many tags GET /tags lists without limit
+const defaultTagLimit = 50
+
+type tagUsecase struct {
+	tagRepo   tag.TagRepository
+	profileUs profile.ProfileUsecase
+}
+
+// FetchProfileTags implements tag.TagUsecase.
+func (t *tagUsecase) FetchProfileTags(profileId *uuid.UUID) ([]string, error) {
+	found, err := t.profileUs.FetchProfileById(profileId)
+	if err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		return nil, constants.ErrProfileNotFound
+	}
+
+	return t.tagRepo.FetchProfileTags(profileId)
+}
+
+// AddProfileTag implements tag.TagUsecase.
+// It returns the tags of the profile once the tag is added.
+func (t *tagUsecase) AddProfileTag(profileId *uuid.UUID, name string) ([]string, error) {
+	normalized, ok := models.NormalizeTag(name)
+	if !ok {
+		return nil, constants.ErrInvalidTag
+	}
+
+	if _, err := t.tagRepo.UpdateProfileTags([]*uuid.UUID{profileId}, []string{normalized}, nil); err != nil {
+		return nil, err
+	}
+
+	return t.tagRepo.FetchProfileTags(profileId)
+}
+
+// RemoveProfileTag implements tag.TagUsecase.
+func (t *tagUsecase) RemoveProfileTag(profileId *uuid.UUID, name string) error {
+	normalized, ok := models.NormalizeTag(name)
+	if !ok {
+		return constants.ErrInvalidTag
+	}
+
+	result, err := t.tagRepo.UpdateProfileTags([]*uuid.UUID{profileId}, nil, []string{normalized})
+	if err != nil {
+		return err
+	}
+
+	if result.Removed == 0 {
+		return constants.ErrProfileTagNotFound
+	}
+
+	return nil
+}
+
+// FetchTagCounts implements tag.TagUsecase.
+func (t *tagUsecase) FetchTagCounts(params tag.GetTagsParams) ([]*models.TagCount, error) {
+	limit := defaultTagLimit
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	var prefix string
+	if params.Prefix != nil && *params.Prefix != "" {
+		normalized, ok := models.NormalizeTag(*params.Prefix)
+		if !ok {
+			// no tag starts with what is not a tag
+			return make([]*models.TagCount, 0), nil
+		}
+		prefix = normalized
+	}
+
+	return t.tagRepo.FetchTagCounts(prefix, limit)
+}
+
+// UpdateProfileTags implements tag.TagUsecase.
+func (t *tagUsecase) UpdateProfileTags(request tag.TagBulkRequest) (*models.TagBulkResult, error) {
+	var add, remove []string
+	var ok bool
+	if request.Add != nil {
+		if add, ok = models.NormalizeTags(*request.Add); !ok {
+			return nil, constants.ErrInvalidTag
+		}
+	}
+	if request.Remove != nil {
+		if remove, ok = models.NormalizeTags(*request.Remove); !ok {
+			return nil, constants.ErrInvalidTag
+		}
+	}
+
+	added := make(map[string]bool, len(add))
+	for _, name := range add {
+		added[name] = true
+	}
+	for _, name := range remove {
+		if added[name] {
+			return nil, constants.ErrConflictingTags
+		}
+	}
+
+	// a profile listed twice is still changed once
+	profileIds := make([]*uuid.UUID, 0, len(request.ProfileIds))
+	seen := make(map[uuid.UUID]bool, len(request.ProfileIds))
+	for i := range request.ProfileIds {
+		profileId := uuid.FromStringOrNil(request.ProfileIds[i].String())
+		if !seen[profileId] {
+			seen[profileId] = true
+			profileIds = append(profileIds, &profileId)
+		}
+	}
+
+	return t.tagRepo.UpdateProfileTags(profileIds, add, remove)
+}
+
+func NewTagUsecase(tagRepo tag.TagRepository, profileUs profile.ProfileUsecase) tag.TagUsecase {
+	return &tagUsecase{
+		tagRepo:   tagRepo,
+		profileUs: profileUs,
+	}
+}
@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/jariwat/p_project/profile-service/constants"
+	"github.com/jariwat/p_project/profile-service/models"
+	profileMocks "github.com/jariwat/p_project/profile-service/service/profile/mocks"
+	_tag "github.com/jariwat/p_project/profile-service/service/tag"
+	"github.com/jariwat/p_project/profile-service/service/tag/mocks"
+	"github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func ptrUUID() *uuid.UUID {
+	id, _ := uuid.NewV4()
+	return &id
+}
+
+func TestFetchProfileTags_ProfileNotFound(t *testing.T) {
+	profileId := ptrUUID()
+	mockRepo := new(mocks.TagRepository)
+	mockProfileUs := new(profileMocks.ProfileUsecase)
+	mockProfileUs.On("FetchProfileById", profileId).Return(nil, nil)
+
+	usecase := NewTagUsecase(mockRepo, mockProfileUs)
+	_, err := usecase.FetchProfileTags(profileId)
+
+	assert.ErrorIs(t, err, constants.ErrProfileNotFound)
+	mockRepo.AssertNotCalled(t, "FetchProfileTags", mock.Anything)
+}
+
+func TestAddProfileTag(t *testing.T) {
+	profileId := ptrUUID()
+	mockRepo := new(mocks.TagRepository)
+	mockRepo.On("UpdateProfileTags", []*uuid.UUID{profileId}, []string{"needs-follow-up"}, []string(nil)).
+		Return(&models.TagBulkResult{Added: 1}, nil)
+	mockRepo.On("FetchProfileTags", profileId).Return([]string{"needs-follow-up", "scholarship"}, nil)
+
+	usecase := NewTagUsecase(mockRepo, new(profileMocks.ProfileUsecase))
+	tags, err := usecase.AddProfileTag(profileId, "  Needs Follow  Up ")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"needs-follow-up", "scholarship"}, tags)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestAddProfileTag_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		tag  string
+	}{
+		{"empty", "   "},
+		{"punctuation", "scholarship!"},
+		{"leading separator", "-robotics"},
+		{"too long", "a123456789012345678901234567890123456789012345678901"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(mocks.TagRepository)
+
+			usecase := NewTagUsecase(mockRepo, new(profileMocks.ProfileUsecase))
+			_, err := usecase.AddProfileTag(ptrUUID(), tt.tag)
+
+			assert.ErrorIs(t, err, constants.ErrInvalidTag)
+			mockRepo.AssertNotCalled(t, "UpdateProfileTags", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestRemoveProfileTag_NotFound(t *testing.T) {
+	profileId := ptrUUID()
+	mockRepo := new(mocks.TagRepository)
+	mockRepo.On("UpdateProfileTags", []*uuid.UUID{profileId}, []string(nil), []string{"ทุนการศึกษา"}).
+		Return(&models.TagBulkResult{}, nil)
+
+	usecase := NewTagUsecase(mockRepo, new(profileMocks.ProfileUsecase))
+	err := usecase.RemoveProfileTag(profileId, "ทุนการศึกษา")
+
+	assert.ErrorIs(t, err, constants.ErrProfileTagNotFound)
+}
+
+func TestFetchTagCounts(t *testing.T) {
+	mockRepo := new(mocks.TagRepository)
+	mockRepo.On("FetchTagCounts", "club:", 50).Return([]*models.TagCount{{Name: "club:robotics", Count: 3}}, nil)
+
+	usecase := NewTagUsecase(mockRepo, new(profileMocks.ProfileUsecase))
+	prefix := "Club:"
+	counts, err := usecase.FetchTagCounts(_tag.GetTagsParams{Prefix: &prefix})
+
+	require.NoError(t, err)
+	assert.Len(t, counts, 1)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateProfileTags(t *testing.T) {
+	first, second := ptrUUID(), ptrUUID()
+	mockRepo := new(mocks.TagRepository)
+	mockRepo.On("UpdateProfileTags", []*uuid.UUID{first, second}, []string{"club:robotics"}, []string{"scholarship"}).
+		Return(&models.TagBulkResult{Added: 2, Removed: 1}, nil)
+
+	usecase := NewTagUsecase(mockRepo, new(profileMocks.ProfileUsecase))
+	result, err := usecase.UpdateProfileTags(_tag.TagBulkRequest{
+		ProfileIds: []types.UUID{types.UUID(*first), types.UUID(*second), types.UUID(*first)},
+		Add:        &[]string{"club:robotics", "Club:Robotics"},
+		Remove:     &[]string{"scholarship"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, &models.TagBulkResult{Added: 2, Removed: 1}, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateProfileTags_Conflicting(t *testing.T) {
+	mockRepo := new(mocks.TagRepository)
+
+	usecase := NewTagUsecase(mockRepo, new(profileMocks.ProfileUsecase))
+	_, err := usecase.UpdateProfileTags(_tag.TagBulkRequest{
+		ProfileIds: []types.UUID{types.UUID(*ptrUUID())},
+		Add:        &[]string{"Scholarship"},
+		Remove:     &[]string{"scholarship"},
+	})
+
+	assert.ErrorIs(t, err, constants.ErrConflictingTags)
+	mockRepo.AssertNotCalled(t, "UpdateProfileTags", mock.Anything, mock.Anything, mock.Anything)
+}